@@ -9,22 +9,38 @@ import (
 	"crypto/x509"
 	"errors"
 	"fmt"
+	"net"
+	"net/http"
+	"strconv"
 
 	loads "github.com/go-openapi/loads"
 	flags "github.com/jessevdk/go-flags"
 
+	"github.com/jianqiu/vps/controllers"
 	"github.com/jianqiu/vps/db"
 	"github.com/jianqiu/vps/db/sqldb"
+	"github.com/jianqiu/vps/grpcapi"
+	"github.com/jianqiu/vps/leader"
 	"github.com/jianqiu/vps/migration"
+	"github.com/jianqiu/vps/registration"
+	"github.com/jianqiu/vps/reaper"
+	"github.com/jianqiu/vps/replenisher"
+	"github.com/jianqiu/vps/reservations"
 	"github.com/jianqiu/vps/restapi/operations"
+	"github.com/jianqiu/vps/settings"
+	"github.com/jianqiu/vps/softlayer"
 	"github.com/jianqiu/vps/vpslager"
+	"github.com/jianqiu/vps/webhooks"
 	"github.com/go-sql-driver/mysql"
+	"github.com/nu7hatch/gouuid"
 	"github.com/tedsuo/ifrit"
 	"github.com/tedsuo/ifrit/grouper"
 	"github.com/tedsuo/ifrit/sigmon"
+	"google.golang.org/grpc/credentials"
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/consuladapter"
 
 	"github.com/jianqiu/vps/restapi"
 )
@@ -40,7 +56,6 @@ func main() {
 
 	api := operations.NewSoftLayerVMPoolAPI(swaggerSpec)
 	server := restapi.NewServer(api)
-	defer server.Shutdown()
 
 	parser := flags.NewParser(server, flags.Default)
 	parser.ShortDescription = `SoftLayer VM Pool`
@@ -54,7 +69,12 @@ func main() {
 		}
 	}
 
-	if _, err := parser.Parse(); err != nil {
+	args, err := restapi.ConfigFileArgs(parser, os.Args[1:])
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	if _, err := parser.ParseArgs(args); err != nil {
 		code := 1
 		if fe, ok := err.(*flags.Error); ok {
 			if fe.Type == flags.ErrHelp {
@@ -64,7 +84,12 @@ func main() {
 		os.Exit(code)
 	}
 
-	logger, _ := vpslager.New("vps", server.LogLevel)
+	if err := server.Validate(); err != nil {
+		log.Fatalln(err)
+	}
+
+	logger, sink := vpslager.New("vps", server.LogLevel)
+	logLevel := controllers.NewLogLevelController(sink)
 	logger.Info("starting-migration")
 
 	clock := clock.NewClock()
@@ -90,7 +115,18 @@ func main() {
 			logger.Fatal("sql-failed-to-connect", err)
 		}
 
-		sqlDB = sqldb.NewSQLDB(sqlConn, clock, server.DBDriver)
+		isolationLevels, err := sqldb.ParseIsolationLevels(server.DBIsolationLevels)
+		if err != nil {
+			logger.Fatal("invalid-isolation-level", err)
+		}
+
+		sqlDB = sqldb.NewSQLDB(sqlConn, clock, server.DBDriver, sqldb.RetryPolicy{
+			MaxAttempts:    server.DBRetryAttempts,
+			InitialBackoff: server.DBRetryInitialBackoff,
+			MaxBackoff:     server.DBRetryMaxBackoff,
+			Jitter:         server.DBRetryJitter,
+			Isolation:      isolationLevels,
+		})
 		err = sqlDB.CreateConfigurationsTable(logger)
 		if err != nil {
 			logger.Fatal("sql-failed-create-configurations-table", err)
@@ -102,6 +138,24 @@ func main() {
 		logger.Fatal("no-database-configured", errors.New("no database configured"))
 	}
 
+	keys, err := server.KeyManager()
+	if err != nil {
+		logger.Fatal("invalid-encryption-keys", err)
+	}
+	secrets := controllers.NewSecretController(activeDB, activeDB, keys)
+
+	// Re-encrypting is a command of its own: it needs a database whose
+	// migrations a server already ran, and exits once every secret is
+	// encrypted with the active key.
+	if server.ReEncrypt {
+		count, err := secrets.ReEncrypt(logger)
+		if err != nil {
+			logger.Fatal("failed-to-re-encrypt-vm-secrets", err)
+		}
+		logger.Info("re-encrypted-vm-secrets", lager.Data{"count": count})
+		return
+	}
+
 	migrationsDone := make(chan struct{})
 
 	migrationManager := migration.NewManager(
@@ -113,20 +167,161 @@ func main() {
 		server.DBDriver,
 	)
 
+	deliverer := webhooks.NewDeliverer(
+		logger,
+		activeDB,
+		&http.Client{Timeout: server.WebhookTimeout},
+		clock,
+		server.WebhookDeliveryInterval,
+		webhooks.RetryPolicy{
+			MaxAttempts:    server.WebhookMaxAttempts,
+			InitialBackoff: server.WebhookInitialBackoff,
+			MaxBackoff:     server.WebhookMaxBackoff,
+		},
+	)
+
+	lockOwner := server.LockOwner
+	if lockOwner == "" {
+		lockOwner = defaultLockOwner(logger)
+	}
+
+	// Background jobs run on a single server at a time, the one holding the
+	// job's lock.
+	singleton := func(name string, runner ifrit.Runner) grouper.Member {
+		return grouper.Member{
+			Name:   name,
+			Runner: leader.NewRunner(logger, activeDB, clock, name, lockOwner, server.LockTTL, server.LockRetryInterval, runner),
+		}
+	}
+
+	settingsStore := settings.NewStore(logger, activeDB, clock, server.SettingsPollInterval)
+
+	// The api only starts serving once the migrations are done and the
+	// settings are read, and stops, draining the requests in flight, before
+	// the database is closed.
 	members := grouper.Members{
-		{"migration-manager", migrationManager},
+		{Name: "migration-manager", Runner: migrationManager},
+		{Name: "settings", Runner: settingsStore},
+		{Name: "api", Runner: server},
+		{Name: "config-reloader", Runner: newConfigReloader(logger, os.Args[1:], logLevel)},
+		singleton("webhook-deliverer", deliverer),
+		singleton("reservation-scheduler",
+			reservations.NewScheduler(logger, activeDB, clock, server.ReservationInterval),
+		),
 	}
 
-	group := grouper.NewOrdered(os.Interrupt, members)
+	softLayerClient := softlayer.NewSoftLayerClient(
+		server.SoftLayerEndpoint,
+		server.SoftLayerUsername,
+		server.SoftLayerAPIKey,
+		nil,
+	)
 
-	monitor := ifrit.Invoke(sigmon.New(group))
+	if server.ReplenishmentPolicyFile != "" {
+		policies, err := replenisher.LoadPolicies(server.ReplenishmentPolicyFile)
+		if err != nil {
+			logger.Fatal("failed-to-load-replenishment-policies", err)
+		}
 
-	server.ConfigureAPI(logger, activeDB)
+		provider := replenisher.NewSoftLayerProvider(softLayerClient, softlayer.VirtualGuestTemplate{
+			Hostname:        server.SoftLayerHostnamePrefix,
+			Domain:          server.SoftLayerDomain,
+			Datacenter:      server.SoftLayerDatacenter,
+			OSReferenceCode: server.SoftLayerOSReferenceCode,
+			HourlyBilling:   server.SoftLayerHourlyBilling,
+		})
+		members = append(members, singleton("replenisher",
+			replenisher.NewReplenisher(logger, activeDB, provider, clock, server.ReplenishInterval, server.ReplenishOrderTimeout, policies),
+		))
+	}
 
-	if err := server.Serve(); err != nil {
-		log.Fatalln(err)
+	reaperPolicies := []reaper.Policy{}
+	if server.ReaperPolicyFile != "" {
+		reaperPolicies, err = reaper.LoadPolicies(server.ReaperPolicyFile)
+		if err != nil {
+			logger.Fatal("failed-to-load-reaper-policies", err)
+		}
+	}
+
+	vmReaper := reaper.NewReaper(logger, activeDB, softLayerClient, clock, server.ReapInterval, reaperPolicies)
+	if server.ReaperPolicyFile != "" {
+		members = append(members, singleton("reaper", vmReaper))
+	}
+
+	var reloader controllers.OSReloader
+	if server.ReleaseMode == "reload" {
+		reloader = controllers.NewOSReloader(
+			logger,
+			activeDB,
+			softLayerClient,
+			clock,
+			server.ReloadPollInterval,
+			server.ReloadTimeout,
+		)
+		members = append(members, singleton("os-reloader", reloader))
+	}
+
+	health := controllers.NewHealthController(activeDB, sqlConn, clock, server.DBDriver, migrationsDone)
+
+	server.ConfigureAPI(logger, activeDB, reloader, vmReaper, health, logLevel, settingsStore, secrets)
+
+	if server.GRPCPort != 0 {
+		var grpcCreds credentials.TransportCredentials
+		if !server.GRPCInsecure {
+			grpcCreds, err = credentials.NewServerTLSFromFile(string(server.TLSCertificate), string(server.TLSCertificateKey))
+			if err != nil {
+				logger.Fatal("failed-to-load-grpc-tls-credentials", err)
+			}
+		}
+
+		members = append(members, grouper.Member{
+			Name: "grpc-api",
+			Runner: grpcapi.NewRunner(
+				logger,
+				net.JoinHostPort(server.Host, strconv.Itoa(server.GRPCPort)),
+				grpcCreds,
+				grpcapi.NewVMPoolServer(
+					logger,
+					controllers.NewVirtualGuestController(activeDB, activeDB, settingsStore, server.StrictFlavors, reloader),
+					grpcapi.Authenticator(restapi.BasicAuth(logger, server.AdminCredentials(), controllers.NewPoolController(activeDB))),
+					activeDB,
+					clock,
+					server.GRPCWatchInterval,
+				),
+			),
+		})
+	}
+
+	// Registered last, the api leaves Consul before it stops serving.
+	if server.ConsulCluster != "" {
+		consulClient, err := consuladapter.NewClientFromUrl(server.ConsulCluster)
+		if err != nil {
+			logger.Fatal("failed-to-create-consul-client", err)
+		}
+
+		// listen first, so that the registration has the ports
+		err = server.Listen()
+		if err != nil {
+			logger.Fatal("failed-to-listen", err)
+		}
+
+		members = append(members, grouper.Member{
+			Name: "consul-registration",
+			Runner: registration.NewRegistrar(
+				logger,
+				consulClient,
+				server.ConsulRegistration(server.ConsulServiceName+"-"+lockOwner),
+				health,
+				clock,
+				server.ConsulCheckTTL,
+			),
+		})
 	}
 
+	group := grouper.NewOrdered(os.Interrupt, members)
+
+	monitor := ifrit.Invoke(sigmon.New(group))
+
 	logger.Info("started")
 
 	err = <-monitor.Wait()
@@ -139,6 +334,21 @@ func main() {
 	}
 }
 
+func defaultLockOwner(logger lager.Logger) string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		logger.Error("failed-to-get-hostname", err)
+		hostname = "vps"
+	}
+
+	guid, err := uuid.NewV4()
+	if err != nil {
+		logger.Fatal("failed-to-generate-lock-owner", err)
+	}
+
+	return hostname + "-" + guid.String()[:8]
+}
+
 func appendSSLConnectionStringParam(logger lager.Logger, driverName, databaseConnectionString, sqlCACertFile string) string {
 	switch driverName {
 	case "mysql":
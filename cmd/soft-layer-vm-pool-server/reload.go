@@ -0,0 +1,67 @@
+package main
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+
+	"code.cloudfoundry.org/lager"
+	flags "github.com/jessevdk/go-flags"
+	"github.com/tedsuo/ifrit"
+
+	"github.com/jianqiu/vps/controllers"
+	"github.com/jianqiu/vps/restapi"
+)
+
+// newConfigReloader reads the config file and the command line args again
+// on every SIGHUP and applies the settings that can change while the server
+// runs. Only the log level can; every other setting keeps the value the
+// server started with. A config that fails to parse or validate is logged
+// and changes nothing.
+func newConfigReloader(logger lager.Logger, args []string, logLevel *controllers.LogLevelController) ifrit.Runner {
+	return ifrit.RunFunc(func(signals <-chan os.Signal, ready chan<- struct{}) error {
+		logger := logger.Session("config-reloader")
+
+		hangups := make(chan os.Signal, 1)
+		signal.Notify(hangups, syscall.SIGHUP)
+		defer signal.Stop(hangups)
+
+		close(ready)
+
+		for {
+			select {
+			case <-hangups:
+				reloadConfig(logger, args, logLevel)
+			case <-signals:
+				return nil
+			}
+		}
+	})
+}
+
+func reloadConfig(logger lager.Logger, args []string, logLevel *controllers.LogLevelController) {
+	logger = logger.Session("reload")
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	// a server of its own, the running one goes on reading its settings
+	server := restapi.NewServer(nil)
+	parser := flags.NewParser(server, flags.PassDoubleDash|flags.IgnoreUnknown)
+
+	args, err := restapi.ConfigFileArgs(parser, args)
+	if err == nil {
+		_, err = parser.ParseArgs(args)
+	}
+	if err == nil {
+		err = server.Validate()
+	}
+	if err != nil {
+		logger.Error("failed-to-reload-config", err)
+		return
+	}
+
+	err = logLevel.SetLogLevel(logger, server.LogLevel)
+	if err != nil {
+		logger.Error("failed-to-set-log-level", err)
+	}
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"syscall"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/tedsuo/ifrit"
+
+	"github.com/jianqiu/vps/controllers"
+)
+
+var _ = Describe("Config reloading", func() {
+	var (
+		logger     *lagertest.TestLogger
+		sink       *lager.ReconfigurableSink
+		logLevel   *controllers.LogLevelController
+		dir        string
+		configPath string
+		args       []string
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		sink = lager.NewReconfigurableSink(lager.NewWriterSink(GinkgoWriter, lager.DEBUG), lager.INFO)
+		logLevel = controllers.NewLogLevelController(sink)
+
+		var err error
+		dir, err = ioutil.TempDir("", "vps-reload")
+		Expect(err).NotTo(HaveOccurred())
+		configPath = filepath.Join(dir, "vps.yml")
+		args = []string{"--config", configPath, "--databaseDriver", "mysql"}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	writeConfig := func(content string) {
+		Expect(ioutil.WriteFile(configPath, []byte(content), 0600)).To(Succeed())
+	}
+
+	Describe("reloadConfig", func() {
+		It("applies the log level of the config file", func() {
+			writeConfig("databaseConnectionString: vps:secret@/vps\nlogLevel: error\n")
+			reloadConfig(logger, args, logLevel)
+			Expect(sink.GetMinLevel()).To(Equal(lager.ERROR))
+		})
+
+		It("lets the command line win", func() {
+			writeConfig("databaseConnectionString: vps:secret@/vps\nlogLevel: error\n")
+			reloadConfig(logger, append(args, "--logLevel", "debug"), logLevel)
+			Expect(sink.GetMinLevel()).To(Equal(lager.DEBUG))
+		})
+
+		It("changes nothing when the config does not validate", func() {
+			writeConfig("logLevel: error\n")
+			reloadConfig(logger, args, logLevel)
+			Expect(sink.GetMinLevel()).To(Equal(lager.INFO))
+			Expect(logger).To(gbytes.Say("failed-to-reload-config"))
+		})
+
+		It("changes nothing when the config file cannot be read", func() {
+			reloadConfig(logger, args, logLevel)
+			Expect(sink.GetMinLevel()).To(Equal(lager.INFO))
+			Expect(logger).To(gbytes.Say("failed-to-reload-config"))
+		})
+
+		It("changes nothing when a switch is set to false in the file", func() {
+			writeConfig("databaseConnectionString: vps:secret@/vps\nlogLevel: error\nstrictFlavors: false\n")
+			reloadConfig(logger, args, logLevel)
+			Expect(sink.GetMinLevel()).To(Equal(lager.ERROR))
+		})
+	})
+
+	Describe("newConfigReloader", func() {
+		var process ifrit.Process
+
+		BeforeEach(func() {
+			writeConfig("databaseConnectionString: vps:secret@/vps\nlogLevel: error\n")
+			process = ifrit.Invoke(newConfigReloader(logger, args, logLevel))
+		})
+
+		AfterEach(func() {
+			process.Signal(os.Interrupt)
+			Eventually(process.Wait()).Should(Receive(BeNil()))
+		})
+
+		It("reloads the config on SIGHUP", func() {
+			Consistently(sink.GetMinLevel).Should(Equal(lager.INFO))
+
+			Expect(syscall.Kill(os.Getpid(), syscall.SIGHUP)).To(Succeed())
+			Eventually(sink.GetMinLevel).Should(Equal(lager.ERROR))
+		})
+	})
+})
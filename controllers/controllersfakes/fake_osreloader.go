@@ -0,0 +1,113 @@
+// This file was generated by counterfeiter
+package controllersfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/controllers"
+	"os"
+)
+
+type FakeOSReloader struct {
+	RunStub        func(signals <-chan os.Signal, ready chan<- struct{}) error
+	runMutex       sync.RWMutex
+	runArgsForCall []struct {
+		signals <-chan os.Signal
+		ready   chan<- struct{}
+	}
+	runReturns struct {
+		result1 error
+	}
+	ReloadStub        func(logger lager.Logger, cid int32)
+	reloadMutex       sync.RWMutex
+	reloadArgsForCall []struct {
+		logger lager.Logger
+		cid    int32
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeOSReloader) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	fake.runMutex.Lock()
+	fake.runArgsForCall = append(fake.runArgsForCall, struct {
+		signals <-chan os.Signal
+		ready   chan<- struct{}
+	}{signals, ready})
+	fake.recordInvocation("Run", []interface{}{signals, ready})
+	fake.runMutex.Unlock()
+	if fake.RunStub != nil {
+		return fake.RunStub(signals, ready)
+	} else {
+		return fake.runReturns.result1
+	}
+}
+
+func (fake *FakeOSReloader) RunCallCount() int {
+	fake.runMutex.RLock()
+	defer fake.runMutex.RUnlock()
+	return len(fake.runArgsForCall)
+}
+
+func (fake *FakeOSReloader) RunArgsForCall(i int) (<-chan os.Signal, chan<- struct{}) {
+	fake.runMutex.RLock()
+	defer fake.runMutex.RUnlock()
+	return fake.runArgsForCall[i].signals, fake.runArgsForCall[i].ready
+}
+
+func (fake *FakeOSReloader) RunReturns(result1 error) {
+	fake.RunStub = nil
+	fake.runReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeOSReloader) Reload(logger lager.Logger, cid int32) {
+	fake.reloadMutex.Lock()
+	fake.reloadArgsForCall = append(fake.reloadArgsForCall, struct {
+		logger lager.Logger
+		cid    int32
+	}{logger, cid})
+	fake.recordInvocation("Reload", []interface{}{logger, cid})
+	fake.reloadMutex.Unlock()
+	if fake.ReloadStub != nil {
+		fake.ReloadStub(logger, cid)
+	}
+}
+
+func (fake *FakeOSReloader) ReloadCallCount() int {
+	fake.reloadMutex.RLock()
+	defer fake.reloadMutex.RUnlock()
+	return len(fake.reloadArgsForCall)
+}
+
+func (fake *FakeOSReloader) ReloadArgsForCall(i int) (lager.Logger, int32) {
+	fake.reloadMutex.RLock()
+	defer fake.reloadMutex.RUnlock()
+	return fake.reloadArgsForCall[i].logger, fake.reloadArgsForCall[i].cid
+}
+
+func (fake *FakeOSReloader) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.runMutex.RLock()
+	defer fake.runMutex.RUnlock()
+	fake.reloadMutex.RLock()
+	defer fake.reloadMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeOSReloader) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ controllers.OSReloader = new(FakeOSReloader)
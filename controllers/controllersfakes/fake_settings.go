@@ -0,0 +1,104 @@
+// This file was generated by counterfeiter
+package controllersfakes
+
+import (
+	"sync"
+
+	"github.com/jianqiu/vps/controllers"
+	"github.com/jianqiu/vps/models"
+)
+
+type FakeSettings struct {
+	MaintenanceModeStub        func() bool
+	maintenanceModeMutex       sync.RWMutex
+	maintenanceModeArgsForCall []struct {
+	}
+	maintenanceModeReturns struct {
+		result1 bool
+	}
+	DefaultFilterStub        func() models.VMFilter
+	defaultFilterMutex       sync.RWMutex
+	defaultFilterArgsForCall []struct {
+	}
+	defaultFilterReturns struct {
+		result1 models.VMFilter
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeSettings) MaintenanceMode() bool {
+	fake.maintenanceModeMutex.Lock()
+	fake.maintenanceModeArgsForCall = append(fake.maintenanceModeArgsForCall, struct {
+	}{})
+	fake.recordInvocation("MaintenanceMode", []interface{}{})
+	fake.maintenanceModeMutex.Unlock()
+	if fake.MaintenanceModeStub != nil {
+		return fake.MaintenanceModeStub()
+	} else {
+		return fake.maintenanceModeReturns.result1
+	}
+}
+
+func (fake *FakeSettings) MaintenanceModeCallCount() int {
+	fake.maintenanceModeMutex.RLock()
+	defer fake.maintenanceModeMutex.RUnlock()
+	return len(fake.maintenanceModeArgsForCall)
+}
+
+func (fake *FakeSettings) MaintenanceModeReturns(result1 bool) {
+	fake.MaintenanceModeStub = nil
+	fake.maintenanceModeReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeSettings) DefaultFilter() models.VMFilter {
+	fake.defaultFilterMutex.Lock()
+	fake.defaultFilterArgsForCall = append(fake.defaultFilterArgsForCall, struct {
+	}{})
+	fake.recordInvocation("DefaultFilter", []interface{}{})
+	fake.defaultFilterMutex.Unlock()
+	if fake.DefaultFilterStub != nil {
+		return fake.DefaultFilterStub()
+	} else {
+		return fake.defaultFilterReturns.result1
+	}
+}
+
+func (fake *FakeSettings) DefaultFilterCallCount() int {
+	fake.defaultFilterMutex.RLock()
+	defer fake.defaultFilterMutex.RUnlock()
+	return len(fake.defaultFilterArgsForCall)
+}
+
+func (fake *FakeSettings) DefaultFilterReturns(result1 models.VMFilter) {
+	fake.DefaultFilterStub = nil
+	fake.defaultFilterReturns = struct {
+		result1 models.VMFilter
+	}{result1}
+}
+
+func (fake *FakeSettings) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.maintenanceModeMutex.RLock()
+	defer fake.maintenanceModeMutex.RUnlock()
+	fake.defaultFilterMutex.RLock()
+	defer fake.defaultFilterMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeSettings) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ controllers.Settings = new(FakeSettings)
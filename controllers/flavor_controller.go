@@ -0,0 +1,69 @@
+package controllers
+
+import (
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/db"
+	"github.com/jianqiu/vps/models"
+)
+
+type FlavorController struct {
+	db db.FlavorDB
+}
+
+func NewFlavorController(
+	db db.FlavorDB,
+) *FlavorController {
+	return &FlavorController{
+		db: db,
+	}
+}
+
+func (h *FlavorController) CreateFlavor(logger lager.Logger, flavor *models.Flavor) (*models.Flavor, error) {
+	logger = logger.Session("create-flavor")
+
+	invalid := flavor.ValidateRecord()
+	if len(invalid) > 0 {
+		return nil, models.NewInvalidRecordError(invalid...)
+	}
+
+	err := h.db.InsertFlavor(logger, flavor)
+	if err != nil {
+		return nil, err
+	}
+
+	return flavor, nil
+}
+
+func (h *FlavorController) Flavors(logger lager.Logger) ([]*models.Flavor, error) {
+	return h.db.Flavors(logger)
+}
+
+func (h *FlavorController) Flavor(logger lager.Logger, name string) (*models.Flavor, error) {
+	return h.db.FlavorByName(logger, name)
+}
+
+// UpdateFlavor replaces the sizes, vlans and labels of the flavor named name.
+// VMs already in the pools are left as they are, even when they no longer
+// match a flavor.
+func (h *FlavorController) UpdateFlavor(logger lager.Logger, name string, flavor *models.Flavor) (*models.Flavor, error) {
+	logger = logger.Session("update-flavor", lager.Data{"name": name})
+
+	updated := *flavor
+	updated.Name = name
+
+	invalid := updated.ValidateRecord()
+	if len(invalid) > 0 {
+		return nil, models.NewInvalidRecordError(invalid...)
+	}
+
+	err := h.db.UpdateFlavor(logger, &updated)
+	if err != nil {
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+func (h *FlavorController) DeleteFlavor(logger lager.Logger, name string) error {
+	return h.db.DeleteFlavor(logger, name)
+}
@@ -0,0 +1,70 @@
+package controllers_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jianqiu/vps/controllers"
+	"github.com/jianqiu/vps/db/dbfakes"
+	"github.com/jianqiu/vps/models"
+
+	"code.cloudfoundry.org/lager/lagertest"
+)
+
+var _ = Describe("FlavorController", func() {
+	var (
+		logger       *lagertest.TestLogger
+		fakeFlavorDB *dbfakes.FakeFlavorDB
+		controller   *controllers.FlavorController
+	)
+
+	BeforeEach(func() {
+		fakeFlavorDB = new(dbfakes.FakeFlavorDB)
+		logger = lagertest.NewTestLogger("test")
+		controller = controllers.NewFlavorController(fakeFlavorDB)
+	})
+
+	Describe("CreateFlavor", func() {
+		It("stores a valid flavor", func() {
+			flavor := &models.Flavor{Name: "m1.large", CPU: 4, MemoryMb: 8192, Labels: map[string]string{"tier": "gold"}}
+
+			created, err := controller.CreateFlavor(logger, flavor)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created).To(Equal(flavor))
+
+			_, inserted := fakeFlavorDB.InsertFlavorArgsForCall(0)
+			Expect(inserted).To(Equal(flavor))
+		})
+
+		It("reports the invalid fields without storing the flavor", func() {
+			_, err := controller.CreateFlavor(logger, &models.Flavor{Name: "M1 Large", MemoryMb: 8192, PublicVlan: -1})
+
+			Expect(fakeFlavorDB.InsertFlavorCallCount()).To(Equal(0))
+			modelErr := models.ConvertError(err)
+			Expect(modelErr.Type).To(Equal(models.ErrorTypeInvalidRecord))
+			Expect(modelErr.Fields).To(ConsistOf(
+				&models.FieldError{Field: "name", Message: "must be lower case letters, digits, dots and dashes"},
+				&models.FieldError{Field: "cpu", Message: "must be positive"},
+				&models.FieldError{Field: "public_vlan", Message: "cannot be negative"},
+			))
+		})
+	})
+
+	Describe("UpdateFlavor", func() {
+		It("updates the flavor named by the route", func() {
+			updated, err := controller.UpdateFlavor(logger, "m1.large", &models.Flavor{Name: "ignored", CPU: 8, MemoryMb: 16384})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated).To(Equal(&models.Flavor{Name: "m1.large", CPU: 8, MemoryMb: 16384}))
+
+			_, stored := fakeFlavorDB.UpdateFlavorArgsForCall(0)
+			Expect(stored).To(Equal(updated))
+		})
+
+		It("returns the errors of the DB", func() {
+			fakeFlavorDB.UpdateFlavorReturns(models.ErrResourceNotFound)
+
+			_, err := controller.UpdateFlavor(logger, "m1.large", &models.Flavor{CPU: 8, MemoryMb: 16384})
+			Expect(err).To(Equal(models.ErrResourceNotFound))
+		})
+	})
+})
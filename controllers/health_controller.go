@@ -0,0 +1,99 @@
+package controllers
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/db"
+	"github.com/jianqiu/vps/models"
+)
+
+//go:generate counterfeiter . Pinger
+
+// Pinger checks that the database answers. *sql.DB satisfies it.
+type Pinger interface {
+	Ping() error
+}
+
+type HealthController struct {
+	db             db.ConfigurationDB
+	pinger         Pinger
+	clock          clock.Clock
+	flavor         string
+	migrationsDone <-chan struct{}
+}
+
+func NewHealthController(
+	db db.ConfigurationDB,
+	pinger Pinger,
+	clock clock.Clock,
+	flavor string,
+	migrationsDone <-chan struct{},
+) *HealthController {
+	return &HealthController{
+		db:             db,
+		pinger:         pinger,
+		clock:          clock,
+		flavor:         flavor,
+		migrationsDone: migrationsDone,
+	}
+}
+
+// Liveness reports the server as alive for as long as it can answer.
+func (h *HealthController) Liveness(logger lager.Logger) *models.Health {
+	return &models.Health{Status: models.HealthStatusOk}
+}
+
+// Readiness reports whether the server can serve requests: its migrations
+// finished and its database answers.
+func (h *HealthController) Readiness(logger lager.Logger) (*models.Health, bool) {
+	logger = logger.Session("readiness")
+
+	health := &models.Health{
+		Migrated: h.migrated(),
+		Database: h.database(logger),
+	}
+
+	ready := health.Migrated && health.Database.Reachable
+	if ready {
+		health.Status = models.HealthStatusOk
+	} else {
+		health.Status = models.HealthStatusUnavailable
+		logger.Info("not-ready", lager.Data{"migrated": health.Migrated, "database": health.Database})
+	}
+
+	return health, ready
+}
+
+func (h *HealthController) migrated() bool {
+	select {
+	case <-h.migrationsDone:
+		return true
+	default:
+		return false
+	}
+}
+
+func (h *HealthController) database(logger lager.Logger) *models.DatabaseHealth {
+	health := &models.DatabaseHealth{Flavor: h.flavor}
+
+	start := h.clock.Now()
+	err := h.pinger.Ping()
+	health.LatencyMs = float64(h.clock.Since(start)) / float64(time.Millisecond)
+	if err != nil {
+		logger.Error("failed-pinging-database", err)
+		health.Error = err.Error()
+		return health
+	}
+
+	version, err := h.db.SchemaVersion(logger)
+	if err != nil {
+		health.Error = err.Error()
+		return health
+	}
+
+	health.Reachable = true
+	health.SchemaVersion = version
+	return health
+}
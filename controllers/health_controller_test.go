@@ -0,0 +1,102 @@
+package controllers_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jianqiu/vps/controllers"
+	"github.com/jianqiu/vps/controllers/controllersfakes"
+	"github.com/jianqiu/vps/db/dbfakes"
+	"github.com/jianqiu/vps/models"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/lagertest"
+)
+
+var _ = Describe("HealthController", func() {
+	var (
+		logger         *lagertest.TestLogger
+		fakeDB         *dbfakes.FakeConfigurationDB
+		fakePinger     *controllersfakes.FakePinger
+		migrationsDone chan struct{}
+		controller     *controllers.HealthController
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		fakeDB = new(dbfakes.FakeConfigurationDB)
+		fakeDB.SchemaVersionReturns(5, nil)
+		fakePinger = new(controllersfakes.FakePinger)
+		migrationsDone = make(chan struct{})
+		controller = controllers.NewHealthController(fakeDB, fakePinger, clock.NewClock(), "postgres", migrationsDone)
+	})
+
+	Describe("Liveness", func() {
+		It("reports ok without touching the database", func() {
+			Expect(controller.Liveness(logger).Status).To(Equal(models.HealthStatusOk))
+			Expect(fakePinger.PingCallCount()).To(Equal(0))
+		})
+	})
+
+	Describe("Readiness", func() {
+		var (
+			health *models.Health
+			ready  bool
+		)
+
+		JustBeforeEach(func() {
+			health, ready = controller.Readiness(logger)
+		})
+
+		Context("before the migrations finished", func() {
+			It("is not ready", func() {
+				Expect(ready).To(BeFalse())
+				Expect(health.Status).To(Equal(models.HealthStatusUnavailable))
+				Expect(health.Migrated).To(BeFalse())
+				Expect(health.Database.Reachable).To(BeTrue())
+			})
+		})
+
+		Context("once the migrations finished", func() {
+			BeforeEach(func() {
+				close(migrationsDone)
+			})
+
+			It("is ready and describes the database", func() {
+				Expect(ready).To(BeTrue())
+				Expect(health.Status).To(Equal(models.HealthStatusOk))
+				Expect(health.Migrated).To(BeTrue())
+				Expect(health.Database.Flavor).To(Equal("postgres"))
+				Expect(health.Database.SchemaVersion).To(Equal(int32(5)))
+				Expect(health.Database.LatencyMs).To(BeNumerically(">=", 0))
+				Expect(health.Database.Error).To(BeEmpty())
+			})
+
+			Context("when the database does not answer", func() {
+				BeforeEach(func() {
+					fakePinger.PingReturns(errors.New("connection refused"))
+				})
+
+				It("is not ready", func() {
+					Expect(ready).To(BeFalse())
+					Expect(health.Status).To(Equal(models.HealthStatusUnavailable))
+					Expect(health.Database.Reachable).To(BeFalse())
+					Expect(health.Database.Error).To(Equal("connection refused"))
+				})
+			})
+
+			Context("when the schema version cannot be read", func() {
+				BeforeEach(func() {
+					fakeDB.SchemaVersionReturns(0, errors.New("kaboom"))
+				})
+
+				It("is not ready", func() {
+					Expect(ready).To(BeFalse())
+					Expect(health.Database.Error).To(Equal("kaboom"))
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,32 @@
+package controllers
+
+import (
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/models"
+	"github.com/jianqiu/vps/vpslager"
+)
+
+// LogLevelController changes the level the server logs at while it runs.
+type LogLevelController struct {
+	sink *lager.ReconfigurableSink
+}
+
+func NewLogLevelController(sink *lager.ReconfigurableSink) *LogLevelController {
+	return &LogLevelController{sink: sink}
+}
+
+func (h *LogLevelController) LogLevel(logger lager.Logger) string {
+	return vpslager.LogLevelName(h.sink.GetMinLevel())
+}
+
+func (h *LogLevelController) SetLogLevel(logger lager.Logger, level string) error {
+	minLevel, err := vpslager.ParseLogLevel(level)
+	if err != nil {
+		return models.NewInvalidRecordError(models.ErrInvalidField{Field: "level", Message: "must be debug, info, error or fatal"})
+	}
+
+	previous := h.LogLevel(logger)
+	h.sink.SetMinLevel(minLevel)
+	logger.Info("changed-log-level", lager.Data{"from": previous, "to": level})
+	return nil
+}
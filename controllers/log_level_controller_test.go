@@ -0,0 +1,46 @@
+package controllers_test
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jianqiu/vps/controllers"
+	"github.com/jianqiu/vps/models"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagertest"
+)
+
+var _ = Describe("LogLevelController", func() {
+	var (
+		logger     *lagertest.TestLogger
+		sink       *lager.ReconfigurableSink
+		controller *controllers.LogLevelController
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		sink = lager.NewReconfigurableSink(lager.NewWriterSink(new(bytes.Buffer), lager.DEBUG), lager.INFO)
+		controller = controllers.NewLogLevelController(sink)
+	})
+
+	It("reports the level of the sink", func() {
+		Expect(controller.LogLevel(logger)).To(Equal("info"))
+	})
+
+	It("changes the level of the sink", func() {
+		Expect(controller.SetLogLevel(logger, "debug")).To(Succeed())
+		Expect(sink.GetMinLevel()).To(Equal(lager.DEBUG))
+		Expect(controller.LogLevel(logger)).To(Equal("debug"))
+	})
+
+	It("rejects an unknown level", func() {
+		err := controller.SetLogLevel(logger, "verbose")
+		Expect(models.ConvertError(err).Fields).To(ConsistOf(
+			&models.FieldError{Field: "level", Message: "must be debug, info, error or fatal"},
+		))
+		Expect(sink.GetMinLevel()).To(Equal(lager.INFO))
+	})
+})
@@ -0,0 +1,52 @@
+package controllers
+
+import (
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/db"
+	"github.com/jianqiu/vps/models"
+)
+
+// MetadataController tells the VMs of every pool about themselves, the
+// agent settings they were ordered with among it.
+type MetadataController struct {
+	vms      db.VirtualGuestDB
+	userData db.UserDataDB
+}
+
+func NewMetadataController(
+	vms db.VirtualGuestDB,
+	userData db.UserDataDB,
+) *MetadataController {
+	return &MetadataController{
+		vms:      vms,
+		userData: userData,
+	}
+}
+
+// Metadata returns the metadata of the VM with ip, provided it is being
+// provisioned or in use. Free VMs and VMs in any other state are not
+// found, so that nothing is left for the next deployment to read.
+func (h *MetadataController) Metadata(logger lager.Logger, ip string) (*models.VMMetadata, error) {
+	logger = logger.Session("metadata", lager.Data{"ip": ip})
+
+	vm, err := h.vms.VirtualGuestByIP(logger, ip)
+	if err != nil {
+		return nil, err
+	}
+	if vm == nil || (vm.State != models.StateProvisioning && vm.State != models.StateUsing) {
+		return nil, models.ErrResourceNotFound
+	}
+
+	userData, err := h.userData.VMUserData(logger, vm.Cid)
+	if err != nil && !models.ConvertError(err).Equal(models.ErrResourceNotFound) {
+		return nil, err
+	}
+
+	return &models.VMMetadata{
+		Cid:            vm.Cid,
+		Hostname:       vm.Hostname,
+		IP:             vm.IP,
+		DeploymentName: vm.DeploymentName,
+		UserData:       userData,
+	}, nil
+}
@@ -0,0 +1,77 @@
+package controllers_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jianqiu/vps/controllers"
+	"github.com/jianqiu/vps/db/dbfakes"
+	"github.com/jianqiu/vps/models"
+
+	"code.cloudfoundry.org/lager/lagertest"
+)
+
+var _ = Describe("MetadataController", func() {
+	var (
+		logger             *lagertest.TestLogger
+		fakeVirtualGuestDB *dbfakes.FakeVirtualGuestDB
+		fakeUserDataDB     *dbfakes.FakeUserDataDB
+		controller         *controllers.MetadataController
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		fakeVirtualGuestDB = new(dbfakes.FakeVirtualGuestDB)
+		fakeUserDataDB = new(dbfakes.FakeUserDataDB)
+		controller = controllers.NewMetadataController(fakeVirtualGuestDB, fakeUserDataDB)
+
+		fakeVirtualGuestDB.VirtualGuestByIPReturns(&models.VM{
+			Cid:            1,
+			Hostname:       "vm-1",
+			IP:             "10.0.0.1",
+			State:          models.StateProvisioning,
+			DeploymentName: "cf",
+		}, nil)
+		fakeUserDataDB.VMUserDataReturns(`{"agent_id":"abc"}`, nil)
+	})
+
+	It("tells the vm with the ip about itself", func() {
+		metadata, err := controller.Metadata(logger, "10.0.0.1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(metadata).To(Equal(&models.VMMetadata{
+			Cid:            1,
+			Hostname:       "vm-1",
+			IP:             "10.0.0.1",
+			DeploymentName: "cf",
+			UserData:       `{"agent_id":"abc"}`,
+		}))
+
+		_, ip := fakeVirtualGuestDB.VirtualGuestByIPArgsForCall(0)
+		Expect(ip).To(Equal("10.0.0.1"))
+		_, cid := fakeUserDataDB.VMUserDataArgsForCall(0)
+		Expect(cid).To(Equal(int32(1)))
+	})
+
+	It("answers without agent settings when the order had none", func() {
+		fakeUserDataDB.VMUserDataReturns("", models.ErrResourceNotFound)
+
+		metadata, err := controller.Metadata(logger, "10.0.0.1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(metadata.UserData).To(BeEmpty())
+	})
+
+	It("does not know free vms", func() {
+		fakeVirtualGuestDB.VirtualGuestByIPReturns(&models.VM{Cid: 1, State: models.StateFree}, nil)
+
+		_, err := controller.Metadata(logger, "10.0.0.1")
+		Expect(err).To(Equal(models.ErrResourceNotFound))
+		Expect(fakeUserDataDB.VMUserDataCallCount()).To(Equal(0))
+	})
+
+	It("does not know addresses that are not vms of a pool", func() {
+		fakeVirtualGuestDB.VirtualGuestByIPReturns(nil, models.ErrResourceNotFound)
+
+		_, err := controller.Metadata(logger, "10.0.0.9")
+		Expect(err).To(Equal(models.ErrResourceNotFound))
+	})
+})
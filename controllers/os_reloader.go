@@ -0,0 +1,198 @@
+package controllers
+
+import (
+	"os"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/db"
+	"github.com/jianqiu/vps/models"
+	"github.com/jianqiu/vps/softlayer"
+	"github.com/tedsuo/ifrit"
+)
+
+//go:generate counterfeiter . OSReloader
+
+// OSReloader wipes released VMs by reloading their operating system. It runs
+// on the server holding its lock and takes on every VM in the reloading
+// state, so the VMs a previous leader left behind are reloaded too; Reload
+// only has it look for them right away instead of on its next tick. A VM is
+// moved to free once SoftLayer is done, or to failed if the reload could not
+// be completed.
+type OSReloader interface {
+	ifrit.Runner
+	Reload(logger lager.Logger, cid int32)
+}
+
+type osReloader struct {
+	logger       lager.Logger
+	db           db.VirtualGuestDB
+	client       softlayer.SoftLayerClient
+	clock        clock.Clock
+	pollInterval time.Duration
+	timeout      time.Duration
+	wake         chan struct{}
+}
+
+func NewOSReloader(
+	logger lager.Logger,
+	db db.VirtualGuestDB,
+	client softlayer.SoftLayerClient,
+	clock clock.Clock,
+	pollInterval time.Duration,
+	timeout time.Duration,
+) OSReloader {
+	return &osReloader{
+		logger:       logger,
+		db:           db,
+		client:       client,
+		clock:        clock,
+		pollInterval: pollInterval,
+		timeout:      timeout,
+		wake:         make(chan struct{}, 1),
+	}
+}
+
+func (r *osReloader) Reload(logger lager.Logger, cid int32) {
+	logger.Debug("waking-os-reloader", lager.Data{"cid": cid})
+	select {
+	case r.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (r *osReloader) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	logger := r.logger.Session("os-reloader")
+	logger.Info("starting", lager.Data{"poll-interval": r.pollInterval.String(), "timeout": r.timeout.String()})
+	defer logger.Info("exited")
+
+	done := make(chan struct{})
+	finished := make(chan int32)
+	reloads := &sync.WaitGroup{}
+	reloading := map[int32]bool{}
+
+	start := func(resumed bool) {
+		vms, err := r.db.VirtualGuests(logger, models.VMFilter{State: models.StateReloading})
+		if err != nil {
+			logger.Error("failed-to-fetch-reloading-vms", err)
+			return
+		}
+
+		for _, vm := range vms {
+			if reloading[vm.Cid] {
+				continue
+			}
+			reloading[vm.Cid] = true
+			reloads.Add(1)
+			go func(cid int32) {
+				defer reloads.Done()
+				r.reload(logger.Session("reload-os", lager.Data{"cid": cid, "resumed": resumed}), cid, resumed, done)
+				select {
+				case finished <- cid:
+				case <-done:
+				}
+			}(vm.Cid)
+		}
+	}
+
+	ticker := r.clock.NewTicker(r.pollInterval)
+	defer ticker.Stop()
+
+	close(ready)
+
+	// whatever is reloading when the reloader starts was left by another
+	// server, which may or may not have got to start the reload
+	start(true)
+
+	for {
+		select {
+		case <-ticker.C():
+			start(false)
+		case <-r.wake:
+			start(false)
+		case cid := <-finished:
+			delete(reloading, cid)
+		case <-signals:
+			close(done)
+			reloads.Wait()
+			return nil
+		}
+	}
+}
+
+// reload reloads the OS of the VM and frees it once SoftLayer is done. A
+// resumed reload waits for the transaction the VM may still be running and
+// reloads it again, as the reload it found might have never been started.
+func (r *osReloader) reload(logger lager.Logger, cid int32, resumed bool, done <-chan struct{}) {
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	timer := r.clock.NewTimer(r.timeout)
+	defer timer.Stop()
+	ticker := r.clock.NewTicker(r.pollInterval)
+	defer ticker.Stop()
+
+	started := !resumed
+	if started {
+		err := r.client.ReloadOS(logger, cid)
+		if err != nil {
+			logger.Error("failed-to-start-reload", err)
+			r.fail(logger, cid)
+			return
+		}
+	}
+
+	for {
+		select {
+		case <-ticker.C():
+			completed, err := r.client.ReloadCompleted(logger, cid)
+			if err != nil {
+				logger.Error("failed-to-poll-reload", err)
+				continue
+			}
+			if !completed {
+				continue
+			}
+
+			if !started {
+				err = r.client.ReloadOS(logger, cid)
+				if err != nil {
+					logger.Error("failed-to-start-reload", err)
+					r.fail(logger, cid)
+					return
+				}
+				started = true
+				continue
+			}
+
+			// the VM stays reloading until it is freed, so a failure is
+			// tried again on the next tick, unless the VM was moved on or
+			// deleted meanwhile
+			err = r.db.ChangeVirtualGuestToFree(logger, cid)
+			if err == nil {
+				return
+			}
+			logger.Error("failed-to-free-vm", err)
+			modelErr := models.ConvertError(err)
+			if modelErr.Type == models.ErrorTypeInvalidStateTransition || modelErr.Equal(models.ErrResourceNotFound) {
+				return
+			}
+		case <-timer.C():
+			logger.Error("timed-out", nil, lager.Data{"timeout": r.timeout.String()})
+			r.fail(logger, cid)
+			return
+		case <-done:
+			logger.Info("stopped")
+			return
+		}
+	}
+}
+
+func (r *osReloader) fail(logger lager.Logger, cid int32) {
+	err := r.db.TransitionVirtualGuest(logger, cid, models.StateFailed)
+	if err != nil {
+		logger.Error("failed-to-mark-vm-failed", err)
+	}
+}
@@ -0,0 +1,235 @@
+package controllers_test
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jianqiu/vps/controllers"
+	"github.com/jianqiu/vps/db/dbfakes"
+	"github.com/jianqiu/vps/models"
+	"github.com/jianqiu/vps/softlayer/softlayerfakes"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/tedsuo/ifrit"
+)
+
+var _ = Describe("OSReloader", func() {
+	var (
+		logger             *lagertest.TestLogger
+		fakeVirtualGuestDB *dbfakes.FakeVirtualGuestDB
+		fakeSoftLayer      *softlayerfakes.FakeSoftLayerClient
+		timeout            time.Duration
+		reloader           controllers.OSReloader
+		process            ifrit.Process
+		cid                int32
+		reloadingVMs       []*models.VM
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		fakeVirtualGuestDB = new(dbfakes.FakeVirtualGuestDB)
+		fakeSoftLayer = new(softlayerfakes.FakeSoftLayerClient)
+		timeout = time.Second
+		cid = 1234567
+		reloadingVMs = nil
+
+		// the vm is reloading until it is freed or failed
+		fakeVirtualGuestDB.VirtualGuestsStub = func(_ lager.Logger, _ models.VMFilter) ([]*models.VM, error) {
+			freed := fakeVirtualGuestDB.ChangeVirtualGuestToFreeCallCount() > 0
+			failed := fakeVirtualGuestDB.TransitionVirtualGuestCallCount() > 0
+			if freed || failed {
+				return []*models.VM{}, nil
+			}
+			return reloadingVMs, nil
+		}
+	})
+
+	JustBeforeEach(func() {
+		reloader = controllers.NewOSReloader(logger, fakeVirtualGuestDB, fakeSoftLayer, clock.NewClock(), 10*time.Millisecond, timeout)
+		process = ifrit.Invoke(reloader)
+	})
+
+	AfterEach(func() {
+		process.Signal(os.Interrupt)
+		Eventually(process.Wait()).Should(Receive(BeNil()))
+	})
+
+	releaseVM := func() {
+		reloadingVMs = []*models.VM{{Cid: cid, State: models.StateReloading}}
+		reloader.Reload(logger, cid)
+	}
+
+	It("looks for the vms to reload", func() {
+		Eventually(fakeVirtualGuestDB.VirtualGuestsCallCount).Should(BeNumerically(">=", 2))
+		_, filter := fakeVirtualGuestDB.VirtualGuestsArgsForCall(0)
+		Expect(filter).To(Equal(models.VMFilter{State: models.StateReloading}))
+		Expect(fakeSoftLayer.ReloadOSCallCount()).To(Equal(0))
+	})
+
+	Context("when a vm is released", func() {
+		JustBeforeEach(releaseVM)
+
+		It("reloads its OS once", func() {
+			Eventually(fakeSoftLayer.ReloadOSCallCount).Should(Equal(1))
+			_, actualCid := fakeSoftLayer.ReloadOSArgsForCall(0)
+			Expect(actualCid).To(Equal(cid))
+			Consistently(fakeSoftLayer.ReloadOSCallCount, 50*time.Millisecond).Should(Equal(1))
+		})
+	})
+
+	Context("when the reload completes", func() {
+		BeforeEach(func() {
+			polls := 0
+			fakeSoftLayer.ReloadCompletedStub = func(_ lager.Logger, _ int32) (bool, error) {
+				polls++
+				return polls > 2, nil
+			}
+		})
+
+		JustBeforeEach(releaseVM)
+
+		It("polls until it is done and frees the vm", func() {
+			Eventually(fakeVirtualGuestDB.ChangeVirtualGuestToFreeCallCount).Should(Equal(1))
+			_, actualCid := fakeVirtualGuestDB.ChangeVirtualGuestToFreeArgsForCall(0)
+			Expect(actualCid).To(Equal(cid))
+			Expect(fakeSoftLayer.ReloadCompletedCallCount()).To(Equal(3))
+			Expect(fakeVirtualGuestDB.TransitionVirtualGuestCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the vm cannot be freed right away", func() {
+		BeforeEach(func() {
+			fakeSoftLayer.ReloadCompletedReturns(true, nil)
+			frees := 0
+			fakeVirtualGuestDB.ChangeVirtualGuestToFreeStub = func(_ lager.Logger, _ int32) error {
+				frees++
+				if frees == 1 {
+					return models.ErrDeadlock
+				}
+				return nil
+			}
+		})
+
+		JustBeforeEach(releaseVM)
+
+		It("tries again", func() {
+			Eventually(fakeVirtualGuestDB.ChangeVirtualGuestToFreeCallCount).Should(Equal(2))
+			Consistently(fakeVirtualGuestDB.ChangeVirtualGuestToFreeCallCount, 50*time.Millisecond).Should(Equal(2))
+			Expect(fakeVirtualGuestDB.TransitionVirtualGuestCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the vm was moved out of reloading meanwhile", func() {
+		BeforeEach(func() {
+			fakeSoftLayer.ReloadCompletedReturns(true, nil)
+			fakeVirtualGuestDB.ChangeVirtualGuestToFreeReturns(models.NewTaskTransitionError(models.StateMaintenance, models.StateFree))
+			fakeVirtualGuestDB.VirtualGuestsStub = func(_ lager.Logger, _ models.VMFilter) ([]*models.VM, error) {
+				if fakeVirtualGuestDB.ChangeVirtualGuestToFreeCallCount() > 0 {
+					return []*models.VM{}, nil
+				}
+				return reloadingVMs, nil
+			}
+		})
+
+		JustBeforeEach(releaseVM)
+
+		It("leaves it alone", func() {
+			Eventually(fakeVirtualGuestDB.ChangeVirtualGuestToFreeCallCount).Should(Equal(1))
+			Consistently(fakeVirtualGuestDB.ChangeVirtualGuestToFreeCallCount, 50*time.Millisecond).Should(Equal(1))
+			Expect(fakeVirtualGuestDB.TransitionVirtualGuestCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when polling fails transiently", func() {
+		BeforeEach(func() {
+			polls := 0
+			fakeSoftLayer.ReloadCompletedStub = func(_ lager.Logger, _ int32) (bool, error) {
+				polls++
+				if polls == 1 {
+					return false, errors.New("connection reset")
+				}
+				return true, nil
+			}
+		})
+
+		JustBeforeEach(releaseVM)
+
+		It("keeps polling and frees the vm", func() {
+			Eventually(fakeVirtualGuestDB.ChangeVirtualGuestToFreeCallCount).Should(Equal(1))
+			Expect(fakeVirtualGuestDB.TransitionVirtualGuestCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when SoftLayer refuses to reload", func() {
+		BeforeEach(func() {
+			fakeSoftLayer.ReloadOSReturns(models.NewError(models.ErrorTypeSoftLayerAPIError, "kaboom"))
+		})
+
+		JustBeforeEach(releaseVM)
+
+		It("marks the vm as failed", func() {
+			Eventually(fakeVirtualGuestDB.TransitionVirtualGuestCallCount).Should(Equal(1))
+			_, actualCid, actualState := fakeVirtualGuestDB.TransitionVirtualGuestArgsForCall(0)
+			Expect(actualCid).To(Equal(cid))
+			Expect(actualState).To(Equal(models.StateFailed))
+			Expect(fakeSoftLayer.ReloadCompletedCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the reload does not finish in time", func() {
+		BeforeEach(func() {
+			timeout = 50 * time.Millisecond
+			fakeSoftLayer.ReloadCompletedReturns(false, nil)
+		})
+
+		JustBeforeEach(releaseVM)
+
+		It("marks the vm as failed", func() {
+			Eventually(fakeVirtualGuestDB.TransitionVirtualGuestCallCount).Should(Equal(1))
+			_, _, actualState := fakeVirtualGuestDB.TransitionVirtualGuestArgsForCall(0)
+			Expect(actualState).To(Equal(models.StateFailed))
+			Consistently(fakeVirtualGuestDB.ChangeVirtualGuestToFreeCallCount, 100*time.Millisecond).Should(Equal(0))
+		})
+	})
+
+	Context("when a vm is still reloading as the reloader starts", func() {
+		BeforeEach(func() {
+			reloadingVMs = []*models.VM{{Cid: cid, State: models.StateReloading}}
+
+			polls := 0
+			fakeSoftLayer.ReloadCompletedStub = func(_ lager.Logger, _ int32) (bool, error) {
+				polls++
+				return polls == 2 || polls >= 4, nil
+			}
+		})
+
+		It("waits for the transaction it may be running, reloads it again and frees it", func() {
+			Eventually(fakeVirtualGuestDB.ChangeVirtualGuestToFreeCallCount).Should(Equal(1))
+			Expect(fakeSoftLayer.ReloadOSCallCount()).To(Equal(1))
+			Expect(fakeSoftLayer.ReloadCompletedCallCount()).To(Equal(4))
+		})
+	})
+
+	Context("when the reloader is stopped", func() {
+		BeforeEach(func() {
+			fakeSoftLayer.ReloadCompletedReturns(false, nil)
+		})
+
+		JustBeforeEach(releaseVM)
+
+		It("leaves the vm reloading for the next leader", func() {
+			Eventually(fakeSoftLayer.ReloadOSCallCount).Should(Equal(1))
+
+			process.Signal(os.Interrupt)
+			Eventually(process.Wait()).Should(Receive(BeNil()))
+			Expect(fakeVirtualGuestDB.TransitionVirtualGuestCallCount()).To(Equal(0))
+			Expect(fakeVirtualGuestDB.ChangeVirtualGuestToFreeCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,266 @@
+package controllers
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"strings"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/db"
+	"github.com/jianqiu/vps/models"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type PoolController struct {
+	db db.PoolDB
+}
+
+func NewPoolController(
+	db db.PoolDB,
+) *PoolController {
+	return &PoolController{
+		db: db,
+	}
+}
+
+// CreatePool adds a pool, with credentials of its own when the request
+// brings a username and password, and returns it without the password.
+func (h *PoolController) CreatePool(logger lager.Logger, pool *models.Pool) (*models.Pool, error) {
+	logger = logger.Session("create-pool")
+
+	invalid, err := h.validatePool(logger, pool)
+	if err != nil {
+		return nil, err
+	}
+	if len(invalid) > 0 {
+		return nil, models.NewInvalidRecordError(invalid...)
+	}
+
+	created := *pool
+	if created.Password != "" {
+		created.Password, err = hashPassword(created.Password)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = h.db.InsertPool(logger, &created)
+	if err != nil {
+		return nil, err
+	}
+
+	return withoutPassword(created), nil
+}
+
+// Pools lists the pools without their passwords.
+func (h *PoolController) Pools(logger lager.Logger) ([]*models.Pool, error) {
+	pools, err := h.db.Pools(logger)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pool := range pools {
+		pool.Password = ""
+	}
+	return pools, nil
+}
+
+func (h *PoolController) Pool(logger lager.Logger, name string) (*models.Pool, error) {
+	pool, err := h.db.PoolByName(logger, name)
+	if err != nil {
+		return nil, err
+	}
+
+	pool.Password = ""
+	return pool, nil
+}
+
+// UpdatePool replaces the description of the pool named name, and its
+// credentials when the request brings a password. Without one the pool keeps
+// the credentials it has.
+func (h *PoolController) UpdatePool(logger lager.Logger, name string, pool *models.Pool) (*models.Pool, error) {
+	logger = logger.Session("update-pool", lager.Data{"name": name})
+
+	existing, err := h.db.PoolByName(logger, name)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := *existing
+	updated.Description = pool.Description
+	if pool.Password != "" {
+		updated.Username = pool.Username
+		updated.Password = pool.Password
+	}
+
+	invalid, err := h.validatePool(logger, &updated)
+	if err != nil {
+		return nil, err
+	}
+	if len(invalid) > 0 {
+		return nil, models.NewInvalidRecordError(invalid...)
+	}
+
+	if pool.Password != "" {
+		updated.Password, err = hashPassword(pool.Password)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = h.db.UpdatePool(logger, &updated)
+	if err != nil {
+		return nil, err
+	}
+
+	return withoutPassword(updated), nil
+}
+
+// DeletePool removes an empty pool. The default pool stays.
+func (h *PoolController) DeletePool(logger lager.Logger, name string) error {
+	if name == models.DefaultPool {
+		return models.NewResourceConflictError(models.ErrInvalidField{Field: "pool", Message: "the default pool cannot be deleted"})
+	}
+	return h.db.DeletePool(logger, name)
+}
+
+// Authenticate returns the user for the credentials of a pool, which only
+// grant access to that pool. Unknown credentials are ErrUnauthorized.
+func (h *PoolController) Authenticate(logger lager.Logger, username, password string) (*models.User, error) {
+	logger = logger.Session("authenticate", lager.Data{"username": username})
+
+	pool, err := h.db.PoolByUsername(logger, username)
+	if err != nil {
+		if models.ConvertError(err).Equal(models.ErrResourceNotFound) {
+			// take as long as for a wrong password, so that the time it
+			// takes does not tell which usernames exist
+			bcrypt.CompareHashAndPassword(unknownUserHash, []byte(password))
+			return nil, models.ErrUnauthorized
+		}
+		logger.Error("failed-finding-pool", err)
+		return nil, err
+	}
+
+	matches, legacy := passwordMatches(pool.Password, password)
+	if !matches {
+		logger.Info("wrong-password")
+		return nil, models.ErrUnauthorized
+	}
+	if legacy {
+		h.rehashPassword(logger, *pool, password)
+	}
+
+	return &models.User{
+		Username: username,
+		Pool:     pool.Name,
+	}, nil
+}
+
+// AdminCredentials are the credentials of the administrator, who has access
+// to every pool. The password is kept as a bcrypt hash, like the passwords
+// of the pools; without one nobody logs in as the administrator.
+type AdminCredentials struct {
+	Username     string
+	PasswordHash string
+}
+
+// IsAdmin tells whether username is the administrator's.
+func (a AdminCredentials) IsAdmin(username string) bool {
+	if a.Username == "" || a.PasswordHash == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(username), []byte(a.Username)) == 1
+}
+
+// Authenticate returns the administrator for the credentials, which grant
+// access to every pool. Any others are ErrUnauthorized.
+func (a AdminCredentials) Authenticate(logger lager.Logger, username, password string) (*models.User, error) {
+	if !a.IsAdmin(username) {
+		return nil, models.ErrUnauthorized
+	}
+	if bcrypt.CompareHashAndPassword([]byte(a.PasswordHash), []byte(password)) != nil {
+		logger.Session("authenticate-admin").Info("wrong-password")
+		return nil, models.ErrUnauthorized
+	}
+	return &models.User{Username: username}, nil
+}
+
+// validatePool checks the fields of pool and that no other pool uses its
+// username.
+func (h *PoolController) validatePool(logger lager.Logger, pool *models.Pool) ([]models.ErrInvalidField, error) {
+	invalid := pool.ValidateRecord()
+	if pool.Username == "" {
+		return invalid, nil
+	}
+
+	existing, err := h.db.PoolByUsername(logger, pool.Username)
+	if err != nil && !models.ConvertError(err).Equal(models.ErrResourceNotFound) {
+		logger.Error("failed-checking-username", err)
+		return nil, err
+	}
+	if existing != nil && existing.Name != pool.Name {
+		invalid = append(invalid, models.ErrInvalidField{
+			Field:   "username",
+			Message: "is already used by pool " + existing.Name,
+		})
+	}
+
+	return invalid, nil
+}
+
+// withoutPassword is a copy of pool fit to hand out, the password hash left
+// out.
+func withoutPassword(pool models.Pool) *models.Pool {
+	pool.Password = ""
+	return &pool
+}
+
+// unknownUserHash is what passwords are checked against when no pool has
+// the username.
+var unknownUserHash, _ = bcrypt.GenerateFromPassword([]byte("no pool has this username"), bcrypt.DefaultCost)
+
+// hashPassword hashes password with bcrypt, salt and cost included.
+func hashPassword(password string) (string, error) {
+	hashed, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hashed), nil
+}
+
+// passwordMatches checks password against a bcrypt hash or, for pools
+// created before passwords were hashed with bcrypt, a sha256$<salt>$<hash>
+// one. legacy tells that the hash is of the latter kind.
+func passwordMatches(hashed, password string) (matches, legacy bool) {
+	if !strings.HasPrefix(hashed, "sha256$") {
+		return bcrypt.CompareHashAndPassword([]byte(hashed), []byte(password)) == nil, false
+	}
+
+	parts := strings.Split(hashed, "$")
+	if len(parts) != 3 {
+		return false, true
+	}
+	salt, err := hex.DecodeString(parts[1])
+	if err != nil {
+		return false, true
+	}
+	sum := sha256.Sum256(append(salt, password...))
+	return subtle.ConstantTimeCompare([]byte(parts[2]), []byte(hex.EncodeToString(sum[:]))) == 1, true
+}
+
+// rehashPassword replaces the legacy hash of pool with a bcrypt one, once
+// the password is known to match it. The login goes ahead when that fails;
+// the next one tries again.
+func (h *PoolController) rehashPassword(logger lager.Logger, pool models.Pool, password string) {
+	var err error
+	pool.Password, err = hashPassword(password)
+	if err == nil {
+		err = h.db.UpdatePool(logger, &pool)
+	}
+	if err != nil {
+		logger.Error("failed-rehashing-password", err)
+		return
+	}
+	logger.Info("rehashed-password")
+}
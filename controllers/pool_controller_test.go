@@ -0,0 +1,295 @@
+package controllers_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jianqiu/vps/controllers"
+	"github.com/jianqiu/vps/db/dbfakes"
+	"github.com/jianqiu/vps/models"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var _ = Describe("PoolController", func() {
+	var (
+		logger     *lagertest.TestLogger
+		fakePoolDB *dbfakes.FakePoolDB
+		controller *controllers.PoolController
+	)
+
+	BeforeEach(func() {
+		fakePoolDB = new(dbfakes.FakePoolDB)
+		logger = lagertest.NewTestLogger("test")
+		controller = controllers.NewPoolController(fakePoolDB)
+
+		fakePoolDB.PoolByUsernameReturns(nil, models.ErrResourceNotFound)
+	})
+
+	// storedPassword creates a pool with password and returns the password
+	// as it was stored.
+	storedPassword := func(password string) string {
+		_, err := controller.CreatePool(logger, &models.Pool{Name: "staging", Username: "staging", Password: password})
+		Expect(err).NotTo(HaveOccurred())
+		_, inserted := fakePoolDB.InsertPoolArgsForCall(fakePoolDB.InsertPoolCallCount() - 1)
+		return inserted.Password
+	}
+
+	Describe("CreatePool", func() {
+		var (
+			requested *models.Pool
+			created   *models.Pool
+			err       error
+		)
+
+		BeforeEach(func() {
+			requested = &models.Pool{Name: "staging", Description: "staging vms", Username: "staging", Password: "s3cret"}
+		})
+
+		JustBeforeEach(func() {
+			created, err = controller.CreatePool(logger, requested)
+		})
+
+		It("stores the pool with a hashed password", func() {
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakePoolDB.InsertPoolCallCount()).To(Equal(1))
+			_, inserted := fakePoolDB.InsertPoolArgsForCall(0)
+			Expect(inserted.Name).To(Equal("staging"))
+			Expect(inserted.Username).To(Equal("staging"))
+			Expect(inserted.Password).To(HavePrefix("$2a$"))
+			Expect(inserted.Password).NotTo(ContainSubstring("s3cret"))
+		})
+
+		It("returns the pool without its password", func() {
+			Expect(created).To(Equal(&models.Pool{Name: "staging", Description: "staging vms", Username: "staging"}))
+		})
+
+		Context("when the pool has no credentials", func() {
+			BeforeEach(func() {
+				requested = &models.Pool{Name: "staging"}
+			})
+
+			It("stores it without a password", func() {
+				Expect(err).NotTo(HaveOccurred())
+				_, inserted := fakePoolDB.InsertPoolArgsForCall(0)
+				Expect(inserted.Password).To(BeEmpty())
+			})
+		})
+
+		Context("when the pool is invalid", func() {
+			BeforeEach(func() {
+				requested = &models.Pool{Name: "Staging Pool", Username: "staging"}
+			})
+
+			It("reports the invalid fields without storing the pool", func() {
+				Expect(fakePoolDB.InsertPoolCallCount()).To(Equal(0))
+
+				modelErr := models.ConvertError(err)
+				Expect(modelErr.Type).To(Equal(models.ErrorTypeInvalidRecord))
+				Expect(modelErr.Fields).To(ConsistOf(
+					&models.FieldError{Field: "name", Message: "must be lower case letters, digits and dashes"},
+					&models.FieldError{Field: "password", Message: "is required with a username"},
+				))
+			})
+		})
+
+		Context("when another pool has the username", func() {
+			BeforeEach(func() {
+				fakePoolDB.PoolByUsernameReturns(&models.Pool{Name: "prod", Username: "staging"}, nil)
+			})
+
+			It("reports the username as taken", func() {
+				Expect(fakePoolDB.InsertPoolCallCount()).To(Equal(0))
+				Expect(models.ConvertError(err).Fields).To(ConsistOf(
+					&models.FieldError{Field: "username", Message: "is already used by pool prod"},
+				))
+			})
+		})
+	})
+
+	Describe("Pools", func() {
+		It("lists the pools without their passwords", func() {
+			fakePoolDB.PoolsReturns([]*models.Pool{{Name: "staging", Username: "staging", Password: "sha256$aa$bb"}}, nil)
+
+			pools, err := controller.Pools(logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pools).To(Equal([]*models.Pool{{Name: "staging", Username: "staging"}}))
+		})
+	})
+
+	Describe("UpdatePool", func() {
+		var (
+			requested *models.Pool
+			updated   *models.Pool
+			err       error
+		)
+
+		BeforeEach(func() {
+			fakePoolDB.PoolByNameReturns(&models.Pool{Name: "staging", Description: "old", Username: "staging", Password: "sha256$aa$bb"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			updated, err = controller.UpdatePool(logger, "staging", requested)
+		})
+
+		Context("without a password", func() {
+			BeforeEach(func() {
+				requested = &models.Pool{Name: "ignored", Description: "new"}
+			})
+
+			It("keeps the credentials of the pool", func() {
+				Expect(err).NotTo(HaveOccurred())
+
+				_, stored := fakePoolDB.UpdatePoolArgsForCall(0)
+				Expect(stored).To(Equal(&models.Pool{Name: "staging", Description: "new", Username: "staging", Password: "sha256$aa$bb"}))
+				Expect(updated).To(Equal(&models.Pool{Name: "staging", Description: "new", Username: "staging"}))
+			})
+		})
+
+		Context("with new credentials", func() {
+			BeforeEach(func() {
+				requested = &models.Pool{Description: "new", Username: "staging-2", Password: "n3w"}
+			})
+
+			It("replaces the credentials of the pool", func() {
+				Expect(err).NotTo(HaveOccurred())
+
+				_, stored := fakePoolDB.UpdatePoolArgsForCall(0)
+				Expect(stored.Username).To(Equal("staging-2"))
+				Expect(stored.Password).To(HavePrefix("$2a$"))
+				Expect(stored.Password).NotTo(Equal("sha256$aa$bb"))
+			})
+		})
+
+		Context("when the pool does not exist", func() {
+			BeforeEach(func() {
+				requested = &models.Pool{Description: "new"}
+				fakePoolDB.PoolByNameReturns(nil, models.ErrResourceNotFound)
+			})
+
+			It("returns not found", func() {
+				Expect(err).To(Equal(models.ErrResourceNotFound))
+				Expect(fakePoolDB.UpdatePoolCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("DeletePool", func() {
+		It("deletes the pool", func() {
+			Expect(controller.DeletePool(logger, "staging")).To(Succeed())
+
+			_, name := fakePoolDB.DeletePoolArgsForCall(0)
+			Expect(name).To(Equal("staging"))
+		})
+
+		It("keeps the default pool", func() {
+			err := controller.DeletePool(logger, models.DefaultPool)
+			Expect(models.ConvertError(err).Type).To(Equal(models.ErrorTypeResourceConflict))
+			Expect(fakePoolDB.DeletePoolCallCount()).To(Equal(0))
+		})
+	})
+
+	Describe("Authenticate", func() {
+		var hashed string
+
+		BeforeEach(func() {
+			hashed = storedPassword("s3cret")
+			fakePoolDB.PoolByUsernameReturns(&models.Pool{Name: "staging", Username: "staging", Password: hashed}, nil)
+		})
+
+		It("returns a user of the pool for its credentials", func() {
+			user, err := controller.Authenticate(logger, "staging", "s3cret")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(user).To(Equal(&models.User{Username: "staging", Pool: "staging"}))
+		})
+
+		It("rejects a wrong password", func() {
+			_, err := controller.Authenticate(logger, "staging", "guess")
+			Expect(err).To(Equal(models.ErrUnauthorized))
+		})
+
+		It("rejects an unknown username", func() {
+			fakePoolDB.PoolByUsernameReturns(nil, models.ErrResourceNotFound)
+
+			_, err := controller.Authenticate(logger, "nobody", "s3cret")
+			Expect(err).To(Equal(models.ErrUnauthorized))
+		})
+
+		It("keeps the password hash as it is", func() {
+			_, err := controller.Authenticate(logger, "staging", "s3cret")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakePoolDB.UpdatePoolCallCount()).To(Equal(0))
+		})
+
+		Context("when the pool was created before passwords were hashed with bcrypt", func() {
+			BeforeEach(func() {
+				// sha256 of the hex salt 00 followed by s3cret
+				sum := sha256.Sum256([]byte("\x00s3cret"))
+				hashed = "sha256$00$" + hex.EncodeToString(sum[:])
+				fakePoolDB.PoolByUsernameReturns(&models.Pool{Name: "staging", Username: "staging", Password: hashed}, nil)
+			})
+
+			It("accepts its password and hashes it again with bcrypt", func() {
+				user, err := controller.Authenticate(logger, "staging", "s3cret")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(user).To(Equal(&models.User{Username: "staging", Pool: "staging"}))
+
+				Expect(fakePoolDB.UpdatePoolCallCount()).To(Equal(1))
+				_, updated := fakePoolDB.UpdatePoolArgsForCall(0)
+				Expect(updated.Name).To(Equal("staging"))
+				Expect(updated.Password).To(HavePrefix("$2a$"))
+			})
+
+			It("rejects a wrong password", func() {
+				_, err := controller.Authenticate(logger, "staging", "guess")
+				Expect(err).To(Equal(models.ErrUnauthorized))
+				Expect(fakePoolDB.UpdatePoolCallCount()).To(Equal(0))
+			})
+		})
+
+		It("returns other errors as they are", func() {
+			fakePoolDB.PoolByUsernameReturns(nil, errors.New("kaboom"))
+
+			_, err := controller.Authenticate(logger, "staging", "s3cret")
+			Expect(err).To(MatchError("kaboom"))
+		})
+	})
+
+	Describe("AdminCredentials", func() {
+		var admin controllers.AdminCredentials
+
+		BeforeEach(func() {
+			hashed, err := bcrypt.GenerateFromPassword([]byte("Pa55word"), bcrypt.MinCost)
+			Expect(err).NotTo(HaveOccurred())
+			admin = controllers.AdminCredentials{Username: "root", PasswordHash: string(hashed)}
+		})
+
+		It("returns the administrator for their credentials", func() {
+			Expect(admin.IsAdmin("root")).To(BeTrue())
+			user, err := admin.Authenticate(logger, "root", "Pa55word")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(user).To(Equal(&models.User{Username: "root"}))
+		})
+
+		It("does not fold the case of the username or the password", func() {
+			Expect(admin.IsAdmin("ROOT")).To(BeFalse())
+			_, err := admin.Authenticate(logger, "ROOT", "Pa55word")
+			Expect(err).To(Equal(models.ErrUnauthorized))
+			_, err = admin.Authenticate(logger, "root", "pa55word")
+			Expect(err).To(Equal(models.ErrUnauthorized))
+		})
+
+		It("lets nobody in without a password hash", func() {
+			admin.PasswordHash = ""
+			Expect(admin.IsAdmin("root")).To(BeFalse())
+			_, err := admin.Authenticate(logger, "root", "")
+			Expect(err).To(Equal(models.ErrUnauthorized))
+		})
+	})
+})
@@ -0,0 +1,103 @@
+package controllers
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/db"
+	"github.com/jianqiu/vps/models"
+	"github.com/nu7hatch/gouuid"
+)
+
+type ReservationController struct {
+	db       db.ReservationDB
+	flavors  db.FlavorDB
+	pools    db.PoolDB
+	settings Settings
+}
+
+func NewReservationController(
+	db db.ReservationDB,
+	flavors db.FlavorDB,
+	pools db.PoolDB,
+	settings Settings,
+) *ReservationController {
+	return &ReservationController{
+		db:       db,
+		flavors:  flavors,
+		pools:    pools,
+		settings: settings,
+	}
+}
+
+// CreateReservation reserves the free VMs a reservation asks for, or
+// schedules it when it starts later, and returns it with its id, status, the
+// reserved cids and when it starts and expires. The filter may name a flavor
+// instead of sizes, just like an order. No reservations are taken in
+// maintenance mode.
+func (h *ReservationController) CreateReservation(logger lager.Logger, reservation *models.Reservation) (*models.Reservation, error) {
+	logger = logger.Session("create-reservation")
+
+	if h.settings.MaintenanceMode() {
+		return nil, models.ErrMaintenance
+	}
+
+	created := *reservation
+	if created.Pool == "" {
+		created.Pool = models.DefaultPool
+	}
+
+	invalid := created.ValidateRecord()
+	// the capacity a scheduled reservation needs is checked per flavor
+	if !time.Time(created.StartsAt).IsZero() && (created.Filter == nil || created.Filter.Flavor == "") {
+		invalid = append(invalid, models.ErrInvalidField{Field: "filter", Message: "must name a flavor when startsAt is given"})
+	}
+	_, err := h.pools.PoolByName(logger, created.Pool)
+	if err != nil {
+		if !models.ConvertError(err).Equal(models.ErrResourceNotFound) {
+			return nil, err
+		}
+		invalid = append(invalid, models.ErrInvalidField{Field: "pool", Message: "is not a known pool"})
+	}
+	if len(invalid) > 0 {
+		return nil, models.NewInvalidRecordError(invalid...)
+	}
+
+	filter := models.VMFilter{}
+	if created.Filter != nil {
+		filter = *created.Filter
+	}
+	if filter.Flavor != "" {
+		err = applyFlavor(logger, h.flavors, &filter)
+		if err != nil {
+			return nil, err
+		}
+	}
+	created.Filter = &filter
+
+	guid, err := uuid.NewV4()
+	if err != nil {
+		return nil, models.NewError(models.ErrorTypeGUIDGeneration, err.Error())
+	}
+	created.ID = guid.String()
+
+	err = h.db.ReserveVirtualGuests(logger, &created)
+	if err != nil {
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+func (h *ReservationController) Reservations(logger lager.Logger) ([]*models.Reservation, error) {
+	return h.db.Reservations(logger)
+}
+
+func (h *ReservationController) Reservation(logger lager.Logger, id string) (*models.Reservation, error) {
+	return h.db.ReservationByID(logger, id)
+}
+
+// CancelReservation frees the VMs of the reservation that no order claimed.
+func (h *ReservationController) CancelReservation(logger lager.Logger, id string) error {
+	return h.db.ReleaseReservation(logger, id)
+}
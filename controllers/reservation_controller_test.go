@@ -0,0 +1,125 @@
+package controllers_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jianqiu/vps/controllers"
+	"github.com/jianqiu/vps/controllers/controllersfakes"
+	"github.com/jianqiu/vps/db/dbfakes"
+	"github.com/jianqiu/vps/models"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/go-openapi/strfmt"
+)
+
+var _ = Describe("ReservationController", func() {
+	var (
+		logger            *lagertest.TestLogger
+		fakeReservationDB *dbfakes.FakeReservationDB
+		fakeFlavorDB      *dbfakes.FakeFlavorDB
+		fakePoolDB        *dbfakes.FakePoolDB
+		fakeSettings      *controllersfakes.FakeSettings
+		controller        *controllers.ReservationController
+	)
+
+	BeforeEach(func() {
+		fakeReservationDB = new(dbfakes.FakeReservationDB)
+		fakeFlavorDB = new(dbfakes.FakeFlavorDB)
+		fakePoolDB = new(dbfakes.FakePoolDB)
+		fakeSettings = new(controllersfakes.FakeSettings)
+		logger = lagertest.NewTestLogger("test")
+		controller = controllers.NewReservationController(fakeReservationDB, fakeFlavorDB, fakePoolDB, fakeSettings)
+	})
+
+	Describe("CreateReservation", func() {
+		It("reserves the vms in the default pool under a new id", func() {
+			created, err := controller.CreateReservation(logger, &models.Reservation{DeploymentName: "cf", Count: 2, TTL: 600})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created.ID).NotTo(BeEmpty())
+			Expect(created.Pool).To(Equal(models.DefaultPool))
+			Expect(created.Filter).To(Equal(&models.VMFilter{}))
+
+			_, pool := fakePoolDB.PoolByNameArgsForCall(0)
+			Expect(pool).To(Equal(models.DefaultPool))
+
+			_, reserved := fakeReservationDB.ReserveVirtualGuestsArgsForCall(0)
+			Expect(reserved).To(Equal(created))
+		})
+
+		It("fills the sizes of the filter in from its flavor", func() {
+			fakeFlavorDB.FlavorByNameReturns(&models.Flavor{Name: "m1.large", CPU: 4, MemoryMb: 8192}, nil)
+
+			created, err := controller.CreateReservation(logger, &models.Reservation{
+				DeploymentName: "cf",
+				Count:          1,
+				TTL:            600,
+				Filter:         &models.VMFilter{Flavor: "m1.large"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created.Filter).To(Equal(&models.VMFilter{Flavor: "m1.large", CPU: 4, MemoryMb: 8192}))
+		})
+
+		It("reports the invalid fields and an unknown pool without reserving", func() {
+			fakePoolDB.PoolByNameReturns(nil, models.ErrResourceNotFound)
+
+			_, err := controller.CreateReservation(logger, &models.Reservation{
+				Pool:   "nowhere",
+				Filter: &models.VMFilter{Cids: []int32{1}},
+			})
+
+			Expect(fakeReservationDB.ReserveVirtualGuestsCallCount()).To(Equal(0))
+			modelErr := models.ConvertError(err)
+			Expect(modelErr.Type).To(Equal(models.ErrorTypeInvalidRecord))
+			Expect(modelErr.Fields).To(ConsistOf(
+				&models.FieldError{Field: "deploymentName", Message: "is required"},
+				&models.FieldError{Field: "count", Message: "must be positive"},
+				&models.FieldError{Field: "ttl", Message: "must be positive"},
+				&models.FieldError{Field: "filter", Message: "cannot name specific vms"},
+				&models.FieldError{Field: "pool", Message: "is not a known pool"},
+			))
+		})
+
+		It("requires a flavor to schedule a reservation", func() {
+			_, err := controller.CreateReservation(logger, &models.Reservation{
+				DeploymentName: "cf",
+				Count:          1,
+				TTL:            600,
+				StartsAt:       strfmt.DateTime(time.Now().Add(24 * time.Hour)),
+				Filter:         &models.VMFilter{CPU: 4},
+			})
+
+			Expect(fakeReservationDB.ReserveVirtualGuestsCallCount()).To(Equal(0))
+			Expect(models.ConvertError(err).Fields).To(ConsistOf(
+				&models.FieldError{Field: "filter", Message: "must name a flavor when startsAt is given"},
+			))
+		})
+
+		It("returns the errors of the DB", func() {
+			conflict := models.NewResourceConflictError(models.ErrInvalidField{Field: "count", Message: "only 1 free vms match"})
+			fakeReservationDB.ReserveVirtualGuestsReturns(conflict)
+
+			_, err := controller.CreateReservation(logger, &models.Reservation{DeploymentName: "cf", Count: 2, TTL: 600})
+			Expect(err).To(Equal(conflict))
+		})
+
+		It("refuses reservations in maintenance mode", func() {
+			fakeSettings.MaintenanceModeReturns(true)
+
+			_, err := controller.CreateReservation(logger, &models.Reservation{DeploymentName: "cf", Count: 2, TTL: 600})
+			Expect(err).To(Equal(models.ErrMaintenance))
+			Expect(fakeReservationDB.ReserveVirtualGuestsCallCount()).To(Equal(0))
+		})
+	})
+
+	Describe("CancelReservation", func() {
+		It("releases the reservation", func() {
+			Expect(controller.CancelReservation(logger, "some-id")).To(Succeed())
+
+			_, id := fakeReservationDB.ReleaseReservationArgsForCall(0)
+			Expect(id).To(Equal("some-id"))
+		})
+	})
+})
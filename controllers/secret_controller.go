@@ -0,0 +1,166 @@
+package controllers
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/json"
+
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/db"
+	"github.com/jianqiu/vps/models"
+)
+
+// SecretController keeps the root credentials and ssh keys of the VMs of
+// the default pool, encrypted with the active key of a key ring. VMs of
+// other pools look to it as if they did not exist.
+type SecretController struct {
+	db      db.SecretDB
+	vms     db.VirtualGuestDB
+	keys    encryption.KeyManager
+	cryptor encryption.Cryptor
+}
+
+// NewSecretController builds a controller that encrypts with the keys of
+// keys. When keys is nil, no secrets can be stored or read.
+func NewSecretController(
+	db db.SecretDB,
+	vms db.VirtualGuestDB,
+	keys encryption.KeyManager,
+) *SecretController {
+	controller := &SecretController{
+		db:   db,
+		vms:  vms,
+		keys: keys,
+	}
+	if keys != nil {
+		controller.cryptor = encryption.NewCryptor(keys, rand.Reader)
+	}
+	return controller
+}
+
+var errNoEncryptionKey = models.NewError(models.ErrorTypeInvalidRequest, "vm secrets are not kept without an encryption key")
+
+// VMSecret decrypts the secret of the VM with cid for the holder of token,
+// which must be the secret token of the order that provisioned the VM, as
+// long as it is provisioning or in use. Any other token is unauthorized, so
+// that a VM handed back to the pool keeps its secret from the deployment
+// that had it before, and no deployment reads the secret of another's VM.
+func (h *SecretController) VMSecret(logger lager.Logger, cid int32, token string) (*models.VMSecret, error) {
+	logger = logger.Session("vm-secret", lager.Data{"cid": cid})
+
+	if h.cryptor == nil {
+		return nil, errNoEncryptionKey
+	}
+
+	vm, err := h.virtualGuestInPool(logger, cid)
+	if err != nil {
+		return nil, err
+	}
+	if vm.State != models.StateProvisioning && vm.State != models.StateUsing {
+		logger.Info("not-owned", lager.Data{"state": vm.State})
+		return nil, models.ErrUnauthorized
+	}
+
+	hash, err := h.db.VMSecretTokenHash(logger, cid)
+	if err != nil && !models.ConvertError(err).Equal(models.ErrResourceNotFound) {
+		return nil, err
+	}
+	if err != nil || token == "" || subtle.ConstantTimeCompare([]byte(models.HashSecretToken(token)), []byte(hash)) != 1 {
+		logger.Info("wrong-secret-token", lager.Data{"deployment": vm.DeploymentName})
+		return nil, models.ErrUnauthorized
+	}
+
+	encrypted, err := h.db.VMSecret(logger, cid)
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := h.cryptor.Decrypt(encrypted)
+	if err != nil {
+		logger.Error("failed-decrypting", err, lager.Data{"key-label": encrypted.KeyLabel})
+		return nil, models.NewError(models.ErrorTypeFailedToOpenEnvelope, err.Error())
+	}
+
+	secret := &models.VMSecret{}
+	err = json.Unmarshal(plaintext, secret)
+	if err != nil {
+		logger.Error("failed-unmarshalling", err)
+		return nil, models.NewError(models.ErrorTypeDeserialize, err.Error())
+	}
+	secret.Cid = cid
+
+	return secret, nil
+}
+
+// SetVMSecret encrypts secret with the active key and stores it as the
+// secret of the VM with cid. The username defaults to root.
+func (h *SecretController) SetVMSecret(logger lager.Logger, cid int32, secret *models.VMSecret) error {
+	logger = logger.Session("set-vm-secret", lager.Data{"cid": cid})
+
+	if h.cryptor == nil {
+		return errNoEncryptionKey
+	}
+
+	_, err := h.virtualGuestInPool(logger, cid)
+	if err != nil {
+		return err
+	}
+
+	stored := *secret
+	stored.Cid = cid
+	if stored.Username == "" {
+		stored.Username = "root"
+	}
+	if stored.Password == "" && len(stored.SSHKeys) == 0 {
+		return models.NewInvalidRecordError(models.ErrInvalidField{Field: "password", Message: "or sshKeys is required"})
+	}
+
+	plaintext, err := json.Marshal(stored)
+	if err != nil {
+		return err
+	}
+
+	encrypted, err := h.cryptor.Encrypt(plaintext)
+	if err != nil {
+		logger.Error("failed-encrypting", err)
+		return err
+	}
+
+	return h.db.SetVMSecret(logger, cid, encrypted)
+}
+
+func (h *SecretController) RemoveVMSecret(logger lager.Logger, cid int32) error {
+	logger = logger.Session("remove-vm-secret", lager.Data{"cid": cid})
+
+	_, err := h.virtualGuestInPool(logger, cid)
+	if err != nil {
+		return err
+	}
+
+	return h.db.RemoveVMSecret(logger, cid)
+}
+
+// ReEncrypt encrypts the secrets of every pool that are not encrypted with
+// the active key yet with it, so that the keys they were encrypted with
+// can be retired. It returns how many it encrypted.
+func (h *SecretController) ReEncrypt(logger lager.Logger) (int, error) {
+	logger = logger.Session("re-encrypt")
+
+	if h.cryptor == nil {
+		return 0, errNoEncryptionKey
+	}
+
+	return h.db.ReEncryptVMSecrets(logger, h.keys.EncryptionKey().Label(), h.cryptor)
+}
+
+func (h *SecretController) virtualGuestInPool(logger lager.Logger, cid int32) (*models.VM, error) {
+	vm, err := h.vms.VirtualGuestByCID(logger, cid)
+	if err != nil {
+		return nil, err
+	}
+	if vm == nil || poolOf(vm) != models.DefaultPool {
+		return nil, models.ErrResourceNotFound
+	}
+	return vm, nil
+}
@@ -0,0 +1,173 @@
+package controllers_test
+
+import (
+	"crypto/rand"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jianqiu/vps/controllers"
+	"github.com/jianqiu/vps/db/dbfakes"
+	"github.com/jianqiu/vps/models"
+
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagertest"
+)
+
+var _ = Describe("SecretController", func() {
+	var (
+		logger             *lagertest.TestLogger
+		fakeSecretDB       *dbfakes.FakeSecretDB
+		fakeVirtualGuestDB *dbfakes.FakeVirtualGuestDB
+		keys               encryption.KeyManager
+		controller         *controllers.SecretController
+	)
+
+	newKey := func(label, phrase string) encryption.Key {
+		key, err := encryption.NewKey(label, phrase)
+		Expect(err).NotTo(HaveOccurred())
+		return key
+	}
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		fakeSecretDB = new(dbfakes.FakeSecretDB)
+		fakeVirtualGuestDB = new(dbfakes.FakeVirtualGuestDB)
+		fakeVirtualGuestDB.VirtualGuestByCIDReturns(&models.VM{Cid: 1, State: models.StateUsing, DeploymentName: "cf"}, nil)
+
+		var err error
+		keys, err = encryption.NewKeyManager(newKey("new", "second passphrase"), []encryption.Key{newKey("old", "first passphrase")})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	JustBeforeEach(func() {
+		controller = controllers.NewSecretController(fakeSecretDB, fakeVirtualGuestDB, keys)
+	})
+
+	// the fake DB hands out the secret it was given last
+	BeforeEach(func() {
+		fakeSecretDB.SetVMSecretStub = func(_ lager.Logger, _ int32, secret encryption.Encrypted) error {
+			fakeSecretDB.VMSecretReturns(secret, nil)
+			return nil
+		}
+	})
+
+	Describe("SetVMSecret", func() {
+		It("stores the secret encrypted with the active key", func() {
+			err := controller.SetVMSecret(logger, 1, &models.VMSecret{Password: "s3cret"})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, cid, encrypted := fakeSecretDB.SetVMSecretArgsForCall(0)
+			Expect(cid).To(Equal(int32(1)))
+			Expect(encrypted.KeyLabel).To(Equal("new"))
+			Expect(string(encrypted.CipherText)).NotTo(ContainSubstring("s3cret"))
+		})
+
+		It("requires a password or ssh keys", func() {
+			err := controller.SetVMSecret(logger, 1, &models.VMSecret{Username: "root"})
+
+			Expect(fakeSecretDB.SetVMSecretCallCount()).To(Equal(0))
+			Expect(models.ConvertError(err).Fields).To(ConsistOf(
+				&models.FieldError{Field: "password", Message: "or sshKeys is required"},
+			))
+		})
+
+		It("does not find vms of other pools", func() {
+			fakeVirtualGuestDB.VirtualGuestByCIDReturns(&models.VM{Cid: 1, Pool: "staging"}, nil)
+
+			err := controller.SetVMSecret(logger, 1, &models.VMSecret{Password: "s3cret"})
+			Expect(err).To(Equal(models.ErrResourceNotFound))
+		})
+
+		Context("without an encryption key", func() {
+			BeforeEach(func() {
+				keys = nil
+			})
+
+			It("stores nothing", func() {
+				err := controller.SetVMSecret(logger, 1, &models.VMSecret{Password: "s3cret"})
+				Expect(models.ConvertError(err).Type).To(Equal(models.ErrorTypeInvalidRequest))
+				Expect(fakeSecretDB.SetVMSecretCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("VMSecret", func() {
+		BeforeEach(func() {
+			fakeSecretDB.VMSecretTokenHashReturns(models.HashSecretToken("cf-token"), nil)
+		})
+
+		JustBeforeEach(func() {
+			err := controller.SetVMSecret(logger, 1, &models.VMSecret{Password: "s3cret", SSHKeys: []string{"a-key"}})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("decrypts the secret for the holder of the token the vm was ordered with", func() {
+			secret, err := controller.VMSecret(logger, 1, "cf-token")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(secret).To(Equal(&models.VMSecret{
+				Cid:      1,
+				Username: "root",
+				Password: "s3cret",
+				SSHKeys:  []string{"a-key"},
+			}))
+
+			_, cid := fakeSecretDB.VMSecretTokenHashArgsForCall(0)
+			Expect(cid).To(Equal(int32(1)))
+		})
+
+		It("keeps it from the holders of other tokens", func() {
+			_, err := controller.VMSecret(logger, 1, "diego-token")
+			Expect(err).To(Equal(models.ErrUnauthorized))
+			Expect(fakeSecretDB.VMSecretCallCount()).To(Equal(0))
+		})
+
+		It("keeps it from callers without a token", func() {
+			_, err := controller.VMSecret(logger, 1, "")
+			Expect(err).To(Equal(models.ErrUnauthorized))
+		})
+
+		It("keeps it from everyone once the vm is back in the pool", func() {
+			fakeVirtualGuestDB.VirtualGuestByCIDReturns(&models.VM{Cid: 1, State: models.StateFree, DeploymentName: "cf"}, nil)
+
+			_, err := controller.VMSecret(logger, 1, "cf-token")
+			Expect(err).To(Equal(models.ErrUnauthorized))
+		})
+
+		It("keeps it from everyone when the vm was not ordered", func() {
+			fakeSecretDB.VMSecretTokenHashReturns("", models.ErrResourceNotFound)
+
+			_, err := controller.VMSecret(logger, 1, "cf-token")
+			Expect(err).To(Equal(models.ErrUnauthorized))
+		})
+
+		It("decrypts secrets encrypted with an older key", func() {
+			oldKeys, err := encryption.NewKeyManager(newKey("old", "first passphrase"), nil)
+			Expect(err).NotTo(HaveOccurred())
+			plaintext, err := json.Marshal(&models.VMSecret{Username: "root", Password: "older"})
+			Expect(err).NotTo(HaveOccurred())
+			encrypted, err := encryption.NewCryptor(oldKeys, rand.Reader).Encrypt(plaintext)
+			Expect(err).NotTo(HaveOccurred())
+			fakeSecretDB.VMSecretReturns(encrypted, nil)
+
+			secret, err := controller.VMSecret(logger, 1, "cf-token")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(secret.Password).To(Equal("older"))
+		})
+	})
+
+	Describe("ReEncrypt", func() {
+		It("re-encrypts the secrets with the active key", func() {
+			fakeSecretDB.ReEncryptVMSecretsReturns(3, nil)
+
+			count, err := controller.ReEncrypt(logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(count).To(Equal(3))
+
+			_, label, _ := fakeSecretDB.ReEncryptVMSecretsArgsForCall(0)
+			Expect(label).To(Equal("new"))
+		})
+	})
+})
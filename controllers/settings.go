@@ -0,0 +1,14 @@
+package controllers
+
+import "github.com/jianqiu/vps/models"
+
+//go:generate counterfeiter . Settings
+
+// Settings are the runtime settings the controllers go by. They can change
+// at any time, so the controllers read them again for every order.
+type Settings interface {
+	// MaintenanceMode is true while orders and reservations are refused.
+	MaintenanceMode() bool
+	// DefaultFilter holds the sizes, vlans or flavor orders default to.
+	DefaultFilter() models.VMFilter
+}
@@ -1,27 +1,60 @@
 package controllers
 
 import (
+	"encoding/json"
+	"fmt"
+
 	"code.cloudfoundry.org/lager"
+	"github.com/go-openapi/strfmt"
 	"github.com/jianqiu/vps/db"
 	"github.com/jianqiu/vps/models"
 )
 
 type VirtualGuestController struct {
 	db            db.VirtualGuestDB
+	flavors       db.FlavorDB
+	settings      Settings
+	strictFlavors bool
+	reloader      OSReloader
+	pool          string
 }
 
+// NewVirtualGuestController builds a controller on top of the pool DB that
+// works on the VMs of the default pool. Orders may name a flavor from
+// flavors instead of its sizes; with strictFlavors, VMs are only added when
+// they match one. Orders are refused in maintenance mode and take what they
+// leave out from the default filter of settings. When reloader is nil, released VMs go straight back to
+// free; otherwise VMs that were in use have their OS reloaded before being
+// handed out again.
 func NewVirtualGuestController(
 	db db.VirtualGuestDB,
+	flavors db.FlavorDB,
+	settings Settings,
+	strictFlavors bool,
+	reloader OSReloader,
 ) *VirtualGuestController {
 	return &VirtualGuestController{
 		db:            db,
+		flavors:       flavors,
+		settings:      settings,
+		strictFlavors: strictFlavors,
+		reloader:      reloader,
+		pool:          models.DefaultPool,
 	}
 }
 
+// InPool returns a controller that works on the VMs of pool instead. VMs of
+// other pools look to it as if they did not exist.
+func (h *VirtualGuestController) InPool(pool string) *VirtualGuestController {
+	scoped := *h
+	scoped.pool = pool
+	return &scoped
+}
+
 func (h *VirtualGuestController) AllVirtualGuests(logger lager.Logger) ([]*models.VM, error) {
 	logger = logger.Session("vms")
 
-	filter := models.VMFilter{}
+	filter := models.VMFilter{Pool: h.pool}
 
 	return h.db.VirtualGuests(logger, filter)
 }
@@ -35,25 +68,171 @@ func (h *VirtualGuestController) VirtualGuests(logger lager.Logger, publicVlan,
 		PublicVlan: publicVlan,
 		PrivateVlan: privateVlan,
 		State: state,
+		Pool: h.pool,
 	}
 
 	return h.db.VirtualGuests(logger, filter)
 }
 
 func (h *VirtualGuestController) OrderVirtualGuest(logger lager.Logger, vmFilter *models.VMFilter) (*models.VM, error){
-	return h.db.OrderVirtualGuestToProvision(logger, *vmFilter)
+	if h.settings.MaintenanceMode() {
+		return nil, models.ErrMaintenance
+	}
+
+	filter := models.VMFilter{}
+	if vmFilter != nil {
+		filter = *vmFilter
+	}
+	filter = withDefaults(filter, h.settings.DefaultFilter())
+	filter.Pool = h.pool
+
+	// only free VMs are for anyone to order; the reserved ones are claimed
+	// below, for the deployment they are reserved for
+	if filter.State != "" && filter.State != models.StateFree {
+		return nil, models.NewInvalidRecordError(models.ErrInvalidField{Field: "state", Message: "must be free when ordering"})
+	}
+
+	if filter.UserData != "" && !json.Valid([]byte(filter.UserData)) {
+		return nil, models.NewInvalidRecordError(models.ErrInvalidField{Field: "userData", Message: "must be JSON"})
+	}
+
+	if filter.Flavor != "" {
+		err := applyFlavor(logger, h.flavors, &filter)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// the VMs reserved for a deployment go to its own orders first
+	if filter.DeploymentName != "" && filter.State == "" {
+		reserved := filter
+		reserved.State = models.StateReserved
+		vm, err := h.db.OrderVirtualGuestToProvision(logger, reserved)
+		if err == nil || !models.ConvertError(err).Equal(models.ErrResourceNotFound) {
+			return vm, err
+		}
+	}
+
+	return h.db.OrderVirtualGuestToProvision(logger, filter)
+}
+
+// withDefaults fills in what filter leaves out from defaults. A filter that
+// names a flavor takes nothing from them, one that gives cpu or memory_mb
+// keeps its own sizes and takes no flavor; vlans it leaves out are always
+// filled in.
+func withDefaults(filter, defaults models.VMFilter) models.VMFilter {
+	if filter.Flavor != "" {
+		return filter
+	}
+	if filter.CPU == 0 && filter.MemoryMb == 0 {
+		filter.CPU = defaults.CPU
+		filter.MemoryMb = defaults.MemoryMb
+		filter.Flavor = defaults.Flavor
+	}
+	if filter.PublicVlan == 0 {
+		filter.PublicVlan = defaults.PublicVlan
+	}
+	if filter.PrivateVlan == 0 {
+		filter.PrivateVlan = defaults.PrivateVlan
+	}
+	return filter
+}
+
+// applyFlavor fills the sizes and vlans of filter in from the flavor it
+// names. Sizes the filter gives as well have to agree with the flavor.
+func applyFlavor(logger lager.Logger, flavors db.FlavorDB, filter *models.VMFilter) error {
+	flavor, err := flavors.FlavorByName(logger, filter.Flavor)
+	if err != nil {
+		if models.ConvertError(err).Equal(models.ErrResourceNotFound) {
+			return models.NewInvalidRecordError(models.ErrInvalidField{Field: "flavor", Message: "is not a known flavor"})
+		}
+		return err
+	}
+
+	var invalid []models.ErrInvalidField
+	for _, size := range []struct {
+		field string
+		given *int32
+		want  int32
+	}{
+		{"cpu", &filter.CPU, flavor.CPU},
+		{"memory_mb", &filter.MemoryMb, flavor.MemoryMb},
+		{"public_vlan", &filter.PublicVlan, flavor.PublicVlan},
+		{"private_vlan", &filter.PrivateVlan, flavor.PrivateVlan},
+	} {
+		switch {
+		case size.want == 0:
+		case *size.given == 0:
+			*size.given = size.want
+		case *size.given != size.want:
+			invalid = append(invalid, models.ErrInvalidField{Field: size.field, Message: "conflicts with flavor " + flavor.Name})
+		}
+	}
+	if len(invalid) > 0 {
+		return models.NewInvalidRecordError(invalid...)
+	}
+
+	return nil
+}
+
+// OrderVirtualGuests claims exactly the VMs with the given cids and ips, or
+// none of them when any is not free. It takes at least one cid or ip.
+func (h *VirtualGuestController) OrderVirtualGuests(logger lager.Logger, cids []int32, ips []strfmt.IPv4) ([]*models.VM, error) {
+	logger = logger.Session("order-vms")
+
+	if h.settings.MaintenanceMode() {
+		return nil, models.ErrMaintenance
+	}
+	if len(cids) == 0 && len(ips) == 0 {
+		return nil, models.ErrBadRequest
+	}
+
+	addresses := make([]string, 0, len(ips))
+	for _, ip := range ips {
+		addresses = append(addresses, string(ip))
+	}
+
+	return h.db.OrderVirtualGuestsToProvision(logger, h.pool, cids, addresses)
 }
 
 func (h *VirtualGuestController) VirtualGuestsByDeployments(logger lager.Logger, names []string) ([]*models.VM, error) {
-	return h.db.VirtualGuestsByDeployments(logger, names)
+	return h.inPool(h.db.VirtualGuestsByDeployments(logger, names))
 }
 
 func (h *VirtualGuestController) VirtualGuestsByStates(logger lager.Logger, states []string) ([]*models.VM, error) {
-	return h.db.VirtualGuestsByStates(logger, states)
+	return h.inPool(h.db.VirtualGuestsByStates(logger, states))
 }
 
 func (h *VirtualGuestController) CreateVM(logger lager.Logger, vmDefinition *models.VM) error {
-	var err error
+	logger = logger.Session("create-vm")
+
+	if vmDefinition.Pool == "" {
+		vmDefinition.Pool = h.pool
+	}
+
+	invalid, err := h.validateVM(logger, vmDefinition)
+	if err != nil {
+		return err
+	}
+	if vmDefinition.Pool != h.pool {
+		invalid = append(invalid, models.ErrInvalidField{Field: "pool", Message: fmt.Sprintf("must be %s", h.pool)})
+	}
+	if !models.IsInitialState(vmDefinition.State) {
+		invalid = append(invalid, models.ErrInvalidField{Field: "state", Message: "is not allowed for a new vm"})
+	}
+	if h.strictFlavors {
+		flavors, err := h.flavors.Flavors(logger)
+		if err != nil {
+			return err
+		}
+		if flavorOf(flavors, vmDefinition) == "" {
+			invalid = append(invalid, models.ErrInvalidField{Field: "flavor", Message: "matches none of the flavors"})
+		}
+	}
+	if len(invalid) > 0 {
+		return models.NewInvalidRecordError(invalid...)
+	}
+
 	err = h.db.InsertVirtualGuestToPool(logger, vmDefinition)
 	if err != nil {
 		return err
@@ -63,23 +242,79 @@ func (h *VirtualGuestController) CreateVM(logger lager.Logger, vmDefinition *mod
 }
 
 func (h *VirtualGuestController) UpdateVM(logger lager.Logger, vmDefinition *models.VM) error {
+	logger = logger.Session("update-vm")
+
+	_, err := h.virtualGuestInPool(logger, vmDefinition.Cid)
+	if err != nil {
+		return err
+	}
+	if vmDefinition.Pool == "" {
+		vmDefinition.Pool = h.pool
+	}
+
+	invalid, err := h.validateVM(logger, vmDefinition)
+	if err != nil {
+		return err
+	}
+	if vmDefinition.Pool != h.pool {
+		invalid = append(invalid, models.ErrInvalidField{Field: "pool", Message: "cannot be changed"})
+	}
+	if len(invalid) > 0 {
+		return models.NewInvalidRecordError(invalid...)
+	}
+
 	return h.db.UpdateVirtualGuestInPool(logger, vmDefinition)
 }
 
+// validateVM checks the fields of vm and that no other VM in the pool has
+// its IP.
+func (h *VirtualGuestController) validateVM(logger lager.Logger, vm *models.VM) ([]models.ErrInvalidField, error) {
+	invalid := vm.ValidateRecord()
+	if vm.IP == "" {
+		return invalid, nil
+	}
+
+	existing, err := h.db.VirtualGuestByIP(logger, string(vm.IP))
+	if err != nil && !models.ConvertError(err).Equal(models.ErrResourceNotFound) {
+		logger.Error("failed-checking-ip", err)
+		return nil, err
+	}
+	if existing != nil && existing.Cid != vm.Cid {
+		invalid = append(invalid, models.ErrInvalidField{
+			Field:   "ip",
+			Message: fmt.Sprintf("is already used by vm %d", existing.Cid),
+		})
+	}
+
+	return invalid, nil
+}
+
 func (h *VirtualGuestController) DeleteVM(logger lager.Logger, cid int32) error {
+	_, err := h.virtualGuestInPool(logger, cid)
+	if err != nil {
+		return err
+	}
+
 	return h.db.DeleteVirtualGuestFromPool(logger, cid)
 }
 
 func (h *VirtualGuestController) UpdateVMWithState(logger lager.Logger, cid int32, updateData *models.State) error {
-	var err error
+	_, err := h.virtualGuestInPool(logger, cid)
+	if err != nil {
+		return err
+	}
 
 	switch *updateData {
+	case models.StateReserved:
+		err = errReservedByHand
 	case models.StateUsing:
 		err = h.db.ChangeVirtualGuestToUse(logger, cid)
 	case models.StateFree:
-		err = h.db.ChangeVirtualGuestToFree(logger, cid)
+		err = h.releaseVM(logger, cid)
 	case models.StateProvisioning:
 		err = h.db.ChangeVirtualGuestToProvision(logger, cid)
+	default:
+		err = h.db.TransitionVirtualGuest(logger, cid, *updateData)
 	}
 
 	if err != nil {
@@ -89,6 +324,170 @@ func (h *VirtualGuestController) UpdateVMWithState(logger lager.Logger, cid int3
 	return nil
 }
 
+func (h *VirtualGuestController) releaseVM(logger lager.Logger, cid int32) error {
+	if h.reloader == nil {
+		return h.db.ChangeVirtualGuestToFree(logger, cid)
+	}
+
+	vm, err := h.db.VirtualGuestByCID(logger, cid)
+	if err != nil {
+		return err
+	}
+	if vm == nil {
+		return models.ErrResourceNotFound
+	}
+	if vm.State != models.StateUsing {
+		return h.db.ChangeVirtualGuestToFree(logger, cid)
+	}
+
+	err = h.db.TransitionVirtualGuest(logger, cid, models.StateReloading)
+	if err != nil {
+		return err
+	}
+
+	h.reloader.Reload(logger, cid)
+	return nil
+}
+
+// errReservedByHand rejects moving a VM to reserved outside of a
+// reservation, which would hold it for no deployment and never free it.
+var errReservedByHand = models.NewError(models.ErrorTypeInvalidRequest, "vms are only reserved through reservations")
+
+// errReloadsDisabled rejects moving a VM to reloading when released VMs
+// are not reloaded, as nothing would ever take it out of that state.
+var errReloadsDisabled = models.NewError(models.ErrorTypeInvalidRequest, "os reloads are not enabled")
+
+func (h *VirtualGuestController) TransitionVM(logger lager.Logger, cid int32, state models.State) error {
+	_, err := h.virtualGuestInPool(logger, cid)
+	if err != nil {
+		return err
+	}
+	if state == models.StateReserved {
+		return errReservedByHand
+	}
+	if state == models.StateReloading && h.reloader == nil {
+		return errReloadsDisabled
+	}
+
+	err = h.db.TransitionVirtualGuest(logger, cid, state)
+	if err != nil {
+		return err
+	}
+
+	if state == models.StateReloading {
+		h.reloader.Reload(logger, cid)
+	}
+	return nil
+}
+
 func (h *VirtualGuestController) VirtualGuestByCid(logger lager.Logger, cid int32) (*models.VM, error) {
-	return h.db.VirtualGuestByCID(logger, cid)
+	return h.virtualGuestInPool(logger, cid)
+}
+
+func (h *VirtualGuestController) VirtualGuestByIP(logger lager.Logger, ip string) (*models.VM, error) {
+	return h.onlyInPool(h.db.VirtualGuestByIP(logger, ip))
+}
+
+func (h *VirtualGuestController) VirtualGuestByHostname(logger lager.Logger, hostname string) (*models.VM, error) {
+	return h.onlyInPool(h.db.VirtualGuestByHostname(logger, hostname))
+}
+
+// VirtualGuestsByHostname finds the VMs whose hostname matches pattern, a *
+// in it matches any run of characters.
+func (h *VirtualGuestController) VirtualGuestsByHostname(logger lager.Logger, pattern string) ([]*models.VM, error) {
+	if pattern == "" {
+		return nil, models.NewError(models.ErrorTypeInvalidRequest, "hostname pattern is empty")
+	}
+	return h.inPool(h.db.VirtualGuestsByHostnamePattern(logger, pattern))
+}
+
+// Summary counts the VMs of the pool by the flavor they match and their
+// state. VMs that match no flavor are counted under an empty flavor name.
+func (h *VirtualGuestController) Summary(logger lager.Logger) (*models.PoolSummary, error) {
+	logger = logger.Session("summary")
+
+	flavors, err := h.flavors.Flavors(logger)
+	if err != nil {
+		return nil, err
+	}
+
+	vms, err := h.db.VirtualGuests(logger, models.VMFilter{Pool: h.pool})
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &models.PoolSummary{
+		Pool:    h.pool,
+		Total:   int32(len(vms)),
+		Flavors: []*models.FlavorSummary{},
+	}
+	byFlavor := map[string]*models.FlavorSummary{}
+	for _, flavor := range flavors {
+		byFlavor[flavor.Name] = &models.FlavorSummary{Flavor: flavor.Name, States: map[string]int32{}}
+		summary.Flavors = append(summary.Flavors, byFlavor[flavor.Name])
+	}
+
+	for _, vm := range vms {
+		name := flavorOf(flavors, vm)
+		flavorSummary, ok := byFlavor[name]
+		if !ok {
+			flavorSummary = &models.FlavorSummary{States: map[string]int32{}}
+			byFlavor[name] = flavorSummary
+			summary.Flavors = append(summary.Flavors, flavorSummary)
+		}
+		flavorSummary.Total++
+		flavorSummary.States[string(vm.State)]++
+	}
+
+	return summary, nil
+}
+
+// flavorOf is the name of the first of flavors that vm matches, or empty
+// when it matches none.
+func flavorOf(flavors []*models.Flavor, vm *models.VM) string {
+	for _, flavor := range flavors {
+		if flavor.Matches(vm) {
+			return flavor.Name
+		}
+	}
+	return ""
+}
+
+func (h *VirtualGuestController) virtualGuestInPool(logger lager.Logger, cid int32) (*models.VM, error) {
+	return h.onlyInPool(h.db.VirtualGuestByCID(logger, cid))
+}
+
+// onlyInPool passes vm on when it belongs to the controller's pool and
+// turns it into ErrResourceNotFound otherwise.
+func (h *VirtualGuestController) onlyInPool(vm *models.VM, err error) (*models.VM, error) {
+	if err != nil {
+		return nil, err
+	}
+	if vm == nil || poolOf(vm) != h.pool {
+		return nil, models.ErrResourceNotFound
+	}
+	return vm, nil
+}
+
+// inPool keeps the vms that belong to the controller's pool.
+func (h *VirtualGuestController) inPool(vms []*models.VM, err error) ([]*models.VM, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	kept := []*models.VM{}
+	for _, vm := range vms {
+		if poolOf(vm) == h.pool {
+			kept = append(kept, vm)
+		}
+	}
+	return kept, nil
+}
+
+// poolOf is the pool of vm, the default pool for VMs stored without one.
+func poolOf(vm *models.VM) string {
+	if vm.Pool == "" {
+		return models.DefaultPool
+	}
+	return vm.Pool
 }
@@ -8,22 +8,33 @@ import (
 
 	"github.com/jianqiu/vps/db/dbfakes"
 	"github.com/jianqiu/vps/controllers"
+	"github.com/jianqiu/vps/controllers/controllersfakes"
 	"github.com/jianqiu/vps/models"
 
+	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/go-openapi/strfmt"
 )
 
 var _ = Describe("VirtualGuestController", func() {
 	var (
 		logger                   *lagertest.TestLogger
 		fakeVirtualGuestDB               *dbfakes.FakeVirtualGuestDB
+		fakeFlavorDB             *dbfakes.FakeFlavorDB
+		fakeSettings             *controllersfakes.FakeSettings
 		controller *controllers.VirtualGuestController
 	)
 
 	BeforeEach(func() {
 		fakeVirtualGuestDB = new(dbfakes.FakeVirtualGuestDB)
+		fakeFlavorDB = new(dbfakes.FakeFlavorDB)
+		fakeSettings = new(controllersfakes.FakeSettings)
 		logger = lagertest.NewTestLogger("test")
-		controller = controllers.NewVirtualGuestController(fakeVirtualGuestDB)
+		controller = controllers.NewVirtualGuestController(fakeVirtualGuestDB, fakeFlavorDB, fakeSettings, false, nil)
+
+		// the vms looked up by cid are in the default pool unless a test
+		// says otherwise
+		fakeVirtualGuestDB.VirtualGuestByCIDReturns(&models.VM{Cid: 1, State: models.StateFree}, nil)
 	})
 
 	Describe("AllVirtualGuests", func() {
@@ -56,10 +67,10 @@ var _ = Describe("VirtualGuestController", func() {
 				Expect(actualVms).To(Equal(vms))
 			})
 
-			It("calls the DB with no filter", func() {
+			It("calls the DB with only the default pool", func() {
 				Expect(fakeVirtualGuestDB.VirtualGuestsCallCount()).To(Equal(1))
 				_, filter := fakeVirtualGuestDB.VirtualGuestsArgsForCall(0)
-				Expect(filter).To(Equal(models.VMFilter{}))
+				Expect(filter).To(Equal(models.VMFilter{Pool: models.DefaultPool}))
 			})
 		})
 
@@ -116,6 +127,11 @@ var _ = Describe("VirtualGuestController", func() {
 				fakeVirtualGuestDB.VirtualGuestsReturns(vms, nil)
 			})
 
+			It("returns the vms", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(actualVms).To(Equal(vms))
+			})
+
 			Context("and filtering by public_vlan, private_vlan, cpu, memory_mb", func() {
 				BeforeEach(func() {
 					public_vlan = 1234567
@@ -356,6 +372,402 @@ var _ = Describe("VirtualGuestController", func() {
 		})
 	})
 
+	Describe("OrderVirtualGuests", func() {
+		var (
+			cids      []int32
+			ips       []strfmt.IPv4
+			actualVms []*models.VM
+			err       error
+		)
+
+		BeforeEach(func() {
+			cids = []int32{1, 2}
+			ips = []strfmt.IPv4{"10.0.0.3"}
+		})
+
+		JustBeforeEach(func() {
+			actualVms, err = controller.OrderVirtualGuests(logger, cids, ips)
+		})
+
+		Context("when no vm is requested", func() {
+			BeforeEach(func() {
+				cids = nil
+				ips = []strfmt.IPv4{}
+			})
+
+			It("rejects the request", func() {
+				Expect(err).To(Equal(models.ErrBadRequest))
+				Expect(fakeVirtualGuestDB.OrderVirtualGuestsToProvisionCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when claiming the vms succeeds", func() {
+			var vms []*models.VM
+
+			BeforeEach(func() {
+				vms = []*models.VM{{Cid: 1}, {Cid: 2}, {Cid: 3, IP: "10.0.0.3"}}
+				fakeVirtualGuestDB.OrderVirtualGuestsToProvisionReturns(vms, nil)
+			})
+
+			It("claims exactly the requested vms", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(actualVms).To(Equal(vms))
+
+				Expect(fakeVirtualGuestDB.OrderVirtualGuestsToProvisionCallCount()).To(Equal(1))
+				_, pool, cids, ips := fakeVirtualGuestDB.OrderVirtualGuestsToProvisionArgsForCall(0)
+				Expect(pool).To(Equal(models.DefaultPool))
+				Expect(cids).To(Equal([]int32{1, 2}))
+				Expect(ips).To(Equal([]string{"10.0.0.3"}))
+			})
+		})
+
+		Context("when some of the vms are taken", func() {
+			var conflict *models.Error
+
+			BeforeEach(func() {
+				conflict = models.NewResourceConflictError(models.ErrInvalidField{Field: "ips", Message: "10.0.0.3 is using"})
+				fakeVirtualGuestDB.OrderVirtualGuestsToProvisionReturns(nil, conflict)
+			})
+
+			It("returns the conflict", func() {
+				Expect(err).To(Equal(conflict))
+				Expect(actualVms).To(BeNil())
+			})
+		})
+
+		Context("when the pool is in maintenance mode", func() {
+			BeforeEach(func() {
+				fakeSettings.MaintenanceModeReturns(true)
+			})
+
+			It("claims no vms", func() {
+				Expect(err).To(Equal(models.ErrMaintenance))
+				Expect(fakeVirtualGuestDB.OrderVirtualGuestsToProvisionCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("OrderVirtualGuest", func() {
+		var (
+			filter *models.VMFilter
+			err    error
+		)
+
+		BeforeEach(func() {
+			fakeFlavorDB.FlavorByNameReturns(&models.Flavor{Name: "m1.large", CPU: 4, MemoryMb: 8192, PrivateVlan: 12}, nil)
+		})
+
+		JustBeforeEach(func() {
+			_, err = controller.OrderVirtualGuest(logger, filter)
+		})
+
+		Context("when the filter names a flavor", func() {
+			BeforeEach(func() {
+				filter = &models.VMFilter{Flavor: "m1.large", PublicVlan: 34}
+			})
+
+			It("orders a vm with the sizes of the flavor", func() {
+				Expect(err).NotTo(HaveOccurred())
+
+				_, name := fakeFlavorDB.FlavorByNameArgsForCall(0)
+				Expect(name).To(Equal("m1.large"))
+
+				_, actualFilter := fakeVirtualGuestDB.OrderVirtualGuestToProvisionArgsForCall(0)
+				Expect(actualFilter).To(Equal(models.VMFilter{
+					Flavor:      "m1.large",
+					CPU:         4,
+					MemoryMb:    8192,
+					PublicVlan:  34,
+					PrivateVlan: 12,
+					Pool:        models.DefaultPool,
+				}))
+			})
+		})
+
+		Context("when the filter disagrees with the flavor", func() {
+			BeforeEach(func() {
+				filter = &models.VMFilter{Flavor: "m1.large", CPU: 2, PrivateVlan: 12}
+			})
+
+			It("rejects the order", func() {
+				Expect(fakeVirtualGuestDB.OrderVirtualGuestToProvisionCallCount()).To(Equal(0))
+				Expect(models.ConvertError(err).Fields).To(ConsistOf(
+					&models.FieldError{Field: "cpu", Message: "conflicts with flavor m1.large"},
+				))
+			})
+		})
+
+		Context("when the order carries agent settings", func() {
+			BeforeEach(func() {
+				filter = &models.VMFilter{CPU: 4, UserData: `{"agent_id":"abc"}`}
+			})
+
+			It("passes them on to the vm it orders", func() {
+				Expect(err).NotTo(HaveOccurred())
+				_, actualFilter := fakeVirtualGuestDB.OrderVirtualGuestToProvisionArgsForCall(0)
+				Expect(actualFilter.UserData).To(Equal(`{"agent_id":"abc"}`))
+			})
+
+			Context("that are not JSON", func() {
+				BeforeEach(func() {
+					filter.UserData = "agent_id=abc"
+				})
+
+				It("rejects the order", func() {
+					Expect(fakeVirtualGuestDB.OrderVirtualGuestToProvisionCallCount()).To(Equal(0))
+					Expect(models.ConvertError(err).Fields).To(ConsistOf(
+						&models.FieldError{Field: "userData", Message: "must be JSON"},
+					))
+				})
+			})
+		})
+
+		Context("when the filter names a state other than free", func() {
+			for _, state := range []models.State{models.StateReserved, models.StateUsing} {
+				state := state
+
+				Context(string(state), func() {
+					BeforeEach(func() {
+						filter = &models.VMFilter{CPU: 2, DeploymentName: "cf", State: state}
+					})
+
+					It("rejects the order", func() {
+						Expect(fakeVirtualGuestDB.OrderVirtualGuestToProvisionCallCount()).To(Equal(0))
+						Expect(models.ConvertError(err).Fields).To(ConsistOf(
+							&models.FieldError{Field: "state", Message: "must be free when ordering"},
+						))
+					})
+				})
+			}
+		})
+
+		Context("when the flavor is not known", func() {
+			BeforeEach(func() {
+				filter = &models.VMFilter{Flavor: "m1.huge"}
+				fakeFlavorDB.FlavorByNameReturns(nil, models.ErrResourceNotFound)
+			})
+
+			It("rejects the order instead of reporting no free vm", func() {
+				Expect(fakeVirtualGuestDB.OrderVirtualGuestToProvisionCallCount()).To(Equal(0))
+				Expect(models.ConvertError(err).Type).To(Equal(models.ErrorTypeInvalidRecord))
+			})
+		})
+
+		Context("when the filter names no flavor", func() {
+			BeforeEach(func() {
+				filter = &models.VMFilter{CPU: 2}
+			})
+
+			It("leaves the catalog alone", func() {
+				Expect(fakeFlavorDB.FlavorByNameCallCount()).To(Equal(0))
+				_, actualFilter := fakeVirtualGuestDB.OrderVirtualGuestToProvisionArgsForCall(0)
+				Expect(actualFilter.CPU).To(Equal(int32(2)))
+			})
+		})
+
+		Context("when the filter names a deployment", func() {
+			BeforeEach(func() {
+				filter = &models.VMFilter{CPU: 2, DeploymentName: "cf"}
+			})
+
+			It("orders a vm reserved for the deployment", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeVirtualGuestDB.OrderVirtualGuestToProvisionCallCount()).To(Equal(1))
+
+				_, actualFilter := fakeVirtualGuestDB.OrderVirtualGuestToProvisionArgsForCall(0)
+				Expect(actualFilter.State).To(Equal(models.StateReserved))
+				Expect(actualFilter.DeploymentName).To(Equal("cf"))
+			})
+
+			Context("and none is reserved for it", func() {
+				BeforeEach(func() {
+					fakeVirtualGuestDB.OrderVirtualGuestToProvisionStub = func(_ lager.Logger, filter models.VMFilter) (*models.VM, error) {
+						if filter.State == models.StateReserved {
+							return nil, models.ErrResourceNotFound
+						}
+						return &models.VM{Cid: 1}, nil
+					}
+				})
+
+				It("orders a vm the usual way", func() {
+					Expect(err).NotTo(HaveOccurred())
+					Expect(fakeVirtualGuestDB.OrderVirtualGuestToProvisionCallCount()).To(Equal(2))
+
+					_, actualFilter := fakeVirtualGuestDB.OrderVirtualGuestToProvisionArgsForCall(1)
+					Expect(actualFilter.State).To(BeEmpty())
+				})
+			})
+
+			Context("and the filter names a state", func() {
+				BeforeEach(func() {
+					filter.State = models.StateFree
+				})
+
+				It("orders a vm in that state only", func() {
+					Expect(fakeVirtualGuestDB.OrderVirtualGuestToProvisionCallCount()).To(Equal(1))
+
+					_, actualFilter := fakeVirtualGuestDB.OrderVirtualGuestToProvisionArgsForCall(0)
+					Expect(actualFilter.State).To(Equal(models.StateFree))
+				})
+			})
+		})
+
+		Context("when there is a default filter", func() {
+			BeforeEach(func() {
+				fakeSettings.DefaultFilterReturns(models.VMFilter{CPU: 2, MemoryMb: 4096, PublicVlan: 56})
+			})
+
+			Context("and the filter gives no sizes", func() {
+				BeforeEach(func() {
+					filter = &models.VMFilter{PrivateVlan: 78}
+				})
+
+				It("orders a vm with the default sizes and vlans", func() {
+					_, actualFilter := fakeVirtualGuestDB.OrderVirtualGuestToProvisionArgsForCall(0)
+					Expect(actualFilter).To(Equal(models.VMFilter{
+						CPU:         2,
+						MemoryMb:    4096,
+						PublicVlan:  56,
+						PrivateVlan: 78,
+						Pool:        models.DefaultPool,
+					}))
+				})
+			})
+
+			Context("and the filter gives a size", func() {
+				BeforeEach(func() {
+					filter = &models.VMFilter{CPU: 8}
+				})
+
+				It("keeps its own sizes", func() {
+					_, actualFilter := fakeVirtualGuestDB.OrderVirtualGuestToProvisionArgsForCall(0)
+					Expect(actualFilter.CPU).To(Equal(int32(8)))
+					Expect(actualFilter.MemoryMb).To(BeZero())
+					Expect(actualFilter.PublicVlan).To(Equal(int32(56)))
+				})
+			})
+
+			Context("and the filter names a flavor", func() {
+				BeforeEach(func() {
+					filter = &models.VMFilter{Flavor: "m1.large"}
+				})
+
+				It("takes nothing from the default", func() {
+					_, actualFilter := fakeVirtualGuestDB.OrderVirtualGuestToProvisionArgsForCall(0)
+					Expect(actualFilter.CPU).To(Equal(int32(4)))
+					Expect(actualFilter.PublicVlan).To(BeZero())
+				})
+			})
+
+			Context("and the default names a flavor", func() {
+				BeforeEach(func() {
+					fakeSettings.DefaultFilterReturns(models.VMFilter{Flavor: "m1.large"})
+					filter = nil
+				})
+
+				It("orders a vm of that flavor", func() {
+					_, name := fakeFlavorDB.FlavorByNameArgsForCall(0)
+					Expect(name).To(Equal("m1.large"))
+
+					_, actualFilter := fakeVirtualGuestDB.OrderVirtualGuestToProvisionArgsForCall(0)
+					Expect(actualFilter.MemoryMb).To(Equal(int32(8192)))
+				})
+			})
+		})
+
+		Context("when the pool is in maintenance mode", func() {
+			BeforeEach(func() {
+				fakeSettings.MaintenanceModeReturns(true)
+				filter = &models.VMFilter{CPU: 2}
+			})
+
+			It("refuses the order", func() {
+				Expect(err).To(Equal(models.ErrMaintenance))
+				Expect(fakeVirtualGuestDB.OrderVirtualGuestToProvisionCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("VirtualGuestByIP", func() {
+		var vm1 *models.VM
+
+		BeforeEach(func() {
+			vm1 = &models.VM{Cid: 1234567, IP: "10.0.0.1"}
+			fakeVirtualGuestDB.VirtualGuestByIPReturns(vm1, nil)
+		})
+
+		It("fetches the vm by ip", func() {
+			actualVm, err := controller.VirtualGuestByIP(logger, "10.0.0.1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actualVm).To(Equal(vm1))
+
+			_, actualIP := fakeVirtualGuestDB.VirtualGuestByIPArgsForCall(0)
+			Expect(actualIP).To(Equal("10.0.0.1"))
+		})
+	})
+
+	Describe("VirtualGuestByHostname", func() {
+		var vm1 *models.VM
+
+		BeforeEach(func() {
+			vm1 = &models.VM{Cid: 1234567, Hostname: "vm-1.example.com"}
+			fakeVirtualGuestDB.VirtualGuestByHostnameReturns(vm1, nil)
+		})
+
+		It("fetches the vm by hostname", func() {
+			actualVm, err := controller.VirtualGuestByHostname(logger, "vm-1.example.com")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actualVm).To(Equal(vm1))
+
+			_, actualHostname := fakeVirtualGuestDB.VirtualGuestByHostnameArgsForCall(0)
+			Expect(actualHostname).To(Equal("vm-1.example.com"))
+		})
+	})
+
+	Describe("VirtualGuestsByHostname", func() {
+		var (
+			pattern   string
+			actualVms []*models.VM
+			err       error
+		)
+
+		BeforeEach(func() {
+			pattern = "vm-*"
+		})
+
+		JustBeforeEach(func() {
+			actualVms, err = controller.VirtualGuestsByHostname(logger, pattern)
+		})
+
+		Context("when searching the DB succeeds", func() {
+			var vms []*models.VM
+
+			BeforeEach(func() {
+				vms = []*models.VM{{Cid: 1, Hostname: "vm-1"}}
+				fakeVirtualGuestDB.VirtualGuestsByHostnamePatternReturns(vms, nil)
+			})
+
+			It("returns the matching vms", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(actualVms).To(Equal(vms))
+
+				_, actualPattern := fakeVirtualGuestDB.VirtualGuestsByHostnamePatternArgsForCall(0)
+				Expect(actualPattern).To(Equal("vm-*"))
+			})
+		})
+
+		Context("when the pattern is empty", func() {
+			BeforeEach(func() {
+				pattern = ""
+			})
+
+			It("rejects the search without querying the DB", func() {
+				Expect(models.ConvertError(err).Type).To(Equal(models.ErrorTypeInvalidRequest))
+				Expect(fakeVirtualGuestDB.VirtualGuestsByHostnamePatternCallCount()).To(Equal(0))
+			})
+		})
+	})
+
 	Describe("CreateVM", func() {
 		Context("when the createVM request is normal", func() {
 			var (
@@ -366,6 +778,8 @@ var _ = Describe("VirtualGuestController", func() {
 			BeforeEach(func() {
 				vmDefinition = &models.VM{
 					Cid: 1234567,
+					Hostname: "vm-1.example.com",
+					IP: "10.0.0.1",
 					CPU: 4,
 					MemoryMb: 1024,
 					PublicVlan: 1234567,
@@ -396,6 +810,133 @@ var _ = Describe("VirtualGuestController", func() {
 					Expect(err).To(MatchError("kaboom"))
 				})
 			})
+
+			Context("when the vm is missing required fields", func() {
+				BeforeEach(func() {
+					vmDefinition = &models.VM{State: models.StateFree}
+				})
+
+				It("reports every invalid field without inserting the vm", func() {
+					Expect(fakeVirtualGuestDB.InsertVirtualGuestToPoolCallCount()).To(Equal(0))
+
+					modelErr := models.ConvertError(err)
+					Expect(modelErr.Type).To(Equal(models.ErrorTypeInvalidRecord))
+					Expect(modelErr.Fields).To(ConsistOf(
+						&models.FieldError{Field: "cid", Message: "must be positive"},
+						&models.FieldError{Field: "hostname", Message: "is required"},
+						&models.FieldError{Field: "ip", Message: "is required"},
+						&models.FieldError{Field: "cpu", Message: "must be positive"},
+						&models.FieldError{Field: "memory_mb", Message: "must be positive"},
+					))
+				})
+			})
+
+			Context("when the hostname is not valid", func() {
+				BeforeEach(func() {
+					vmDefinition.Hostname = "-vm_1..example"
+				})
+
+				It("rejects the vm", func() {
+					Expect(fakeVirtualGuestDB.InsertVirtualGuestToPoolCallCount()).To(Equal(0))
+					Expect(models.ConvertError(err).Fields).To(ConsistOf(
+						&models.FieldError{Field: "hostname", Message: "is not a valid hostname"},
+					))
+				})
+			})
+
+			Context("when the state is not allowed for a new vm", func() {
+				BeforeEach(func() {
+					vmDefinition.State = models.StateUsing
+				})
+
+				It("rejects the vm", func() {
+					Expect(fakeVirtualGuestDB.InsertVirtualGuestToPoolCallCount()).To(Equal(0))
+					Expect(models.ConvertError(err).Fields).To(ConsistOf(
+						&models.FieldError{Field: "state", Message: "is not allowed for a new vm"},
+					))
+				})
+			})
+
+			Context("when the state is not a known state", func() {
+				BeforeEach(func() {
+					vmDefinition.State = models.State("bogus")
+				})
+
+				It("rejects the vm instead of storing it as unknown", func() {
+					Expect(fakeVirtualGuestDB.InsertVirtualGuestToPoolCallCount()).To(Equal(0))
+					Expect(models.ConvertError(err).Fields).To(ConsistOf(
+						&models.FieldError{Field: "state", Message: "is not a known state"},
+						&models.FieldError{Field: "state", Message: "is not allowed for a new vm"},
+					))
+				})
+			})
+
+			Context("when another vm already has the ip", func() {
+				BeforeEach(func() {
+					fakeVirtualGuestDB.VirtualGuestByIPReturns(&models.VM{Cid: 42, IP: vmDefinition.IP}, nil)
+				})
+
+				It("rejects the vm", func() {
+					Expect(fakeVirtualGuestDB.VirtualGuestByIPCallCount()).To(Equal(1))
+					_, ip := fakeVirtualGuestDB.VirtualGuestByIPArgsForCall(0)
+					Expect(ip).To(Equal("10.0.0.1"))
+
+					Expect(fakeVirtualGuestDB.InsertVirtualGuestToPoolCallCount()).To(Equal(0))
+					Expect(models.ConvertError(err).Fields).To(ConsistOf(
+						&models.FieldError{Field: "ip", Message: "is already used by vm 42"},
+					))
+				})
+			})
+
+			Context("when no vm has the ip yet", func() {
+				BeforeEach(func() {
+					fakeVirtualGuestDB.VirtualGuestByIPReturns(nil, models.ErrResourceNotFound)
+				})
+
+				It("inserts the vm", func() {
+					Expect(err).NotTo(HaveOccurred())
+					Expect(fakeVirtualGuestDB.InsertVirtualGuestToPoolCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when checking the ip fails", func() {
+				BeforeEach(func() {
+					fakeVirtualGuestDB.VirtualGuestByIPReturns(nil, models.ErrDeadlock)
+				})
+
+				It("returns the error without inserting the vm", func() {
+					Expect(err).To(Equal(models.ErrDeadlock))
+					Expect(fakeVirtualGuestDB.InsertVirtualGuestToPoolCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when flavors are strict", func() {
+				BeforeEach(func() {
+					controller = controllers.NewVirtualGuestController(fakeVirtualGuestDB, fakeFlavorDB, fakeSettings, true, nil)
+					fakeFlavorDB.FlavorsReturns([]*models.Flavor{
+						{Name: "m1.small", CPU: 2, MemoryMb: 1024},
+						{Name: "m1.large", CPU: 4, MemoryMb: 1024, PublicVlan: 1234567},
+					}, nil)
+				})
+
+				It("inserts a vm that matches a flavor", func() {
+					Expect(err).NotTo(HaveOccurred())
+					Expect(fakeVirtualGuestDB.InsertVirtualGuestToPoolCallCount()).To(Equal(1))
+				})
+
+				Context("and the vm matches none", func() {
+					BeforeEach(func() {
+						vmDefinition.PublicVlan = 7654321
+					})
+
+					It("rejects the vm", func() {
+						Expect(fakeVirtualGuestDB.InsertVirtualGuestToPoolCallCount()).To(Equal(0))
+						Expect(models.ConvertError(err).Fields).To(ConsistOf(
+							&models.FieldError{Field: "flavor", Message: "matches none of the flavors"},
+						))
+					})
+				})
+			})
 		})
 	})
 
@@ -409,6 +950,8 @@ var _ = Describe("VirtualGuestController", func() {
 			BeforeEach(func() {
 				vmDefinition = &models.VM{
 					Cid: 1234567,
+					Hostname: "vm-1.example.com",
+					IP: "10.0.0.1",
 					CPU: 4,
 					MemoryMb: 1024,
 					PublicVlan: 1234567,
@@ -439,6 +982,47 @@ var _ = Describe("VirtualGuestController", func() {
 					Expect(err).To(MatchError("kaboom"))
 				})
 			})
+
+			Context("when the vm keeps its own ip", func() {
+				BeforeEach(func() {
+					fakeVirtualGuestDB.VirtualGuestByIPReturns(&models.VM{Cid: vmDefinition.Cid, IP: vmDefinition.IP}, nil)
+				})
+
+				It("updates the vm", func() {
+					Expect(err).NotTo(HaveOccurred())
+					Expect(fakeVirtualGuestDB.UpdateVirtualGuestInPoolCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when the vm is moved to a state outside the initial ones", func() {
+				BeforeEach(func() {
+					vmDefinition.State = models.StateUsing
+				})
+
+				It("updates the vm", func() {
+					Expect(err).NotTo(HaveOccurred())
+					Expect(fakeVirtualGuestDB.UpdateVirtualGuestInPoolCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when the vm is invalid", func() {
+				BeforeEach(func() {
+					vmDefinition.CPU = 0
+					fakeVirtualGuestDB.VirtualGuestByIPReturns(&models.VM{Cid: 42, IP: vmDefinition.IP}, nil)
+				})
+
+				It("reports the invalid fields without updating the vm", func() {
+					Expect(fakeVirtualGuestDB.UpdateVirtualGuestInPoolCallCount()).To(Equal(0))
+
+					modelErr := models.ConvertError(err)
+					Expect(modelErr.Type).To(Equal(models.ErrorTypeInvalidRecord))
+					Expect(modelErr.Message).To(Equal("Invalid field: cpu: must be positive; Invalid field: ip: is already used by vm 42"))
+					Expect(modelErr.Fields).To(ConsistOf(
+						&models.FieldError{Field: "cpu", Message: "must be positive"},
+						&models.FieldError{Field: "ip", Message: "is already used by vm 42"},
+					))
+				})
+			})
 		})
 	})
 
@@ -505,6 +1089,280 @@ var _ = Describe("VirtualGuestController", func() {
 					Expect(err).To(MatchError("kaboom"))
 				})
 			})
+
+			Context("when updating the vm with Maintenance succeeds", func() {
+				It("transitions the vm through the lifecycle", func() {
+					vmState = models.VMState{
+						State: models.StateMaintenance,
+					}
+					err = controller.UpdateVMWithState(logger, cid, &vmState.State)
+					Expect(fakeVirtualGuestDB.TransitionVirtualGuestCallCount()).To(Equal(1))
+					_, actualCid, actualState := fakeVirtualGuestDB.TransitionVirtualGuestArgsForCall(0)
+					Expect(actualCid).To(Equal(cid))
+					Expect(actualState).To(Equal(models.StateMaintenance))
+					Expect(err).NotTo(HaveOccurred())
+				})
+			})
+		})
+
+		Context("when releasing a vm with an OS reloader configured", func() {
+			var (
+				fakeReloader *controllersfakes.FakeOSReloader
+				state        models.State
+				cid          int32
+				err          error
+			)
+
+			BeforeEach(func() {
+				cid = 1234567
+				state = models.StateFree
+				fakeReloader = new(controllersfakes.FakeOSReloader)
+				controller = controllers.NewVirtualGuestController(fakeVirtualGuestDB, fakeFlavorDB, fakeSettings, false, fakeReloader)
+			})
+
+			JustBeforeEach(func() {
+				err = controller.UpdateVMWithState(logger, cid, &state)
+			})
+
+			Context("and the vm is in use", func() {
+				BeforeEach(func() {
+					fakeVirtualGuestDB.VirtualGuestByCIDReturns(&models.VM{Cid: cid, State: models.StateUsing}, nil)
+				})
+
+				It("moves the vm to reloading", func() {
+					Expect(err).NotTo(HaveOccurred())
+					Expect(fakeVirtualGuestDB.TransitionVirtualGuestCallCount()).To(Equal(1))
+					_, actualCid, actualState := fakeVirtualGuestDB.TransitionVirtualGuestArgsForCall(0)
+					Expect(actualCid).To(Equal(cid))
+					Expect(actualState).To(Equal(models.StateReloading))
+					Expect(fakeVirtualGuestDB.ChangeVirtualGuestToFreeCallCount()).To(Equal(0))
+				})
+
+				It("starts the OS reload", func() {
+					Expect(fakeReloader.ReloadCallCount()).To(Equal(1))
+					_, actualCid := fakeReloader.ReloadArgsForCall(0)
+					Expect(actualCid).To(Equal(cid))
+				})
+
+				Context("and moving it to reloading fails", func() {
+					BeforeEach(func() {
+						fakeVirtualGuestDB.TransitionVirtualGuestReturns(models.NewTaskTransitionError(models.StateFree, models.StateReloading))
+					})
+
+					It("does not reload the vm", func() {
+						Expect(err).To(HaveOccurred())
+						Expect(fakeReloader.ReloadCallCount()).To(Equal(0))
+					})
+				})
+			})
+
+			Context("and the vm was never used", func() {
+				BeforeEach(func() {
+					fakeVirtualGuestDB.VirtualGuestByCIDReturns(&models.VM{Cid: cid, State: models.StateProvisioning}, nil)
+				})
+
+				It("returns the vm straight to free", func() {
+					Expect(err).NotTo(HaveOccurred())
+					Expect(fakeVirtualGuestDB.ChangeVirtualGuestToFreeCallCount()).To(Equal(1))
+					Expect(fakeReloader.ReloadCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("and the vm does not exist", func() {
+				BeforeEach(func() {
+					fakeVirtualGuestDB.VirtualGuestByCIDReturns(nil, models.ErrResourceNotFound)
+				})
+
+				It("returns the error", func() {
+					Expect(err).To(Equal(models.ErrResourceNotFound))
+					Expect(fakeReloader.ReloadCallCount()).To(Equal(0))
+				})
+			})
+		})
+	})
+
+	Describe("TransitionVM", func() {
+		var (
+			cid   int32
+			state models.State
+			err   error
+		)
+
+		BeforeEach(func() {
+			cid = 1234567
+			state = models.StateRetiring
+		})
+
+		JustBeforeEach(func() {
+			err = controller.TransitionVM(logger, cid, state)
+		})
+
+		Context("when the transition succeeds", func() {
+			It("transitions the vm in the DB", func() {
+				Expect(fakeVirtualGuestDB.TransitionVirtualGuestCallCount()).To(Equal(1))
+				_, actualCid, actualState := fakeVirtualGuestDB.TransitionVirtualGuestArgsForCall(0)
+				Expect(actualCid).To(Equal(cid))
+				Expect(actualState).To(Equal(state))
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when the DB errors out", func() {
+			BeforeEach(func() {
+				fakeVirtualGuestDB.TransitionVirtualGuestReturns(models.NewTaskTransitionError(models.StateUsing, state))
+			})
+
+			It("provides relevant error information", func() {
+				Expect(err).To(Equal(models.NewTaskTransitionError(models.StateUsing, state)))
+			})
+		})
+
+		Context("when the state is reserved", func() {
+			BeforeEach(func() {
+				state = models.StateReserved
+			})
+
+			It("rejects the transition, which only reservations make", func() {
+				Expect(fakeVirtualGuestDB.TransitionVirtualGuestCallCount()).To(Equal(0))
+				Expect(models.ConvertError(err).Type).To(Equal(models.ErrorTypeInvalidRequest))
+			})
+		})
+
+		Context("when the state is reloading", func() {
+			BeforeEach(func() {
+				state = models.StateReloading
+			})
+
+			It("rejects the transition when os reloads are not enabled", func() {
+				Expect(fakeVirtualGuestDB.TransitionVirtualGuestCallCount()).To(Equal(0))
+				Expect(models.ConvertError(err).Type).To(Equal(models.ErrorTypeInvalidRequest))
+			})
+
+			Context("with an OS reloader configured", func() {
+				var fakeReloader *controllersfakes.FakeOSReloader
+
+				BeforeEach(func() {
+					fakeReloader = new(controllersfakes.FakeOSReloader)
+					controller = controllers.NewVirtualGuestController(fakeVirtualGuestDB, fakeFlavorDB, fakeSettings, false, fakeReloader)
+				})
+
+				It("moves the vm to reloading and has the reloader pick it up", func() {
+					Expect(err).NotTo(HaveOccurred())
+					Expect(fakeVirtualGuestDB.TransitionVirtualGuestCallCount()).To(Equal(1))
+					_, _, actualState := fakeVirtualGuestDB.TransitionVirtualGuestArgsForCall(0)
+					Expect(actualState).To(Equal(models.StateReloading))
+
+					Expect(fakeReloader.ReloadCallCount()).To(Equal(1))
+					_, actualCid := fakeReloader.ReloadArgsForCall(0)
+					Expect(actualCid).To(Equal(cid))
+				})
+
+				Context("when the vm cannot move to reloading", func() {
+					BeforeEach(func() {
+						fakeVirtualGuestDB.TransitionVirtualGuestReturns(models.NewTaskTransitionError(models.StateFree, state))
+					})
+
+					It("does not reload it", func() {
+						Expect(err).To(HaveOccurred())
+						Expect(fakeReloader.ReloadCallCount()).To(Equal(0))
+					})
+				})
+			})
+		})
+	})
+
+	Describe("InPool", func() {
+		var (
+			staging *controllers.VirtualGuestController
+			newVM   *models.VM
+		)
+
+		BeforeEach(func() {
+			staging = controller.InPool("staging")
+			newVM = &models.VM{
+				Cid:      2,
+				Hostname: "vm-2.example.com",
+				IP:       "10.0.0.2",
+				CPU:      4,
+				MemoryMb: 1024,
+				State:    models.StateFree,
+			}
+		})
+
+		It("filters the vms by its pool", func() {
+			_, err := staging.VirtualGuests(logger, 0, 0, 0, 0, "")
+			Expect(err).NotTo(HaveOccurred())
+
+			_, filter := fakeVirtualGuestDB.VirtualGuestsArgsForCall(0)
+			Expect(filter.Pool).To(Equal("staging"))
+		})
+
+		It("orders the vms of its pool", func() {
+			_, err := staging.OrderVirtualGuests(logger, []int32{1}, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, pool, _, _ := fakeVirtualGuestDB.OrderVirtualGuestsToProvisionArgsForCall(0)
+			Expect(pool).To(Equal("staging"))
+		})
+
+		It("does not find vms of other pools", func() {
+			_, err := staging.VirtualGuestByCid(logger, 1)
+			Expect(err).To(Equal(models.ErrResourceNotFound))
+		})
+
+		It("does not delete vms of other pools", func() {
+			err := staging.DeleteVM(logger, 1)
+			Expect(err).To(Equal(models.ErrResourceNotFound))
+			Expect(fakeVirtualGuestDB.DeleteVirtualGuestFromPoolCallCount()).To(Equal(0))
+		})
+
+		It("adds new vms to its pool", func() {
+			err := staging.CreateVM(logger, newVM)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, inserted := fakeVirtualGuestDB.InsertVirtualGuestToPoolArgsForCall(0)
+			Expect(inserted.Pool).To(Equal("staging"))
+		})
+
+		It("rejects new vms of another pool", func() {
+			newVM.Pool = "prod"
+
+			err := staging.CreateVM(logger, newVM)
+			Expect(models.ConvertError(err).Fields).To(ConsistOf(
+				&models.FieldError{Field: "pool", Message: "must be staging"},
+			))
+			Expect(fakeVirtualGuestDB.InsertVirtualGuestToPoolCallCount()).To(Equal(0))
+		})
+	})
+
+	Describe("Summary", func() {
+		It("counts the vms of the pool by flavor and state", func() {
+			fakeFlavorDB.FlavorsReturns([]*models.Flavor{
+				{Name: "m1.small", CPU: 2, MemoryMb: 1024},
+				{Name: "m1.large", CPU: 4, MemoryMb: 8192},
+			}, nil)
+			fakeVirtualGuestDB.VirtualGuestsReturns([]*models.VM{
+				{Cid: 1, CPU: 2, MemoryMb: 1024, State: models.StateFree},
+				{Cid: 2, CPU: 2, MemoryMb: 1024, State: models.StateUsing},
+				{Cid: 3, CPU: 2, MemoryMb: 1024, State: models.StateFree},
+				{Cid: 4, CPU: 8, MemoryMb: 1024, State: models.StateFree},
+			}, nil)
+
+			summary, err := controller.InPool("staging").Summary(logger)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, filter := fakeVirtualGuestDB.VirtualGuestsArgsForCall(0)
+			Expect(filter).To(Equal(models.VMFilter{Pool: "staging"}))
+
+			Expect(summary).To(Equal(&models.PoolSummary{
+				Pool:  "staging",
+				Total: 4,
+				Flavors: []*models.FlavorSummary{
+					{Flavor: "m1.small", Total: 3, States: map[string]int32{"free": 2, "using": 1}},
+					{Flavor: "m1.large", States: map[string]int32{}},
+					{Total: 1, States: map[string]int32{"free": 1}},
+				},
+			}))
 		})
 	})
 })
\ No newline at end of file
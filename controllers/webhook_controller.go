@@ -0,0 +1,86 @@
+package controllers
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/url"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/db"
+	"github.com/jianqiu/vps/models"
+	"github.com/nu7hatch/gouuid"
+)
+
+type WebhookController struct {
+	db db.WebhookDB
+}
+
+func NewWebhookController(
+	db db.WebhookDB,
+) *WebhookController {
+	return &WebhookController{
+		db: db,
+	}
+}
+
+// CreateWebhook subscribes a webhook and returns it with its id and secret.
+// A secret is generated when the request does not bring one.
+func (h *WebhookController) CreateWebhook(logger lager.Logger, webhook *models.Webhook) (*models.Webhook, error) {
+	logger = logger.Session("create-webhook")
+
+	target, err := url.Parse(webhook.URL)
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+		return nil, models.NewError(models.ErrorTypeInvalidRequest, "webhook url must be an absolute http or https url")
+	}
+
+	guid, err := uuid.NewV4()
+	if err != nil {
+		return nil, models.NewError(models.ErrorTypeGUIDGeneration, err.Error())
+	}
+
+	created := *webhook
+	created.ID = guid.String()
+	if created.Secret == "" {
+		secret := make([]byte, 32)
+		_, err = rand.Read(secret)
+		if err != nil {
+			return nil, err
+		}
+		created.Secret = hex.EncodeToString(secret)
+	}
+	if created.Events == nil {
+		created.Events = []models.WebhookEventType{}
+	}
+
+	err = h.db.InsertWebhook(logger, &created)
+	if err != nil {
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+// Webhooks lists the subscribed webhooks without their secrets.
+func (h *WebhookController) Webhooks(logger lager.Logger) ([]*models.Webhook, error) {
+	webhooks, err := h.db.Webhooks(logger)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, webhook := range webhooks {
+		webhook.Secret = ""
+	}
+	return webhooks, nil
+}
+
+func (h *WebhookController) DeleteWebhook(logger lager.Logger, id string) error {
+	return h.db.DeleteWebhook(logger, id)
+}
+
+func (h *WebhookController) DeadWebhookDeliveries(logger lager.Logger) ([]*models.WebhookDelivery, error) {
+	return h.db.DeadWebhookDeliveries(logger)
+}
+
+func (h *WebhookController) RetryWebhookDelivery(logger lager.Logger, id string) error {
+	return h.db.RetryWebhookDelivery(logger, id)
+}
@@ -0,0 +1,103 @@
+package controllers_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jianqiu/vps/controllers"
+	"github.com/jianqiu/vps/db/dbfakes"
+	"github.com/jianqiu/vps/models"
+
+	"code.cloudfoundry.org/lager/lagertest"
+)
+
+var _ = Describe("WebhookController", func() {
+	var (
+		logger        *lagertest.TestLogger
+		fakeWebhookDB *dbfakes.FakeWebhookDB
+		controller    *controllers.WebhookController
+	)
+
+	BeforeEach(func() {
+		fakeWebhookDB = new(dbfakes.FakeWebhookDB)
+		logger = lagertest.NewTestLogger("test")
+		controller = controllers.NewWebhookController(fakeWebhookDB)
+	})
+
+	Describe("CreateWebhook", func() {
+		var (
+			requested *models.Webhook
+			created   *models.Webhook
+			err       error
+		)
+
+		BeforeEach(func() {
+			requested = &models.Webhook{
+				URL:    "https://example.com/hook",
+				Events: []models.WebhookEventType{models.WebhookEventTypeVMReleased},
+			}
+		})
+
+		JustBeforeEach(func() {
+			created, err = controller.CreateWebhook(logger, requested)
+		})
+
+		It("stores the webhook with a new id and a generated secret", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created.ID).NotTo(BeEmpty())
+			Expect(created.Secret).To(HaveLen(64))
+			Expect(created.URL).To(Equal(requested.URL))
+			Expect(created.Events).To(Equal(requested.Events))
+
+			Expect(fakeWebhookDB.InsertWebhookCallCount()).To(Equal(1))
+			_, inserted := fakeWebhookDB.InsertWebhookArgsForCall(0)
+			Expect(inserted).To(Equal(created))
+		})
+
+		Context("when the request brings a secret", func() {
+			BeforeEach(func() {
+				requested.Secret = "s3cret"
+			})
+
+			It("keeps it", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(created.Secret).To(Equal("s3cret"))
+			})
+		})
+
+		Context("when the url is not an absolute http url", func() {
+			BeforeEach(func() {
+				requested.URL = "ftp://example.com/hook"
+			})
+
+			It("rejects the request", func() {
+				Expect(err).To(HaveOccurred())
+				Expect(models.ConvertError(err).Type).To(Equal(models.ErrorTypeInvalidRequest))
+				Expect(fakeWebhookDB.InsertWebhookCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when inserting fails", func() {
+			BeforeEach(func() {
+				fakeWebhookDB.InsertWebhookReturns(errors.New("kaboom"))
+			})
+
+			It("returns the error", func() {
+				Expect(err).To(MatchError("kaboom"))
+				Expect(created).To(BeNil())
+			})
+		})
+	})
+
+	Describe("Webhooks", func() {
+		It("does not reveal the secrets", func() {
+			fakeWebhookDB.WebhooksReturns([]*models.Webhook{{ID: "webhook-1", Secret: "s3cret"}}, nil)
+
+			webhooks, err := controller.Webhooks(logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(webhooks).To(Equal([]*models.Webhook{{ID: "webhook-1"}}))
+		})
+	})
+})
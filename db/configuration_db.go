@@ -0,0 +1,23 @@
+package db
+
+import (
+	"code.cloudfoundry.org/lager"
+)
+
+//go:generate counterfeiter . ConfigurationDB
+type ConfigurationDB interface {
+	// SchemaVersion returns the version the migrations last brought the
+	// schema to, or 0 before they ever finished.
+	SchemaVersion(logger lager.Logger) (int32, error)
+	SetSchemaVersion(logger lager.Logger, version int32) error
+}
+
+// SettingDB keeps the runtime settings that were changed from their
+// defaults, by name. Values are stored as given; checking them is up to the
+// caller.
+//go:generate counterfeiter . SettingDB
+type SettingDB interface {
+	Settings(logger lager.Logger) (map[string]string, error)
+	SetSetting(logger lager.Logger, name, value string) error
+	RemoveSetting(logger lager.Logger, name string) error
+}
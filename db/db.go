@@ -4,5 +4,14 @@ package db
 
 type DB interface {
 	VirtualGuestDB
+	PoolDB
+	FlavorDB
+	ReservationDB
+	WebhookDB
+	LockDB
+	ConfigurationDB
+	SettingDB
+	SecretDB
+	UserDataDB
+	PendingOrderDB
 }
-
@@ -0,0 +1,124 @@
+// This file was generated by counterfeiter
+package dbfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/db"
+)
+
+type FakeConfigurationDB struct {
+	SchemaVersionStub        func(logger lager.Logger) (int32, error)
+	schemaVersionMutex       sync.RWMutex
+	schemaVersionArgsForCall []struct {
+		logger lager.Logger
+	}
+	schemaVersionReturns struct {
+		result1 int32
+		result2 error
+	}
+	SetSchemaVersionStub        func(logger lager.Logger, version int32) error
+	setSchemaVersionMutex       sync.RWMutex
+	setSchemaVersionArgsForCall []struct {
+		logger  lager.Logger
+		version int32
+	}
+	setSchemaVersionReturns struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeConfigurationDB) SchemaVersion(logger lager.Logger) (int32, error) {
+	fake.schemaVersionMutex.Lock()
+	fake.schemaVersionArgsForCall = append(fake.schemaVersionArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("SchemaVersion", []interface{}{logger})
+	fake.schemaVersionMutex.Unlock()
+	if fake.SchemaVersionStub != nil {
+		return fake.SchemaVersionStub(logger)
+	} else {
+		return fake.schemaVersionReturns.result1, fake.schemaVersionReturns.result2
+	}
+}
+
+func (fake *FakeConfigurationDB) SchemaVersionCallCount() int {
+	fake.schemaVersionMutex.RLock()
+	defer fake.schemaVersionMutex.RUnlock()
+	return len(fake.schemaVersionArgsForCall)
+}
+
+func (fake *FakeConfigurationDB) SchemaVersionArgsForCall(i int) lager.Logger {
+	fake.schemaVersionMutex.RLock()
+	defer fake.schemaVersionMutex.RUnlock()
+	return fake.schemaVersionArgsForCall[i].logger
+}
+
+func (fake *FakeConfigurationDB) SchemaVersionReturns(result1 int32, result2 error) {
+	fake.SchemaVersionStub = nil
+	fake.schemaVersionReturns = struct {
+		result1 int32
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeConfigurationDB) SetSchemaVersion(logger lager.Logger, version int32) error {
+	fake.setSchemaVersionMutex.Lock()
+	fake.setSchemaVersionArgsForCall = append(fake.setSchemaVersionArgsForCall, struct {
+		logger  lager.Logger
+		version int32
+	}{logger, version})
+	fake.recordInvocation("SetSchemaVersion", []interface{}{logger, version})
+	fake.setSchemaVersionMutex.Unlock()
+	if fake.SetSchemaVersionStub != nil {
+		return fake.SetSchemaVersionStub(logger, version)
+	} else {
+		return fake.setSchemaVersionReturns.result1
+	}
+}
+
+func (fake *FakeConfigurationDB) SetSchemaVersionCallCount() int {
+	fake.setSchemaVersionMutex.RLock()
+	defer fake.setSchemaVersionMutex.RUnlock()
+	return len(fake.setSchemaVersionArgsForCall)
+}
+
+func (fake *FakeConfigurationDB) SetSchemaVersionArgsForCall(i int) (lager.Logger, int32) {
+	fake.setSchemaVersionMutex.RLock()
+	defer fake.setSchemaVersionMutex.RUnlock()
+	return fake.setSchemaVersionArgsForCall[i].logger, fake.setSchemaVersionArgsForCall[i].version
+}
+
+func (fake *FakeConfigurationDB) SetSchemaVersionReturns(result1 error) {
+	fake.SetSchemaVersionStub = nil
+	fake.setSchemaVersionReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfigurationDB) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.schemaVersionMutex.RLock()
+	defer fake.schemaVersionMutex.RUnlock()
+	fake.setSchemaVersionMutex.RLock()
+	defer fake.setSchemaVersionMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeConfigurationDB) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.ConfigurationDB = new(FakeConfigurationDB)
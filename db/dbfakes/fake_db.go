@@ -4,9 +4,11 @@ package dbfakes
 import (
 	"sync"
 
+	"code.cloudfoundry.org/bbs/encryption"
 	"code.cloudfoundry.org/lager"
 	"github.com/jianqiu/vps/db"
 	"github.com/jianqiu/vps/models"
+	"time"
 )
 
 type FakeDB struct {
@@ -30,6 +32,18 @@ type FakeDB struct {
 		result1 *models.VM
 		result2 error
 	}
+	OrderVirtualGuestsToProvisionStub        func(logger lager.Logger, pool string, cids []int32, ips []string) ([]*models.VM, error)
+	orderVirtualGuestsToProvisionMutex       sync.RWMutex
+	orderVirtualGuestsToProvisionArgsForCall []struct {
+		logger lager.Logger
+		pool   string
+		cids   []int32
+		ips    []string
+	}
+	orderVirtualGuestsToProvisionReturns struct {
+		result1 []*models.VM
+		result2 error
+	}
 	VirtualGuestsByStatesStub        func(logger lager.Logger, states []string) ([]*models.VM, error)
 	virtualGuestsByStatesMutex       sync.RWMutex
 	virtualGuestsByStatesArgsForCall []struct {
@@ -70,6 +84,26 @@ type FakeDB struct {
 		result1 *models.VM
 		result2 error
 	}
+	VirtualGuestByHostnameStub        func(logger lager.Logger, hostname string) (*models.VM, error)
+	virtualGuestByHostnameMutex       sync.RWMutex
+	virtualGuestByHostnameArgsForCall []struct {
+		logger   lager.Logger
+		hostname string
+	}
+	virtualGuestByHostnameReturns struct {
+		result1 *models.VM
+		result2 error
+	}
+	VirtualGuestsByHostnamePatternStub        func(logger lager.Logger, pattern string) ([]*models.VM, error)
+	virtualGuestsByHostnamePatternMutex       sync.RWMutex
+	virtualGuestsByHostnamePatternArgsForCall []struct {
+		logger  lager.Logger
+		pattern string
+	}
+	virtualGuestsByHostnamePatternReturns struct {
+		result1 []*models.VM
+		result2 error
+	}
 	InsertVirtualGuestToPoolStub        func(logger lager.Logger, virtualGuest *models.VM) error
 	insertVirtualGuestToPoolMutex       sync.RWMutex
 	insertVirtualGuestToPoolArgsForCall []struct {
@@ -115,6 +149,26 @@ type FakeDB struct {
 	changeVirtualGuestToFreeReturns struct {
 		result1 error
 	}
+	TransitionVirtualGuestStub        func(logger lager.Logger, cid int32, state models.State) error
+	transitionVirtualGuestMutex       sync.RWMutex
+	transitionVirtualGuestArgsForCall []struct {
+		logger lager.Logger
+		cid    int32
+		state  models.State
+	}
+	transitionVirtualGuestReturns struct {
+		result1 error
+	}
+	RetireVirtualGuestStub        func(logger lager.Logger, cid int32, idleBefore time.Time) error
+	retireVirtualGuestMutex       sync.RWMutex
+	retireVirtualGuestArgsForCall []struct {
+		logger     lager.Logger
+		cid        int32
+		idleBefore time.Time
+	}
+	retireVirtualGuestReturns struct {
+		result1 error
+	}
 	DeleteVirtualGuestFromPoolStub        func(logger lager.Logger, cid int32) error
 	deleteVirtualGuestFromPoolMutex       sync.RWMutex
 	deleteVirtualGuestFromPoolArgsForCall []struct {
@@ -124,6 +178,415 @@ type FakeDB struct {
 	deleteVirtualGuestFromPoolReturns struct {
 		result1 error
 	}
+	PoolsStub        func(logger lager.Logger) ([]*models.Pool, error)
+	poolsMutex       sync.RWMutex
+	poolsArgsForCall []struct {
+		logger lager.Logger
+	}
+	poolsReturns struct {
+		result1 []*models.Pool
+		result2 error
+	}
+	PoolByNameStub        func(logger lager.Logger, name string) (*models.Pool, error)
+	poolByNameMutex       sync.RWMutex
+	poolByNameArgsForCall []struct {
+		logger lager.Logger
+		name   string
+	}
+	poolByNameReturns struct {
+		result1 *models.Pool
+		result2 error
+	}
+	PoolByUsernameStub        func(logger lager.Logger, username string) (*models.Pool, error)
+	poolByUsernameMutex       sync.RWMutex
+	poolByUsernameArgsForCall []struct {
+		logger   lager.Logger
+		username string
+	}
+	poolByUsernameReturns struct {
+		result1 *models.Pool
+		result2 error
+	}
+	InsertPoolStub        func(logger lager.Logger, pool *models.Pool) error
+	insertPoolMutex       sync.RWMutex
+	insertPoolArgsForCall []struct {
+		logger lager.Logger
+		pool   *models.Pool
+	}
+	insertPoolReturns struct {
+		result1 error
+	}
+	UpdatePoolStub        func(logger lager.Logger, pool *models.Pool) error
+	updatePoolMutex       sync.RWMutex
+	updatePoolArgsForCall []struct {
+		logger lager.Logger
+		pool   *models.Pool
+	}
+	updatePoolReturns struct {
+		result1 error
+	}
+	DeletePoolStub        func(logger lager.Logger, name string) error
+	deletePoolMutex       sync.RWMutex
+	deletePoolArgsForCall []struct {
+		logger lager.Logger
+		name   string
+	}
+	deletePoolReturns struct {
+		result1 error
+	}
+	FlavorsStub        func(logger lager.Logger) ([]*models.Flavor, error)
+	flavorsMutex       sync.RWMutex
+	flavorsArgsForCall []struct {
+		logger lager.Logger
+	}
+	flavorsReturns struct {
+		result1 []*models.Flavor
+		result2 error
+	}
+	FlavorByNameStub        func(logger lager.Logger, name string) (*models.Flavor, error)
+	flavorByNameMutex       sync.RWMutex
+	flavorByNameArgsForCall []struct {
+		logger lager.Logger
+		name   string
+	}
+	flavorByNameReturns struct {
+		result1 *models.Flavor
+		result2 error
+	}
+	InsertFlavorStub        func(logger lager.Logger, flavor *models.Flavor) error
+	insertFlavorMutex       sync.RWMutex
+	insertFlavorArgsForCall []struct {
+		logger lager.Logger
+		flavor *models.Flavor
+	}
+	insertFlavorReturns struct {
+		result1 error
+	}
+	UpdateFlavorStub        func(logger lager.Logger, flavor *models.Flavor) error
+	updateFlavorMutex       sync.RWMutex
+	updateFlavorArgsForCall []struct {
+		logger lager.Logger
+		flavor *models.Flavor
+	}
+	updateFlavorReturns struct {
+		result1 error
+	}
+	DeleteFlavorStub        func(logger lager.Logger, name string) error
+	deleteFlavorMutex       sync.RWMutex
+	deleteFlavorArgsForCall []struct {
+		logger lager.Logger
+		name   string
+	}
+	deleteFlavorReturns struct {
+		result1 error
+	}
+	ReservationsStub        func(logger lager.Logger) ([]*models.Reservation, error)
+	reservationsMutex       sync.RWMutex
+	reservationsArgsForCall []struct {
+		logger lager.Logger
+	}
+	reservationsReturns struct {
+		result1 []*models.Reservation
+		result2 error
+	}
+	ReservationByIDStub        func(logger lager.Logger, id string) (*models.Reservation, error)
+	reservationByIDMutex       sync.RWMutex
+	reservationByIDArgsForCall []struct {
+		logger lager.Logger
+		id     string
+	}
+	reservationByIDReturns struct {
+		result1 *models.Reservation
+		result2 error
+	}
+	ReserveVirtualGuestsStub        func(logger lager.Logger, reservation *models.Reservation) error
+	reserveVirtualGuestsMutex       sync.RWMutex
+	reserveVirtualGuestsArgsForCall []struct {
+		logger      lager.Logger
+		reservation *models.Reservation
+	}
+	reserveVirtualGuestsReturns struct {
+		result1 error
+	}
+	ActivateReservationStub        func(logger lager.Logger, id string) error
+	activateReservationMutex       sync.RWMutex
+	activateReservationArgsForCall []struct {
+		logger lager.Logger
+		id     string
+	}
+	activateReservationReturns struct {
+		result1 error
+	}
+	ReleaseReservationStub        func(logger lager.Logger, id string) error
+	releaseReservationMutex       sync.RWMutex
+	releaseReservationArgsForCall []struct {
+		logger lager.Logger
+		id     string
+	}
+	releaseReservationReturns struct {
+		result1 error
+	}
+	WebhooksStub        func(logger lager.Logger) ([]*models.Webhook, error)
+	webhooksMutex       sync.RWMutex
+	webhooksArgsForCall []struct {
+		logger lager.Logger
+	}
+	webhooksReturns struct {
+		result1 []*models.Webhook
+		result2 error
+	}
+	WebhookByIDStub        func(logger lager.Logger, id string) (*models.Webhook, error)
+	webhookByIDMutex       sync.RWMutex
+	webhookByIDArgsForCall []struct {
+		logger lager.Logger
+		id     string
+	}
+	webhookByIDReturns struct {
+		result1 *models.Webhook
+		result2 error
+	}
+	InsertWebhookStub        func(logger lager.Logger, webhook *models.Webhook) error
+	insertWebhookMutex       sync.RWMutex
+	insertWebhookArgsForCall []struct {
+		logger  lager.Logger
+		webhook *models.Webhook
+	}
+	insertWebhookReturns struct {
+		result1 error
+	}
+	DeleteWebhookStub        func(logger lager.Logger, id string) error
+	deleteWebhookMutex       sync.RWMutex
+	deleteWebhookArgsForCall []struct {
+		logger lager.Logger
+		id     string
+	}
+	deleteWebhookReturns struct {
+		result1 error
+	}
+	DispatchOutboxEventsStub        func(logger lager.Logger, limit int) (int, error)
+	dispatchOutboxEventsMutex       sync.RWMutex
+	dispatchOutboxEventsArgsForCall []struct {
+		logger lager.Logger
+		limit  int
+	}
+	dispatchOutboxEventsReturns struct {
+		result1 int
+		result2 error
+	}
+	OutboxEventsSinceStub        func(logger lager.Logger, since int64) ([]*models.WebhookEvent, error)
+	outboxEventsSinceMutex       sync.RWMutex
+	outboxEventsSinceArgsForCall []struct {
+		logger lager.Logger
+		since  int64
+	}
+	outboxEventsSinceReturns struct {
+		result1 []*models.WebhookEvent
+		result2 error
+	}
+	PendingWebhookDeliveriesStub        func(logger lager.Logger, limit int) ([]*models.WebhookDelivery, error)
+	pendingWebhookDeliveriesMutex       sync.RWMutex
+	pendingWebhookDeliveriesArgsForCall []struct {
+		logger lager.Logger
+		limit  int
+	}
+	pendingWebhookDeliveriesReturns struct {
+		result1 []*models.WebhookDelivery
+		result2 error
+	}
+	CompleteWebhookDeliveryStub        func(logger lager.Logger, id string) error
+	completeWebhookDeliveryMutex       sync.RWMutex
+	completeWebhookDeliveryArgsForCall []struct {
+		logger lager.Logger
+		id     string
+	}
+	completeWebhookDeliveryReturns struct {
+		result1 error
+	}
+	FailWebhookDeliveryStub        func(logger lager.Logger, id string, reason string, nextAttemptAt time.Time, dead bool) error
+	failWebhookDeliveryMutex       sync.RWMutex
+	failWebhookDeliveryArgsForCall []struct {
+		logger        lager.Logger
+		id            string
+		reason        string
+		nextAttemptAt time.Time
+		dead          bool
+	}
+	failWebhookDeliveryReturns struct {
+		result1 error
+	}
+	DeadWebhookDeliveriesStub        func(logger lager.Logger) ([]*models.WebhookDelivery, error)
+	deadWebhookDeliveriesMutex       sync.RWMutex
+	deadWebhookDeliveriesArgsForCall []struct {
+		logger lager.Logger
+	}
+	deadWebhookDeliveriesReturns struct {
+		result1 []*models.WebhookDelivery
+		result2 error
+	}
+	RetryWebhookDeliveryStub        func(logger lager.Logger, id string) error
+	retryWebhookDeliveryMutex       sync.RWMutex
+	retryWebhookDeliveryArgsForCall []struct {
+		logger lager.Logger
+		id     string
+	}
+	retryWebhookDeliveryReturns struct {
+		result1 error
+	}
+	AcquireLockStub        func(logger lager.Logger, name, owner string, ttl time.Duration) (bool, error)
+	acquireLockMutex       sync.RWMutex
+	acquireLockArgsForCall []struct {
+		logger lager.Logger
+		name   string
+		owner  string
+		ttl    time.Duration
+	}
+	acquireLockReturns struct {
+		result1 bool
+		result2 error
+	}
+	ReleaseLockStub        func(logger lager.Logger, name, owner string) error
+	releaseLockMutex       sync.RWMutex
+	releaseLockArgsForCall []struct {
+		logger lager.Logger
+		name   string
+		owner  string
+	}
+	releaseLockReturns struct {
+		result1 error
+	}
+	SchemaVersionStub        func(logger lager.Logger) (int32, error)
+	schemaVersionMutex       sync.RWMutex
+	schemaVersionArgsForCall []struct {
+		logger lager.Logger
+	}
+	schemaVersionReturns struct {
+		result1 int32
+		result2 error
+	}
+	SetSchemaVersionStub        func(logger lager.Logger, version int32) error
+	setSchemaVersionMutex       sync.RWMutex
+	setSchemaVersionArgsForCall []struct {
+		logger  lager.Logger
+		version int32
+	}
+	setSchemaVersionReturns struct {
+		result1 error
+	}
+	SettingsStub        func(logger lager.Logger) (map[string]string, error)
+	settingsMutex       sync.RWMutex
+	settingsArgsForCall []struct {
+		logger lager.Logger
+	}
+	settingsReturns struct {
+		result1 map[string]string
+		result2 error
+	}
+	SetSettingStub        func(logger lager.Logger, name, value string) error
+	setSettingMutex       sync.RWMutex
+	setSettingArgsForCall []struct {
+		logger lager.Logger
+		name   string
+		value  string
+	}
+	setSettingReturns struct {
+		result1 error
+	}
+	RemoveSettingStub        func(logger lager.Logger, name string) error
+	removeSettingMutex       sync.RWMutex
+	removeSettingArgsForCall []struct {
+		logger lager.Logger
+		name   string
+	}
+	removeSettingReturns struct {
+		result1 error
+	}
+	VMSecretStub        func(logger lager.Logger, cid int32) (encryption.Encrypted, error)
+	vmSecretMutex       sync.RWMutex
+	vmSecretArgsForCall []struct {
+		logger lager.Logger
+		cid    int32
+	}
+	vmSecretReturns struct {
+		result1 encryption.Encrypted
+		result2 error
+	}
+	SetVMSecretStub        func(logger lager.Logger, cid int32, secret encryption.Encrypted) error
+	setVMSecretMutex       sync.RWMutex
+	setVMSecretArgsForCall []struct {
+		logger lager.Logger
+		cid    int32
+		secret encryption.Encrypted
+	}
+	setVMSecretReturns struct {
+		result1 error
+	}
+	RemoveVMSecretStub        func(logger lager.Logger, cid int32) error
+	removeVMSecretMutex       sync.RWMutex
+	removeVMSecretArgsForCall []struct {
+		logger lager.Logger
+		cid    int32
+	}
+	removeVMSecretReturns struct {
+		result1 error
+	}
+	VMSecretTokenHashStub        func(logger lager.Logger, cid int32) (string, error)
+	vmSecretTokenHashMutex       sync.RWMutex
+	vmSecretTokenHashArgsForCall []struct {
+		logger lager.Logger
+		cid    int32
+	}
+	vmSecretTokenHashReturns struct {
+		result1 string
+		result2 error
+	}
+	ReEncryptVMSecretsStub        func(logger lager.Logger, activeLabel string, cryptor encryption.Cryptor) (int, error)
+	reEncryptVMSecretsMutex       sync.RWMutex
+	reEncryptVMSecretsArgsForCall []struct {
+		logger      lager.Logger
+		activeLabel string
+		cryptor     encryption.Cryptor
+	}
+	reEncryptVMSecretsReturns struct {
+		result1 int
+		result2 error
+	}
+	VMUserDataStub        func(logger lager.Logger, cid int32) (string, error)
+	vmUserDataMutex       sync.RWMutex
+	vmUserDataArgsForCall []struct {
+		logger lager.Logger
+		cid    int32
+	}
+	vmUserDataReturns struct {
+		result1 string
+		result2 error
+	}
+	PendingOrdersStub        func(logger lager.Logger) ([]*models.PendingOrder, error)
+	pendingOrdersMutex       sync.RWMutex
+	pendingOrdersArgsForCall []struct {
+		logger lager.Logger
+	}
+	pendingOrdersReturns struct {
+		result1 []*models.PendingOrder
+		result2 error
+	}
+	InsertPendingOrderStub        func(logger lager.Logger, order *models.PendingOrder) error
+	insertPendingOrderMutex       sync.RWMutex
+	insertPendingOrderArgsForCall []struct {
+		logger lager.Logger
+		order  *models.PendingOrder
+	}
+	insertPendingOrderReturns struct {
+		result1 error
+	}
+	RemovePendingOrderStub        func(logger lager.Logger, cid int32) error
+	removePendingOrderMutex       sync.RWMutex
+	removePendingOrderArgsForCall []struct {
+		logger lager.Logger
+		cid    int32
+	}
+	removePendingOrderReturns struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -198,6 +661,53 @@ func (fake *FakeDB) OrderVirtualGuestToProvisionReturns(result1 *models.VM, resu
 	}{result1, result2}
 }
 
+func (fake *FakeDB) OrderVirtualGuestsToProvision(logger lager.Logger, pool string, cids []int32, ips []string) ([]*models.VM, error) {
+	var cidsCopy []int32
+	if cids != nil {
+		cidsCopy = make([]int32, len(cids))
+		copy(cidsCopy, cids)
+	}
+	var ipsCopy []string
+	if ips != nil {
+		ipsCopy = make([]string, len(ips))
+		copy(ipsCopy, ips)
+	}
+	fake.orderVirtualGuestsToProvisionMutex.Lock()
+	fake.orderVirtualGuestsToProvisionArgsForCall = append(fake.orderVirtualGuestsToProvisionArgsForCall, struct {
+		logger lager.Logger
+		pool   string
+		cids   []int32
+		ips    []string
+	}{logger, pool, cidsCopy, ipsCopy})
+	fake.recordInvocation("OrderVirtualGuestsToProvision", []interface{}{logger, pool, cidsCopy, ipsCopy})
+	fake.orderVirtualGuestsToProvisionMutex.Unlock()
+	if fake.OrderVirtualGuestsToProvisionStub != nil {
+		return fake.OrderVirtualGuestsToProvisionStub(logger, pool, cids, ips)
+	} else {
+		return fake.orderVirtualGuestsToProvisionReturns.result1, fake.orderVirtualGuestsToProvisionReturns.result2
+	}
+}
+
+func (fake *FakeDB) OrderVirtualGuestsToProvisionCallCount() int {
+	fake.orderVirtualGuestsToProvisionMutex.RLock()
+	defer fake.orderVirtualGuestsToProvisionMutex.RUnlock()
+	return len(fake.orderVirtualGuestsToProvisionArgsForCall)
+}
+
+func (fake *FakeDB) OrderVirtualGuestsToProvisionArgsForCall(i int) (lager.Logger, string, []int32, []string) {
+	fake.orderVirtualGuestsToProvisionMutex.RLock()
+	defer fake.orderVirtualGuestsToProvisionMutex.RUnlock()
+	return fake.orderVirtualGuestsToProvisionArgsForCall[i].logger, fake.orderVirtualGuestsToProvisionArgsForCall[i].pool, fake.orderVirtualGuestsToProvisionArgsForCall[i].cids, fake.orderVirtualGuestsToProvisionArgsForCall[i].ips
+}
+
+func (fake *FakeDB) OrderVirtualGuestsToProvisionReturns(result1 []*models.VM, result2 error) {
+	fake.OrderVirtualGuestsToProvisionStub = nil
+	fake.orderVirtualGuestsToProvisionReturns = struct {
+		result1 []*models.VM
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDB) VirtualGuestsByStates(logger lager.Logger, states []string) ([]*models.VM, error) {
 	var statesCopy []string
 	if states != nil {
@@ -348,6 +858,76 @@ func (fake *FakeDB) VirtualGuestByIPReturns(result1 *models.VM, result2 error) {
 	}{result1, result2}
 }
 
+func (fake *FakeDB) VirtualGuestByHostname(logger lager.Logger, hostname string) (*models.VM, error) {
+	fake.virtualGuestByHostnameMutex.Lock()
+	fake.virtualGuestByHostnameArgsForCall = append(fake.virtualGuestByHostnameArgsForCall, struct {
+		logger   lager.Logger
+		hostname string
+	}{logger, hostname})
+	fake.recordInvocation("VirtualGuestByHostname", []interface{}{logger, hostname})
+	fake.virtualGuestByHostnameMutex.Unlock()
+	if fake.VirtualGuestByHostnameStub != nil {
+		return fake.VirtualGuestByHostnameStub(logger, hostname)
+	} else {
+		return fake.virtualGuestByHostnameReturns.result1, fake.virtualGuestByHostnameReturns.result2
+	}
+}
+
+func (fake *FakeDB) VirtualGuestByHostnameCallCount() int {
+	fake.virtualGuestByHostnameMutex.RLock()
+	defer fake.virtualGuestByHostnameMutex.RUnlock()
+	return len(fake.virtualGuestByHostnameArgsForCall)
+}
+
+func (fake *FakeDB) VirtualGuestByHostnameArgsForCall(i int) (lager.Logger, string) {
+	fake.virtualGuestByHostnameMutex.RLock()
+	defer fake.virtualGuestByHostnameMutex.RUnlock()
+	return fake.virtualGuestByHostnameArgsForCall[i].logger, fake.virtualGuestByHostnameArgsForCall[i].hostname
+}
+
+func (fake *FakeDB) VirtualGuestByHostnameReturns(result1 *models.VM, result2 error) {
+	fake.VirtualGuestByHostnameStub = nil
+	fake.virtualGuestByHostnameReturns = struct {
+		result1 *models.VM
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) VirtualGuestsByHostnamePattern(logger lager.Logger, pattern string) ([]*models.VM, error) {
+	fake.virtualGuestsByHostnamePatternMutex.Lock()
+	fake.virtualGuestsByHostnamePatternArgsForCall = append(fake.virtualGuestsByHostnamePatternArgsForCall, struct {
+		logger  lager.Logger
+		pattern string
+	}{logger, pattern})
+	fake.recordInvocation("VirtualGuestsByHostnamePattern", []interface{}{logger, pattern})
+	fake.virtualGuestsByHostnamePatternMutex.Unlock()
+	if fake.VirtualGuestsByHostnamePatternStub != nil {
+		return fake.VirtualGuestsByHostnamePatternStub(logger, pattern)
+	} else {
+		return fake.virtualGuestsByHostnamePatternReturns.result1, fake.virtualGuestsByHostnamePatternReturns.result2
+	}
+}
+
+func (fake *FakeDB) VirtualGuestsByHostnamePatternCallCount() int {
+	fake.virtualGuestsByHostnamePatternMutex.RLock()
+	defer fake.virtualGuestsByHostnamePatternMutex.RUnlock()
+	return len(fake.virtualGuestsByHostnamePatternArgsForCall)
+}
+
+func (fake *FakeDB) VirtualGuestsByHostnamePatternArgsForCall(i int) (lager.Logger, string) {
+	fake.virtualGuestsByHostnamePatternMutex.RLock()
+	defer fake.virtualGuestsByHostnamePatternMutex.RUnlock()
+	return fake.virtualGuestsByHostnamePatternArgsForCall[i].logger, fake.virtualGuestsByHostnamePatternArgsForCall[i].pattern
+}
+
+func (fake *FakeDB) VirtualGuestsByHostnamePatternReturns(result1 []*models.VM, result2 error) {
+	fake.VirtualGuestsByHostnamePatternStub = nil
+	fake.virtualGuestsByHostnamePatternReturns = struct {
+		result1 []*models.VM
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDB) InsertVirtualGuestToPool(logger lager.Logger, virtualGuest *models.VM) error {
 	fake.insertVirtualGuestToPoolMutex.Lock()
 	fake.insertVirtualGuestToPoolArgsForCall = append(fake.insertVirtualGuestToPoolArgsForCall, struct {
@@ -518,36 +1098,1590 @@ func (fake *FakeDB) ChangeVirtualGuestToFreeReturns(result1 error) {
 	}{result1}
 }
 
-func (fake *FakeDB) DeleteVirtualGuestFromPool(logger lager.Logger, cid int32) error {
-	fake.deleteVirtualGuestFromPoolMutex.Lock()
-	fake.deleteVirtualGuestFromPoolArgsForCall = append(fake.deleteVirtualGuestFromPoolArgsForCall, struct {
+func (fake *FakeDB) TransitionVirtualGuest(logger lager.Logger, cid int32, state models.State) error {
+	fake.transitionVirtualGuestMutex.Lock()
+	fake.transitionVirtualGuestArgsForCall = append(fake.transitionVirtualGuestArgsForCall, struct {
 		logger lager.Logger
 		cid    int32
-	}{logger, cid})
-	fake.recordInvocation("DeleteVirtualGuestFromPool", []interface{}{logger, cid})
-	fake.deleteVirtualGuestFromPoolMutex.Unlock()
-	if fake.DeleteVirtualGuestFromPoolStub != nil {
-		return fake.DeleteVirtualGuestFromPoolStub(logger, cid)
+		state  models.State
+	}{logger, cid, state})
+	fake.recordInvocation("TransitionVirtualGuest", []interface{}{logger, cid, state})
+	fake.transitionVirtualGuestMutex.Unlock()
+	if fake.TransitionVirtualGuestStub != nil {
+		return fake.TransitionVirtualGuestStub(logger, cid, state)
 	} else {
-		return fake.deleteVirtualGuestFromPoolReturns.result1
+		return fake.transitionVirtualGuestReturns.result1
 	}
 }
 
-func (fake *FakeDB) DeleteVirtualGuestFromPoolCallCount() int {
-	fake.deleteVirtualGuestFromPoolMutex.RLock()
-	defer fake.deleteVirtualGuestFromPoolMutex.RUnlock()
-	return len(fake.deleteVirtualGuestFromPoolArgsForCall)
+func (fake *FakeDB) TransitionVirtualGuestCallCount() int {
+	fake.transitionVirtualGuestMutex.RLock()
+	defer fake.transitionVirtualGuestMutex.RUnlock()
+	return len(fake.transitionVirtualGuestArgsForCall)
 }
 
-func (fake *FakeDB) DeleteVirtualGuestFromPoolArgsForCall(i int) (lager.Logger, int32) {
-	fake.deleteVirtualGuestFromPoolMutex.RLock()
-	defer fake.deleteVirtualGuestFromPoolMutex.RUnlock()
-	return fake.deleteVirtualGuestFromPoolArgsForCall[i].logger, fake.deleteVirtualGuestFromPoolArgsForCall[i].cid
+func (fake *FakeDB) TransitionVirtualGuestArgsForCall(i int) (lager.Logger, int32, models.State) {
+	fake.transitionVirtualGuestMutex.RLock()
+	defer fake.transitionVirtualGuestMutex.RUnlock()
+	return fake.transitionVirtualGuestArgsForCall[i].logger, fake.transitionVirtualGuestArgsForCall[i].cid, fake.transitionVirtualGuestArgsForCall[i].state
 }
 
-func (fake *FakeDB) DeleteVirtualGuestFromPoolReturns(result1 error) {
-	fake.DeleteVirtualGuestFromPoolStub = nil
-	fake.deleteVirtualGuestFromPoolReturns = struct {
+func (fake *FakeDB) TransitionVirtualGuestReturns(result1 error) {
+	fake.TransitionVirtualGuestStub = nil
+	fake.transitionVirtualGuestReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) RetireVirtualGuest(logger lager.Logger, cid int32, idleBefore time.Time) error {
+	fake.retireVirtualGuestMutex.Lock()
+	fake.retireVirtualGuestArgsForCall = append(fake.retireVirtualGuestArgsForCall, struct {
+		logger     lager.Logger
+		cid        int32
+		idleBefore time.Time
+	}{logger, cid, idleBefore})
+	fake.recordInvocation("RetireVirtualGuest", []interface{}{logger, cid, idleBefore})
+	fake.retireVirtualGuestMutex.Unlock()
+	if fake.RetireVirtualGuestStub != nil {
+		return fake.RetireVirtualGuestStub(logger, cid, idleBefore)
+	} else {
+		return fake.retireVirtualGuestReturns.result1
+	}
+}
+
+func (fake *FakeDB) RetireVirtualGuestCallCount() int {
+	fake.retireVirtualGuestMutex.RLock()
+	defer fake.retireVirtualGuestMutex.RUnlock()
+	return len(fake.retireVirtualGuestArgsForCall)
+}
+
+func (fake *FakeDB) RetireVirtualGuestArgsForCall(i int) (lager.Logger, int32, time.Time) {
+	fake.retireVirtualGuestMutex.RLock()
+	defer fake.retireVirtualGuestMutex.RUnlock()
+	return fake.retireVirtualGuestArgsForCall[i].logger, fake.retireVirtualGuestArgsForCall[i].cid, fake.retireVirtualGuestArgsForCall[i].idleBefore
+}
+
+func (fake *FakeDB) RetireVirtualGuestReturns(result1 error) {
+	fake.RetireVirtualGuestStub = nil
+	fake.retireVirtualGuestReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) DeleteVirtualGuestFromPool(logger lager.Logger, cid int32) error {
+	fake.deleteVirtualGuestFromPoolMutex.Lock()
+	fake.deleteVirtualGuestFromPoolArgsForCall = append(fake.deleteVirtualGuestFromPoolArgsForCall, struct {
+		logger lager.Logger
+		cid    int32
+	}{logger, cid})
+	fake.recordInvocation("DeleteVirtualGuestFromPool", []interface{}{logger, cid})
+	fake.deleteVirtualGuestFromPoolMutex.Unlock()
+	if fake.DeleteVirtualGuestFromPoolStub != nil {
+		return fake.DeleteVirtualGuestFromPoolStub(logger, cid)
+	} else {
+		return fake.deleteVirtualGuestFromPoolReturns.result1
+	}
+}
+
+func (fake *FakeDB) DeleteVirtualGuestFromPoolCallCount() int {
+	fake.deleteVirtualGuestFromPoolMutex.RLock()
+	defer fake.deleteVirtualGuestFromPoolMutex.RUnlock()
+	return len(fake.deleteVirtualGuestFromPoolArgsForCall)
+}
+
+func (fake *FakeDB) DeleteVirtualGuestFromPoolArgsForCall(i int) (lager.Logger, int32) {
+	fake.deleteVirtualGuestFromPoolMutex.RLock()
+	defer fake.deleteVirtualGuestFromPoolMutex.RUnlock()
+	return fake.deleteVirtualGuestFromPoolArgsForCall[i].logger, fake.deleteVirtualGuestFromPoolArgsForCall[i].cid
+}
+
+func (fake *FakeDB) DeleteVirtualGuestFromPoolReturns(result1 error) {
+	fake.DeleteVirtualGuestFromPoolStub = nil
+	fake.deleteVirtualGuestFromPoolReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) Pools(logger lager.Logger) ([]*models.Pool, error) {
+	fake.poolsMutex.Lock()
+	fake.poolsArgsForCall = append(fake.poolsArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("Pools", []interface{}{logger})
+	fake.poolsMutex.Unlock()
+	if fake.PoolsStub != nil {
+		return fake.PoolsStub(logger)
+	} else {
+		return fake.poolsReturns.result1, fake.poolsReturns.result2
+	}
+}
+
+func (fake *FakeDB) PoolsCallCount() int {
+	fake.poolsMutex.RLock()
+	defer fake.poolsMutex.RUnlock()
+	return len(fake.poolsArgsForCall)
+}
+
+func (fake *FakeDB) PoolsArgsForCall(i int) lager.Logger {
+	fake.poolsMutex.RLock()
+	defer fake.poolsMutex.RUnlock()
+	return fake.poolsArgsForCall[i].logger
+}
+
+func (fake *FakeDB) PoolsReturns(result1 []*models.Pool, result2 error) {
+	fake.PoolsStub = nil
+	fake.poolsReturns = struct {
+		result1 []*models.Pool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) PoolByName(logger lager.Logger, name string) (*models.Pool, error) {
+	fake.poolByNameMutex.Lock()
+	fake.poolByNameArgsForCall = append(fake.poolByNameArgsForCall, struct {
+		logger lager.Logger
+		name   string
+	}{logger, name})
+	fake.recordInvocation("PoolByName", []interface{}{logger, name})
+	fake.poolByNameMutex.Unlock()
+	if fake.PoolByNameStub != nil {
+		return fake.PoolByNameStub(logger, name)
+	} else {
+		return fake.poolByNameReturns.result1, fake.poolByNameReturns.result2
+	}
+}
+
+func (fake *FakeDB) PoolByNameCallCount() int {
+	fake.poolByNameMutex.RLock()
+	defer fake.poolByNameMutex.RUnlock()
+	return len(fake.poolByNameArgsForCall)
+}
+
+func (fake *FakeDB) PoolByNameArgsForCall(i int) (lager.Logger, string) {
+	fake.poolByNameMutex.RLock()
+	defer fake.poolByNameMutex.RUnlock()
+	return fake.poolByNameArgsForCall[i].logger, fake.poolByNameArgsForCall[i].name
+}
+
+func (fake *FakeDB) PoolByNameReturns(result1 *models.Pool, result2 error) {
+	fake.PoolByNameStub = nil
+	fake.poolByNameReturns = struct {
+		result1 *models.Pool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) PoolByUsername(logger lager.Logger, username string) (*models.Pool, error) {
+	fake.poolByUsernameMutex.Lock()
+	fake.poolByUsernameArgsForCall = append(fake.poolByUsernameArgsForCall, struct {
+		logger   lager.Logger
+		username string
+	}{logger, username})
+	fake.recordInvocation("PoolByUsername", []interface{}{logger, username})
+	fake.poolByUsernameMutex.Unlock()
+	if fake.PoolByUsernameStub != nil {
+		return fake.PoolByUsernameStub(logger, username)
+	} else {
+		return fake.poolByUsernameReturns.result1, fake.poolByUsernameReturns.result2
+	}
+}
+
+func (fake *FakeDB) PoolByUsernameCallCount() int {
+	fake.poolByUsernameMutex.RLock()
+	defer fake.poolByUsernameMutex.RUnlock()
+	return len(fake.poolByUsernameArgsForCall)
+}
+
+func (fake *FakeDB) PoolByUsernameArgsForCall(i int) (lager.Logger, string) {
+	fake.poolByUsernameMutex.RLock()
+	defer fake.poolByUsernameMutex.RUnlock()
+	return fake.poolByUsernameArgsForCall[i].logger, fake.poolByUsernameArgsForCall[i].username
+}
+
+func (fake *FakeDB) PoolByUsernameReturns(result1 *models.Pool, result2 error) {
+	fake.PoolByUsernameStub = nil
+	fake.poolByUsernameReturns = struct {
+		result1 *models.Pool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) InsertPool(logger lager.Logger, pool *models.Pool) error {
+	fake.insertPoolMutex.Lock()
+	fake.insertPoolArgsForCall = append(fake.insertPoolArgsForCall, struct {
+		logger lager.Logger
+		pool   *models.Pool
+	}{logger, pool})
+	fake.recordInvocation("InsertPool", []interface{}{logger, pool})
+	fake.insertPoolMutex.Unlock()
+	if fake.InsertPoolStub != nil {
+		return fake.InsertPoolStub(logger, pool)
+	} else {
+		return fake.insertPoolReturns.result1
+	}
+}
+
+func (fake *FakeDB) InsertPoolCallCount() int {
+	fake.insertPoolMutex.RLock()
+	defer fake.insertPoolMutex.RUnlock()
+	return len(fake.insertPoolArgsForCall)
+}
+
+func (fake *FakeDB) InsertPoolArgsForCall(i int) (lager.Logger, *models.Pool) {
+	fake.insertPoolMutex.RLock()
+	defer fake.insertPoolMutex.RUnlock()
+	return fake.insertPoolArgsForCall[i].logger, fake.insertPoolArgsForCall[i].pool
+}
+
+func (fake *FakeDB) InsertPoolReturns(result1 error) {
+	fake.InsertPoolStub = nil
+	fake.insertPoolReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) UpdatePool(logger lager.Logger, pool *models.Pool) error {
+	fake.updatePoolMutex.Lock()
+	fake.updatePoolArgsForCall = append(fake.updatePoolArgsForCall, struct {
+		logger lager.Logger
+		pool   *models.Pool
+	}{logger, pool})
+	fake.recordInvocation("UpdatePool", []interface{}{logger, pool})
+	fake.updatePoolMutex.Unlock()
+	if fake.UpdatePoolStub != nil {
+		return fake.UpdatePoolStub(logger, pool)
+	} else {
+		return fake.updatePoolReturns.result1
+	}
+}
+
+func (fake *FakeDB) UpdatePoolCallCount() int {
+	fake.updatePoolMutex.RLock()
+	defer fake.updatePoolMutex.RUnlock()
+	return len(fake.updatePoolArgsForCall)
+}
+
+func (fake *FakeDB) UpdatePoolArgsForCall(i int) (lager.Logger, *models.Pool) {
+	fake.updatePoolMutex.RLock()
+	defer fake.updatePoolMutex.RUnlock()
+	return fake.updatePoolArgsForCall[i].logger, fake.updatePoolArgsForCall[i].pool
+}
+
+func (fake *FakeDB) UpdatePoolReturns(result1 error) {
+	fake.UpdatePoolStub = nil
+	fake.updatePoolReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) DeletePool(logger lager.Logger, name string) error {
+	fake.deletePoolMutex.Lock()
+	fake.deletePoolArgsForCall = append(fake.deletePoolArgsForCall, struct {
+		logger lager.Logger
+		name   string
+	}{logger, name})
+	fake.recordInvocation("DeletePool", []interface{}{logger, name})
+	fake.deletePoolMutex.Unlock()
+	if fake.DeletePoolStub != nil {
+		return fake.DeletePoolStub(logger, name)
+	} else {
+		return fake.deletePoolReturns.result1
+	}
+}
+
+func (fake *FakeDB) DeletePoolCallCount() int {
+	fake.deletePoolMutex.RLock()
+	defer fake.deletePoolMutex.RUnlock()
+	return len(fake.deletePoolArgsForCall)
+}
+
+func (fake *FakeDB) DeletePoolArgsForCall(i int) (lager.Logger, string) {
+	fake.deletePoolMutex.RLock()
+	defer fake.deletePoolMutex.RUnlock()
+	return fake.deletePoolArgsForCall[i].logger, fake.deletePoolArgsForCall[i].name
+}
+
+func (fake *FakeDB) DeletePoolReturns(result1 error) {
+	fake.DeletePoolStub = nil
+	fake.deletePoolReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) Flavors(logger lager.Logger) ([]*models.Flavor, error) {
+	fake.flavorsMutex.Lock()
+	fake.flavorsArgsForCall = append(fake.flavorsArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("Flavors", []interface{}{logger})
+	fake.flavorsMutex.Unlock()
+	if fake.FlavorsStub != nil {
+		return fake.FlavorsStub(logger)
+	} else {
+		return fake.flavorsReturns.result1, fake.flavorsReturns.result2
+	}
+}
+
+func (fake *FakeDB) FlavorsCallCount() int {
+	fake.flavorsMutex.RLock()
+	defer fake.flavorsMutex.RUnlock()
+	return len(fake.flavorsArgsForCall)
+}
+
+func (fake *FakeDB) FlavorsArgsForCall(i int) lager.Logger {
+	fake.flavorsMutex.RLock()
+	defer fake.flavorsMutex.RUnlock()
+	return fake.flavorsArgsForCall[i].logger
+}
+
+func (fake *FakeDB) FlavorsReturns(result1 []*models.Flavor, result2 error) {
+	fake.FlavorsStub = nil
+	fake.flavorsReturns = struct {
+		result1 []*models.Flavor
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) FlavorByName(logger lager.Logger, name string) (*models.Flavor, error) {
+	fake.flavorByNameMutex.Lock()
+	fake.flavorByNameArgsForCall = append(fake.flavorByNameArgsForCall, struct {
+		logger lager.Logger
+		name   string
+	}{logger, name})
+	fake.recordInvocation("FlavorByName", []interface{}{logger, name})
+	fake.flavorByNameMutex.Unlock()
+	if fake.FlavorByNameStub != nil {
+		return fake.FlavorByNameStub(logger, name)
+	} else {
+		return fake.flavorByNameReturns.result1, fake.flavorByNameReturns.result2
+	}
+}
+
+func (fake *FakeDB) FlavorByNameCallCount() int {
+	fake.flavorByNameMutex.RLock()
+	defer fake.flavorByNameMutex.RUnlock()
+	return len(fake.flavorByNameArgsForCall)
+}
+
+func (fake *FakeDB) FlavorByNameArgsForCall(i int) (lager.Logger, string) {
+	fake.flavorByNameMutex.RLock()
+	defer fake.flavorByNameMutex.RUnlock()
+	return fake.flavorByNameArgsForCall[i].logger, fake.flavorByNameArgsForCall[i].name
+}
+
+func (fake *FakeDB) FlavorByNameReturns(result1 *models.Flavor, result2 error) {
+	fake.FlavorByNameStub = nil
+	fake.flavorByNameReturns = struct {
+		result1 *models.Flavor
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) InsertFlavor(logger lager.Logger, flavor *models.Flavor) error {
+	fake.insertFlavorMutex.Lock()
+	fake.insertFlavorArgsForCall = append(fake.insertFlavorArgsForCall, struct {
+		logger lager.Logger
+		flavor *models.Flavor
+	}{logger, flavor})
+	fake.recordInvocation("InsertFlavor", []interface{}{logger, flavor})
+	fake.insertFlavorMutex.Unlock()
+	if fake.InsertFlavorStub != nil {
+		return fake.InsertFlavorStub(logger, flavor)
+	} else {
+		return fake.insertFlavorReturns.result1
+	}
+}
+
+func (fake *FakeDB) InsertFlavorCallCount() int {
+	fake.insertFlavorMutex.RLock()
+	defer fake.insertFlavorMutex.RUnlock()
+	return len(fake.insertFlavorArgsForCall)
+}
+
+func (fake *FakeDB) InsertFlavorArgsForCall(i int) (lager.Logger, *models.Flavor) {
+	fake.insertFlavorMutex.RLock()
+	defer fake.insertFlavorMutex.RUnlock()
+	return fake.insertFlavorArgsForCall[i].logger, fake.insertFlavorArgsForCall[i].flavor
+}
+
+func (fake *FakeDB) InsertFlavorReturns(result1 error) {
+	fake.InsertFlavorStub = nil
+	fake.insertFlavorReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) UpdateFlavor(logger lager.Logger, flavor *models.Flavor) error {
+	fake.updateFlavorMutex.Lock()
+	fake.updateFlavorArgsForCall = append(fake.updateFlavorArgsForCall, struct {
+		logger lager.Logger
+		flavor *models.Flavor
+	}{logger, flavor})
+	fake.recordInvocation("UpdateFlavor", []interface{}{logger, flavor})
+	fake.updateFlavorMutex.Unlock()
+	if fake.UpdateFlavorStub != nil {
+		return fake.UpdateFlavorStub(logger, flavor)
+	} else {
+		return fake.updateFlavorReturns.result1
+	}
+}
+
+func (fake *FakeDB) UpdateFlavorCallCount() int {
+	fake.updateFlavorMutex.RLock()
+	defer fake.updateFlavorMutex.RUnlock()
+	return len(fake.updateFlavorArgsForCall)
+}
+
+func (fake *FakeDB) UpdateFlavorArgsForCall(i int) (lager.Logger, *models.Flavor) {
+	fake.updateFlavorMutex.RLock()
+	defer fake.updateFlavorMutex.RUnlock()
+	return fake.updateFlavorArgsForCall[i].logger, fake.updateFlavorArgsForCall[i].flavor
+}
+
+func (fake *FakeDB) UpdateFlavorReturns(result1 error) {
+	fake.UpdateFlavorStub = nil
+	fake.updateFlavorReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) DeleteFlavor(logger lager.Logger, name string) error {
+	fake.deleteFlavorMutex.Lock()
+	fake.deleteFlavorArgsForCall = append(fake.deleteFlavorArgsForCall, struct {
+		logger lager.Logger
+		name   string
+	}{logger, name})
+	fake.recordInvocation("DeleteFlavor", []interface{}{logger, name})
+	fake.deleteFlavorMutex.Unlock()
+	if fake.DeleteFlavorStub != nil {
+		return fake.DeleteFlavorStub(logger, name)
+	} else {
+		return fake.deleteFlavorReturns.result1
+	}
+}
+
+func (fake *FakeDB) DeleteFlavorCallCount() int {
+	fake.deleteFlavorMutex.RLock()
+	defer fake.deleteFlavorMutex.RUnlock()
+	return len(fake.deleteFlavorArgsForCall)
+}
+
+func (fake *FakeDB) DeleteFlavorArgsForCall(i int) (lager.Logger, string) {
+	fake.deleteFlavorMutex.RLock()
+	defer fake.deleteFlavorMutex.RUnlock()
+	return fake.deleteFlavorArgsForCall[i].logger, fake.deleteFlavorArgsForCall[i].name
+}
+
+func (fake *FakeDB) DeleteFlavorReturns(result1 error) {
+	fake.DeleteFlavorStub = nil
+	fake.deleteFlavorReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) Reservations(logger lager.Logger) ([]*models.Reservation, error) {
+	fake.reservationsMutex.Lock()
+	fake.reservationsArgsForCall = append(fake.reservationsArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("Reservations", []interface{}{logger})
+	fake.reservationsMutex.Unlock()
+	if fake.ReservationsStub != nil {
+		return fake.ReservationsStub(logger)
+	} else {
+		return fake.reservationsReturns.result1, fake.reservationsReturns.result2
+	}
+}
+
+func (fake *FakeDB) ReservationsCallCount() int {
+	fake.reservationsMutex.RLock()
+	defer fake.reservationsMutex.RUnlock()
+	return len(fake.reservationsArgsForCall)
+}
+
+func (fake *FakeDB) ReservationsArgsForCall(i int) lager.Logger {
+	fake.reservationsMutex.RLock()
+	defer fake.reservationsMutex.RUnlock()
+	return fake.reservationsArgsForCall[i].logger
+}
+
+func (fake *FakeDB) ReservationsReturns(result1 []*models.Reservation, result2 error) {
+	fake.ReservationsStub = nil
+	fake.reservationsReturns = struct {
+		result1 []*models.Reservation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) ReservationByID(logger lager.Logger, id string) (*models.Reservation, error) {
+	fake.reservationByIDMutex.Lock()
+	fake.reservationByIDArgsForCall = append(fake.reservationByIDArgsForCall, struct {
+		logger lager.Logger
+		id     string
+	}{logger, id})
+	fake.recordInvocation("ReservationByID", []interface{}{logger, id})
+	fake.reservationByIDMutex.Unlock()
+	if fake.ReservationByIDStub != nil {
+		return fake.ReservationByIDStub(logger, id)
+	} else {
+		return fake.reservationByIDReturns.result1, fake.reservationByIDReturns.result2
+	}
+}
+
+func (fake *FakeDB) ReservationByIDCallCount() int {
+	fake.reservationByIDMutex.RLock()
+	defer fake.reservationByIDMutex.RUnlock()
+	return len(fake.reservationByIDArgsForCall)
+}
+
+func (fake *FakeDB) ReservationByIDArgsForCall(i int) (lager.Logger, string) {
+	fake.reservationByIDMutex.RLock()
+	defer fake.reservationByIDMutex.RUnlock()
+	return fake.reservationByIDArgsForCall[i].logger, fake.reservationByIDArgsForCall[i].id
+}
+
+func (fake *FakeDB) ReservationByIDReturns(result1 *models.Reservation, result2 error) {
+	fake.ReservationByIDStub = nil
+	fake.reservationByIDReturns = struct {
+		result1 *models.Reservation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) ReserveVirtualGuests(logger lager.Logger, reservation *models.Reservation) error {
+	fake.reserveVirtualGuestsMutex.Lock()
+	fake.reserveVirtualGuestsArgsForCall = append(fake.reserveVirtualGuestsArgsForCall, struct {
+		logger      lager.Logger
+		reservation *models.Reservation
+	}{logger, reservation})
+	fake.recordInvocation("ReserveVirtualGuests", []interface{}{logger, reservation})
+	fake.reserveVirtualGuestsMutex.Unlock()
+	if fake.ReserveVirtualGuestsStub != nil {
+		return fake.ReserveVirtualGuestsStub(logger, reservation)
+	} else {
+		return fake.reserveVirtualGuestsReturns.result1
+	}
+}
+
+func (fake *FakeDB) ReserveVirtualGuestsCallCount() int {
+	fake.reserveVirtualGuestsMutex.RLock()
+	defer fake.reserveVirtualGuestsMutex.RUnlock()
+	return len(fake.reserveVirtualGuestsArgsForCall)
+}
+
+func (fake *FakeDB) ReserveVirtualGuestsArgsForCall(i int) (lager.Logger, *models.Reservation) {
+	fake.reserveVirtualGuestsMutex.RLock()
+	defer fake.reserveVirtualGuestsMutex.RUnlock()
+	return fake.reserveVirtualGuestsArgsForCall[i].logger, fake.reserveVirtualGuestsArgsForCall[i].reservation
+}
+
+func (fake *FakeDB) ReserveVirtualGuestsReturns(result1 error) {
+	fake.ReserveVirtualGuestsStub = nil
+	fake.reserveVirtualGuestsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) ActivateReservation(logger lager.Logger, id string) error {
+	fake.activateReservationMutex.Lock()
+	fake.activateReservationArgsForCall = append(fake.activateReservationArgsForCall, struct {
+		logger lager.Logger
+		id     string
+	}{logger, id})
+	fake.recordInvocation("ActivateReservation", []interface{}{logger, id})
+	fake.activateReservationMutex.Unlock()
+	if fake.ActivateReservationStub != nil {
+		return fake.ActivateReservationStub(logger, id)
+	} else {
+		return fake.activateReservationReturns.result1
+	}
+}
+
+func (fake *FakeDB) ActivateReservationCallCount() int {
+	fake.activateReservationMutex.RLock()
+	defer fake.activateReservationMutex.RUnlock()
+	return len(fake.activateReservationArgsForCall)
+}
+
+func (fake *FakeDB) ActivateReservationArgsForCall(i int) (lager.Logger, string) {
+	fake.activateReservationMutex.RLock()
+	defer fake.activateReservationMutex.RUnlock()
+	return fake.activateReservationArgsForCall[i].logger, fake.activateReservationArgsForCall[i].id
+}
+
+func (fake *FakeDB) ActivateReservationReturns(result1 error) {
+	fake.ActivateReservationStub = nil
+	fake.activateReservationReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) ReleaseReservation(logger lager.Logger, id string) error {
+	fake.releaseReservationMutex.Lock()
+	fake.releaseReservationArgsForCall = append(fake.releaseReservationArgsForCall, struct {
+		logger lager.Logger
+		id     string
+	}{logger, id})
+	fake.recordInvocation("ReleaseReservation", []interface{}{logger, id})
+	fake.releaseReservationMutex.Unlock()
+	if fake.ReleaseReservationStub != nil {
+		return fake.ReleaseReservationStub(logger, id)
+	} else {
+		return fake.releaseReservationReturns.result1
+	}
+}
+
+func (fake *FakeDB) ReleaseReservationCallCount() int {
+	fake.releaseReservationMutex.RLock()
+	defer fake.releaseReservationMutex.RUnlock()
+	return len(fake.releaseReservationArgsForCall)
+}
+
+func (fake *FakeDB) ReleaseReservationArgsForCall(i int) (lager.Logger, string) {
+	fake.releaseReservationMutex.RLock()
+	defer fake.releaseReservationMutex.RUnlock()
+	return fake.releaseReservationArgsForCall[i].logger, fake.releaseReservationArgsForCall[i].id
+}
+
+func (fake *FakeDB) ReleaseReservationReturns(result1 error) {
+	fake.ReleaseReservationStub = nil
+	fake.releaseReservationReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) Webhooks(logger lager.Logger) ([]*models.Webhook, error) {
+	fake.webhooksMutex.Lock()
+	fake.webhooksArgsForCall = append(fake.webhooksArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("Webhooks", []interface{}{logger})
+	fake.webhooksMutex.Unlock()
+	if fake.WebhooksStub != nil {
+		return fake.WebhooksStub(logger)
+	} else {
+		return fake.webhooksReturns.result1, fake.webhooksReturns.result2
+	}
+}
+
+func (fake *FakeDB) WebhooksCallCount() int {
+	fake.webhooksMutex.RLock()
+	defer fake.webhooksMutex.RUnlock()
+	return len(fake.webhooksArgsForCall)
+}
+
+func (fake *FakeDB) WebhooksArgsForCall(i int) lager.Logger {
+	fake.webhooksMutex.RLock()
+	defer fake.webhooksMutex.RUnlock()
+	return fake.webhooksArgsForCall[i].logger
+}
+
+func (fake *FakeDB) WebhooksReturns(result1 []*models.Webhook, result2 error) {
+	fake.WebhooksStub = nil
+	fake.webhooksReturns = struct {
+		result1 []*models.Webhook
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) WebhookByID(logger lager.Logger, id string) (*models.Webhook, error) {
+	fake.webhookByIDMutex.Lock()
+	fake.webhookByIDArgsForCall = append(fake.webhookByIDArgsForCall, struct {
+		logger lager.Logger
+		id     string
+	}{logger, id})
+	fake.recordInvocation("WebhookByID", []interface{}{logger, id})
+	fake.webhookByIDMutex.Unlock()
+	if fake.WebhookByIDStub != nil {
+		return fake.WebhookByIDStub(logger, id)
+	} else {
+		return fake.webhookByIDReturns.result1, fake.webhookByIDReturns.result2
+	}
+}
+
+func (fake *FakeDB) WebhookByIDCallCount() int {
+	fake.webhookByIDMutex.RLock()
+	defer fake.webhookByIDMutex.RUnlock()
+	return len(fake.webhookByIDArgsForCall)
+}
+
+func (fake *FakeDB) WebhookByIDArgsForCall(i int) (lager.Logger, string) {
+	fake.webhookByIDMutex.RLock()
+	defer fake.webhookByIDMutex.RUnlock()
+	return fake.webhookByIDArgsForCall[i].logger, fake.webhookByIDArgsForCall[i].id
+}
+
+func (fake *FakeDB) WebhookByIDReturns(result1 *models.Webhook, result2 error) {
+	fake.WebhookByIDStub = nil
+	fake.webhookByIDReturns = struct {
+		result1 *models.Webhook
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) InsertWebhook(logger lager.Logger, webhook *models.Webhook) error {
+	fake.insertWebhookMutex.Lock()
+	fake.insertWebhookArgsForCall = append(fake.insertWebhookArgsForCall, struct {
+		logger  lager.Logger
+		webhook *models.Webhook
+	}{logger, webhook})
+	fake.recordInvocation("InsertWebhook", []interface{}{logger, webhook})
+	fake.insertWebhookMutex.Unlock()
+	if fake.InsertWebhookStub != nil {
+		return fake.InsertWebhookStub(logger, webhook)
+	} else {
+		return fake.insertWebhookReturns.result1
+	}
+}
+
+func (fake *FakeDB) InsertWebhookCallCount() int {
+	fake.insertWebhookMutex.RLock()
+	defer fake.insertWebhookMutex.RUnlock()
+	return len(fake.insertWebhookArgsForCall)
+}
+
+func (fake *FakeDB) InsertWebhookArgsForCall(i int) (lager.Logger, *models.Webhook) {
+	fake.insertWebhookMutex.RLock()
+	defer fake.insertWebhookMutex.RUnlock()
+	return fake.insertWebhookArgsForCall[i].logger, fake.insertWebhookArgsForCall[i].webhook
+}
+
+func (fake *FakeDB) InsertWebhookReturns(result1 error) {
+	fake.InsertWebhookStub = nil
+	fake.insertWebhookReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) DeleteWebhook(logger lager.Logger, id string) error {
+	fake.deleteWebhookMutex.Lock()
+	fake.deleteWebhookArgsForCall = append(fake.deleteWebhookArgsForCall, struct {
+		logger lager.Logger
+		id     string
+	}{logger, id})
+	fake.recordInvocation("DeleteWebhook", []interface{}{logger, id})
+	fake.deleteWebhookMutex.Unlock()
+	if fake.DeleteWebhookStub != nil {
+		return fake.DeleteWebhookStub(logger, id)
+	} else {
+		return fake.deleteWebhookReturns.result1
+	}
+}
+
+func (fake *FakeDB) DeleteWebhookCallCount() int {
+	fake.deleteWebhookMutex.RLock()
+	defer fake.deleteWebhookMutex.RUnlock()
+	return len(fake.deleteWebhookArgsForCall)
+}
+
+func (fake *FakeDB) DeleteWebhookArgsForCall(i int) (lager.Logger, string) {
+	fake.deleteWebhookMutex.RLock()
+	defer fake.deleteWebhookMutex.RUnlock()
+	return fake.deleteWebhookArgsForCall[i].logger, fake.deleteWebhookArgsForCall[i].id
+}
+
+func (fake *FakeDB) DeleteWebhookReturns(result1 error) {
+	fake.DeleteWebhookStub = nil
+	fake.deleteWebhookReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) DispatchOutboxEvents(logger lager.Logger, limit int) (int, error) {
+	fake.dispatchOutboxEventsMutex.Lock()
+	fake.dispatchOutboxEventsArgsForCall = append(fake.dispatchOutboxEventsArgsForCall, struct {
+		logger lager.Logger
+		limit  int
+	}{logger, limit})
+	fake.recordInvocation("DispatchOutboxEvents", []interface{}{logger, limit})
+	fake.dispatchOutboxEventsMutex.Unlock()
+	if fake.DispatchOutboxEventsStub != nil {
+		return fake.DispatchOutboxEventsStub(logger, limit)
+	} else {
+		return fake.dispatchOutboxEventsReturns.result1, fake.dispatchOutboxEventsReturns.result2
+	}
+}
+
+func (fake *FakeDB) DispatchOutboxEventsCallCount() int {
+	fake.dispatchOutboxEventsMutex.RLock()
+	defer fake.dispatchOutboxEventsMutex.RUnlock()
+	return len(fake.dispatchOutboxEventsArgsForCall)
+}
+
+func (fake *FakeDB) DispatchOutboxEventsArgsForCall(i int) (lager.Logger, int) {
+	fake.dispatchOutboxEventsMutex.RLock()
+	defer fake.dispatchOutboxEventsMutex.RUnlock()
+	return fake.dispatchOutboxEventsArgsForCall[i].logger, fake.dispatchOutboxEventsArgsForCall[i].limit
+}
+
+func (fake *FakeDB) DispatchOutboxEventsReturns(result1 int, result2 error) {
+	fake.DispatchOutboxEventsStub = nil
+	fake.dispatchOutboxEventsReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) OutboxEventsSince(logger lager.Logger, since int64) ([]*models.WebhookEvent, error) {
+	fake.outboxEventsSinceMutex.Lock()
+	fake.outboxEventsSinceArgsForCall = append(fake.outboxEventsSinceArgsForCall, struct {
+		logger lager.Logger
+		since  int64
+	}{logger, since})
+	fake.recordInvocation("OutboxEventsSince", []interface{}{logger, since})
+	fake.outboxEventsSinceMutex.Unlock()
+	if fake.OutboxEventsSinceStub != nil {
+		return fake.OutboxEventsSinceStub(logger, since)
+	} else {
+		return fake.outboxEventsSinceReturns.result1, fake.outboxEventsSinceReturns.result2
+	}
+}
+
+func (fake *FakeDB) OutboxEventsSinceCallCount() int {
+	fake.outboxEventsSinceMutex.RLock()
+	defer fake.outboxEventsSinceMutex.RUnlock()
+	return len(fake.outboxEventsSinceArgsForCall)
+}
+
+func (fake *FakeDB) OutboxEventsSinceArgsForCall(i int) (lager.Logger, int64) {
+	fake.outboxEventsSinceMutex.RLock()
+	defer fake.outboxEventsSinceMutex.RUnlock()
+	return fake.outboxEventsSinceArgsForCall[i].logger, fake.outboxEventsSinceArgsForCall[i].since
+}
+
+func (fake *FakeDB) OutboxEventsSinceReturns(result1 []*models.WebhookEvent, result2 error) {
+	fake.OutboxEventsSinceStub = nil
+	fake.outboxEventsSinceReturns = struct {
+		result1 []*models.WebhookEvent
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) PendingWebhookDeliveries(logger lager.Logger, limit int) ([]*models.WebhookDelivery, error) {
+	fake.pendingWebhookDeliveriesMutex.Lock()
+	fake.pendingWebhookDeliveriesArgsForCall = append(fake.pendingWebhookDeliveriesArgsForCall, struct {
+		logger lager.Logger
+		limit  int
+	}{logger, limit})
+	fake.recordInvocation("PendingWebhookDeliveries", []interface{}{logger, limit})
+	fake.pendingWebhookDeliveriesMutex.Unlock()
+	if fake.PendingWebhookDeliveriesStub != nil {
+		return fake.PendingWebhookDeliveriesStub(logger, limit)
+	} else {
+		return fake.pendingWebhookDeliveriesReturns.result1, fake.pendingWebhookDeliveriesReturns.result2
+	}
+}
+
+func (fake *FakeDB) PendingWebhookDeliveriesCallCount() int {
+	fake.pendingWebhookDeliveriesMutex.RLock()
+	defer fake.pendingWebhookDeliveriesMutex.RUnlock()
+	return len(fake.pendingWebhookDeliveriesArgsForCall)
+}
+
+func (fake *FakeDB) PendingWebhookDeliveriesArgsForCall(i int) (lager.Logger, int) {
+	fake.pendingWebhookDeliveriesMutex.RLock()
+	defer fake.pendingWebhookDeliveriesMutex.RUnlock()
+	return fake.pendingWebhookDeliveriesArgsForCall[i].logger, fake.pendingWebhookDeliveriesArgsForCall[i].limit
+}
+
+func (fake *FakeDB) PendingWebhookDeliveriesReturns(result1 []*models.WebhookDelivery, result2 error) {
+	fake.PendingWebhookDeliveriesStub = nil
+	fake.pendingWebhookDeliveriesReturns = struct {
+		result1 []*models.WebhookDelivery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) CompleteWebhookDelivery(logger lager.Logger, id string) error {
+	fake.completeWebhookDeliveryMutex.Lock()
+	fake.completeWebhookDeliveryArgsForCall = append(fake.completeWebhookDeliveryArgsForCall, struct {
+		logger lager.Logger
+		id     string
+	}{logger, id})
+	fake.recordInvocation("CompleteWebhookDelivery", []interface{}{logger, id})
+	fake.completeWebhookDeliveryMutex.Unlock()
+	if fake.CompleteWebhookDeliveryStub != nil {
+		return fake.CompleteWebhookDeliveryStub(logger, id)
+	} else {
+		return fake.completeWebhookDeliveryReturns.result1
+	}
+}
+
+func (fake *FakeDB) CompleteWebhookDeliveryCallCount() int {
+	fake.completeWebhookDeliveryMutex.RLock()
+	defer fake.completeWebhookDeliveryMutex.RUnlock()
+	return len(fake.completeWebhookDeliveryArgsForCall)
+}
+
+func (fake *FakeDB) CompleteWebhookDeliveryArgsForCall(i int) (lager.Logger, string) {
+	fake.completeWebhookDeliveryMutex.RLock()
+	defer fake.completeWebhookDeliveryMutex.RUnlock()
+	return fake.completeWebhookDeliveryArgsForCall[i].logger, fake.completeWebhookDeliveryArgsForCall[i].id
+}
+
+func (fake *FakeDB) CompleteWebhookDeliveryReturns(result1 error) {
+	fake.CompleteWebhookDeliveryStub = nil
+	fake.completeWebhookDeliveryReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) FailWebhookDelivery(logger lager.Logger, id string, reason string, nextAttemptAt time.Time, dead bool) error {
+	fake.failWebhookDeliveryMutex.Lock()
+	fake.failWebhookDeliveryArgsForCall = append(fake.failWebhookDeliveryArgsForCall, struct {
+		logger        lager.Logger
+		id            string
+		reason        string
+		nextAttemptAt time.Time
+		dead          bool
+	}{logger, id, reason, nextAttemptAt, dead})
+	fake.recordInvocation("FailWebhookDelivery", []interface{}{logger, id, reason, nextAttemptAt, dead})
+	fake.failWebhookDeliveryMutex.Unlock()
+	if fake.FailWebhookDeliveryStub != nil {
+		return fake.FailWebhookDeliveryStub(logger, id, reason, nextAttemptAt, dead)
+	} else {
+		return fake.failWebhookDeliveryReturns.result1
+	}
+}
+
+func (fake *FakeDB) FailWebhookDeliveryCallCount() int {
+	fake.failWebhookDeliveryMutex.RLock()
+	defer fake.failWebhookDeliveryMutex.RUnlock()
+	return len(fake.failWebhookDeliveryArgsForCall)
+}
+
+func (fake *FakeDB) FailWebhookDeliveryArgsForCall(i int) (lager.Logger, string, string, time.Time, bool) {
+	fake.failWebhookDeliveryMutex.RLock()
+	defer fake.failWebhookDeliveryMutex.RUnlock()
+	return fake.failWebhookDeliveryArgsForCall[i].logger, fake.failWebhookDeliveryArgsForCall[i].id, fake.failWebhookDeliveryArgsForCall[i].reason, fake.failWebhookDeliveryArgsForCall[i].nextAttemptAt, fake.failWebhookDeliveryArgsForCall[i].dead
+}
+
+func (fake *FakeDB) FailWebhookDeliveryReturns(result1 error) {
+	fake.FailWebhookDeliveryStub = nil
+	fake.failWebhookDeliveryReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) DeadWebhookDeliveries(logger lager.Logger) ([]*models.WebhookDelivery, error) {
+	fake.deadWebhookDeliveriesMutex.Lock()
+	fake.deadWebhookDeliveriesArgsForCall = append(fake.deadWebhookDeliveriesArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("DeadWebhookDeliveries", []interface{}{logger})
+	fake.deadWebhookDeliveriesMutex.Unlock()
+	if fake.DeadWebhookDeliveriesStub != nil {
+		return fake.DeadWebhookDeliveriesStub(logger)
+	} else {
+		return fake.deadWebhookDeliveriesReturns.result1, fake.deadWebhookDeliveriesReturns.result2
+	}
+}
+
+func (fake *FakeDB) DeadWebhookDeliveriesCallCount() int {
+	fake.deadWebhookDeliveriesMutex.RLock()
+	defer fake.deadWebhookDeliveriesMutex.RUnlock()
+	return len(fake.deadWebhookDeliveriesArgsForCall)
+}
+
+func (fake *FakeDB) DeadWebhookDeliveriesArgsForCall(i int) lager.Logger {
+	fake.deadWebhookDeliveriesMutex.RLock()
+	defer fake.deadWebhookDeliveriesMutex.RUnlock()
+	return fake.deadWebhookDeliveriesArgsForCall[i].logger
+}
+
+func (fake *FakeDB) DeadWebhookDeliveriesReturns(result1 []*models.WebhookDelivery, result2 error) {
+	fake.DeadWebhookDeliveriesStub = nil
+	fake.deadWebhookDeliveriesReturns = struct {
+		result1 []*models.WebhookDelivery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) RetryWebhookDelivery(logger lager.Logger, id string) error {
+	fake.retryWebhookDeliveryMutex.Lock()
+	fake.retryWebhookDeliveryArgsForCall = append(fake.retryWebhookDeliveryArgsForCall, struct {
+		logger lager.Logger
+		id     string
+	}{logger, id})
+	fake.recordInvocation("RetryWebhookDelivery", []interface{}{logger, id})
+	fake.retryWebhookDeliveryMutex.Unlock()
+	if fake.RetryWebhookDeliveryStub != nil {
+		return fake.RetryWebhookDeliveryStub(logger, id)
+	} else {
+		return fake.retryWebhookDeliveryReturns.result1
+	}
+}
+
+func (fake *FakeDB) RetryWebhookDeliveryCallCount() int {
+	fake.retryWebhookDeliveryMutex.RLock()
+	defer fake.retryWebhookDeliveryMutex.RUnlock()
+	return len(fake.retryWebhookDeliveryArgsForCall)
+}
+
+func (fake *FakeDB) RetryWebhookDeliveryArgsForCall(i int) (lager.Logger, string) {
+	fake.retryWebhookDeliveryMutex.RLock()
+	defer fake.retryWebhookDeliveryMutex.RUnlock()
+	return fake.retryWebhookDeliveryArgsForCall[i].logger, fake.retryWebhookDeliveryArgsForCall[i].id
+}
+
+func (fake *FakeDB) RetryWebhookDeliveryReturns(result1 error) {
+	fake.RetryWebhookDeliveryStub = nil
+	fake.retryWebhookDeliveryReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) AcquireLock(logger lager.Logger, name string, owner string, ttl time.Duration) (bool, error) {
+	fake.acquireLockMutex.Lock()
+	fake.acquireLockArgsForCall = append(fake.acquireLockArgsForCall, struct {
+		logger lager.Logger
+		name   string
+		owner  string
+		ttl    time.Duration
+	}{logger, name, owner, ttl})
+	fake.recordInvocation("AcquireLock", []interface{}{logger, name, owner, ttl})
+	fake.acquireLockMutex.Unlock()
+	if fake.AcquireLockStub != nil {
+		return fake.AcquireLockStub(logger, name, owner, ttl)
+	} else {
+		return fake.acquireLockReturns.result1, fake.acquireLockReturns.result2
+	}
+}
+
+func (fake *FakeDB) AcquireLockCallCount() int {
+	fake.acquireLockMutex.RLock()
+	defer fake.acquireLockMutex.RUnlock()
+	return len(fake.acquireLockArgsForCall)
+}
+
+func (fake *FakeDB) AcquireLockArgsForCall(i int) (lager.Logger, string, string, time.Duration) {
+	fake.acquireLockMutex.RLock()
+	defer fake.acquireLockMutex.RUnlock()
+	return fake.acquireLockArgsForCall[i].logger, fake.acquireLockArgsForCall[i].name, fake.acquireLockArgsForCall[i].owner, fake.acquireLockArgsForCall[i].ttl
+}
+
+func (fake *FakeDB) AcquireLockReturns(result1 bool, result2 error) {
+	fake.AcquireLockStub = nil
+	fake.acquireLockReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) ReleaseLock(logger lager.Logger, name string, owner string) error {
+	fake.releaseLockMutex.Lock()
+	fake.releaseLockArgsForCall = append(fake.releaseLockArgsForCall, struct {
+		logger lager.Logger
+		name   string
+		owner  string
+	}{logger, name, owner})
+	fake.recordInvocation("ReleaseLock", []interface{}{logger, name, owner})
+	fake.releaseLockMutex.Unlock()
+	if fake.ReleaseLockStub != nil {
+		return fake.ReleaseLockStub(logger, name, owner)
+	} else {
+		return fake.releaseLockReturns.result1
+	}
+}
+
+func (fake *FakeDB) ReleaseLockCallCount() int {
+	fake.releaseLockMutex.RLock()
+	defer fake.releaseLockMutex.RUnlock()
+	return len(fake.releaseLockArgsForCall)
+}
+
+func (fake *FakeDB) ReleaseLockArgsForCall(i int) (lager.Logger, string, string) {
+	fake.releaseLockMutex.RLock()
+	defer fake.releaseLockMutex.RUnlock()
+	return fake.releaseLockArgsForCall[i].logger, fake.releaseLockArgsForCall[i].name, fake.releaseLockArgsForCall[i].owner
+}
+
+func (fake *FakeDB) ReleaseLockReturns(result1 error) {
+	fake.ReleaseLockStub = nil
+	fake.releaseLockReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) SchemaVersion(logger lager.Logger) (int32, error) {
+	fake.schemaVersionMutex.Lock()
+	fake.schemaVersionArgsForCall = append(fake.schemaVersionArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("SchemaVersion", []interface{}{logger})
+	fake.schemaVersionMutex.Unlock()
+	if fake.SchemaVersionStub != nil {
+		return fake.SchemaVersionStub(logger)
+	} else {
+		return fake.schemaVersionReturns.result1, fake.schemaVersionReturns.result2
+	}
+}
+
+func (fake *FakeDB) SchemaVersionCallCount() int {
+	fake.schemaVersionMutex.RLock()
+	defer fake.schemaVersionMutex.RUnlock()
+	return len(fake.schemaVersionArgsForCall)
+}
+
+func (fake *FakeDB) SchemaVersionArgsForCall(i int) lager.Logger {
+	fake.schemaVersionMutex.RLock()
+	defer fake.schemaVersionMutex.RUnlock()
+	return fake.schemaVersionArgsForCall[i].logger
+}
+
+func (fake *FakeDB) SchemaVersionReturns(result1 int32, result2 error) {
+	fake.SchemaVersionStub = nil
+	fake.schemaVersionReturns = struct {
+		result1 int32
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) SetSchemaVersion(logger lager.Logger, version int32) error {
+	fake.setSchemaVersionMutex.Lock()
+	fake.setSchemaVersionArgsForCall = append(fake.setSchemaVersionArgsForCall, struct {
+		logger  lager.Logger
+		version int32
+	}{logger, version})
+	fake.recordInvocation("SetSchemaVersion", []interface{}{logger, version})
+	fake.setSchemaVersionMutex.Unlock()
+	if fake.SetSchemaVersionStub != nil {
+		return fake.SetSchemaVersionStub(logger, version)
+	} else {
+		return fake.setSchemaVersionReturns.result1
+	}
+}
+
+func (fake *FakeDB) SetSchemaVersionCallCount() int {
+	fake.setSchemaVersionMutex.RLock()
+	defer fake.setSchemaVersionMutex.RUnlock()
+	return len(fake.setSchemaVersionArgsForCall)
+}
+
+func (fake *FakeDB) SetSchemaVersionArgsForCall(i int) (lager.Logger, int32) {
+	fake.setSchemaVersionMutex.RLock()
+	defer fake.setSchemaVersionMutex.RUnlock()
+	return fake.setSchemaVersionArgsForCall[i].logger, fake.setSchemaVersionArgsForCall[i].version
+}
+
+func (fake *FakeDB) SetSchemaVersionReturns(result1 error) {
+	fake.SetSchemaVersionStub = nil
+	fake.setSchemaVersionReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) Settings(logger lager.Logger) (map[string]string, error) {
+	fake.settingsMutex.Lock()
+	fake.settingsArgsForCall = append(fake.settingsArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("Settings", []interface{}{logger})
+	fake.settingsMutex.Unlock()
+	if fake.SettingsStub != nil {
+		return fake.SettingsStub(logger)
+	} else {
+		return fake.settingsReturns.result1, fake.settingsReturns.result2
+	}
+}
+
+func (fake *FakeDB) SettingsCallCount() int {
+	fake.settingsMutex.RLock()
+	defer fake.settingsMutex.RUnlock()
+	return len(fake.settingsArgsForCall)
+}
+
+func (fake *FakeDB) SettingsArgsForCall(i int) lager.Logger {
+	fake.settingsMutex.RLock()
+	defer fake.settingsMutex.RUnlock()
+	return fake.settingsArgsForCall[i].logger
+}
+
+func (fake *FakeDB) SettingsReturns(result1 map[string]string, result2 error) {
+	fake.SettingsStub = nil
+	fake.settingsReturns = struct {
+		result1 map[string]string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) SetSetting(logger lager.Logger, name string, value string) error {
+	fake.setSettingMutex.Lock()
+	fake.setSettingArgsForCall = append(fake.setSettingArgsForCall, struct {
+		logger lager.Logger
+		name   string
+		value  string
+	}{logger, name, value})
+	fake.recordInvocation("SetSetting", []interface{}{logger, name, value})
+	fake.setSettingMutex.Unlock()
+	if fake.SetSettingStub != nil {
+		return fake.SetSettingStub(logger, name, value)
+	} else {
+		return fake.setSettingReturns.result1
+	}
+}
+
+func (fake *FakeDB) SetSettingCallCount() int {
+	fake.setSettingMutex.RLock()
+	defer fake.setSettingMutex.RUnlock()
+	return len(fake.setSettingArgsForCall)
+}
+
+func (fake *FakeDB) SetSettingArgsForCall(i int) (lager.Logger, string, string) {
+	fake.setSettingMutex.RLock()
+	defer fake.setSettingMutex.RUnlock()
+	return fake.setSettingArgsForCall[i].logger, fake.setSettingArgsForCall[i].name, fake.setSettingArgsForCall[i].value
+}
+
+func (fake *FakeDB) SetSettingReturns(result1 error) {
+	fake.SetSettingStub = nil
+	fake.setSettingReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) RemoveSetting(logger lager.Logger, name string) error {
+	fake.removeSettingMutex.Lock()
+	fake.removeSettingArgsForCall = append(fake.removeSettingArgsForCall, struct {
+		logger lager.Logger
+		name   string
+	}{logger, name})
+	fake.recordInvocation("RemoveSetting", []interface{}{logger, name})
+	fake.removeSettingMutex.Unlock()
+	if fake.RemoveSettingStub != nil {
+		return fake.RemoveSettingStub(logger, name)
+	} else {
+		return fake.removeSettingReturns.result1
+	}
+}
+
+func (fake *FakeDB) RemoveSettingCallCount() int {
+	fake.removeSettingMutex.RLock()
+	defer fake.removeSettingMutex.RUnlock()
+	return len(fake.removeSettingArgsForCall)
+}
+
+func (fake *FakeDB) RemoveSettingArgsForCall(i int) (lager.Logger, string) {
+	fake.removeSettingMutex.RLock()
+	defer fake.removeSettingMutex.RUnlock()
+	return fake.removeSettingArgsForCall[i].logger, fake.removeSettingArgsForCall[i].name
+}
+
+func (fake *FakeDB) RemoveSettingReturns(result1 error) {
+	fake.RemoveSettingStub = nil
+	fake.removeSettingReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) VMSecret(logger lager.Logger, cid int32) (encryption.Encrypted, error) {
+	fake.vmSecretMutex.Lock()
+	fake.vmSecretArgsForCall = append(fake.vmSecretArgsForCall, struct {
+		logger lager.Logger
+		cid    int32
+	}{logger, cid})
+	fake.recordInvocation("VMSecret", []interface{}{logger, cid})
+	fake.vmSecretMutex.Unlock()
+	if fake.VMSecretStub != nil {
+		return fake.VMSecretStub(logger, cid)
+	} else {
+		return fake.vmSecretReturns.result1, fake.vmSecretReturns.result2
+	}
+}
+
+func (fake *FakeDB) VMSecretCallCount() int {
+	fake.vmSecretMutex.RLock()
+	defer fake.vmSecretMutex.RUnlock()
+	return len(fake.vmSecretArgsForCall)
+}
+
+func (fake *FakeDB) VMSecretArgsForCall(i int) (lager.Logger, int32) {
+	fake.vmSecretMutex.RLock()
+	defer fake.vmSecretMutex.RUnlock()
+	return fake.vmSecretArgsForCall[i].logger, fake.vmSecretArgsForCall[i].cid
+}
+
+func (fake *FakeDB) VMSecretReturns(result1 encryption.Encrypted, result2 error) {
+	fake.VMSecretStub = nil
+	fake.vmSecretReturns = struct {
+		result1 encryption.Encrypted
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) SetVMSecret(logger lager.Logger, cid int32, secret encryption.Encrypted) error {
+	fake.setVMSecretMutex.Lock()
+	fake.setVMSecretArgsForCall = append(fake.setVMSecretArgsForCall, struct {
+		logger lager.Logger
+		cid    int32
+		secret encryption.Encrypted
+	}{logger, cid, secret})
+	fake.recordInvocation("SetVMSecret", []interface{}{logger, cid, secret})
+	fake.setVMSecretMutex.Unlock()
+	if fake.SetVMSecretStub != nil {
+		return fake.SetVMSecretStub(logger, cid, secret)
+	} else {
+		return fake.setVMSecretReturns.result1
+	}
+}
+
+func (fake *FakeDB) SetVMSecretCallCount() int {
+	fake.setVMSecretMutex.RLock()
+	defer fake.setVMSecretMutex.RUnlock()
+	return len(fake.setVMSecretArgsForCall)
+}
+
+func (fake *FakeDB) SetVMSecretArgsForCall(i int) (lager.Logger, int32, encryption.Encrypted) {
+	fake.setVMSecretMutex.RLock()
+	defer fake.setVMSecretMutex.RUnlock()
+	return fake.setVMSecretArgsForCall[i].logger, fake.setVMSecretArgsForCall[i].cid, fake.setVMSecretArgsForCall[i].secret
+}
+
+func (fake *FakeDB) SetVMSecretReturns(result1 error) {
+	fake.SetVMSecretStub = nil
+	fake.setVMSecretReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) RemoveVMSecret(logger lager.Logger, cid int32) error {
+	fake.removeVMSecretMutex.Lock()
+	fake.removeVMSecretArgsForCall = append(fake.removeVMSecretArgsForCall, struct {
+		logger lager.Logger
+		cid    int32
+	}{logger, cid})
+	fake.recordInvocation("RemoveVMSecret", []interface{}{logger, cid})
+	fake.removeVMSecretMutex.Unlock()
+	if fake.RemoveVMSecretStub != nil {
+		return fake.RemoveVMSecretStub(logger, cid)
+	} else {
+		return fake.removeVMSecretReturns.result1
+	}
+}
+
+func (fake *FakeDB) RemoveVMSecretCallCount() int {
+	fake.removeVMSecretMutex.RLock()
+	defer fake.removeVMSecretMutex.RUnlock()
+	return len(fake.removeVMSecretArgsForCall)
+}
+
+func (fake *FakeDB) RemoveVMSecretArgsForCall(i int) (lager.Logger, int32) {
+	fake.removeVMSecretMutex.RLock()
+	defer fake.removeVMSecretMutex.RUnlock()
+	return fake.removeVMSecretArgsForCall[i].logger, fake.removeVMSecretArgsForCall[i].cid
+}
+
+func (fake *FakeDB) RemoveVMSecretReturns(result1 error) {
+	fake.RemoveVMSecretStub = nil
+	fake.removeVMSecretReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) VMSecretTokenHash(logger lager.Logger, cid int32) (string, error) {
+	fake.vmSecretTokenHashMutex.Lock()
+	fake.vmSecretTokenHashArgsForCall = append(fake.vmSecretTokenHashArgsForCall, struct {
+		logger lager.Logger
+		cid    int32
+	}{logger, cid})
+	fake.recordInvocation("VMSecretTokenHash", []interface{}{logger, cid})
+	fake.vmSecretTokenHashMutex.Unlock()
+	if fake.VMSecretTokenHashStub != nil {
+		return fake.VMSecretTokenHashStub(logger, cid)
+	} else {
+		return fake.vmSecretTokenHashReturns.result1, fake.vmSecretTokenHashReturns.result2
+	}
+}
+
+func (fake *FakeDB) VMSecretTokenHashCallCount() int {
+	fake.vmSecretTokenHashMutex.RLock()
+	defer fake.vmSecretTokenHashMutex.RUnlock()
+	return len(fake.vmSecretTokenHashArgsForCall)
+}
+
+func (fake *FakeDB) VMSecretTokenHashArgsForCall(i int) (lager.Logger, int32) {
+	fake.vmSecretTokenHashMutex.RLock()
+	defer fake.vmSecretTokenHashMutex.RUnlock()
+	return fake.vmSecretTokenHashArgsForCall[i].logger, fake.vmSecretTokenHashArgsForCall[i].cid
+}
+
+func (fake *FakeDB) VMSecretTokenHashReturns(result1 string, result2 error) {
+	fake.VMSecretTokenHashStub = nil
+	fake.vmSecretTokenHashReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) ReEncryptVMSecrets(logger lager.Logger, activeLabel string, cryptor encryption.Cryptor) (int, error) {
+	fake.reEncryptVMSecretsMutex.Lock()
+	fake.reEncryptVMSecretsArgsForCall = append(fake.reEncryptVMSecretsArgsForCall, struct {
+		logger      lager.Logger
+		activeLabel string
+		cryptor     encryption.Cryptor
+	}{logger, activeLabel, cryptor})
+	fake.recordInvocation("ReEncryptVMSecrets", []interface{}{logger, activeLabel, cryptor})
+	fake.reEncryptVMSecretsMutex.Unlock()
+	if fake.ReEncryptVMSecretsStub != nil {
+		return fake.ReEncryptVMSecretsStub(logger, activeLabel, cryptor)
+	} else {
+		return fake.reEncryptVMSecretsReturns.result1, fake.reEncryptVMSecretsReturns.result2
+	}
+}
+
+func (fake *FakeDB) ReEncryptVMSecretsCallCount() int {
+	fake.reEncryptVMSecretsMutex.RLock()
+	defer fake.reEncryptVMSecretsMutex.RUnlock()
+	return len(fake.reEncryptVMSecretsArgsForCall)
+}
+
+func (fake *FakeDB) ReEncryptVMSecretsArgsForCall(i int) (lager.Logger, string, encryption.Cryptor) {
+	fake.reEncryptVMSecretsMutex.RLock()
+	defer fake.reEncryptVMSecretsMutex.RUnlock()
+	return fake.reEncryptVMSecretsArgsForCall[i].logger, fake.reEncryptVMSecretsArgsForCall[i].activeLabel, fake.reEncryptVMSecretsArgsForCall[i].cryptor
+}
+
+func (fake *FakeDB) ReEncryptVMSecretsReturns(result1 int, result2 error) {
+	fake.ReEncryptVMSecretsStub = nil
+	fake.reEncryptVMSecretsReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) VMUserData(logger lager.Logger, cid int32) (string, error) {
+	fake.vmUserDataMutex.Lock()
+	fake.vmUserDataArgsForCall = append(fake.vmUserDataArgsForCall, struct {
+		logger lager.Logger
+		cid    int32
+	}{logger, cid})
+	fake.recordInvocation("VMUserData", []interface{}{logger, cid})
+	fake.vmUserDataMutex.Unlock()
+	if fake.VMUserDataStub != nil {
+		return fake.VMUserDataStub(logger, cid)
+	} else {
+		return fake.vmUserDataReturns.result1, fake.vmUserDataReturns.result2
+	}
+}
+
+func (fake *FakeDB) VMUserDataCallCount() int {
+	fake.vmUserDataMutex.RLock()
+	defer fake.vmUserDataMutex.RUnlock()
+	return len(fake.vmUserDataArgsForCall)
+}
+
+func (fake *FakeDB) VMUserDataArgsForCall(i int) (lager.Logger, int32) {
+	fake.vmUserDataMutex.RLock()
+	defer fake.vmUserDataMutex.RUnlock()
+	return fake.vmUserDataArgsForCall[i].logger, fake.vmUserDataArgsForCall[i].cid
+}
+
+func (fake *FakeDB) VMUserDataReturns(result1 string, result2 error) {
+	fake.VMUserDataStub = nil
+	fake.vmUserDataReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) PendingOrders(logger lager.Logger) ([]*models.PendingOrder, error) {
+	fake.pendingOrdersMutex.Lock()
+	fake.pendingOrdersArgsForCall = append(fake.pendingOrdersArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("PendingOrders", []interface{}{logger})
+	fake.pendingOrdersMutex.Unlock()
+	if fake.PendingOrdersStub != nil {
+		return fake.PendingOrdersStub(logger)
+	} else {
+		return fake.pendingOrdersReturns.result1, fake.pendingOrdersReturns.result2
+	}
+}
+
+func (fake *FakeDB) PendingOrdersCallCount() int {
+	fake.pendingOrdersMutex.RLock()
+	defer fake.pendingOrdersMutex.RUnlock()
+	return len(fake.pendingOrdersArgsForCall)
+}
+
+func (fake *FakeDB) PendingOrdersArgsForCall(i int) lager.Logger {
+	fake.pendingOrdersMutex.RLock()
+	defer fake.pendingOrdersMutex.RUnlock()
+	return fake.pendingOrdersArgsForCall[i].logger
+}
+
+func (fake *FakeDB) PendingOrdersReturns(result1 []*models.PendingOrder, result2 error) {
+	fake.PendingOrdersStub = nil
+	fake.pendingOrdersReturns = struct {
+		result1 []*models.PendingOrder
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) InsertPendingOrder(logger lager.Logger, order *models.PendingOrder) error {
+	fake.insertPendingOrderMutex.Lock()
+	fake.insertPendingOrderArgsForCall = append(fake.insertPendingOrderArgsForCall, struct {
+		logger lager.Logger
+		order  *models.PendingOrder
+	}{logger, order})
+	fake.recordInvocation("InsertPendingOrder", []interface{}{logger, order})
+	fake.insertPendingOrderMutex.Unlock()
+	if fake.InsertPendingOrderStub != nil {
+		return fake.InsertPendingOrderStub(logger, order)
+	} else {
+		return fake.insertPendingOrderReturns.result1
+	}
+}
+
+func (fake *FakeDB) InsertPendingOrderCallCount() int {
+	fake.insertPendingOrderMutex.RLock()
+	defer fake.insertPendingOrderMutex.RUnlock()
+	return len(fake.insertPendingOrderArgsForCall)
+}
+
+func (fake *FakeDB) InsertPendingOrderArgsForCall(i int) (lager.Logger, *models.PendingOrder) {
+	fake.insertPendingOrderMutex.RLock()
+	defer fake.insertPendingOrderMutex.RUnlock()
+	return fake.insertPendingOrderArgsForCall[i].logger, fake.insertPendingOrderArgsForCall[i].order
+}
+
+func (fake *FakeDB) InsertPendingOrderReturns(result1 error) {
+	fake.InsertPendingOrderStub = nil
+	fake.insertPendingOrderReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) RemovePendingOrder(logger lager.Logger, cid int32) error {
+	fake.removePendingOrderMutex.Lock()
+	fake.removePendingOrderArgsForCall = append(fake.removePendingOrderArgsForCall, struct {
+		logger lager.Logger
+		cid    int32
+	}{logger, cid})
+	fake.recordInvocation("RemovePendingOrder", []interface{}{logger, cid})
+	fake.removePendingOrderMutex.Unlock()
+	if fake.RemovePendingOrderStub != nil {
+		return fake.RemovePendingOrderStub(logger, cid)
+	} else {
+		return fake.removePendingOrderReturns.result1
+	}
+}
+
+func (fake *FakeDB) RemovePendingOrderCallCount() int {
+	fake.removePendingOrderMutex.RLock()
+	defer fake.removePendingOrderMutex.RUnlock()
+	return len(fake.removePendingOrderArgsForCall)
+}
+
+func (fake *FakeDB) RemovePendingOrderArgsForCall(i int) (lager.Logger, int32) {
+	fake.removePendingOrderMutex.RLock()
+	defer fake.removePendingOrderMutex.RUnlock()
+	return fake.removePendingOrderArgsForCall[i].logger, fake.removePendingOrderArgsForCall[i].cid
+}
+
+func (fake *FakeDB) RemovePendingOrderReturns(result1 error) {
+	fake.RemovePendingOrderStub = nil
+	fake.removePendingOrderReturns = struct {
 		result1 error
 	}{result1}
 }
@@ -559,6 +2693,8 @@ func (fake *FakeDB) Invocations() map[string][][]interface{} {
 	defer fake.virtualGuestsMutex.RUnlock()
 	fake.orderVirtualGuestToProvisionMutex.RLock()
 	defer fake.orderVirtualGuestToProvisionMutex.RUnlock()
+	fake.orderVirtualGuestsToProvisionMutex.RLock()
+	defer fake.orderVirtualGuestsToProvisionMutex.RUnlock()
 	fake.virtualGuestsByStatesMutex.RLock()
 	defer fake.virtualGuestsByStatesMutex.RUnlock()
 	fake.virtualGuestsByDeploymentsMutex.RLock()
@@ -567,6 +2703,10 @@ func (fake *FakeDB) Invocations() map[string][][]interface{} {
 	defer fake.virtualGuestByCIDMutex.RUnlock()
 	fake.virtualGuestByIPMutex.RLock()
 	defer fake.virtualGuestByIPMutex.RUnlock()
+	fake.virtualGuestByHostnameMutex.RLock()
+	defer fake.virtualGuestByHostnameMutex.RUnlock()
+	fake.virtualGuestsByHostnamePatternMutex.RLock()
+	defer fake.virtualGuestsByHostnamePatternMutex.RUnlock()
 	fake.insertVirtualGuestToPoolMutex.RLock()
 	defer fake.insertVirtualGuestToPoolMutex.RUnlock()
 	fake.updateVirtualGuestInPoolMutex.RLock()
@@ -577,8 +2717,98 @@ func (fake *FakeDB) Invocations() map[string][][]interface{} {
 	defer fake.changeVirtualGuestToUseMutex.RUnlock()
 	fake.changeVirtualGuestToFreeMutex.RLock()
 	defer fake.changeVirtualGuestToFreeMutex.RUnlock()
+	fake.transitionVirtualGuestMutex.RLock()
+	defer fake.transitionVirtualGuestMutex.RUnlock()
+	fake.retireVirtualGuestMutex.RLock()
+	defer fake.retireVirtualGuestMutex.RUnlock()
 	fake.deleteVirtualGuestFromPoolMutex.RLock()
 	defer fake.deleteVirtualGuestFromPoolMutex.RUnlock()
+	fake.poolsMutex.RLock()
+	defer fake.poolsMutex.RUnlock()
+	fake.poolByNameMutex.RLock()
+	defer fake.poolByNameMutex.RUnlock()
+	fake.poolByUsernameMutex.RLock()
+	defer fake.poolByUsernameMutex.RUnlock()
+	fake.insertPoolMutex.RLock()
+	defer fake.insertPoolMutex.RUnlock()
+	fake.updatePoolMutex.RLock()
+	defer fake.updatePoolMutex.RUnlock()
+	fake.deletePoolMutex.RLock()
+	defer fake.deletePoolMutex.RUnlock()
+	fake.flavorsMutex.RLock()
+	defer fake.flavorsMutex.RUnlock()
+	fake.flavorByNameMutex.RLock()
+	defer fake.flavorByNameMutex.RUnlock()
+	fake.insertFlavorMutex.RLock()
+	defer fake.insertFlavorMutex.RUnlock()
+	fake.updateFlavorMutex.RLock()
+	defer fake.updateFlavorMutex.RUnlock()
+	fake.deleteFlavorMutex.RLock()
+	defer fake.deleteFlavorMutex.RUnlock()
+	fake.reservationsMutex.RLock()
+	defer fake.reservationsMutex.RUnlock()
+	fake.reservationByIDMutex.RLock()
+	defer fake.reservationByIDMutex.RUnlock()
+	fake.reserveVirtualGuestsMutex.RLock()
+	defer fake.reserveVirtualGuestsMutex.RUnlock()
+	fake.activateReservationMutex.RLock()
+	defer fake.activateReservationMutex.RUnlock()
+	fake.releaseReservationMutex.RLock()
+	defer fake.releaseReservationMutex.RUnlock()
+	fake.webhooksMutex.RLock()
+	defer fake.webhooksMutex.RUnlock()
+	fake.webhookByIDMutex.RLock()
+	defer fake.webhookByIDMutex.RUnlock()
+	fake.insertWebhookMutex.RLock()
+	defer fake.insertWebhookMutex.RUnlock()
+	fake.deleteWebhookMutex.RLock()
+	defer fake.deleteWebhookMutex.RUnlock()
+	fake.dispatchOutboxEventsMutex.RLock()
+	defer fake.dispatchOutboxEventsMutex.RUnlock()
+	fake.outboxEventsSinceMutex.RLock()
+	defer fake.outboxEventsSinceMutex.RUnlock()
+	fake.pendingWebhookDeliveriesMutex.RLock()
+	defer fake.pendingWebhookDeliveriesMutex.RUnlock()
+	fake.completeWebhookDeliveryMutex.RLock()
+	defer fake.completeWebhookDeliveryMutex.RUnlock()
+	fake.failWebhookDeliveryMutex.RLock()
+	defer fake.failWebhookDeliveryMutex.RUnlock()
+	fake.deadWebhookDeliveriesMutex.RLock()
+	defer fake.deadWebhookDeliveriesMutex.RUnlock()
+	fake.retryWebhookDeliveryMutex.RLock()
+	defer fake.retryWebhookDeliveryMutex.RUnlock()
+	fake.acquireLockMutex.RLock()
+	defer fake.acquireLockMutex.RUnlock()
+	fake.releaseLockMutex.RLock()
+	defer fake.releaseLockMutex.RUnlock()
+	fake.schemaVersionMutex.RLock()
+	defer fake.schemaVersionMutex.RUnlock()
+	fake.setSchemaVersionMutex.RLock()
+	defer fake.setSchemaVersionMutex.RUnlock()
+	fake.settingsMutex.RLock()
+	defer fake.settingsMutex.RUnlock()
+	fake.setSettingMutex.RLock()
+	defer fake.setSettingMutex.RUnlock()
+	fake.removeSettingMutex.RLock()
+	defer fake.removeSettingMutex.RUnlock()
+	fake.vmSecretMutex.RLock()
+	defer fake.vmSecretMutex.RUnlock()
+	fake.setVMSecretMutex.RLock()
+	defer fake.setVMSecretMutex.RUnlock()
+	fake.removeVMSecretMutex.RLock()
+	defer fake.removeVMSecretMutex.RUnlock()
+	fake.vmSecretTokenHashMutex.RLock()
+	defer fake.vmSecretTokenHashMutex.RUnlock()
+	fake.reEncryptVMSecretsMutex.RLock()
+	defer fake.reEncryptVMSecretsMutex.RUnlock()
+	fake.vmUserDataMutex.RLock()
+	defer fake.vmUserDataMutex.RUnlock()
+	fake.pendingOrdersMutex.RLock()
+	defer fake.pendingOrdersMutex.RUnlock()
+	fake.insertPendingOrderMutex.RLock()
+	defer fake.insertPendingOrderMutex.RUnlock()
+	fake.removePendingOrderMutex.RLock()
+	defer fake.removePendingOrderMutex.RUnlock()
 	return fake.invocations
 }
 
@@ -0,0 +1,262 @@
+// This file was generated by counterfeiter
+package dbfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/db"
+	"github.com/jianqiu/vps/models"
+)
+
+type FakeFlavorDB struct {
+	FlavorsStub        func(logger lager.Logger) ([]*models.Flavor, error)
+	flavorsMutex       sync.RWMutex
+	flavorsArgsForCall []struct {
+		logger lager.Logger
+	}
+	flavorsReturns struct {
+		result1 []*models.Flavor
+		result2 error
+	}
+	FlavorByNameStub        func(logger lager.Logger, name string) (*models.Flavor, error)
+	flavorByNameMutex       sync.RWMutex
+	flavorByNameArgsForCall []struct {
+		logger lager.Logger
+		name   string
+	}
+	flavorByNameReturns struct {
+		result1 *models.Flavor
+		result2 error
+	}
+	InsertFlavorStub        func(logger lager.Logger, flavor *models.Flavor) error
+	insertFlavorMutex       sync.RWMutex
+	insertFlavorArgsForCall []struct {
+		logger lager.Logger
+		flavor *models.Flavor
+	}
+	insertFlavorReturns struct {
+		result1 error
+	}
+	UpdateFlavorStub        func(logger lager.Logger, flavor *models.Flavor) error
+	updateFlavorMutex       sync.RWMutex
+	updateFlavorArgsForCall []struct {
+		logger lager.Logger
+		flavor *models.Flavor
+	}
+	updateFlavorReturns struct {
+		result1 error
+	}
+	DeleteFlavorStub        func(logger lager.Logger, name string) error
+	deleteFlavorMutex       sync.RWMutex
+	deleteFlavorArgsForCall []struct {
+		logger lager.Logger
+		name   string
+	}
+	deleteFlavorReturns struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeFlavorDB) Flavors(logger lager.Logger) ([]*models.Flavor, error) {
+	fake.flavorsMutex.Lock()
+	fake.flavorsArgsForCall = append(fake.flavorsArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("Flavors", []interface{}{logger})
+	fake.flavorsMutex.Unlock()
+	if fake.FlavorsStub != nil {
+		return fake.FlavorsStub(logger)
+	} else {
+		return fake.flavorsReturns.result1, fake.flavorsReturns.result2
+	}
+}
+
+func (fake *FakeFlavorDB) FlavorsCallCount() int {
+	fake.flavorsMutex.RLock()
+	defer fake.flavorsMutex.RUnlock()
+	return len(fake.flavorsArgsForCall)
+}
+
+func (fake *FakeFlavorDB) FlavorsArgsForCall(i int) lager.Logger {
+	fake.flavorsMutex.RLock()
+	defer fake.flavorsMutex.RUnlock()
+	return fake.flavorsArgsForCall[i].logger
+}
+
+func (fake *FakeFlavorDB) FlavorsReturns(result1 []*models.Flavor, result2 error) {
+	fake.FlavorsStub = nil
+	fake.flavorsReturns = struct {
+		result1 []*models.Flavor
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFlavorDB) FlavorByName(logger lager.Logger, name string) (*models.Flavor, error) {
+	fake.flavorByNameMutex.Lock()
+	fake.flavorByNameArgsForCall = append(fake.flavorByNameArgsForCall, struct {
+		logger lager.Logger
+		name   string
+	}{logger, name})
+	fake.recordInvocation("FlavorByName", []interface{}{logger, name})
+	fake.flavorByNameMutex.Unlock()
+	if fake.FlavorByNameStub != nil {
+		return fake.FlavorByNameStub(logger, name)
+	} else {
+		return fake.flavorByNameReturns.result1, fake.flavorByNameReturns.result2
+	}
+}
+
+func (fake *FakeFlavorDB) FlavorByNameCallCount() int {
+	fake.flavorByNameMutex.RLock()
+	defer fake.flavorByNameMutex.RUnlock()
+	return len(fake.flavorByNameArgsForCall)
+}
+
+func (fake *FakeFlavorDB) FlavorByNameArgsForCall(i int) (lager.Logger, string) {
+	fake.flavorByNameMutex.RLock()
+	defer fake.flavorByNameMutex.RUnlock()
+	return fake.flavorByNameArgsForCall[i].logger, fake.flavorByNameArgsForCall[i].name
+}
+
+func (fake *FakeFlavorDB) FlavorByNameReturns(result1 *models.Flavor, result2 error) {
+	fake.FlavorByNameStub = nil
+	fake.flavorByNameReturns = struct {
+		result1 *models.Flavor
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFlavorDB) InsertFlavor(logger lager.Logger, flavor *models.Flavor) error {
+	fake.insertFlavorMutex.Lock()
+	fake.insertFlavorArgsForCall = append(fake.insertFlavorArgsForCall, struct {
+		logger lager.Logger
+		flavor *models.Flavor
+	}{logger, flavor})
+	fake.recordInvocation("InsertFlavor", []interface{}{logger, flavor})
+	fake.insertFlavorMutex.Unlock()
+	if fake.InsertFlavorStub != nil {
+		return fake.InsertFlavorStub(logger, flavor)
+	} else {
+		return fake.insertFlavorReturns.result1
+	}
+}
+
+func (fake *FakeFlavorDB) InsertFlavorCallCount() int {
+	fake.insertFlavorMutex.RLock()
+	defer fake.insertFlavorMutex.RUnlock()
+	return len(fake.insertFlavorArgsForCall)
+}
+
+func (fake *FakeFlavorDB) InsertFlavorArgsForCall(i int) (lager.Logger, *models.Flavor) {
+	fake.insertFlavorMutex.RLock()
+	defer fake.insertFlavorMutex.RUnlock()
+	return fake.insertFlavorArgsForCall[i].logger, fake.insertFlavorArgsForCall[i].flavor
+}
+
+func (fake *FakeFlavorDB) InsertFlavorReturns(result1 error) {
+	fake.InsertFlavorStub = nil
+	fake.insertFlavorReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeFlavorDB) UpdateFlavor(logger lager.Logger, flavor *models.Flavor) error {
+	fake.updateFlavorMutex.Lock()
+	fake.updateFlavorArgsForCall = append(fake.updateFlavorArgsForCall, struct {
+		logger lager.Logger
+		flavor *models.Flavor
+	}{logger, flavor})
+	fake.recordInvocation("UpdateFlavor", []interface{}{logger, flavor})
+	fake.updateFlavorMutex.Unlock()
+	if fake.UpdateFlavorStub != nil {
+		return fake.UpdateFlavorStub(logger, flavor)
+	} else {
+		return fake.updateFlavorReturns.result1
+	}
+}
+
+func (fake *FakeFlavorDB) UpdateFlavorCallCount() int {
+	fake.updateFlavorMutex.RLock()
+	defer fake.updateFlavorMutex.RUnlock()
+	return len(fake.updateFlavorArgsForCall)
+}
+
+func (fake *FakeFlavorDB) UpdateFlavorArgsForCall(i int) (lager.Logger, *models.Flavor) {
+	fake.updateFlavorMutex.RLock()
+	defer fake.updateFlavorMutex.RUnlock()
+	return fake.updateFlavorArgsForCall[i].logger, fake.updateFlavorArgsForCall[i].flavor
+}
+
+func (fake *FakeFlavorDB) UpdateFlavorReturns(result1 error) {
+	fake.UpdateFlavorStub = nil
+	fake.updateFlavorReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeFlavorDB) DeleteFlavor(logger lager.Logger, name string) error {
+	fake.deleteFlavorMutex.Lock()
+	fake.deleteFlavorArgsForCall = append(fake.deleteFlavorArgsForCall, struct {
+		logger lager.Logger
+		name   string
+	}{logger, name})
+	fake.recordInvocation("DeleteFlavor", []interface{}{logger, name})
+	fake.deleteFlavorMutex.Unlock()
+	if fake.DeleteFlavorStub != nil {
+		return fake.DeleteFlavorStub(logger, name)
+	} else {
+		return fake.deleteFlavorReturns.result1
+	}
+}
+
+func (fake *FakeFlavorDB) DeleteFlavorCallCount() int {
+	fake.deleteFlavorMutex.RLock()
+	defer fake.deleteFlavorMutex.RUnlock()
+	return len(fake.deleteFlavorArgsForCall)
+}
+
+func (fake *FakeFlavorDB) DeleteFlavorArgsForCall(i int) (lager.Logger, string) {
+	fake.deleteFlavorMutex.RLock()
+	defer fake.deleteFlavorMutex.RUnlock()
+	return fake.deleteFlavorArgsForCall[i].logger, fake.deleteFlavorArgsForCall[i].name
+}
+
+func (fake *FakeFlavorDB) DeleteFlavorReturns(result1 error) {
+	fake.DeleteFlavorStub = nil
+	fake.deleteFlavorReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeFlavorDB) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.flavorsMutex.RLock()
+	defer fake.flavorsMutex.RUnlock()
+	fake.flavorByNameMutex.RLock()
+	defer fake.flavorByNameMutex.RUnlock()
+	fake.insertFlavorMutex.RLock()
+	defer fake.insertFlavorMutex.RUnlock()
+	fake.updateFlavorMutex.RLock()
+	defer fake.updateFlavorMutex.RUnlock()
+	fake.deleteFlavorMutex.RLock()
+	defer fake.deleteFlavorMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeFlavorDB) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.FlavorDB = new(FakeFlavorDB)
@@ -0,0 +1,133 @@
+// This file was generated by counterfeiter
+package dbfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/db"
+	"time"
+)
+
+type FakeLockDB struct {
+	AcquireLockStub        func(logger lager.Logger, name, owner string, ttl time.Duration) (bool, error)
+	acquireLockMutex       sync.RWMutex
+	acquireLockArgsForCall []struct {
+		logger lager.Logger
+		name   string
+		owner  string
+		ttl    time.Duration
+	}
+	acquireLockReturns struct {
+		result1 bool
+		result2 error
+	}
+	ReleaseLockStub        func(logger lager.Logger, name, owner string) error
+	releaseLockMutex       sync.RWMutex
+	releaseLockArgsForCall []struct {
+		logger lager.Logger
+		name   string
+		owner  string
+	}
+	releaseLockReturns struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeLockDB) AcquireLock(logger lager.Logger, name string, owner string, ttl time.Duration) (bool, error) {
+	fake.acquireLockMutex.Lock()
+	fake.acquireLockArgsForCall = append(fake.acquireLockArgsForCall, struct {
+		logger lager.Logger
+		name   string
+		owner  string
+		ttl    time.Duration
+	}{logger, name, owner, ttl})
+	fake.recordInvocation("AcquireLock", []interface{}{logger, name, owner, ttl})
+	fake.acquireLockMutex.Unlock()
+	if fake.AcquireLockStub != nil {
+		return fake.AcquireLockStub(logger, name, owner, ttl)
+	} else {
+		return fake.acquireLockReturns.result1, fake.acquireLockReturns.result2
+	}
+}
+
+func (fake *FakeLockDB) AcquireLockCallCount() int {
+	fake.acquireLockMutex.RLock()
+	defer fake.acquireLockMutex.RUnlock()
+	return len(fake.acquireLockArgsForCall)
+}
+
+func (fake *FakeLockDB) AcquireLockArgsForCall(i int) (lager.Logger, string, string, time.Duration) {
+	fake.acquireLockMutex.RLock()
+	defer fake.acquireLockMutex.RUnlock()
+	return fake.acquireLockArgsForCall[i].logger, fake.acquireLockArgsForCall[i].name, fake.acquireLockArgsForCall[i].owner, fake.acquireLockArgsForCall[i].ttl
+}
+
+func (fake *FakeLockDB) AcquireLockReturns(result1 bool, result2 error) {
+	fake.AcquireLockStub = nil
+	fake.acquireLockReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLockDB) ReleaseLock(logger lager.Logger, name string, owner string) error {
+	fake.releaseLockMutex.Lock()
+	fake.releaseLockArgsForCall = append(fake.releaseLockArgsForCall, struct {
+		logger lager.Logger
+		name   string
+		owner  string
+	}{logger, name, owner})
+	fake.recordInvocation("ReleaseLock", []interface{}{logger, name, owner})
+	fake.releaseLockMutex.Unlock()
+	if fake.ReleaseLockStub != nil {
+		return fake.ReleaseLockStub(logger, name, owner)
+	} else {
+		return fake.releaseLockReturns.result1
+	}
+}
+
+func (fake *FakeLockDB) ReleaseLockCallCount() int {
+	fake.releaseLockMutex.RLock()
+	defer fake.releaseLockMutex.RUnlock()
+	return len(fake.releaseLockArgsForCall)
+}
+
+func (fake *FakeLockDB) ReleaseLockArgsForCall(i int) (lager.Logger, string, string) {
+	fake.releaseLockMutex.RLock()
+	defer fake.releaseLockMutex.RUnlock()
+	return fake.releaseLockArgsForCall[i].logger, fake.releaseLockArgsForCall[i].name, fake.releaseLockArgsForCall[i].owner
+}
+
+func (fake *FakeLockDB) ReleaseLockReturns(result1 error) {
+	fake.ReleaseLockStub = nil
+	fake.releaseLockReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeLockDB) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.acquireLockMutex.RLock()
+	defer fake.acquireLockMutex.RUnlock()
+	fake.releaseLockMutex.RLock()
+	defer fake.releaseLockMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeLockDB) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.LockDB = new(FakeLockDB)
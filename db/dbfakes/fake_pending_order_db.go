@@ -0,0 +1,170 @@
+// This file was generated by counterfeiter
+package dbfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/db"
+	"github.com/jianqiu/vps/models"
+)
+
+type FakePendingOrderDB struct {
+	PendingOrdersStub        func(logger lager.Logger) ([]*models.PendingOrder, error)
+	pendingOrdersMutex       sync.RWMutex
+	pendingOrdersArgsForCall []struct {
+		logger lager.Logger
+	}
+	pendingOrdersReturns struct {
+		result1 []*models.PendingOrder
+		result2 error
+	}
+	InsertPendingOrderStub        func(logger lager.Logger, order *models.PendingOrder) error
+	insertPendingOrderMutex       sync.RWMutex
+	insertPendingOrderArgsForCall []struct {
+		logger lager.Logger
+		order  *models.PendingOrder
+	}
+	insertPendingOrderReturns struct {
+		result1 error
+	}
+	RemovePendingOrderStub        func(logger lager.Logger, cid int32) error
+	removePendingOrderMutex       sync.RWMutex
+	removePendingOrderArgsForCall []struct {
+		logger lager.Logger
+		cid    int32
+	}
+	removePendingOrderReturns struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakePendingOrderDB) PendingOrders(logger lager.Logger) ([]*models.PendingOrder, error) {
+	fake.pendingOrdersMutex.Lock()
+	fake.pendingOrdersArgsForCall = append(fake.pendingOrdersArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("PendingOrders", []interface{}{logger})
+	fake.pendingOrdersMutex.Unlock()
+	if fake.PendingOrdersStub != nil {
+		return fake.PendingOrdersStub(logger)
+	} else {
+		return fake.pendingOrdersReturns.result1, fake.pendingOrdersReturns.result2
+	}
+}
+
+func (fake *FakePendingOrderDB) PendingOrdersCallCount() int {
+	fake.pendingOrdersMutex.RLock()
+	defer fake.pendingOrdersMutex.RUnlock()
+	return len(fake.pendingOrdersArgsForCall)
+}
+
+func (fake *FakePendingOrderDB) PendingOrdersArgsForCall(i int) lager.Logger {
+	fake.pendingOrdersMutex.RLock()
+	defer fake.pendingOrdersMutex.RUnlock()
+	return fake.pendingOrdersArgsForCall[i].logger
+}
+
+func (fake *FakePendingOrderDB) PendingOrdersReturns(result1 []*models.PendingOrder, result2 error) {
+	fake.PendingOrdersStub = nil
+	fake.pendingOrdersReturns = struct {
+		result1 []*models.PendingOrder
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePendingOrderDB) InsertPendingOrder(logger lager.Logger, order *models.PendingOrder) error {
+	fake.insertPendingOrderMutex.Lock()
+	fake.insertPendingOrderArgsForCall = append(fake.insertPendingOrderArgsForCall, struct {
+		logger lager.Logger
+		order  *models.PendingOrder
+	}{logger, order})
+	fake.recordInvocation("InsertPendingOrder", []interface{}{logger, order})
+	fake.insertPendingOrderMutex.Unlock()
+	if fake.InsertPendingOrderStub != nil {
+		return fake.InsertPendingOrderStub(logger, order)
+	} else {
+		return fake.insertPendingOrderReturns.result1
+	}
+}
+
+func (fake *FakePendingOrderDB) InsertPendingOrderCallCount() int {
+	fake.insertPendingOrderMutex.RLock()
+	defer fake.insertPendingOrderMutex.RUnlock()
+	return len(fake.insertPendingOrderArgsForCall)
+}
+
+func (fake *FakePendingOrderDB) InsertPendingOrderArgsForCall(i int) (lager.Logger, *models.PendingOrder) {
+	fake.insertPendingOrderMutex.RLock()
+	defer fake.insertPendingOrderMutex.RUnlock()
+	return fake.insertPendingOrderArgsForCall[i].logger, fake.insertPendingOrderArgsForCall[i].order
+}
+
+func (fake *FakePendingOrderDB) InsertPendingOrderReturns(result1 error) {
+	fake.InsertPendingOrderStub = nil
+	fake.insertPendingOrderReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePendingOrderDB) RemovePendingOrder(logger lager.Logger, cid int32) error {
+	fake.removePendingOrderMutex.Lock()
+	fake.removePendingOrderArgsForCall = append(fake.removePendingOrderArgsForCall, struct {
+		logger lager.Logger
+		cid    int32
+	}{logger, cid})
+	fake.recordInvocation("RemovePendingOrder", []interface{}{logger, cid})
+	fake.removePendingOrderMutex.Unlock()
+	if fake.RemovePendingOrderStub != nil {
+		return fake.RemovePendingOrderStub(logger, cid)
+	} else {
+		return fake.removePendingOrderReturns.result1
+	}
+}
+
+func (fake *FakePendingOrderDB) RemovePendingOrderCallCount() int {
+	fake.removePendingOrderMutex.RLock()
+	defer fake.removePendingOrderMutex.RUnlock()
+	return len(fake.removePendingOrderArgsForCall)
+}
+
+func (fake *FakePendingOrderDB) RemovePendingOrderArgsForCall(i int) (lager.Logger, int32) {
+	fake.removePendingOrderMutex.RLock()
+	defer fake.removePendingOrderMutex.RUnlock()
+	return fake.removePendingOrderArgsForCall[i].logger, fake.removePendingOrderArgsForCall[i].cid
+}
+
+func (fake *FakePendingOrderDB) RemovePendingOrderReturns(result1 error) {
+	fake.RemovePendingOrderStub = nil
+	fake.removePendingOrderReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePendingOrderDB) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.pendingOrdersMutex.RLock()
+	defer fake.pendingOrdersMutex.RUnlock()
+	fake.insertPendingOrderMutex.RLock()
+	defer fake.insertPendingOrderMutex.RUnlock()
+	fake.removePendingOrderMutex.RLock()
+	defer fake.removePendingOrderMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakePendingOrderDB) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.PendingOrderDB = new(FakePendingOrderDB)
@@ -0,0 +1,309 @@
+// This file was generated by counterfeiter
+package dbfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/db"
+	"github.com/jianqiu/vps/models"
+)
+
+type FakePoolDB struct {
+	PoolsStub        func(logger lager.Logger) ([]*models.Pool, error)
+	poolsMutex       sync.RWMutex
+	poolsArgsForCall []struct {
+		logger lager.Logger
+	}
+	poolsReturns struct {
+		result1 []*models.Pool
+		result2 error
+	}
+	PoolByNameStub        func(logger lager.Logger, name string) (*models.Pool, error)
+	poolByNameMutex       sync.RWMutex
+	poolByNameArgsForCall []struct {
+		logger lager.Logger
+		name   string
+	}
+	poolByNameReturns struct {
+		result1 *models.Pool
+		result2 error
+	}
+	PoolByUsernameStub        func(logger lager.Logger, username string) (*models.Pool, error)
+	poolByUsernameMutex       sync.RWMutex
+	poolByUsernameArgsForCall []struct {
+		logger   lager.Logger
+		username string
+	}
+	poolByUsernameReturns struct {
+		result1 *models.Pool
+		result2 error
+	}
+	InsertPoolStub        func(logger lager.Logger, pool *models.Pool) error
+	insertPoolMutex       sync.RWMutex
+	insertPoolArgsForCall []struct {
+		logger lager.Logger
+		pool   *models.Pool
+	}
+	insertPoolReturns struct {
+		result1 error
+	}
+	UpdatePoolStub        func(logger lager.Logger, pool *models.Pool) error
+	updatePoolMutex       sync.RWMutex
+	updatePoolArgsForCall []struct {
+		logger lager.Logger
+		pool   *models.Pool
+	}
+	updatePoolReturns struct {
+		result1 error
+	}
+	DeletePoolStub        func(logger lager.Logger, name string) error
+	deletePoolMutex       sync.RWMutex
+	deletePoolArgsForCall []struct {
+		logger lager.Logger
+		name   string
+	}
+	deletePoolReturns struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakePoolDB) Pools(logger lager.Logger) ([]*models.Pool, error) {
+	fake.poolsMutex.Lock()
+	fake.poolsArgsForCall = append(fake.poolsArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("Pools", []interface{}{logger})
+	fake.poolsMutex.Unlock()
+	if fake.PoolsStub != nil {
+		return fake.PoolsStub(logger)
+	} else {
+		return fake.poolsReturns.result1, fake.poolsReturns.result2
+	}
+}
+
+func (fake *FakePoolDB) PoolsCallCount() int {
+	fake.poolsMutex.RLock()
+	defer fake.poolsMutex.RUnlock()
+	return len(fake.poolsArgsForCall)
+}
+
+func (fake *FakePoolDB) PoolsArgsForCall(i int) lager.Logger {
+	fake.poolsMutex.RLock()
+	defer fake.poolsMutex.RUnlock()
+	return fake.poolsArgsForCall[i].logger
+}
+
+func (fake *FakePoolDB) PoolsReturns(result1 []*models.Pool, result2 error) {
+	fake.PoolsStub = nil
+	fake.poolsReturns = struct {
+		result1 []*models.Pool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePoolDB) PoolByName(logger lager.Logger, name string) (*models.Pool, error) {
+	fake.poolByNameMutex.Lock()
+	fake.poolByNameArgsForCall = append(fake.poolByNameArgsForCall, struct {
+		logger lager.Logger
+		name   string
+	}{logger, name})
+	fake.recordInvocation("PoolByName", []interface{}{logger, name})
+	fake.poolByNameMutex.Unlock()
+	if fake.PoolByNameStub != nil {
+		return fake.PoolByNameStub(logger, name)
+	} else {
+		return fake.poolByNameReturns.result1, fake.poolByNameReturns.result2
+	}
+}
+
+func (fake *FakePoolDB) PoolByNameCallCount() int {
+	fake.poolByNameMutex.RLock()
+	defer fake.poolByNameMutex.RUnlock()
+	return len(fake.poolByNameArgsForCall)
+}
+
+func (fake *FakePoolDB) PoolByNameArgsForCall(i int) (lager.Logger, string) {
+	fake.poolByNameMutex.RLock()
+	defer fake.poolByNameMutex.RUnlock()
+	return fake.poolByNameArgsForCall[i].logger, fake.poolByNameArgsForCall[i].name
+}
+
+func (fake *FakePoolDB) PoolByNameReturns(result1 *models.Pool, result2 error) {
+	fake.PoolByNameStub = nil
+	fake.poolByNameReturns = struct {
+		result1 *models.Pool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePoolDB) PoolByUsername(logger lager.Logger, username string) (*models.Pool, error) {
+	fake.poolByUsernameMutex.Lock()
+	fake.poolByUsernameArgsForCall = append(fake.poolByUsernameArgsForCall, struct {
+		logger   lager.Logger
+		username string
+	}{logger, username})
+	fake.recordInvocation("PoolByUsername", []interface{}{logger, username})
+	fake.poolByUsernameMutex.Unlock()
+	if fake.PoolByUsernameStub != nil {
+		return fake.PoolByUsernameStub(logger, username)
+	} else {
+		return fake.poolByUsernameReturns.result1, fake.poolByUsernameReturns.result2
+	}
+}
+
+func (fake *FakePoolDB) PoolByUsernameCallCount() int {
+	fake.poolByUsernameMutex.RLock()
+	defer fake.poolByUsernameMutex.RUnlock()
+	return len(fake.poolByUsernameArgsForCall)
+}
+
+func (fake *FakePoolDB) PoolByUsernameArgsForCall(i int) (lager.Logger, string) {
+	fake.poolByUsernameMutex.RLock()
+	defer fake.poolByUsernameMutex.RUnlock()
+	return fake.poolByUsernameArgsForCall[i].logger, fake.poolByUsernameArgsForCall[i].username
+}
+
+func (fake *FakePoolDB) PoolByUsernameReturns(result1 *models.Pool, result2 error) {
+	fake.PoolByUsernameStub = nil
+	fake.poolByUsernameReturns = struct {
+		result1 *models.Pool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePoolDB) InsertPool(logger lager.Logger, pool *models.Pool) error {
+	fake.insertPoolMutex.Lock()
+	fake.insertPoolArgsForCall = append(fake.insertPoolArgsForCall, struct {
+		logger lager.Logger
+		pool   *models.Pool
+	}{logger, pool})
+	fake.recordInvocation("InsertPool", []interface{}{logger, pool})
+	fake.insertPoolMutex.Unlock()
+	if fake.InsertPoolStub != nil {
+		return fake.InsertPoolStub(logger, pool)
+	} else {
+		return fake.insertPoolReturns.result1
+	}
+}
+
+func (fake *FakePoolDB) InsertPoolCallCount() int {
+	fake.insertPoolMutex.RLock()
+	defer fake.insertPoolMutex.RUnlock()
+	return len(fake.insertPoolArgsForCall)
+}
+
+func (fake *FakePoolDB) InsertPoolArgsForCall(i int) (lager.Logger, *models.Pool) {
+	fake.insertPoolMutex.RLock()
+	defer fake.insertPoolMutex.RUnlock()
+	return fake.insertPoolArgsForCall[i].logger, fake.insertPoolArgsForCall[i].pool
+}
+
+func (fake *FakePoolDB) InsertPoolReturns(result1 error) {
+	fake.InsertPoolStub = nil
+	fake.insertPoolReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePoolDB) UpdatePool(logger lager.Logger, pool *models.Pool) error {
+	fake.updatePoolMutex.Lock()
+	fake.updatePoolArgsForCall = append(fake.updatePoolArgsForCall, struct {
+		logger lager.Logger
+		pool   *models.Pool
+	}{logger, pool})
+	fake.recordInvocation("UpdatePool", []interface{}{logger, pool})
+	fake.updatePoolMutex.Unlock()
+	if fake.UpdatePoolStub != nil {
+		return fake.UpdatePoolStub(logger, pool)
+	} else {
+		return fake.updatePoolReturns.result1
+	}
+}
+
+func (fake *FakePoolDB) UpdatePoolCallCount() int {
+	fake.updatePoolMutex.RLock()
+	defer fake.updatePoolMutex.RUnlock()
+	return len(fake.updatePoolArgsForCall)
+}
+
+func (fake *FakePoolDB) UpdatePoolArgsForCall(i int) (lager.Logger, *models.Pool) {
+	fake.updatePoolMutex.RLock()
+	defer fake.updatePoolMutex.RUnlock()
+	return fake.updatePoolArgsForCall[i].logger, fake.updatePoolArgsForCall[i].pool
+}
+
+func (fake *FakePoolDB) UpdatePoolReturns(result1 error) {
+	fake.UpdatePoolStub = nil
+	fake.updatePoolReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePoolDB) DeletePool(logger lager.Logger, name string) error {
+	fake.deletePoolMutex.Lock()
+	fake.deletePoolArgsForCall = append(fake.deletePoolArgsForCall, struct {
+		logger lager.Logger
+		name   string
+	}{logger, name})
+	fake.recordInvocation("DeletePool", []interface{}{logger, name})
+	fake.deletePoolMutex.Unlock()
+	if fake.DeletePoolStub != nil {
+		return fake.DeletePoolStub(logger, name)
+	} else {
+		return fake.deletePoolReturns.result1
+	}
+}
+
+func (fake *FakePoolDB) DeletePoolCallCount() int {
+	fake.deletePoolMutex.RLock()
+	defer fake.deletePoolMutex.RUnlock()
+	return len(fake.deletePoolArgsForCall)
+}
+
+func (fake *FakePoolDB) DeletePoolArgsForCall(i int) (lager.Logger, string) {
+	fake.deletePoolMutex.RLock()
+	defer fake.deletePoolMutex.RUnlock()
+	return fake.deletePoolArgsForCall[i].logger, fake.deletePoolArgsForCall[i].name
+}
+
+func (fake *FakePoolDB) DeletePoolReturns(result1 error) {
+	fake.DeletePoolStub = nil
+	fake.deletePoolReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePoolDB) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.poolsMutex.RLock()
+	defer fake.poolsMutex.RUnlock()
+	fake.poolByNameMutex.RLock()
+	defer fake.poolByNameMutex.RUnlock()
+	fake.poolByUsernameMutex.RLock()
+	defer fake.poolByUsernameMutex.RUnlock()
+	fake.insertPoolMutex.RLock()
+	defer fake.insertPoolMutex.RUnlock()
+	fake.updatePoolMutex.RLock()
+	defer fake.updatePoolMutex.RUnlock()
+	fake.deletePoolMutex.RLock()
+	defer fake.deletePoolMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakePoolDB) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.PoolDB = new(FakePoolDB)
@@ -0,0 +1,262 @@
+// This file was generated by counterfeiter
+package dbfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/db"
+	"github.com/jianqiu/vps/models"
+)
+
+type FakeReservationDB struct {
+	ReservationsStub        func(logger lager.Logger) ([]*models.Reservation, error)
+	reservationsMutex       sync.RWMutex
+	reservationsArgsForCall []struct {
+		logger lager.Logger
+	}
+	reservationsReturns struct {
+		result1 []*models.Reservation
+		result2 error
+	}
+	ReservationByIDStub        func(logger lager.Logger, id string) (*models.Reservation, error)
+	reservationByIDMutex       sync.RWMutex
+	reservationByIDArgsForCall []struct {
+		logger lager.Logger
+		id     string
+	}
+	reservationByIDReturns struct {
+		result1 *models.Reservation
+		result2 error
+	}
+	ReserveVirtualGuestsStub        func(logger lager.Logger, reservation *models.Reservation) error
+	reserveVirtualGuestsMutex       sync.RWMutex
+	reserveVirtualGuestsArgsForCall []struct {
+		logger      lager.Logger
+		reservation *models.Reservation
+	}
+	reserveVirtualGuestsReturns struct {
+		result1 error
+	}
+	ActivateReservationStub        func(logger lager.Logger, id string) error
+	activateReservationMutex       sync.RWMutex
+	activateReservationArgsForCall []struct {
+		logger lager.Logger
+		id     string
+	}
+	activateReservationReturns struct {
+		result1 error
+	}
+	ReleaseReservationStub        func(logger lager.Logger, id string) error
+	releaseReservationMutex       sync.RWMutex
+	releaseReservationArgsForCall []struct {
+		logger lager.Logger
+		id     string
+	}
+	releaseReservationReturns struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeReservationDB) Reservations(logger lager.Logger) ([]*models.Reservation, error) {
+	fake.reservationsMutex.Lock()
+	fake.reservationsArgsForCall = append(fake.reservationsArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("Reservations", []interface{}{logger})
+	fake.reservationsMutex.Unlock()
+	if fake.ReservationsStub != nil {
+		return fake.ReservationsStub(logger)
+	} else {
+		return fake.reservationsReturns.result1, fake.reservationsReturns.result2
+	}
+}
+
+func (fake *FakeReservationDB) ReservationsCallCount() int {
+	fake.reservationsMutex.RLock()
+	defer fake.reservationsMutex.RUnlock()
+	return len(fake.reservationsArgsForCall)
+}
+
+func (fake *FakeReservationDB) ReservationsArgsForCall(i int) lager.Logger {
+	fake.reservationsMutex.RLock()
+	defer fake.reservationsMutex.RUnlock()
+	return fake.reservationsArgsForCall[i].logger
+}
+
+func (fake *FakeReservationDB) ReservationsReturns(result1 []*models.Reservation, result2 error) {
+	fake.ReservationsStub = nil
+	fake.reservationsReturns = struct {
+		result1 []*models.Reservation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReservationDB) ReservationByID(logger lager.Logger, id string) (*models.Reservation, error) {
+	fake.reservationByIDMutex.Lock()
+	fake.reservationByIDArgsForCall = append(fake.reservationByIDArgsForCall, struct {
+		logger lager.Logger
+		id     string
+	}{logger, id})
+	fake.recordInvocation("ReservationByID", []interface{}{logger, id})
+	fake.reservationByIDMutex.Unlock()
+	if fake.ReservationByIDStub != nil {
+		return fake.ReservationByIDStub(logger, id)
+	} else {
+		return fake.reservationByIDReturns.result1, fake.reservationByIDReturns.result2
+	}
+}
+
+func (fake *FakeReservationDB) ReservationByIDCallCount() int {
+	fake.reservationByIDMutex.RLock()
+	defer fake.reservationByIDMutex.RUnlock()
+	return len(fake.reservationByIDArgsForCall)
+}
+
+func (fake *FakeReservationDB) ReservationByIDArgsForCall(i int) (lager.Logger, string) {
+	fake.reservationByIDMutex.RLock()
+	defer fake.reservationByIDMutex.RUnlock()
+	return fake.reservationByIDArgsForCall[i].logger, fake.reservationByIDArgsForCall[i].id
+}
+
+func (fake *FakeReservationDB) ReservationByIDReturns(result1 *models.Reservation, result2 error) {
+	fake.ReservationByIDStub = nil
+	fake.reservationByIDReturns = struct {
+		result1 *models.Reservation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReservationDB) ReserveVirtualGuests(logger lager.Logger, reservation *models.Reservation) error {
+	fake.reserveVirtualGuestsMutex.Lock()
+	fake.reserveVirtualGuestsArgsForCall = append(fake.reserveVirtualGuestsArgsForCall, struct {
+		logger      lager.Logger
+		reservation *models.Reservation
+	}{logger, reservation})
+	fake.recordInvocation("ReserveVirtualGuests", []interface{}{logger, reservation})
+	fake.reserveVirtualGuestsMutex.Unlock()
+	if fake.ReserveVirtualGuestsStub != nil {
+		return fake.ReserveVirtualGuestsStub(logger, reservation)
+	} else {
+		return fake.reserveVirtualGuestsReturns.result1
+	}
+}
+
+func (fake *FakeReservationDB) ReserveVirtualGuestsCallCount() int {
+	fake.reserveVirtualGuestsMutex.RLock()
+	defer fake.reserveVirtualGuestsMutex.RUnlock()
+	return len(fake.reserveVirtualGuestsArgsForCall)
+}
+
+func (fake *FakeReservationDB) ReserveVirtualGuestsArgsForCall(i int) (lager.Logger, *models.Reservation) {
+	fake.reserveVirtualGuestsMutex.RLock()
+	defer fake.reserveVirtualGuestsMutex.RUnlock()
+	return fake.reserveVirtualGuestsArgsForCall[i].logger, fake.reserveVirtualGuestsArgsForCall[i].reservation
+}
+
+func (fake *FakeReservationDB) ReserveVirtualGuestsReturns(result1 error) {
+	fake.ReserveVirtualGuestsStub = nil
+	fake.reserveVirtualGuestsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeReservationDB) ActivateReservation(logger lager.Logger, id string) error {
+	fake.activateReservationMutex.Lock()
+	fake.activateReservationArgsForCall = append(fake.activateReservationArgsForCall, struct {
+		logger lager.Logger
+		id     string
+	}{logger, id})
+	fake.recordInvocation("ActivateReservation", []interface{}{logger, id})
+	fake.activateReservationMutex.Unlock()
+	if fake.ActivateReservationStub != nil {
+		return fake.ActivateReservationStub(logger, id)
+	} else {
+		return fake.activateReservationReturns.result1
+	}
+}
+
+func (fake *FakeReservationDB) ActivateReservationCallCount() int {
+	fake.activateReservationMutex.RLock()
+	defer fake.activateReservationMutex.RUnlock()
+	return len(fake.activateReservationArgsForCall)
+}
+
+func (fake *FakeReservationDB) ActivateReservationArgsForCall(i int) (lager.Logger, string) {
+	fake.activateReservationMutex.RLock()
+	defer fake.activateReservationMutex.RUnlock()
+	return fake.activateReservationArgsForCall[i].logger, fake.activateReservationArgsForCall[i].id
+}
+
+func (fake *FakeReservationDB) ActivateReservationReturns(result1 error) {
+	fake.ActivateReservationStub = nil
+	fake.activateReservationReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeReservationDB) ReleaseReservation(logger lager.Logger, id string) error {
+	fake.releaseReservationMutex.Lock()
+	fake.releaseReservationArgsForCall = append(fake.releaseReservationArgsForCall, struct {
+		logger lager.Logger
+		id     string
+	}{logger, id})
+	fake.recordInvocation("ReleaseReservation", []interface{}{logger, id})
+	fake.releaseReservationMutex.Unlock()
+	if fake.ReleaseReservationStub != nil {
+		return fake.ReleaseReservationStub(logger, id)
+	} else {
+		return fake.releaseReservationReturns.result1
+	}
+}
+
+func (fake *FakeReservationDB) ReleaseReservationCallCount() int {
+	fake.releaseReservationMutex.RLock()
+	defer fake.releaseReservationMutex.RUnlock()
+	return len(fake.releaseReservationArgsForCall)
+}
+
+func (fake *FakeReservationDB) ReleaseReservationArgsForCall(i int) (lager.Logger, string) {
+	fake.releaseReservationMutex.RLock()
+	defer fake.releaseReservationMutex.RUnlock()
+	return fake.releaseReservationArgsForCall[i].logger, fake.releaseReservationArgsForCall[i].id
+}
+
+func (fake *FakeReservationDB) ReleaseReservationReturns(result1 error) {
+	fake.ReleaseReservationStub = nil
+	fake.releaseReservationReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeReservationDB) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.reservationsMutex.RLock()
+	defer fake.reservationsMutex.RUnlock()
+	fake.reservationByIDMutex.RLock()
+	defer fake.reservationByIDMutex.RUnlock()
+	fake.reserveVirtualGuestsMutex.RLock()
+	defer fake.reserveVirtualGuestsMutex.RUnlock()
+	fake.activateReservationMutex.RLock()
+	defer fake.activateReservationMutex.RUnlock()
+	fake.releaseReservationMutex.RLock()
+	defer fake.releaseReservationMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeReservationDB) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.ReservationDB = new(FakeReservationDB)
@@ -0,0 +1,270 @@
+// This file was generated by counterfeiter
+package dbfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/db"
+)
+
+type FakeSecretDB struct {
+	VMSecretStub        func(logger lager.Logger, cid int32) (encryption.Encrypted, error)
+	vmSecretMutex       sync.RWMutex
+	vmSecretArgsForCall []struct {
+		logger lager.Logger
+		cid    int32
+	}
+	vmSecretReturns struct {
+		result1 encryption.Encrypted
+		result2 error
+	}
+	SetVMSecretStub        func(logger lager.Logger, cid int32, secret encryption.Encrypted) error
+	setVMSecretMutex       sync.RWMutex
+	setVMSecretArgsForCall []struct {
+		logger lager.Logger
+		cid    int32
+		secret encryption.Encrypted
+	}
+	setVMSecretReturns struct {
+		result1 error
+	}
+	RemoveVMSecretStub        func(logger lager.Logger, cid int32) error
+	removeVMSecretMutex       sync.RWMutex
+	removeVMSecretArgsForCall []struct {
+		logger lager.Logger
+		cid    int32
+	}
+	removeVMSecretReturns struct {
+		result1 error
+	}
+	VMSecretTokenHashStub        func(logger lager.Logger, cid int32) (string, error)
+	vmSecretTokenHashMutex       sync.RWMutex
+	vmSecretTokenHashArgsForCall []struct {
+		logger lager.Logger
+		cid    int32
+	}
+	vmSecretTokenHashReturns struct {
+		result1 string
+		result2 error
+	}
+	ReEncryptVMSecretsStub        func(logger lager.Logger, activeLabel string, cryptor encryption.Cryptor) (int, error)
+	reEncryptVMSecretsMutex       sync.RWMutex
+	reEncryptVMSecretsArgsForCall []struct {
+		logger      lager.Logger
+		activeLabel string
+		cryptor     encryption.Cryptor
+	}
+	reEncryptVMSecretsReturns struct {
+		result1 int
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeSecretDB) VMSecret(logger lager.Logger, cid int32) (encryption.Encrypted, error) {
+	fake.vmSecretMutex.Lock()
+	fake.vmSecretArgsForCall = append(fake.vmSecretArgsForCall, struct {
+		logger lager.Logger
+		cid    int32
+	}{logger, cid})
+	fake.recordInvocation("VMSecret", []interface{}{logger, cid})
+	fake.vmSecretMutex.Unlock()
+	if fake.VMSecretStub != nil {
+		return fake.VMSecretStub(logger, cid)
+	} else {
+		return fake.vmSecretReturns.result1, fake.vmSecretReturns.result2
+	}
+}
+
+func (fake *FakeSecretDB) VMSecretCallCount() int {
+	fake.vmSecretMutex.RLock()
+	defer fake.vmSecretMutex.RUnlock()
+	return len(fake.vmSecretArgsForCall)
+}
+
+func (fake *FakeSecretDB) VMSecretArgsForCall(i int) (lager.Logger, int32) {
+	fake.vmSecretMutex.RLock()
+	defer fake.vmSecretMutex.RUnlock()
+	return fake.vmSecretArgsForCall[i].logger, fake.vmSecretArgsForCall[i].cid
+}
+
+func (fake *FakeSecretDB) VMSecretReturns(result1 encryption.Encrypted, result2 error) {
+	fake.VMSecretStub = nil
+	fake.vmSecretReturns = struct {
+		result1 encryption.Encrypted
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSecretDB) SetVMSecret(logger lager.Logger, cid int32, secret encryption.Encrypted) error {
+	fake.setVMSecretMutex.Lock()
+	fake.setVMSecretArgsForCall = append(fake.setVMSecretArgsForCall, struct {
+		logger lager.Logger
+		cid    int32
+		secret encryption.Encrypted
+	}{logger, cid, secret})
+	fake.recordInvocation("SetVMSecret", []interface{}{logger, cid, secret})
+	fake.setVMSecretMutex.Unlock()
+	if fake.SetVMSecretStub != nil {
+		return fake.SetVMSecretStub(logger, cid, secret)
+	} else {
+		return fake.setVMSecretReturns.result1
+	}
+}
+
+func (fake *FakeSecretDB) SetVMSecretCallCount() int {
+	fake.setVMSecretMutex.RLock()
+	defer fake.setVMSecretMutex.RUnlock()
+	return len(fake.setVMSecretArgsForCall)
+}
+
+func (fake *FakeSecretDB) SetVMSecretArgsForCall(i int) (lager.Logger, int32, encryption.Encrypted) {
+	fake.setVMSecretMutex.RLock()
+	defer fake.setVMSecretMutex.RUnlock()
+	return fake.setVMSecretArgsForCall[i].logger, fake.setVMSecretArgsForCall[i].cid, fake.setVMSecretArgsForCall[i].secret
+}
+
+func (fake *FakeSecretDB) SetVMSecretReturns(result1 error) {
+	fake.SetVMSecretStub = nil
+	fake.setVMSecretReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecretDB) RemoveVMSecret(logger lager.Logger, cid int32) error {
+	fake.removeVMSecretMutex.Lock()
+	fake.removeVMSecretArgsForCall = append(fake.removeVMSecretArgsForCall, struct {
+		logger lager.Logger
+		cid    int32
+	}{logger, cid})
+	fake.recordInvocation("RemoveVMSecret", []interface{}{logger, cid})
+	fake.removeVMSecretMutex.Unlock()
+	if fake.RemoveVMSecretStub != nil {
+		return fake.RemoveVMSecretStub(logger, cid)
+	} else {
+		return fake.removeVMSecretReturns.result1
+	}
+}
+
+func (fake *FakeSecretDB) RemoveVMSecretCallCount() int {
+	fake.removeVMSecretMutex.RLock()
+	defer fake.removeVMSecretMutex.RUnlock()
+	return len(fake.removeVMSecretArgsForCall)
+}
+
+func (fake *FakeSecretDB) RemoveVMSecretArgsForCall(i int) (lager.Logger, int32) {
+	fake.removeVMSecretMutex.RLock()
+	defer fake.removeVMSecretMutex.RUnlock()
+	return fake.removeVMSecretArgsForCall[i].logger, fake.removeVMSecretArgsForCall[i].cid
+}
+
+func (fake *FakeSecretDB) RemoveVMSecretReturns(result1 error) {
+	fake.RemoveVMSecretStub = nil
+	fake.removeVMSecretReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecretDB) VMSecretTokenHash(logger lager.Logger, cid int32) (string, error) {
+	fake.vmSecretTokenHashMutex.Lock()
+	fake.vmSecretTokenHashArgsForCall = append(fake.vmSecretTokenHashArgsForCall, struct {
+		logger lager.Logger
+		cid    int32
+	}{logger, cid})
+	fake.recordInvocation("VMSecretTokenHash", []interface{}{logger, cid})
+	fake.vmSecretTokenHashMutex.Unlock()
+	if fake.VMSecretTokenHashStub != nil {
+		return fake.VMSecretTokenHashStub(logger, cid)
+	} else {
+		return fake.vmSecretTokenHashReturns.result1, fake.vmSecretTokenHashReturns.result2
+	}
+}
+
+func (fake *FakeSecretDB) VMSecretTokenHashCallCount() int {
+	fake.vmSecretTokenHashMutex.RLock()
+	defer fake.vmSecretTokenHashMutex.RUnlock()
+	return len(fake.vmSecretTokenHashArgsForCall)
+}
+
+func (fake *FakeSecretDB) VMSecretTokenHashArgsForCall(i int) (lager.Logger, int32) {
+	fake.vmSecretTokenHashMutex.RLock()
+	defer fake.vmSecretTokenHashMutex.RUnlock()
+	return fake.vmSecretTokenHashArgsForCall[i].logger, fake.vmSecretTokenHashArgsForCall[i].cid
+}
+
+func (fake *FakeSecretDB) VMSecretTokenHashReturns(result1 string, result2 error) {
+	fake.VMSecretTokenHashStub = nil
+	fake.vmSecretTokenHashReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSecretDB) ReEncryptVMSecrets(logger lager.Logger, activeLabel string, cryptor encryption.Cryptor) (int, error) {
+	fake.reEncryptVMSecretsMutex.Lock()
+	fake.reEncryptVMSecretsArgsForCall = append(fake.reEncryptVMSecretsArgsForCall, struct {
+		logger      lager.Logger
+		activeLabel string
+		cryptor     encryption.Cryptor
+	}{logger, activeLabel, cryptor})
+	fake.recordInvocation("ReEncryptVMSecrets", []interface{}{logger, activeLabel, cryptor})
+	fake.reEncryptVMSecretsMutex.Unlock()
+	if fake.ReEncryptVMSecretsStub != nil {
+		return fake.ReEncryptVMSecretsStub(logger, activeLabel, cryptor)
+	} else {
+		return fake.reEncryptVMSecretsReturns.result1, fake.reEncryptVMSecretsReturns.result2
+	}
+}
+
+func (fake *FakeSecretDB) ReEncryptVMSecretsCallCount() int {
+	fake.reEncryptVMSecretsMutex.RLock()
+	defer fake.reEncryptVMSecretsMutex.RUnlock()
+	return len(fake.reEncryptVMSecretsArgsForCall)
+}
+
+func (fake *FakeSecretDB) ReEncryptVMSecretsArgsForCall(i int) (lager.Logger, string, encryption.Cryptor) {
+	fake.reEncryptVMSecretsMutex.RLock()
+	defer fake.reEncryptVMSecretsMutex.RUnlock()
+	return fake.reEncryptVMSecretsArgsForCall[i].logger, fake.reEncryptVMSecretsArgsForCall[i].activeLabel, fake.reEncryptVMSecretsArgsForCall[i].cryptor
+}
+
+func (fake *FakeSecretDB) ReEncryptVMSecretsReturns(result1 int, result2 error) {
+	fake.ReEncryptVMSecretsStub = nil
+	fake.reEncryptVMSecretsReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSecretDB) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.vmSecretMutex.RLock()
+	defer fake.vmSecretMutex.RUnlock()
+	fake.setVMSecretMutex.RLock()
+	defer fake.setVMSecretMutex.RUnlock()
+	fake.removeVMSecretMutex.RLock()
+	defer fake.removeVMSecretMutex.RUnlock()
+	fake.vmSecretTokenHashMutex.RLock()
+	defer fake.vmSecretTokenHashMutex.RUnlock()
+	fake.reEncryptVMSecretsMutex.RLock()
+	defer fake.reEncryptVMSecretsMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeSecretDB) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.SecretDB = new(FakeSecretDB)
@@ -0,0 +1,171 @@
+// This file was generated by counterfeiter
+package dbfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/db"
+)
+
+type FakeSettingDB struct {
+	SettingsStub        func(logger lager.Logger) (map[string]string, error)
+	settingsMutex       sync.RWMutex
+	settingsArgsForCall []struct {
+		logger lager.Logger
+	}
+	settingsReturns struct {
+		result1 map[string]string
+		result2 error
+	}
+	SetSettingStub        func(logger lager.Logger, name, value string) error
+	setSettingMutex       sync.RWMutex
+	setSettingArgsForCall []struct {
+		logger lager.Logger
+		name   string
+		value  string
+	}
+	setSettingReturns struct {
+		result1 error
+	}
+	RemoveSettingStub        func(logger lager.Logger, name string) error
+	removeSettingMutex       sync.RWMutex
+	removeSettingArgsForCall []struct {
+		logger lager.Logger
+		name   string
+	}
+	removeSettingReturns struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeSettingDB) Settings(logger lager.Logger) (map[string]string, error) {
+	fake.settingsMutex.Lock()
+	fake.settingsArgsForCall = append(fake.settingsArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("Settings", []interface{}{logger})
+	fake.settingsMutex.Unlock()
+	if fake.SettingsStub != nil {
+		return fake.SettingsStub(logger)
+	} else {
+		return fake.settingsReturns.result1, fake.settingsReturns.result2
+	}
+}
+
+func (fake *FakeSettingDB) SettingsCallCount() int {
+	fake.settingsMutex.RLock()
+	defer fake.settingsMutex.RUnlock()
+	return len(fake.settingsArgsForCall)
+}
+
+func (fake *FakeSettingDB) SettingsArgsForCall(i int) lager.Logger {
+	fake.settingsMutex.RLock()
+	defer fake.settingsMutex.RUnlock()
+	return fake.settingsArgsForCall[i].logger
+}
+
+func (fake *FakeSettingDB) SettingsReturns(result1 map[string]string, result2 error) {
+	fake.SettingsStub = nil
+	fake.settingsReturns = struct {
+		result1 map[string]string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSettingDB) SetSetting(logger lager.Logger, name string, value string) error {
+	fake.setSettingMutex.Lock()
+	fake.setSettingArgsForCall = append(fake.setSettingArgsForCall, struct {
+		logger lager.Logger
+		name   string
+		value  string
+	}{logger, name, value})
+	fake.recordInvocation("SetSetting", []interface{}{logger, name, value})
+	fake.setSettingMutex.Unlock()
+	if fake.SetSettingStub != nil {
+		return fake.SetSettingStub(logger, name, value)
+	} else {
+		return fake.setSettingReturns.result1
+	}
+}
+
+func (fake *FakeSettingDB) SetSettingCallCount() int {
+	fake.setSettingMutex.RLock()
+	defer fake.setSettingMutex.RUnlock()
+	return len(fake.setSettingArgsForCall)
+}
+
+func (fake *FakeSettingDB) SetSettingArgsForCall(i int) (lager.Logger, string, string) {
+	fake.setSettingMutex.RLock()
+	defer fake.setSettingMutex.RUnlock()
+	return fake.setSettingArgsForCall[i].logger, fake.setSettingArgsForCall[i].name, fake.setSettingArgsForCall[i].value
+}
+
+func (fake *FakeSettingDB) SetSettingReturns(result1 error) {
+	fake.SetSettingStub = nil
+	fake.setSettingReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSettingDB) RemoveSetting(logger lager.Logger, name string) error {
+	fake.removeSettingMutex.Lock()
+	fake.removeSettingArgsForCall = append(fake.removeSettingArgsForCall, struct {
+		logger lager.Logger
+		name   string
+	}{logger, name})
+	fake.recordInvocation("RemoveSetting", []interface{}{logger, name})
+	fake.removeSettingMutex.Unlock()
+	if fake.RemoveSettingStub != nil {
+		return fake.RemoveSettingStub(logger, name)
+	} else {
+		return fake.removeSettingReturns.result1
+	}
+}
+
+func (fake *FakeSettingDB) RemoveSettingCallCount() int {
+	fake.removeSettingMutex.RLock()
+	defer fake.removeSettingMutex.RUnlock()
+	return len(fake.removeSettingArgsForCall)
+}
+
+func (fake *FakeSettingDB) RemoveSettingArgsForCall(i int) (lager.Logger, string) {
+	fake.removeSettingMutex.RLock()
+	defer fake.removeSettingMutex.RUnlock()
+	return fake.removeSettingArgsForCall[i].logger, fake.removeSettingArgsForCall[i].name
+}
+
+func (fake *FakeSettingDB) RemoveSettingReturns(result1 error) {
+	fake.RemoveSettingStub = nil
+	fake.removeSettingReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSettingDB) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.settingsMutex.RLock()
+	defer fake.settingsMutex.RUnlock()
+	fake.setSettingMutex.RLock()
+	defer fake.setSettingMutex.RUnlock()
+	fake.removeSettingMutex.RLock()
+	defer fake.removeSettingMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeSettingDB) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.SettingDB = new(FakeSettingDB)
@@ -0,0 +1,81 @@
+// This file was generated by counterfeiter
+package dbfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/db"
+)
+
+type FakeUserDataDB struct {
+	VMUserDataStub        func(logger lager.Logger, cid int32) (string, error)
+	vmUserDataMutex       sync.RWMutex
+	vmUserDataArgsForCall []struct {
+		logger lager.Logger
+		cid    int32
+	}
+	vmUserDataReturns struct {
+		result1 string
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeUserDataDB) VMUserData(logger lager.Logger, cid int32) (string, error) {
+	fake.vmUserDataMutex.Lock()
+	fake.vmUserDataArgsForCall = append(fake.vmUserDataArgsForCall, struct {
+		logger lager.Logger
+		cid    int32
+	}{logger, cid})
+	fake.recordInvocation("VMUserData", []interface{}{logger, cid})
+	fake.vmUserDataMutex.Unlock()
+	if fake.VMUserDataStub != nil {
+		return fake.VMUserDataStub(logger, cid)
+	} else {
+		return fake.vmUserDataReturns.result1, fake.vmUserDataReturns.result2
+	}
+}
+
+func (fake *FakeUserDataDB) VMUserDataCallCount() int {
+	fake.vmUserDataMutex.RLock()
+	defer fake.vmUserDataMutex.RUnlock()
+	return len(fake.vmUserDataArgsForCall)
+}
+
+func (fake *FakeUserDataDB) VMUserDataArgsForCall(i int) (lager.Logger, int32) {
+	fake.vmUserDataMutex.RLock()
+	defer fake.vmUserDataMutex.RUnlock()
+	return fake.vmUserDataArgsForCall[i].logger, fake.vmUserDataArgsForCall[i].cid
+}
+
+func (fake *FakeUserDataDB) VMUserDataReturns(result1 string, result2 error) {
+	fake.VMUserDataStub = nil
+	fake.vmUserDataReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserDataDB) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.vmUserDataMutex.RLock()
+	defer fake.vmUserDataMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeUserDataDB) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.UserDataDB = new(FakeUserDataDB)
@@ -7,6 +7,7 @@ import (
 	"code.cloudfoundry.org/lager"
 	"github.com/jianqiu/vps/db"
 	"github.com/jianqiu/vps/models"
+	"time"
 )
 
 type FakeVirtualGuestDB struct {
@@ -30,6 +31,18 @@ type FakeVirtualGuestDB struct {
 		result1 *models.VM
 		result2 error
 	}
+	OrderVirtualGuestsToProvisionStub        func(logger lager.Logger, pool string, cids []int32, ips []string) ([]*models.VM, error)
+	orderVirtualGuestsToProvisionMutex       sync.RWMutex
+	orderVirtualGuestsToProvisionArgsForCall []struct {
+		logger lager.Logger
+		pool   string
+		cids   []int32
+		ips    []string
+	}
+	orderVirtualGuestsToProvisionReturns struct {
+		result1 []*models.VM
+		result2 error
+	}
 	VirtualGuestsByStatesStub        func(logger lager.Logger, states []string) ([]*models.VM, error)
 	virtualGuestsByStatesMutex       sync.RWMutex
 	virtualGuestsByStatesArgsForCall []struct {
@@ -70,6 +83,26 @@ type FakeVirtualGuestDB struct {
 		result1 *models.VM
 		result2 error
 	}
+	VirtualGuestByHostnameStub        func(logger lager.Logger, hostname string) (*models.VM, error)
+	virtualGuestByHostnameMutex       sync.RWMutex
+	virtualGuestByHostnameArgsForCall []struct {
+		logger   lager.Logger
+		hostname string
+	}
+	virtualGuestByHostnameReturns struct {
+		result1 *models.VM
+		result2 error
+	}
+	VirtualGuestsByHostnamePatternStub        func(logger lager.Logger, pattern string) ([]*models.VM, error)
+	virtualGuestsByHostnamePatternMutex       sync.RWMutex
+	virtualGuestsByHostnamePatternArgsForCall []struct {
+		logger  lager.Logger
+		pattern string
+	}
+	virtualGuestsByHostnamePatternReturns struct {
+		result1 []*models.VM
+		result2 error
+	}
 	InsertVirtualGuestToPoolStub        func(logger lager.Logger, virtualGuest *models.VM) error
 	insertVirtualGuestToPoolMutex       sync.RWMutex
 	insertVirtualGuestToPoolArgsForCall []struct {
@@ -115,6 +148,26 @@ type FakeVirtualGuestDB struct {
 	changeVirtualGuestToFreeReturns struct {
 		result1 error
 	}
+	TransitionVirtualGuestStub        func(logger lager.Logger, cid int32, state models.State) error
+	transitionVirtualGuestMutex       sync.RWMutex
+	transitionVirtualGuestArgsForCall []struct {
+		logger lager.Logger
+		cid    int32
+		state  models.State
+	}
+	transitionVirtualGuestReturns struct {
+		result1 error
+	}
+	RetireVirtualGuestStub        func(logger lager.Logger, cid int32, idleBefore time.Time) error
+	retireVirtualGuestMutex       sync.RWMutex
+	retireVirtualGuestArgsForCall []struct {
+		logger     lager.Logger
+		cid        int32
+		idleBefore time.Time
+	}
+	retireVirtualGuestReturns struct {
+		result1 error
+	}
 	DeleteVirtualGuestFromPoolStub        func(logger lager.Logger, cid int32) error
 	deleteVirtualGuestFromPoolMutex       sync.RWMutex
 	deleteVirtualGuestFromPoolArgsForCall []struct {
@@ -198,6 +251,53 @@ func (fake *FakeVirtualGuestDB) OrderVirtualGuestToProvisionReturns(result1 *mod
 	}{result1, result2}
 }
 
+func (fake *FakeVirtualGuestDB) OrderVirtualGuestsToProvision(logger lager.Logger, pool string, cids []int32, ips []string) ([]*models.VM, error) {
+	var cidsCopy []int32
+	if cids != nil {
+		cidsCopy = make([]int32, len(cids))
+		copy(cidsCopy, cids)
+	}
+	var ipsCopy []string
+	if ips != nil {
+		ipsCopy = make([]string, len(ips))
+		copy(ipsCopy, ips)
+	}
+	fake.orderVirtualGuestsToProvisionMutex.Lock()
+	fake.orderVirtualGuestsToProvisionArgsForCall = append(fake.orderVirtualGuestsToProvisionArgsForCall, struct {
+		logger lager.Logger
+		pool   string
+		cids   []int32
+		ips    []string
+	}{logger, pool, cidsCopy, ipsCopy})
+	fake.recordInvocation("OrderVirtualGuestsToProvision", []interface{}{logger, pool, cidsCopy, ipsCopy})
+	fake.orderVirtualGuestsToProvisionMutex.Unlock()
+	if fake.OrderVirtualGuestsToProvisionStub != nil {
+		return fake.OrderVirtualGuestsToProvisionStub(logger, pool, cids, ips)
+	} else {
+		return fake.orderVirtualGuestsToProvisionReturns.result1, fake.orderVirtualGuestsToProvisionReturns.result2
+	}
+}
+
+func (fake *FakeVirtualGuestDB) OrderVirtualGuestsToProvisionCallCount() int {
+	fake.orderVirtualGuestsToProvisionMutex.RLock()
+	defer fake.orderVirtualGuestsToProvisionMutex.RUnlock()
+	return len(fake.orderVirtualGuestsToProvisionArgsForCall)
+}
+
+func (fake *FakeVirtualGuestDB) OrderVirtualGuestsToProvisionArgsForCall(i int) (lager.Logger, string, []int32, []string) {
+	fake.orderVirtualGuestsToProvisionMutex.RLock()
+	defer fake.orderVirtualGuestsToProvisionMutex.RUnlock()
+	return fake.orderVirtualGuestsToProvisionArgsForCall[i].logger, fake.orderVirtualGuestsToProvisionArgsForCall[i].pool, fake.orderVirtualGuestsToProvisionArgsForCall[i].cids, fake.orderVirtualGuestsToProvisionArgsForCall[i].ips
+}
+
+func (fake *FakeVirtualGuestDB) OrderVirtualGuestsToProvisionReturns(result1 []*models.VM, result2 error) {
+	fake.OrderVirtualGuestsToProvisionStub = nil
+	fake.orderVirtualGuestsToProvisionReturns = struct {
+		result1 []*models.VM
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeVirtualGuestDB) VirtualGuestsByStates(logger lager.Logger, states []string) ([]*models.VM, error) {
 	var statesCopy []string
 	if states != nil {
@@ -348,6 +448,76 @@ func (fake *FakeVirtualGuestDB) VirtualGuestByIPReturns(result1 *models.VM, resu
 	}{result1, result2}
 }
 
+func (fake *FakeVirtualGuestDB) VirtualGuestByHostname(logger lager.Logger, hostname string) (*models.VM, error) {
+	fake.virtualGuestByHostnameMutex.Lock()
+	fake.virtualGuestByHostnameArgsForCall = append(fake.virtualGuestByHostnameArgsForCall, struct {
+		logger   lager.Logger
+		hostname string
+	}{logger, hostname})
+	fake.recordInvocation("VirtualGuestByHostname", []interface{}{logger, hostname})
+	fake.virtualGuestByHostnameMutex.Unlock()
+	if fake.VirtualGuestByHostnameStub != nil {
+		return fake.VirtualGuestByHostnameStub(logger, hostname)
+	} else {
+		return fake.virtualGuestByHostnameReturns.result1, fake.virtualGuestByHostnameReturns.result2
+	}
+}
+
+func (fake *FakeVirtualGuestDB) VirtualGuestByHostnameCallCount() int {
+	fake.virtualGuestByHostnameMutex.RLock()
+	defer fake.virtualGuestByHostnameMutex.RUnlock()
+	return len(fake.virtualGuestByHostnameArgsForCall)
+}
+
+func (fake *FakeVirtualGuestDB) VirtualGuestByHostnameArgsForCall(i int) (lager.Logger, string) {
+	fake.virtualGuestByHostnameMutex.RLock()
+	defer fake.virtualGuestByHostnameMutex.RUnlock()
+	return fake.virtualGuestByHostnameArgsForCall[i].logger, fake.virtualGuestByHostnameArgsForCall[i].hostname
+}
+
+func (fake *FakeVirtualGuestDB) VirtualGuestByHostnameReturns(result1 *models.VM, result2 error) {
+	fake.VirtualGuestByHostnameStub = nil
+	fake.virtualGuestByHostnameReturns = struct {
+		result1 *models.VM
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeVirtualGuestDB) VirtualGuestsByHostnamePattern(logger lager.Logger, pattern string) ([]*models.VM, error) {
+	fake.virtualGuestsByHostnamePatternMutex.Lock()
+	fake.virtualGuestsByHostnamePatternArgsForCall = append(fake.virtualGuestsByHostnamePatternArgsForCall, struct {
+		logger  lager.Logger
+		pattern string
+	}{logger, pattern})
+	fake.recordInvocation("VirtualGuestsByHostnamePattern", []interface{}{logger, pattern})
+	fake.virtualGuestsByHostnamePatternMutex.Unlock()
+	if fake.VirtualGuestsByHostnamePatternStub != nil {
+		return fake.VirtualGuestsByHostnamePatternStub(logger, pattern)
+	} else {
+		return fake.virtualGuestsByHostnamePatternReturns.result1, fake.virtualGuestsByHostnamePatternReturns.result2
+	}
+}
+
+func (fake *FakeVirtualGuestDB) VirtualGuestsByHostnamePatternCallCount() int {
+	fake.virtualGuestsByHostnamePatternMutex.RLock()
+	defer fake.virtualGuestsByHostnamePatternMutex.RUnlock()
+	return len(fake.virtualGuestsByHostnamePatternArgsForCall)
+}
+
+func (fake *FakeVirtualGuestDB) VirtualGuestsByHostnamePatternArgsForCall(i int) (lager.Logger, string) {
+	fake.virtualGuestsByHostnamePatternMutex.RLock()
+	defer fake.virtualGuestsByHostnamePatternMutex.RUnlock()
+	return fake.virtualGuestsByHostnamePatternArgsForCall[i].logger, fake.virtualGuestsByHostnamePatternArgsForCall[i].pattern
+}
+
+func (fake *FakeVirtualGuestDB) VirtualGuestsByHostnamePatternReturns(result1 []*models.VM, result2 error) {
+	fake.VirtualGuestsByHostnamePatternStub = nil
+	fake.virtualGuestsByHostnamePatternReturns = struct {
+		result1 []*models.VM
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeVirtualGuestDB) InsertVirtualGuestToPool(logger lager.Logger, virtualGuest *models.VM) error {
 	fake.insertVirtualGuestToPoolMutex.Lock()
 	fake.insertVirtualGuestToPoolArgsForCall = append(fake.insertVirtualGuestToPoolArgsForCall, struct {
@@ -518,6 +688,76 @@ func (fake *FakeVirtualGuestDB) ChangeVirtualGuestToFreeReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeVirtualGuestDB) TransitionVirtualGuest(logger lager.Logger, cid int32, state models.State) error {
+	fake.transitionVirtualGuestMutex.Lock()
+	fake.transitionVirtualGuestArgsForCall = append(fake.transitionVirtualGuestArgsForCall, struct {
+		logger lager.Logger
+		cid    int32
+		state  models.State
+	}{logger, cid, state})
+	fake.recordInvocation("TransitionVirtualGuest", []interface{}{logger, cid, state})
+	fake.transitionVirtualGuestMutex.Unlock()
+	if fake.TransitionVirtualGuestStub != nil {
+		return fake.TransitionVirtualGuestStub(logger, cid, state)
+	} else {
+		return fake.transitionVirtualGuestReturns.result1
+	}
+}
+
+func (fake *FakeVirtualGuestDB) TransitionVirtualGuestCallCount() int {
+	fake.transitionVirtualGuestMutex.RLock()
+	defer fake.transitionVirtualGuestMutex.RUnlock()
+	return len(fake.transitionVirtualGuestArgsForCall)
+}
+
+func (fake *FakeVirtualGuestDB) TransitionVirtualGuestArgsForCall(i int) (lager.Logger, int32, models.State) {
+	fake.transitionVirtualGuestMutex.RLock()
+	defer fake.transitionVirtualGuestMutex.RUnlock()
+	return fake.transitionVirtualGuestArgsForCall[i].logger, fake.transitionVirtualGuestArgsForCall[i].cid, fake.transitionVirtualGuestArgsForCall[i].state
+}
+
+func (fake *FakeVirtualGuestDB) TransitionVirtualGuestReturns(result1 error) {
+	fake.TransitionVirtualGuestStub = nil
+	fake.transitionVirtualGuestReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeVirtualGuestDB) RetireVirtualGuest(logger lager.Logger, cid int32, idleBefore time.Time) error {
+	fake.retireVirtualGuestMutex.Lock()
+	fake.retireVirtualGuestArgsForCall = append(fake.retireVirtualGuestArgsForCall, struct {
+		logger     lager.Logger
+		cid        int32
+		idleBefore time.Time
+	}{logger, cid, idleBefore})
+	fake.recordInvocation("RetireVirtualGuest", []interface{}{logger, cid, idleBefore})
+	fake.retireVirtualGuestMutex.Unlock()
+	if fake.RetireVirtualGuestStub != nil {
+		return fake.RetireVirtualGuestStub(logger, cid, idleBefore)
+	} else {
+		return fake.retireVirtualGuestReturns.result1
+	}
+}
+
+func (fake *FakeVirtualGuestDB) RetireVirtualGuestCallCount() int {
+	fake.retireVirtualGuestMutex.RLock()
+	defer fake.retireVirtualGuestMutex.RUnlock()
+	return len(fake.retireVirtualGuestArgsForCall)
+}
+
+func (fake *FakeVirtualGuestDB) RetireVirtualGuestArgsForCall(i int) (lager.Logger, int32, time.Time) {
+	fake.retireVirtualGuestMutex.RLock()
+	defer fake.retireVirtualGuestMutex.RUnlock()
+	return fake.retireVirtualGuestArgsForCall[i].logger, fake.retireVirtualGuestArgsForCall[i].cid, fake.retireVirtualGuestArgsForCall[i].idleBefore
+}
+
+func (fake *FakeVirtualGuestDB) RetireVirtualGuestReturns(result1 error) {
+	fake.RetireVirtualGuestStub = nil
+	fake.retireVirtualGuestReturns = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeVirtualGuestDB) DeleteVirtualGuestFromPool(logger lager.Logger, cid int32) error {
 	fake.deleteVirtualGuestFromPoolMutex.Lock()
 	fake.deleteVirtualGuestFromPoolArgsForCall = append(fake.deleteVirtualGuestFromPoolArgsForCall, struct {
@@ -559,6 +799,8 @@ func (fake *FakeVirtualGuestDB) Invocations() map[string][][]interface{} {
 	defer fake.virtualGuestsMutex.RUnlock()
 	fake.orderVirtualGuestToProvisionMutex.RLock()
 	defer fake.orderVirtualGuestToProvisionMutex.RUnlock()
+	fake.orderVirtualGuestsToProvisionMutex.RLock()
+	defer fake.orderVirtualGuestsToProvisionMutex.RUnlock()
 	fake.virtualGuestsByStatesMutex.RLock()
 	defer fake.virtualGuestsByStatesMutex.RUnlock()
 	fake.virtualGuestsByDeploymentsMutex.RLock()
@@ -567,6 +809,10 @@ func (fake *FakeVirtualGuestDB) Invocations() map[string][][]interface{} {
 	defer fake.virtualGuestByCIDMutex.RUnlock()
 	fake.virtualGuestByIPMutex.RLock()
 	defer fake.virtualGuestByIPMutex.RUnlock()
+	fake.virtualGuestByHostnameMutex.RLock()
+	defer fake.virtualGuestByHostnameMutex.RUnlock()
+	fake.virtualGuestsByHostnamePatternMutex.RLock()
+	defer fake.virtualGuestsByHostnamePatternMutex.RUnlock()
 	fake.insertVirtualGuestToPoolMutex.RLock()
 	defer fake.insertVirtualGuestToPoolMutex.RUnlock()
 	fake.updateVirtualGuestInPoolMutex.RLock()
@@ -577,6 +823,10 @@ func (fake *FakeVirtualGuestDB) Invocations() map[string][][]interface{} {
 	defer fake.changeVirtualGuestToUseMutex.RUnlock()
 	fake.changeVirtualGuestToFreeMutex.RLock()
 	defer fake.changeVirtualGuestToFreeMutex.RUnlock()
+	fake.transitionVirtualGuestMutex.RLock()
+	defer fake.transitionVirtualGuestMutex.RUnlock()
+	fake.retireVirtualGuestMutex.RLock()
+	defer fake.retireVirtualGuestMutex.RUnlock()
 	fake.deleteVirtualGuestFromPoolMutex.RLock()
 	defer fake.deleteVirtualGuestFromPoolMutex.RUnlock()
 	return fake.invocations
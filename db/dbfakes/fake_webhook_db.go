@@ -0,0 +1,545 @@
+// This file was generated by counterfeiter
+package dbfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/db"
+	"github.com/jianqiu/vps/models"
+	"time"
+)
+
+type FakeWebhookDB struct {
+	WebhooksStub        func(logger lager.Logger) ([]*models.Webhook, error)
+	webhooksMutex       sync.RWMutex
+	webhooksArgsForCall []struct {
+		logger lager.Logger
+	}
+	webhooksReturns struct {
+		result1 []*models.Webhook
+		result2 error
+	}
+	WebhookByIDStub        func(logger lager.Logger, id string) (*models.Webhook, error)
+	webhookByIDMutex       sync.RWMutex
+	webhookByIDArgsForCall []struct {
+		logger lager.Logger
+		id     string
+	}
+	webhookByIDReturns struct {
+		result1 *models.Webhook
+		result2 error
+	}
+	InsertWebhookStub        func(logger lager.Logger, webhook *models.Webhook) error
+	insertWebhookMutex       sync.RWMutex
+	insertWebhookArgsForCall []struct {
+		logger  lager.Logger
+		webhook *models.Webhook
+	}
+	insertWebhookReturns struct {
+		result1 error
+	}
+	DeleteWebhookStub        func(logger lager.Logger, id string) error
+	deleteWebhookMutex       sync.RWMutex
+	deleteWebhookArgsForCall []struct {
+		logger lager.Logger
+		id     string
+	}
+	deleteWebhookReturns struct {
+		result1 error
+	}
+	DispatchOutboxEventsStub        func(logger lager.Logger, limit int) (int, error)
+	dispatchOutboxEventsMutex       sync.RWMutex
+	dispatchOutboxEventsArgsForCall []struct {
+		logger lager.Logger
+		limit  int
+	}
+	dispatchOutboxEventsReturns struct {
+		result1 int
+		result2 error
+	}
+	OutboxEventsSinceStub        func(logger lager.Logger, since int64) ([]*models.WebhookEvent, error)
+	outboxEventsSinceMutex       sync.RWMutex
+	outboxEventsSinceArgsForCall []struct {
+		logger lager.Logger
+		since  int64
+	}
+	outboxEventsSinceReturns struct {
+		result1 []*models.WebhookEvent
+		result2 error
+	}
+	PendingWebhookDeliveriesStub        func(logger lager.Logger, limit int) ([]*models.WebhookDelivery, error)
+	pendingWebhookDeliveriesMutex       sync.RWMutex
+	pendingWebhookDeliveriesArgsForCall []struct {
+		logger lager.Logger
+		limit  int
+	}
+	pendingWebhookDeliveriesReturns struct {
+		result1 []*models.WebhookDelivery
+		result2 error
+	}
+	CompleteWebhookDeliveryStub        func(logger lager.Logger, id string) error
+	completeWebhookDeliveryMutex       sync.RWMutex
+	completeWebhookDeliveryArgsForCall []struct {
+		logger lager.Logger
+		id     string
+	}
+	completeWebhookDeliveryReturns struct {
+		result1 error
+	}
+	FailWebhookDeliveryStub        func(logger lager.Logger, id string, reason string, nextAttemptAt time.Time, dead bool) error
+	failWebhookDeliveryMutex       sync.RWMutex
+	failWebhookDeliveryArgsForCall []struct {
+		logger        lager.Logger
+		id            string
+		reason        string
+		nextAttemptAt time.Time
+		dead          bool
+	}
+	failWebhookDeliveryReturns struct {
+		result1 error
+	}
+	DeadWebhookDeliveriesStub        func(logger lager.Logger) ([]*models.WebhookDelivery, error)
+	deadWebhookDeliveriesMutex       sync.RWMutex
+	deadWebhookDeliveriesArgsForCall []struct {
+		logger lager.Logger
+	}
+	deadWebhookDeliveriesReturns struct {
+		result1 []*models.WebhookDelivery
+		result2 error
+	}
+	RetryWebhookDeliveryStub        func(logger lager.Logger, id string) error
+	retryWebhookDeliveryMutex       sync.RWMutex
+	retryWebhookDeliveryArgsForCall []struct {
+		logger lager.Logger
+		id     string
+	}
+	retryWebhookDeliveryReturns struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeWebhookDB) Webhooks(logger lager.Logger) ([]*models.Webhook, error) {
+	fake.webhooksMutex.Lock()
+	fake.webhooksArgsForCall = append(fake.webhooksArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("Webhooks", []interface{}{logger})
+	fake.webhooksMutex.Unlock()
+	if fake.WebhooksStub != nil {
+		return fake.WebhooksStub(logger)
+	} else {
+		return fake.webhooksReturns.result1, fake.webhooksReturns.result2
+	}
+}
+
+func (fake *FakeWebhookDB) WebhooksCallCount() int {
+	fake.webhooksMutex.RLock()
+	defer fake.webhooksMutex.RUnlock()
+	return len(fake.webhooksArgsForCall)
+}
+
+func (fake *FakeWebhookDB) WebhooksArgsForCall(i int) lager.Logger {
+	fake.webhooksMutex.RLock()
+	defer fake.webhooksMutex.RUnlock()
+	return fake.webhooksArgsForCall[i].logger
+}
+
+func (fake *FakeWebhookDB) WebhooksReturns(result1 []*models.Webhook, result2 error) {
+	fake.WebhooksStub = nil
+	fake.webhooksReturns = struct {
+		result1 []*models.Webhook
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWebhookDB) WebhookByID(logger lager.Logger, id string) (*models.Webhook, error) {
+	fake.webhookByIDMutex.Lock()
+	fake.webhookByIDArgsForCall = append(fake.webhookByIDArgsForCall, struct {
+		logger lager.Logger
+		id     string
+	}{logger, id})
+	fake.recordInvocation("WebhookByID", []interface{}{logger, id})
+	fake.webhookByIDMutex.Unlock()
+	if fake.WebhookByIDStub != nil {
+		return fake.WebhookByIDStub(logger, id)
+	} else {
+		return fake.webhookByIDReturns.result1, fake.webhookByIDReturns.result2
+	}
+}
+
+func (fake *FakeWebhookDB) WebhookByIDCallCount() int {
+	fake.webhookByIDMutex.RLock()
+	defer fake.webhookByIDMutex.RUnlock()
+	return len(fake.webhookByIDArgsForCall)
+}
+
+func (fake *FakeWebhookDB) WebhookByIDArgsForCall(i int) (lager.Logger, string) {
+	fake.webhookByIDMutex.RLock()
+	defer fake.webhookByIDMutex.RUnlock()
+	return fake.webhookByIDArgsForCall[i].logger, fake.webhookByIDArgsForCall[i].id
+}
+
+func (fake *FakeWebhookDB) WebhookByIDReturns(result1 *models.Webhook, result2 error) {
+	fake.WebhookByIDStub = nil
+	fake.webhookByIDReturns = struct {
+		result1 *models.Webhook
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWebhookDB) InsertWebhook(logger lager.Logger, webhook *models.Webhook) error {
+	fake.insertWebhookMutex.Lock()
+	fake.insertWebhookArgsForCall = append(fake.insertWebhookArgsForCall, struct {
+		logger  lager.Logger
+		webhook *models.Webhook
+	}{logger, webhook})
+	fake.recordInvocation("InsertWebhook", []interface{}{logger, webhook})
+	fake.insertWebhookMutex.Unlock()
+	if fake.InsertWebhookStub != nil {
+		return fake.InsertWebhookStub(logger, webhook)
+	} else {
+		return fake.insertWebhookReturns.result1
+	}
+}
+
+func (fake *FakeWebhookDB) InsertWebhookCallCount() int {
+	fake.insertWebhookMutex.RLock()
+	defer fake.insertWebhookMutex.RUnlock()
+	return len(fake.insertWebhookArgsForCall)
+}
+
+func (fake *FakeWebhookDB) InsertWebhookArgsForCall(i int) (lager.Logger, *models.Webhook) {
+	fake.insertWebhookMutex.RLock()
+	defer fake.insertWebhookMutex.RUnlock()
+	return fake.insertWebhookArgsForCall[i].logger, fake.insertWebhookArgsForCall[i].webhook
+}
+
+func (fake *FakeWebhookDB) InsertWebhookReturns(result1 error) {
+	fake.InsertWebhookStub = nil
+	fake.insertWebhookReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWebhookDB) DeleteWebhook(logger lager.Logger, id string) error {
+	fake.deleteWebhookMutex.Lock()
+	fake.deleteWebhookArgsForCall = append(fake.deleteWebhookArgsForCall, struct {
+		logger lager.Logger
+		id     string
+	}{logger, id})
+	fake.recordInvocation("DeleteWebhook", []interface{}{logger, id})
+	fake.deleteWebhookMutex.Unlock()
+	if fake.DeleteWebhookStub != nil {
+		return fake.DeleteWebhookStub(logger, id)
+	} else {
+		return fake.deleteWebhookReturns.result1
+	}
+}
+
+func (fake *FakeWebhookDB) DeleteWebhookCallCount() int {
+	fake.deleteWebhookMutex.RLock()
+	defer fake.deleteWebhookMutex.RUnlock()
+	return len(fake.deleteWebhookArgsForCall)
+}
+
+func (fake *FakeWebhookDB) DeleteWebhookArgsForCall(i int) (lager.Logger, string) {
+	fake.deleteWebhookMutex.RLock()
+	defer fake.deleteWebhookMutex.RUnlock()
+	return fake.deleteWebhookArgsForCall[i].logger, fake.deleteWebhookArgsForCall[i].id
+}
+
+func (fake *FakeWebhookDB) DeleteWebhookReturns(result1 error) {
+	fake.DeleteWebhookStub = nil
+	fake.deleteWebhookReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWebhookDB) DispatchOutboxEvents(logger lager.Logger, limit int) (int, error) {
+	fake.dispatchOutboxEventsMutex.Lock()
+	fake.dispatchOutboxEventsArgsForCall = append(fake.dispatchOutboxEventsArgsForCall, struct {
+		logger lager.Logger
+		limit  int
+	}{logger, limit})
+	fake.recordInvocation("DispatchOutboxEvents", []interface{}{logger, limit})
+	fake.dispatchOutboxEventsMutex.Unlock()
+	if fake.DispatchOutboxEventsStub != nil {
+		return fake.DispatchOutboxEventsStub(logger, limit)
+	} else {
+		return fake.dispatchOutboxEventsReturns.result1, fake.dispatchOutboxEventsReturns.result2
+	}
+}
+
+func (fake *FakeWebhookDB) DispatchOutboxEventsCallCount() int {
+	fake.dispatchOutboxEventsMutex.RLock()
+	defer fake.dispatchOutboxEventsMutex.RUnlock()
+	return len(fake.dispatchOutboxEventsArgsForCall)
+}
+
+func (fake *FakeWebhookDB) DispatchOutboxEventsArgsForCall(i int) (lager.Logger, int) {
+	fake.dispatchOutboxEventsMutex.RLock()
+	defer fake.dispatchOutboxEventsMutex.RUnlock()
+	return fake.dispatchOutboxEventsArgsForCall[i].logger, fake.dispatchOutboxEventsArgsForCall[i].limit
+}
+
+func (fake *FakeWebhookDB) DispatchOutboxEventsReturns(result1 int, result2 error) {
+	fake.DispatchOutboxEventsStub = nil
+	fake.dispatchOutboxEventsReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWebhookDB) OutboxEventsSince(logger lager.Logger, since int64) ([]*models.WebhookEvent, error) {
+	fake.outboxEventsSinceMutex.Lock()
+	fake.outboxEventsSinceArgsForCall = append(fake.outboxEventsSinceArgsForCall, struct {
+		logger lager.Logger
+		since  int64
+	}{logger, since})
+	fake.recordInvocation("OutboxEventsSince", []interface{}{logger, since})
+	fake.outboxEventsSinceMutex.Unlock()
+	if fake.OutboxEventsSinceStub != nil {
+		return fake.OutboxEventsSinceStub(logger, since)
+	} else {
+		return fake.outboxEventsSinceReturns.result1, fake.outboxEventsSinceReturns.result2
+	}
+}
+
+func (fake *FakeWebhookDB) OutboxEventsSinceCallCount() int {
+	fake.outboxEventsSinceMutex.RLock()
+	defer fake.outboxEventsSinceMutex.RUnlock()
+	return len(fake.outboxEventsSinceArgsForCall)
+}
+
+func (fake *FakeWebhookDB) OutboxEventsSinceArgsForCall(i int) (lager.Logger, int64) {
+	fake.outboxEventsSinceMutex.RLock()
+	defer fake.outboxEventsSinceMutex.RUnlock()
+	return fake.outboxEventsSinceArgsForCall[i].logger, fake.outboxEventsSinceArgsForCall[i].since
+}
+
+func (fake *FakeWebhookDB) OutboxEventsSinceReturns(result1 []*models.WebhookEvent, result2 error) {
+	fake.OutboxEventsSinceStub = nil
+	fake.outboxEventsSinceReturns = struct {
+		result1 []*models.WebhookEvent
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWebhookDB) PendingWebhookDeliveries(logger lager.Logger, limit int) ([]*models.WebhookDelivery, error) {
+	fake.pendingWebhookDeliveriesMutex.Lock()
+	fake.pendingWebhookDeliveriesArgsForCall = append(fake.pendingWebhookDeliveriesArgsForCall, struct {
+		logger lager.Logger
+		limit  int
+	}{logger, limit})
+	fake.recordInvocation("PendingWebhookDeliveries", []interface{}{logger, limit})
+	fake.pendingWebhookDeliveriesMutex.Unlock()
+	if fake.PendingWebhookDeliveriesStub != nil {
+		return fake.PendingWebhookDeliveriesStub(logger, limit)
+	} else {
+		return fake.pendingWebhookDeliveriesReturns.result1, fake.pendingWebhookDeliveriesReturns.result2
+	}
+}
+
+func (fake *FakeWebhookDB) PendingWebhookDeliveriesCallCount() int {
+	fake.pendingWebhookDeliveriesMutex.RLock()
+	defer fake.pendingWebhookDeliveriesMutex.RUnlock()
+	return len(fake.pendingWebhookDeliveriesArgsForCall)
+}
+
+func (fake *FakeWebhookDB) PendingWebhookDeliveriesArgsForCall(i int) (lager.Logger, int) {
+	fake.pendingWebhookDeliveriesMutex.RLock()
+	defer fake.pendingWebhookDeliveriesMutex.RUnlock()
+	return fake.pendingWebhookDeliveriesArgsForCall[i].logger, fake.pendingWebhookDeliveriesArgsForCall[i].limit
+}
+
+func (fake *FakeWebhookDB) PendingWebhookDeliveriesReturns(result1 []*models.WebhookDelivery, result2 error) {
+	fake.PendingWebhookDeliveriesStub = nil
+	fake.pendingWebhookDeliveriesReturns = struct {
+		result1 []*models.WebhookDelivery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWebhookDB) CompleteWebhookDelivery(logger lager.Logger, id string) error {
+	fake.completeWebhookDeliveryMutex.Lock()
+	fake.completeWebhookDeliveryArgsForCall = append(fake.completeWebhookDeliveryArgsForCall, struct {
+		logger lager.Logger
+		id     string
+	}{logger, id})
+	fake.recordInvocation("CompleteWebhookDelivery", []interface{}{logger, id})
+	fake.completeWebhookDeliveryMutex.Unlock()
+	if fake.CompleteWebhookDeliveryStub != nil {
+		return fake.CompleteWebhookDeliveryStub(logger, id)
+	} else {
+		return fake.completeWebhookDeliveryReturns.result1
+	}
+}
+
+func (fake *FakeWebhookDB) CompleteWebhookDeliveryCallCount() int {
+	fake.completeWebhookDeliveryMutex.RLock()
+	defer fake.completeWebhookDeliveryMutex.RUnlock()
+	return len(fake.completeWebhookDeliveryArgsForCall)
+}
+
+func (fake *FakeWebhookDB) CompleteWebhookDeliveryArgsForCall(i int) (lager.Logger, string) {
+	fake.completeWebhookDeliveryMutex.RLock()
+	defer fake.completeWebhookDeliveryMutex.RUnlock()
+	return fake.completeWebhookDeliveryArgsForCall[i].logger, fake.completeWebhookDeliveryArgsForCall[i].id
+}
+
+func (fake *FakeWebhookDB) CompleteWebhookDeliveryReturns(result1 error) {
+	fake.CompleteWebhookDeliveryStub = nil
+	fake.completeWebhookDeliveryReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWebhookDB) FailWebhookDelivery(logger lager.Logger, id string, reason string, nextAttemptAt time.Time, dead bool) error {
+	fake.failWebhookDeliveryMutex.Lock()
+	fake.failWebhookDeliveryArgsForCall = append(fake.failWebhookDeliveryArgsForCall, struct {
+		logger        lager.Logger
+		id            string
+		reason        string
+		nextAttemptAt time.Time
+		dead          bool
+	}{logger, id, reason, nextAttemptAt, dead})
+	fake.recordInvocation("FailWebhookDelivery", []interface{}{logger, id, reason, nextAttemptAt, dead})
+	fake.failWebhookDeliveryMutex.Unlock()
+	if fake.FailWebhookDeliveryStub != nil {
+		return fake.FailWebhookDeliveryStub(logger, id, reason, nextAttemptAt, dead)
+	} else {
+		return fake.failWebhookDeliveryReturns.result1
+	}
+}
+
+func (fake *FakeWebhookDB) FailWebhookDeliveryCallCount() int {
+	fake.failWebhookDeliveryMutex.RLock()
+	defer fake.failWebhookDeliveryMutex.RUnlock()
+	return len(fake.failWebhookDeliveryArgsForCall)
+}
+
+func (fake *FakeWebhookDB) FailWebhookDeliveryArgsForCall(i int) (lager.Logger, string, string, time.Time, bool) {
+	fake.failWebhookDeliveryMutex.RLock()
+	defer fake.failWebhookDeliveryMutex.RUnlock()
+	return fake.failWebhookDeliveryArgsForCall[i].logger, fake.failWebhookDeliveryArgsForCall[i].id, fake.failWebhookDeliveryArgsForCall[i].reason, fake.failWebhookDeliveryArgsForCall[i].nextAttemptAt, fake.failWebhookDeliveryArgsForCall[i].dead
+}
+
+func (fake *FakeWebhookDB) FailWebhookDeliveryReturns(result1 error) {
+	fake.FailWebhookDeliveryStub = nil
+	fake.failWebhookDeliveryReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWebhookDB) DeadWebhookDeliveries(logger lager.Logger) ([]*models.WebhookDelivery, error) {
+	fake.deadWebhookDeliveriesMutex.Lock()
+	fake.deadWebhookDeliveriesArgsForCall = append(fake.deadWebhookDeliveriesArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("DeadWebhookDeliveries", []interface{}{logger})
+	fake.deadWebhookDeliveriesMutex.Unlock()
+	if fake.DeadWebhookDeliveriesStub != nil {
+		return fake.DeadWebhookDeliveriesStub(logger)
+	} else {
+		return fake.deadWebhookDeliveriesReturns.result1, fake.deadWebhookDeliveriesReturns.result2
+	}
+}
+
+func (fake *FakeWebhookDB) DeadWebhookDeliveriesCallCount() int {
+	fake.deadWebhookDeliveriesMutex.RLock()
+	defer fake.deadWebhookDeliveriesMutex.RUnlock()
+	return len(fake.deadWebhookDeliveriesArgsForCall)
+}
+
+func (fake *FakeWebhookDB) DeadWebhookDeliveriesArgsForCall(i int) lager.Logger {
+	fake.deadWebhookDeliveriesMutex.RLock()
+	defer fake.deadWebhookDeliveriesMutex.RUnlock()
+	return fake.deadWebhookDeliveriesArgsForCall[i].logger
+}
+
+func (fake *FakeWebhookDB) DeadWebhookDeliveriesReturns(result1 []*models.WebhookDelivery, result2 error) {
+	fake.DeadWebhookDeliveriesStub = nil
+	fake.deadWebhookDeliveriesReturns = struct {
+		result1 []*models.WebhookDelivery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWebhookDB) RetryWebhookDelivery(logger lager.Logger, id string) error {
+	fake.retryWebhookDeliveryMutex.Lock()
+	fake.retryWebhookDeliveryArgsForCall = append(fake.retryWebhookDeliveryArgsForCall, struct {
+		logger lager.Logger
+		id     string
+	}{logger, id})
+	fake.recordInvocation("RetryWebhookDelivery", []interface{}{logger, id})
+	fake.retryWebhookDeliveryMutex.Unlock()
+	if fake.RetryWebhookDeliveryStub != nil {
+		return fake.RetryWebhookDeliveryStub(logger, id)
+	} else {
+		return fake.retryWebhookDeliveryReturns.result1
+	}
+}
+
+func (fake *FakeWebhookDB) RetryWebhookDeliveryCallCount() int {
+	fake.retryWebhookDeliveryMutex.RLock()
+	defer fake.retryWebhookDeliveryMutex.RUnlock()
+	return len(fake.retryWebhookDeliveryArgsForCall)
+}
+
+func (fake *FakeWebhookDB) RetryWebhookDeliveryArgsForCall(i int) (lager.Logger, string) {
+	fake.retryWebhookDeliveryMutex.RLock()
+	defer fake.retryWebhookDeliveryMutex.RUnlock()
+	return fake.retryWebhookDeliveryArgsForCall[i].logger, fake.retryWebhookDeliveryArgsForCall[i].id
+}
+
+func (fake *FakeWebhookDB) RetryWebhookDeliveryReturns(result1 error) {
+	fake.RetryWebhookDeliveryStub = nil
+	fake.retryWebhookDeliveryReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWebhookDB) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.webhooksMutex.RLock()
+	defer fake.webhooksMutex.RUnlock()
+	fake.webhookByIDMutex.RLock()
+	defer fake.webhookByIDMutex.RUnlock()
+	fake.insertWebhookMutex.RLock()
+	defer fake.insertWebhookMutex.RUnlock()
+	fake.deleteWebhookMutex.RLock()
+	defer fake.deleteWebhookMutex.RUnlock()
+	fake.dispatchOutboxEventsMutex.RLock()
+	defer fake.dispatchOutboxEventsMutex.RUnlock()
+	fake.outboxEventsSinceMutex.RLock()
+	defer fake.outboxEventsSinceMutex.RUnlock()
+	fake.pendingWebhookDeliveriesMutex.RLock()
+	defer fake.pendingWebhookDeliveriesMutex.RUnlock()
+	fake.completeWebhookDeliveryMutex.RLock()
+	defer fake.completeWebhookDeliveryMutex.RUnlock()
+	fake.failWebhookDeliveryMutex.RLock()
+	defer fake.failWebhookDeliveryMutex.RUnlock()
+	fake.deadWebhookDeliveriesMutex.RLock()
+	defer fake.deadWebhookDeliveriesMutex.RUnlock()
+	fake.retryWebhookDeliveryMutex.RLock()
+	defer fake.retryWebhookDeliveryMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeWebhookDB) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.WebhookDB = new(FakeWebhookDB)
@@ -0,0 +1,16 @@
+package db
+
+import (
+	"github.com/jianqiu/vps/models"
+	"code.cloudfoundry.org/lager"
+)
+
+// FlavorDB keeps the catalog of named VM sizes orders can ask for.
+//go:generate counterfeiter . FlavorDB
+type FlavorDB interface {
+	Flavors(logger lager.Logger) ([]*models.Flavor, error)
+	FlavorByName(logger lager.Logger, name string) (*models.Flavor, error)
+	InsertFlavor(logger lager.Logger, flavor *models.Flavor) error
+	UpdateFlavor(logger lager.Logger, flavor *models.Flavor) error
+	DeleteFlavor(logger lager.Logger, name string) error
+}
@@ -0,0 +1,17 @@
+package db
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/lager"
+)
+
+//go:generate counterfeiter . LockDB
+type LockDB interface {
+	// AcquireLock takes the named lock for owner, or extends it when owner
+	// already holds it, so that it expires ttl from now. It returns false
+	// when another owner holds a lock that has not expired yet.
+	AcquireLock(logger lager.Logger, name, owner string, ttl time.Duration) (bool, error)
+	// ReleaseLock gives up the named lock if owner holds it.
+	ReleaseLock(logger lager.Logger, name, owner string) error
+}
@@ -0,0 +1,18 @@
+package db
+
+import (
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/models"
+)
+
+//go:generate counterfeiter . PendingOrderDB
+type PendingOrderDB interface {
+	// PendingOrders returns the orders that were recorded and not removed
+	// since.
+	PendingOrders(logger lager.Logger) ([]*models.PendingOrder, error)
+	// InsertPendingOrder records an order placed with the provider.
+	InsertPendingOrder(logger lager.Logger, order *models.PendingOrder) error
+	// RemovePendingOrder forgets the order of the VM with cid, if there is
+	// one.
+	RemovePendingOrder(logger lager.Logger, cid int32) error
+}
@@ -0,0 +1,18 @@
+package db
+
+import (
+	"github.com/jianqiu/vps/models"
+	"code.cloudfoundry.org/lager"
+)
+
+// PoolDB keeps the pools VMs are grouped in. A pool's Password is stored as
+// given, callers hash it before it gets here.
+//go:generate counterfeiter . PoolDB
+type PoolDB interface {
+	Pools(logger lager.Logger) ([]*models.Pool, error)
+	PoolByName(logger lager.Logger, name string) (*models.Pool, error)
+	PoolByUsername(logger lager.Logger, username string) (*models.Pool, error)
+	InsertPool(logger lager.Logger, pool *models.Pool) error
+	UpdatePool(logger lager.Logger, pool *models.Pool) error
+	DeletePool(logger lager.Logger, name string) error
+}
@@ -0,0 +1,19 @@
+package db
+
+import (
+	"github.com/jianqiu/vps/models"
+	"code.cloudfoundry.org/lager"
+)
+
+// ReservationDB earmarks free VMs for the orders of one deployment. The VMs
+// of a reservation are the reserved VMs that carry its id. A deployment may
+// hold several reservations of a pool, scheduled, active or partial, as
+// long as they do not overlap; its orders claim the VMs of any of them.
+//go:generate counterfeiter . ReservationDB
+type ReservationDB interface {
+	Reservations(logger lager.Logger) ([]*models.Reservation, error)
+	ReservationByID(logger lager.Logger, id string) (*models.Reservation, error)
+	ReserveVirtualGuests(logger lager.Logger, reservation *models.Reservation) error
+	ActivateReservation(logger lager.Logger, id string) error
+	ReleaseReservation(logger lager.Logger, id string) error
+}
@@ -0,0 +1,26 @@
+package db
+
+import (
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/lager"
+)
+
+//go:generate counterfeiter . SecretDB
+type SecretDB interface {
+	// VMSecret returns the secret of the VM with cid as it is stored, or
+	// ErrResourceNotFound when the VM has none.
+	VMSecret(logger lager.Logger, cid int32) (encryption.Encrypted, error)
+	// SetVMSecret stores the secret of the VM with cid, replacing the one
+	// it had.
+	SetVMSecret(logger lager.Logger, cid int32, secret encryption.Encrypted) error
+	// RemoveVMSecret forgets the secret of the VM with cid, if it has one.
+	RemoveVMSecret(logger lager.Logger, cid int32) error
+	// VMSecretTokenHash returns the SHA-256 hash, hex encoded, of the
+	// secret token the VM with cid was last provisioned with, or
+	// ErrResourceNotFound when it has been free since.
+	VMSecretTokenHash(logger lager.Logger, cid int32) (string, error)
+	// ReEncryptVMSecrets encrypts the secrets that were not encrypted with
+	// the key labelled activeLabel again with cryptor, which must encrypt
+	// with that key, and returns how many it encrypted.
+	ReEncryptVMSecrets(logger lager.Logger, activeLabel string, cryptor encryption.Cryptor) (int, error)
+}
@@ -0,0 +1,130 @@
+package sqldb
+
+import (
+	"database/sql"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/lager"
+)
+
+const schemaVersionID = "schema_version"
+
+func (db *SQLDB) SchemaVersion(logger lager.Logger) (int32, error) {
+	logger = logger.Session("schema-version")
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	var value string
+	row := db.one(logger, db.db, configurations,
+		ColumnList{"value"}, NoLockRow,
+		"id = ?", schemaVersionID,
+	)
+	err := row.Scan(&value)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	if err != nil {
+		logger.Error("failed-scanning-row", err)
+		return 0, db.convertSQLError(err)
+	}
+
+	version, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		logger.Error("invalid-schema-version", err, lager.Data{"value": value})
+		return 0, err
+	}
+
+	return int32(version), nil
+}
+
+func (db *SQLDB) SetSchemaVersion(logger lager.Logger, version int32) error {
+	logger = logger.Session("set-schema-version", lager.Data{"version": version})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	return db.transact(logger, "set-schema-version", func(logger lager.Logger, tx *sql.Tx) error {
+		_, err := db.upsert(logger, tx, configurations,
+			SQLAttributes{"id": schemaVersionID},
+			SQLAttributes{"value": strconv.Itoa(int(version))},
+		)
+		if err != nil {
+			logger.Error("failed-upserting-schema-version", err)
+			return db.convertSQLError(err)
+		}
+		return nil
+	})
+}
+
+// Settings share the configurations table with the schema version, under
+// ids of their own.
+const settingIDPrefix = "setting:"
+
+func (db *SQLDB) Settings(logger lager.Logger) (map[string]string, error) {
+	logger = logger.Session("settings")
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	rows, err := db.all(logger, db.db, configurations,
+		ColumnList{"id", "value"}, NoLockRow,
+		"id LIKE ?", settingIDPrefix+"%",
+	)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return nil, db.convertSQLError(err)
+	}
+	defer rows.Close()
+
+	settings := map[string]string{}
+	for rows.Next() {
+		var id, value string
+		err = rows.Scan(&id, &value)
+		if err != nil {
+			logger.Error("failed-scanning-row", err)
+			return nil, db.convertSQLError(err)
+		}
+		settings[strings.TrimPrefix(id, settingIDPrefix)] = value
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-getting-next-row", rows.Err())
+		return nil, db.convertSQLError(rows.Err())
+	}
+
+	return settings, nil
+}
+
+func (db *SQLDB) SetSetting(logger lager.Logger, name, value string) error {
+	logger = logger.Session("set-setting", lager.Data{"name": name})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	return db.transact(logger, "set-setting", func(logger lager.Logger, tx *sql.Tx) error {
+		_, err := db.upsert(logger, tx, configurations,
+			SQLAttributes{"id": settingIDPrefix + name},
+			SQLAttributes{"value": value},
+		)
+		if err != nil {
+			logger.Error("failed-upserting-setting", err)
+			return db.convertSQLError(err)
+		}
+		return nil
+	})
+}
+
+// RemoveSetting forgets the value of a setting, which is not an error when
+// it has none.
+func (db *SQLDB) RemoveSetting(logger lager.Logger, name string) error {
+	logger = logger.Session("remove-setting", lager.Data{"name": name})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	return db.transact(logger, "remove-setting", func(logger lager.Logger, tx *sql.Tx) error {
+		_, err := db.delete(logger, tx, configurations, "id = ?", settingIDPrefix+name)
+		if err != nil {
+			logger.Error("failed-removing-setting", err)
+			return db.convertSQLError(err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,19 @@
+package sqldb
+
+import "github.com/jianqiu/vps/models"
+
+var MySQLSkipsLockedRows = mysqlSkipsLockedRows
+
+var PeakReserved = peakReserved
+
+// SetSkipsLockedRows picks the ordering strategy instead of asking the
+// server for its version.
+func (db *SQLDB) SetSkipsLockedRows(skip bool) {
+	db.skipLockedMutex.Lock()
+	defer db.skipLockedMutex.Unlock()
+	db.skipLocked = &skip
+}
+
+func (db *SQLDB) ConvertSQLError(err error) *models.Error {
+	return db.convertSQLError(err)
+}
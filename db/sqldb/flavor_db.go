@@ -0,0 +1,181 @@
+package sqldb
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/models"
+)
+
+func (db *SQLDB) Flavors(logger lager.Logger) ([]*models.Flavor, error) {
+	logger = logger.Session("flavors")
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	rows, err := db.all(logger, db.db, flavors,
+		flavorColumns, NoLockRow,
+		"",
+	)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return nil, db.convertSQLError(err)
+	}
+	defer rows.Close()
+
+	results := []*models.Flavor{}
+	for rows.Next() {
+		flavor, err := db.fetchFlavor(logger, rows)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, flavor)
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-getting-next-row", rows.Err())
+		return nil, db.convertSQLError(rows.Err())
+	}
+
+	return results, nil
+}
+
+func (db *SQLDB) FlavorByName(logger lager.Logger, name string) (*models.Flavor, error) {
+	logger = logger.Session("flavor-by-name", lager.Data{"name": name})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	row := db.one(logger, db.db, flavors,
+		flavorColumns, NoLockRow,
+		"name = ?", name,
+	)
+	return db.fetchFlavor(logger, row)
+}
+
+func (db *SQLDB) InsertFlavor(logger lager.Logger, flavor *models.Flavor) error {
+	logger = logger.Session("insert-flavor", lager.Data{"name": flavor.Name})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	labels, err := marshalLabels(logger, flavor.Labels)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.insert(logger, db.db, flavors,
+		SQLAttributes{
+			"name":         flavor.Name,
+			"cpu":          flavor.CPU,
+			"memory_mb":    flavor.MemoryMb,
+			"public_vlan":  flavor.PublicVlan,
+			"private_vlan": flavor.PrivateVlan,
+			"labels":       labels,
+			"created_at":   db.clock.Now().UnixNano(),
+		},
+	)
+	if err != nil {
+		logger.Error("failed-inserting-flavor", err)
+		return db.convertSQLError(err)
+	}
+
+	return nil
+}
+
+// UpdateFlavor replaces the sizes, vlans and labels of the flavor named
+// flavor.Name.
+func (db *SQLDB) UpdateFlavor(logger lager.Logger, flavor *models.Flavor) error {
+	logger = logger.Session("update-flavor", lager.Data{"name": flavor.Name})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	labels, err := marshalLabels(logger, flavor.Labels)
+	if err != nil {
+		return err
+	}
+
+	result, err := db.update(logger, db.db, flavors,
+		SQLAttributes{
+			"cpu":          flavor.CPU,
+			"memory_mb":    flavor.MemoryMb,
+			"public_vlan":  flavor.PublicVlan,
+			"private_vlan": flavor.PrivateVlan,
+			"labels":       labels,
+		},
+		"name = ?", flavor.Name,
+	)
+	if err != nil {
+		logger.Error("failed-updating-flavor", err)
+		return db.convertSQLError(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return db.convertSQLError(err)
+	}
+	if rowsAffected == 0 {
+		return models.ErrResourceNotFound
+	}
+
+	return nil
+}
+
+func (db *SQLDB) DeleteFlavor(logger lager.Logger, name string) error {
+	logger = logger.Session("delete-flavor", lager.Data{"name": name})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	result, err := db.delete(logger, db.db, flavors, "name = ?", name)
+	if err != nil {
+		logger.Error("failed-removing-flavor", err)
+		return db.convertSQLError(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return db.convertSQLError(err)
+	}
+	if rowsAffected == 0 {
+		return models.ErrResourceNotFound
+	}
+
+	return nil
+}
+
+func (db *SQLDB) fetchFlavor(logger lager.Logger, scanner RowScanner) (*models.Flavor, error) {
+	var name, labels string
+	var cpu, memoryMb, publicVlan, privateVlan int32
+	err := scanner.Scan(&name, &cpu, &memoryMb, &publicVlan, &privateVlan, &labels)
+	if err != nil {
+		logger.Error("failed-scanning-row", err)
+		return nil, models.ErrResourceNotFound
+	}
+
+	flavor := &models.Flavor{
+		Name:        name,
+		CPU:         cpu,
+		MemoryMb:    memoryMb,
+		PublicVlan:  publicVlan,
+		PrivateVlan: privateVlan,
+	}
+	if labels != "" {
+		err = json.Unmarshal([]byte(labels), &flavor.Labels)
+		if err != nil {
+			logger.Error("failed-unmarshaling-labels", err)
+			return nil, models.NewError(models.ErrorTypeInvalidJSON, err.Error())
+		}
+	}
+
+	return flavor, nil
+}
+
+func marshalLabels(logger lager.Logger, labels map[string]string) (string, error) {
+	if len(labels) == 0 {
+		return "{}", nil
+	}
+
+	encoded, err := json.Marshal(labels)
+	if err != nil {
+		logger.Error("failed-to-marshal-labels", err)
+		return "", models.NewError(models.ErrorTypeInvalidJSON, err.Error())
+	}
+	return string(encoded), nil
+}
@@ -0,0 +1,73 @@
+package sqldb
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/models"
+)
+
+// AcquireLock first tries to take over a lock that owner already holds or
+// that has expired, and otherwise tries to create it. Both statements are
+// atomic on their own, so two servers racing for the same lock cannot both
+// win.
+func (db *SQLDB) AcquireLock(logger lager.Logger, name, owner string, ttl time.Duration) (bool, error) {
+	logger = logger.Session("acquire-lock", lager.Data{"name": name, "owner": owner})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	now := db.clock.Now()
+	expiresAt := now.Add(ttl).UnixNano()
+
+	result, err := db.update(logger, db.db, locks,
+		SQLAttributes{
+			"owner":      owner,
+			"expires_at": expiresAt,
+		},
+		"name = ? AND (owner = ? OR expires_at <= ?)", name, owner, now.UnixNano(),
+	)
+	if err != nil {
+		logger.Error("failed-updating-lock", err)
+		return false, db.convertSQLError(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, db.convertSQLError(err)
+	}
+	if rowsAffected > 0 {
+		return true, nil
+	}
+
+	_, err = db.insert(logger, db.db, locks,
+		SQLAttributes{
+			"name":       name,
+			"owner":      owner,
+			"expires_at": expiresAt,
+		},
+	)
+	if err != nil {
+		convertedErr := db.convertSQLError(err)
+		if convertedErr == models.ErrResourceExists {
+			return false, nil
+		}
+		logger.Error("failed-inserting-lock", err)
+		return false, convertedErr
+	}
+
+	return true, nil
+}
+
+func (db *SQLDB) ReleaseLock(logger lager.Logger, name, owner string) error {
+	logger = logger.Session("release-lock", lager.Data{"name": name, "owner": owner})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	_, err := db.delete(logger, db.db, locks, "name = ? AND owner = ?", name, owner)
+	if err != nil {
+		logger.Error("failed-deleting-lock", err)
+		return db.convertSQLError(err)
+	}
+
+	return nil
+}
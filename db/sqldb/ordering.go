@@ -0,0 +1,256 @@
+package sqldb
+
+import (
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/models"
+)
+
+// orderCandidates is how many VMs an orderer reads at once when the database
+// cannot skip locked rows.
+const orderCandidates = 10
+
+// OrderVirtualGuestToProvision claims one VM matching filter and moves it to
+// provisioning. A filter without a state only matches free VMs. A filter for
+// reserved VMs has to name the deployment, and only matches the VMs held by
+// a reservation of that deployment.
+//
+// Candidates are taken in cid order. Where the database can skip rows that
+// other transactions locked, concurrent orderers each lock a different
+// candidate instead of queueing up behind the first one. Otherwise the
+// candidates are read without a lock and claimed with an UPDATE that only
+// succeeds while the VM is still in the state it was read in, moving on to
+// the next candidate when another orderer got there first.
+func (db *SQLDB) OrderVirtualGuestToProvision(logger lager.Logger, filter models.VMFilter) (*models.VM, error) {
+	// the agent settings may hold credentials
+	logged := filter
+	logged.UserData = ""
+	logger = logger.Session("order-free-vm", lager.Data{"filter": logged})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	if filter.State == "" {
+		filter.State = models.StateFree
+	}
+	if filter.State == models.StateReserved && filter.DeploymentName == "" {
+		return nil, models.NewInvalidRecordError(models.ErrInvalidField{Field: "deploymentName", Message: "is required to order a reserved vm"})
+	}
+
+	if db.skipsLockedRows(logger) {
+		return db.orderSkippingLockedRows(logger, filter)
+	}
+	return db.orderByConditionalUpdate(logger, filter)
+}
+
+func (db *SQLDB) orderSkippingLockedRows(logger lager.Logger, filter models.VMFilter) (*models.VM, error) {
+	var vm *models.VM
+
+	err := db.transact(logger, "order-free-vm", func(logger lager.Logger, tx *sql.Tx) error {
+		var err error
+
+		wheres, values := orderWheres(filter)
+		row := tx.QueryRow(db.rebind(candidatesQuery(wheres, "LIMIT 1\nFOR UPDATE SKIP LOCKED")), values...)
+		vm, err = db.fetchVirtualGuest(logger, row, tx)
+		if err != nil {
+			logger.Error("failed-locking-vm", err)
+			return err
+		}
+
+		claimed, err := db.claimForProvisioning(logger, tx, vm, filter.UserData)
+		if err != nil {
+			return err
+		}
+		if !claimed {
+			return models.ErrResourceConflict
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return vm, nil
+}
+
+func (db *SQLDB) orderByConditionalUpdate(logger lager.Logger, filter models.VMFilter) (*models.VM, error) {
+	wheres, values := orderWheres(filter)
+	query := db.rebind(candidatesQuery(wheres, "LIMIT ?"))
+	values = append(values, orderCandidates)
+
+	// every round either claims a VM or finds all of its candidates claimed
+	// by others, which no longer match the filter's state afterwards
+	for {
+		candidates, err := db.fetchCandidates(logger, query, values)
+		if err != nil {
+			logger.Error("failed-fetching-candidates", err)
+			return nil, err
+		}
+		if len(candidates) == 0 {
+			return nil, models.ErrResourceNotFound
+		}
+
+		for _, candidate := range candidates {
+			claimed := false
+			err := db.transact(logger, "order-free-vm", func(logger lager.Logger, tx *sql.Tx) error {
+				var err error
+				claimed, err = db.claimForProvisioning(logger, tx, candidate, filter.UserData)
+				return err
+			})
+			if err != nil {
+				logger.Error("failed-claiming-vm", err, lager.Data{"cid": candidate.Cid})
+				return nil, err
+			}
+			if claimed {
+				return candidate, nil
+			}
+			logger.Debug("candidate-taken", lager.Data{"cid": candidate.Cid})
+		}
+	}
+}
+
+func (db *SQLDB) fetchCandidates(logger lager.Logger, query string, values []interface{}) ([]*models.VM, error) {
+	rows, err := db.db.Query(query, values...)
+	if err != nil {
+		return nil, db.convertSQLError(err)
+	}
+	defer rows.Close()
+
+	candidates := []*models.VM{}
+	for rows.Next() {
+		vm, err := db.fetchVirtualGuest(logger, rows, db.db)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, vm)
+	}
+
+	if rows.Err() != nil {
+		return nil, db.convertSQLError(rows.Err())
+	}
+
+	return candidates, nil
+}
+
+// claimForProvisioning moves vm to provisioning, provided it is still in the
+// state it was read in, and reports whether it was. The VM keeps userData,
+// when given, as its agent settings.
+func (db *SQLDB) claimForProvisioning(logger lager.Logger, tx *sql.Tx, vm *models.VM, userData string) (bool, error) {
+	if err := vm.ValidateTransitionTo(models.StateProvisioning); err != nil {
+		logger.Error("failed-to-transition-vm-to-provisioning", err)
+		return false, err
+	}
+
+	now := db.clock.Now().UnixNano()
+	result, err := db.update(logger, tx, virtualGuests,
+		SQLAttributes{
+			"state":      string(models.StateProvisioning),
+			"updated_at": now,
+		},
+		"cid = ? AND state = ?", vm.Cid, string(vm.State),
+	)
+	if err != nil {
+		return false, db.convertSQLError(err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, db.convertSQLError(err)
+	}
+	if affected == 0 {
+		return false, nil
+	}
+
+	if userData != "" {
+		err = db.storeUserData(logger, tx, vm.Cid, userData, now)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return true, db.recordTransition(logger, tx, vm, models.StateProvisioning, now)
+}
+
+// orderWheres selects the VMs matching filter that may be ordered with it: a
+// reserved VM only goes to the deployment of the reservation holding it.
+func orderWheres(filter models.VMFilter) ([]string, []interface{}) {
+	wheres, values := filterWheres(filter)
+	if filter.State == models.StateReserved {
+		wheres = append(wheres, fmt.Sprintf(
+			"reservation_id IN (SELECT id FROM %s WHERE %s.deployment_name = ? AND %s.pool = %s.pool)",
+			reservations, reservations, reservations, virtualGuests,
+		))
+		values = append(values, filter.DeploymentName)
+	}
+	return wheres, values
+}
+
+// candidatesQuery selects the VMs matching wheres in cid order, so that every
+// orderer walks the candidates the same way.
+func candidatesQuery(wheres []string, suffix string) string {
+	query := fmt.Sprintf("SELECT %s FROM %s\n", strings.Join(virtualGuestColumns, ", "), virtualGuests)
+	if len(wheres) > 0 {
+		query += "WHERE " + strings.Join(wheres, " AND ") + "\n"
+	}
+	return query + "ORDER BY cid\n" + suffix
+}
+
+// skipsLockedRows reports whether the database supports SELECT ... FOR
+// UPDATE SKIP LOCKED. The answer is looked up once; a failed lookup is
+// retried on the next order.
+func (db *SQLDB) skipsLockedRows(logger lager.Logger) bool {
+	db.skipLockedMutex.Lock()
+	defer db.skipLockedMutex.Unlock()
+
+	if db.skipLocked != nil {
+		return *db.skipLocked
+	}
+
+	// every supported Postgres, 9.5 and later, skips locked rows
+	supported := true
+	if db.flavor == MySQL {
+		var version string
+		err := db.db.QueryRow("SELECT VERSION()").Scan(&version)
+		if err != nil {
+			logger.Error("failed-reading-server-version", err)
+			return false
+		}
+		supported = mysqlSkipsLockedRows(version)
+		logger.Info("read-server-version", lager.Data{"version": version, "skip-locked": supported})
+	}
+
+	db.skipLocked = &supported
+	return supported
+}
+
+// mysqlSkipsLockedRows reports whether a MySQL server of the given version
+// supports SKIP LOCKED, which came with MySQL 8.0.1 and MariaDB 10.6.
+func mysqlSkipsLockedRows(version string) bool {
+	minimum := []int{8, 0, 1}
+	if strings.Contains(strings.ToLower(version), "mariadb") {
+		minimum = []int{10, 6, 0}
+	}
+
+	release := version
+	if i := strings.IndexAny(release, "-+ "); i >= 0 {
+		release = release[:i]
+	}
+
+	parts := strings.Split(release, ".")
+	for i, least := range minimum {
+		if i >= len(parts) {
+			return false
+		}
+		number, err := strconv.Atoi(parts[i])
+		if err != nil {
+			return false
+		}
+		if number != least {
+			return number > least
+		}
+	}
+	return true
+}
@@ -0,0 +1,176 @@
+package sqldb_test
+
+import (
+	"database/sql"
+	"fmt"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-sql-driver/mysql"
+	"github.com/jianqiu/vps/db/sqldb"
+	"github.com/jianqiu/vps/migration"
+	"github.com/jianqiu/vps/models"
+)
+
+// BenchmarkOrderVirtualGuestToProvision measures how fast many parallel
+// orderers drain a pool of identical free VMs, once for each ordering
+// strategy. It creates a scratch database on the server the connection
+// string points at, which the user needs the privilege for, and drops it
+// when done; the database the connection string names is left alone:
+//
+//	USE_SQL=postgres SQL_CONNECTION_STRING=postgres://user:pw@localhost/postgres?sslmode=disable \
+//	  go test ./db/sqldb -run NONE -bench Order -cpu 1,4,16
+func BenchmarkOrderVirtualGuestToProvision(b *testing.B) {
+	flavor := os.Getenv("USE_SQL")
+	connectionString := os.Getenv("SQL_CONNECTION_STRING")
+	if flavor == "" || connectionString == "" {
+		b.Skip("set USE_SQL and SQL_CONNECTION_STRING to benchmark against a database")
+	}
+
+	logger := lager.NewLogger("benchmark")
+	conn, drop := scratchDatabase(b, flavor, connectionString)
+	defer drop()
+
+	db := sqldb.NewSQLDB(conn, clock.NewClock(), flavor, sqldb.RetryPolicy{
+		MaxAttempts:    5,
+		InitialBackoff: 10 * time.Millisecond,
+		MaxBackoff:     time.Second,
+		Jitter:         0.5,
+	})
+	migrate(b, logger, db, conn, flavor)
+
+	strategies := map[string]bool{"skip-locked": true, "conditional-update": false}
+	for name, skipLocked := range strategies {
+		if skipLocked && flavor == sqldb.MySQL && !supportsSkipLocked(conn) {
+			continue
+		}
+
+		b.Run(name, func(b *testing.B) {
+			db.SetSkipsLockedRows(skipLocked)
+			fillPool(b, logger, db, conn, b.N)
+			b.SetParallelism(8)
+			b.ResetTimer()
+
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					_, err := db.OrderVirtualGuestToProvision(logger, models.VMFilter{CPU: 4, MemoryMb: 1024})
+					if err != nil {
+						b.Error(err)
+					}
+				}
+			})
+		})
+	}
+}
+
+// scratchDatabase creates a database of its own on the server of
+// connectionString and connects to it. drop closes the connection and drops
+// the database.
+func scratchDatabase(b *testing.B, flavor, connectionString string) (conn *sql.DB, drop func()) {
+	server, err := sql.Open(flavor, connectionString)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	name := fmt.Sprintf("vps_bench_%d_%d", os.Getpid(), time.Now().UnixNano())
+	if _, err := server.Exec("CREATE DATABASE " + name); err != nil {
+		server.Close()
+		b.Fatal(err)
+	}
+
+	drop = func() {
+		// the database cannot be dropped while connections to it are open
+		if conn != nil {
+			conn.Close()
+		}
+		if _, err := server.Exec("DROP DATABASE " + name); err != nil {
+			b.Error(err)
+		}
+		server.Close()
+	}
+
+	conn, err = sql.Open(flavor, scratchConnectionString(b, flavor, connectionString, name))
+	if err != nil {
+		drop()
+		b.Fatal(err)
+	}
+	return conn, drop
+}
+
+// scratchConnectionString is connectionString with the database replaced
+// by the one named name.
+func scratchConnectionString(b *testing.B, flavor, connectionString, name string) string {
+	if flavor == sqldb.MySQL {
+		config, err := mysql.ParseDSN(connectionString)
+		if err != nil {
+			b.Fatal(err)
+		}
+		config.DBName = name
+		return config.FormatDSN()
+	}
+
+	u, err := url.Parse(connectionString)
+	if err != nil || u.Scheme == "" {
+		b.Fatal("SQL_CONNECTION_STRING must be a postgres:// URL")
+	}
+	u.Path = "/" + name
+	return u.String()
+}
+
+func migrate(b *testing.B, logger lager.Logger, db *sqldb.SQLDB, conn *sql.DB, flavor string) {
+	err := db.CreateConfigurationsTable(logger)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	migrationsDone := make(chan struct{})
+	manager := migration.NewManager(logger, db, conn, migrationsDone, clock.NewClock(), flavor)
+	signals := make(chan os.Signal)
+	errs := make(chan error, 1)
+	go func() {
+		errs <- manager.Run(signals, make(chan struct{}))
+	}()
+
+	select {
+	case <-migrationsDone:
+		close(signals)
+	case err := <-errs:
+		b.Fatal(err)
+	}
+}
+
+func supportsSkipLocked(conn *sql.DB) bool {
+	var version string
+	if err := conn.QueryRow("SELECT VERSION()").Scan(&version); err != nil {
+		return false
+	}
+	return sqldb.MySQLSkipsLockedRows(version)
+}
+
+// fillPool replaces the VMs of the scratch database with size free ones.
+func fillPool(b *testing.B, logger lager.Logger, db *sqldb.SQLDB, conn *sql.DB, size int) {
+	for _, table := range []string{"virtual_guests", "outbox_events"} {
+		if _, err := conn.Exec("DELETE FROM " + table); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	for i := 1; i <= size; i++ {
+		err := db.InsertVirtualGuestToPool(logger, &models.VM{
+			Cid:      int32(i),
+			Hostname: fmt.Sprintf("vm-%d.bench", i),
+			IP:       strfmt.IPv4(fmt.Sprintf("10.%d.%d.%d", i>>16&0xff, i>>8&0xff, i&0xff)),
+			CPU:      4,
+			MemoryMb: 1024,
+			State:    models.StateFree,
+		})
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
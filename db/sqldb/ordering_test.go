@@ -0,0 +1,181 @@
+package sqldb_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/lagertest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jianqiu/vps/db/sqldb"
+	"github.com/jianqiu/vps/db/sqldb/sqltest"
+	"github.com/jianqiu/vps/models"
+)
+
+var _ = Describe("Ordering", func() {
+	Describe("OrderVirtualGuestToProvision", func() {
+		var (
+			logger    *lagertest.TestLogger
+			connector *sqltest.RecordingConnector
+			db        *sqldb.SQLDB
+			filter    models.VMFilter
+		)
+
+		BeforeEach(func() {
+			logger = lagertest.NewTestLogger("test")
+			connector = sqltest.NewRecordingConnector()
+			filter = models.VMFilter{CPU: 4, Pool: models.DefaultPool}
+
+			connector.SetRows("FROM virtual_guests", vmRow(1234, models.StateFree), vmRow(1235, models.StateFree))
+		})
+
+		// claims are the conditional updates that move a vm to provisioning
+		claims := func() []sqltest.Statement {
+			return connector.Statements("UPDATE virtual_guests")
+		}
+
+		Context("when the database skips locked rows", func() {
+			BeforeEach(func() {
+				db = sqldb.NewSQLDB(sql.OpenDB(connector), clock.NewClock(), sqldb.MySQL, sqldb.RetryPolicy{MaxAttempts: 1})
+				connector.SetRows("SELECT VERSION()", []driver.Value{"8.0.36"})
+			})
+
+			It("locks one free candidate, skipping those others hold, and claims it", func() {
+				vm, err := db.OrderVirtualGuestToProvision(logger, filter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(vm.Cid).To(Equal(int32(1234)))
+
+				selects := connector.Statements("SELECT virtual_guests")
+				Expect(selects).To(HaveLen(1))
+				Expect(selects[0].Query).To(ContainSubstring("ORDER BY cid\nLIMIT 1\nFOR UPDATE SKIP LOCKED"))
+				Expect(selects[0].Args).To(ContainElement(string(models.StateFree)))
+
+				Expect(claims()).To(HaveLen(1))
+				Expect(claims()[0].Query).To(ContainSubstring("WHERE cid = ? AND state = ?"))
+				Expect(claims()[0].Args).To(ContainElement(int64(1234)))
+				Expect(claims()[0].Args).To(ContainElement(string(models.StateFree)))
+				Expect(connector.Statements("INSERT INTO outbox_events")).To(HaveLen(1))
+			})
+
+			It("reports a conflict when the locked vm changed anyway", func() {
+				connector.SetRowsAffected("UPDATE virtual_guests", 0)
+
+				_, err := db.OrderVirtualGuestToProvision(logger, filter)
+				Expect(err).To(Equal(models.ErrResourceConflict))
+				Expect(connector.Statements("INSERT INTO outbox_events")).To(BeEmpty())
+			})
+
+			It("reports that no vm is free", func() {
+				connector.SetRows("FROM virtual_guests")
+
+				_, err := db.OrderVirtualGuestToProvision(logger, filter)
+				Expect(models.ConvertError(err).Type).To(Equal(models.ErrorTypeResourceNotFound))
+				Expect(claims()).To(BeEmpty())
+			})
+		})
+
+		Context("when the database cannot skip locked rows", func() {
+			BeforeEach(func() {
+				db = sqldb.NewSQLDB(sql.OpenDB(connector), clock.NewClock(), sqldb.MySQL, sqldb.RetryPolicy{MaxAttempts: 1})
+				connector.SetRows("SELECT VERSION()", []driver.Value{"5.7.44-log"})
+			})
+
+			It("reads candidates without a lock and claims the first with a conditional update", func() {
+				vm, err := db.OrderVirtualGuestToProvision(logger, filter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(vm.Cid).To(Equal(int32(1234)))
+
+				selects := connector.Statements("SELECT virtual_guests")
+				Expect(selects).To(HaveLen(1))
+				Expect(selects[0].Query).To(HaveSuffix("ORDER BY cid\nLIMIT ?"))
+				Expect(selects[0].Args[len(selects[0].Args)-1]).To(Equal(int64(10)))
+
+				Expect(claims()).To(HaveLen(1))
+				Expect(claims()[0].Query).To(ContainSubstring("WHERE cid = ? AND state = ?"))
+				Expect(claims()[0].Args).To(ContainElement(int64(1234)))
+			})
+
+			It("moves on to the next candidate when another orderer claimed one first", func() {
+				connector.SetRowsAffected("UPDATE virtual_guests", 0)
+
+				vm, err := db.OrderVirtualGuestToProvision(logger, filter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(vm.Cid).To(Equal(int32(1235)))
+
+				Expect(claims()).To(HaveLen(2))
+				Expect(claims()[0].Args).To(ContainElement(int64(1234)))
+				Expect(claims()[1].Args).To(ContainElement(int64(1235)))
+				Expect(connector.Statements("INSERT INTO outbox_events")).To(HaveLen(1))
+			})
+
+			It("reads the candidates again once others claimed all of them", func() {
+				connector.SetRowsAffected("UPDATE virtual_guests", 0, 0)
+
+				vm, err := db.OrderVirtualGuestToProvision(logger, filter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(vm.Cid).To(Equal(int32(1234)))
+
+				Expect(connector.Statements("SELECT virtual_guests")).To(HaveLen(2))
+				Expect(claims()).To(HaveLen(3))
+			})
+
+			It("reports that no vm is free", func() {
+				connector.SetRows("FROM virtual_guests")
+
+				_, err := db.OrderVirtualGuestToProvision(logger, filter)
+				Expect(err).To(Equal(models.ErrResourceNotFound))
+				Expect(claims()).To(BeEmpty())
+			})
+		})
+
+		Context("when ordering a reserved vm", func() {
+			BeforeEach(func() {
+				db = sqldb.NewSQLDB(sql.OpenDB(connector), clock.NewClock(), sqldb.Postgres, sqldb.RetryPolicy{MaxAttempts: 1})
+				connector.SetRows("FROM virtual_guests", vmRow(1234, models.StateReserved))
+			})
+
+			It("only claims the vms held by a reservation of the deployment", func() {
+				vm, err := db.OrderVirtualGuestToProvision(logger, models.VMFilter{State: models.StateReserved, DeploymentName: "deployment", Pool: models.DefaultPool})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(vm.Cid).To(Equal(int32(1234)))
+
+				selects := connector.Statements("SELECT")
+				Expect(selects[0].Query).To(ContainSubstring("reservation_id IN (SELECT id FROM reservations WHERE reservations.deployment_name = $"))
+				Expect(selects[0].Query).To(ContainSubstring("reservations.pool = virtual_guests.pool"))
+				Expect(selects[0].Args).To(ContainElement("deployment"))
+			})
+
+			It("requires the deployment", func() {
+				_, err := db.OrderVirtualGuestToProvision(logger, models.VMFilter{State: models.StateReserved, Pool: models.DefaultPool})
+				Expect(models.ConvertError(err).Type).To(Equal(models.ErrorTypeInvalidRecord))
+				Expect(connector.Statements("")).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("MySQLSkipsLockedRows", func() {
+		versions := map[string]bool{
+			"8.0.1":            true,
+			"8.0.36":           true,
+			"8.4.0-commercial": true,
+			"8.0.0-dmr":        false,
+			"5.7.44-log":       false,
+			"5.6.51":           false,
+			"10.6.16-MariaDB-1:10.6.16+maria~ubu2004": true,
+			"11.2.2-MariaDB":        true,
+			"10.5.23-MariaDB":       false,
+			"5.5.5-10.3.39-MariaDB": false,
+			"garbage":               false,
+			"":                      false,
+		}
+
+		for version, supported := range versions {
+			version, supported := version, supported
+			It("knows whether "+version+" skips locked rows", func() {
+				Expect(sqldb.MySQLSkipsLockedRows(version)).To(Equal(supported))
+			})
+		}
+	})
+})
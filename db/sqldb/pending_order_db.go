@@ -0,0 +1,90 @@
+package sqldb
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/models"
+)
+
+func (db *SQLDB) PendingOrders(logger lager.Logger) ([]*models.PendingOrder, error) {
+	logger = logger.Session("pending-orders")
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	rows, err := db.all(logger, db.db, pendingOrders,
+		pendingOrderColumns, NoLockRow,
+		"",
+	)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return nil, db.convertSQLError(err)
+	}
+	defer rows.Close()
+
+	orders := []*models.PendingOrder{}
+	for rows.Next() {
+		order := &models.PendingOrder{}
+		var orderedAt int64
+		err := rows.Scan(
+			&order.Cid,
+			&order.Pool,
+			&order.CPU,
+			&order.MemoryMb,
+			&order.PublicVlan,
+			&order.PrivateVlan,
+			&orderedAt,
+		)
+		if err != nil {
+			logger.Error("failed-scanning-row", err)
+			return nil, db.convertSQLError(err)
+		}
+		order.OrderedAt = time.Unix(0, orderedAt).UTC()
+		orders = append(orders, order)
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-getting-next-row", rows.Err())
+		return nil, db.convertSQLError(rows.Err())
+	}
+
+	return orders, nil
+}
+
+func (db *SQLDB) InsertPendingOrder(logger lager.Logger, order *models.PendingOrder) error {
+	logger = logger.Session("insert-pending-order", lager.Data{"cid": order.Cid, "pool": order.Pool})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	_, err := db.insert(logger, db.db, pendingOrders,
+		SQLAttributes{
+			"cid":          order.Cid,
+			"pool":         order.Pool,
+			"cpu":          order.CPU,
+			"memory_mb":    order.MemoryMb,
+			"public_vlan":  order.PublicVlan,
+			"private_vlan": order.PrivateVlan,
+			"ordered_at":   order.OrderedAt.UnixNano(),
+		},
+	)
+	if err != nil {
+		logger.Error("failed-inserting-pending-order", err)
+		return db.convertSQLError(err)
+	}
+
+	return nil
+}
+
+func (db *SQLDB) RemovePendingOrder(logger lager.Logger, cid int32) error {
+	logger = logger.Session("remove-pending-order", lager.Data{"cid": cid})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	_, err := db.delete(logger, db.db, pendingOrders, "cid = ?", cid)
+	if err != nil {
+		logger.Error("failed-removing-pending-order", err)
+		return db.convertSQLError(err)
+	}
+
+	return nil
+}
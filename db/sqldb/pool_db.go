@@ -0,0 +1,178 @@
+package sqldb
+
+import (
+	"database/sql"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/models"
+)
+
+func (db *SQLDB) Pools(logger lager.Logger) ([]*models.Pool, error) {
+	logger = logger.Session("pools")
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	rows, err := db.all(logger, db.db, pools,
+		poolColumns, NoLockRow,
+		"",
+	)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return nil, db.convertSQLError(err)
+	}
+	defer rows.Close()
+
+	results := []*models.Pool{}
+	for rows.Next() {
+		pool, err := db.fetchPool(logger, rows)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, pool)
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-getting-next-row", rows.Err())
+		return nil, db.convertSQLError(rows.Err())
+	}
+
+	return results, nil
+}
+
+func (db *SQLDB) PoolByName(logger lager.Logger, name string) (*models.Pool, error) {
+	logger = logger.Session("pool-by-name", lager.Data{"name": name})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	row := db.one(logger, db.db, pools,
+		poolColumns, NoLockRow,
+		"name = ?", name,
+	)
+	return db.fetchPool(logger, row)
+}
+
+// PoolByUsername finds the pool whose credentials have username. Pools
+// without credentials are never found.
+func (db *SQLDB) PoolByUsername(logger lager.Logger, username string) (*models.Pool, error) {
+	logger = logger.Session("pool-by-username", lager.Data{"username": username})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	if username == "" {
+		return nil, models.ErrResourceNotFound
+	}
+
+	row := db.one(logger, db.db, pools,
+		poolColumns, NoLockRow,
+		"username = ?", username,
+	)
+	return db.fetchPool(logger, row)
+}
+
+func (db *SQLDB) InsertPool(logger lager.Logger, pool *models.Pool) error {
+	logger = logger.Session("insert-pool", lager.Data{"name": pool.Name})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	_, err := db.insert(logger, db.db, pools,
+		SQLAttributes{
+			"name":          pool.Name,
+			"description":   pool.Description,
+			"username":      pool.Username,
+			"password_hash": pool.Password,
+			"created_at":    db.clock.Now().UnixNano(),
+		},
+	)
+	if err != nil {
+		logger.Error("failed-inserting-pool", err)
+		return db.convertSQLError(err)
+	}
+
+	return nil
+}
+
+// UpdatePool replaces the description and credentials of the pool named
+// pool.Name.
+func (db *SQLDB) UpdatePool(logger lager.Logger, pool *models.Pool) error {
+	logger = logger.Session("update-pool", lager.Data{"name": pool.Name})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	result, err := db.update(logger, db.db, pools,
+		SQLAttributes{
+			"description":   pool.Description,
+			"username":      pool.Username,
+			"password_hash": pool.Password,
+		},
+		"name = ?", pool.Name,
+	)
+	if err != nil {
+		logger.Error("failed-updating-pool", err)
+		return db.convertSQLError(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return db.convertSQLError(err)
+	}
+	if rowsAffected == 0 {
+		return models.ErrResourceNotFound
+	}
+
+	return nil
+}
+
+// DeletePool removes a pool that has no VMs left; a pool that still has some
+// comes back with a conflict.
+func (db *SQLDB) DeletePool(logger lager.Logger, name string) error {
+	logger = logger.Session("delete-pool", lager.Data{"name": name})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	return db.transact(logger, "delete-pool", func(logger lager.Logger, tx *sql.Tx) error {
+		row := db.one(logger, tx, pools,
+			poolColumns, LockRow,
+			"name = ?", name,
+		)
+		_, err := db.fetchPool(logger, row)
+		if err != nil {
+			logger.Error("failed-locking-pool", err)
+			return err
+		}
+
+		var count int
+		err = tx.QueryRow(db.rebind("SELECT COUNT(*) FROM "+virtualGuests+" WHERE pool = ?"), name).Scan(&count)
+		if err != nil {
+			logger.Error("failed-counting-vms", err)
+			return db.convertSQLError(err)
+		}
+		if count > 0 {
+			logger.Info("pool-not-empty", lager.Data{"vms": count})
+			return models.NewResourceConflictError(models.ErrInvalidField{Field: "pool", Message: "still has vms"})
+		}
+
+		_, err = db.delete(logger, tx, pools, "name = ?", name)
+		if err != nil {
+			logger.Error("failed-removing-pool", err)
+			return db.convertSQLError(err)
+		}
+
+		return nil
+	})
+}
+
+func (db *SQLDB) fetchPool(logger lager.Logger, scanner RowScanner) (*models.Pool, error) {
+	var name, description, username, passwordHash string
+	err := scanner.Scan(&name, &description, &username, &passwordHash)
+	if err != nil {
+		logger.Error("failed-scanning-row", err)
+		return nil, models.ErrResourceNotFound
+	}
+
+	return &models.Pool{
+		Name:        name,
+		Description: description,
+		Username:    username,
+		Password:    passwordHash,
+	}, nil
+}
@@ -25,8 +25,24 @@ type SQLAttributes map[string]interface{}
 
 type ColumnList []string
 
+// sqlExpression is an update value that is written into the query as is,
+// e.g. to increment a column in place.
+type sqlExpression string
+
 const (
-	virtualGuests = "virtual_guests"
+	configurations    = "configurations"
+	virtualGuests     = "virtual_guests"
+	pools             = "pools"
+	flavors           = "flavors"
+	reservations      = "reservations"
+	webhooks          = "webhooks"
+	outboxEvents      = "outbox_events"
+	webhookDeliveries = "webhook_deliveries"
+	locks             = "locks"
+	vmSecrets         = "vm_secrets"
+	vmUserData        = "vm_user_data"
+	pendingOrders     = "pending_orders"
+	vmSecretTokens    = "vm_secret_tokens"
 )
 
 var (
@@ -40,6 +56,72 @@ var (
 		virtualGuests + ".public_vlan",
 		virtualGuests + ".deployment_name",
 		virtualGuests + ".state",
+		virtualGuests + ".created_at",
+		virtualGuests + ".updated_at",
+		virtualGuests + ".pool",
+	}
+
+	poolColumns = ColumnList{
+		pools + ".name",
+		pools + ".description",
+		pools + ".username",
+		pools + ".password_hash",
+	}
+
+	flavorColumns = ColumnList{
+		flavors + ".name",
+		flavors + ".cpu",
+		flavors + ".memory_mb",
+		flavors + ".public_vlan",
+		flavors + ".private_vlan",
+		flavors + ".labels",
+	}
+
+	reservationColumns = ColumnList{
+		reservations + ".id",
+		reservations + ".deployment_name",
+		reservations + ".pool",
+		reservations + ".count",
+		reservations + ".filter",
+		reservations + ".starts_at",
+		reservations + ".expires_at",
+		reservations + ".status",
+	}
+
+	webhookColumns = ColumnList{
+		webhooks + ".id",
+		webhooks + ".url",
+		webhooks + ".secret",
+		webhooks + ".events",
+	}
+
+	outboxEventColumns = ColumnList{
+		outboxEvents + ".id",
+		outboxEvents + ".event_type",
+		outboxEvents + ".payload",
+	}
+
+	webhookDeliveryColumns = ColumnList{
+		webhookDeliveries + ".id",
+		webhookDeliveries + ".webhook_id",
+		webhookDeliveries + ".event_id",
+		webhookDeliveries + ".event_type",
+		webhookDeliveries + ".url",
+		webhookDeliveries + ".payload",
+		webhookDeliveries + ".state",
+		webhookDeliveries + ".attempts",
+		webhookDeliveries + ".last_error",
+		webhookDeliveries + ".next_attempt_at",
+	}
+
+	pendingOrderColumns = ColumnList{
+		pendingOrders + ".cid",
+		pendingOrders + ".pool",
+		pendingOrders + ".cpu",
+		pendingOrders + ".memory_mb",
+		pendingOrders + ".public_vlan",
+		pendingOrders + ".private_vlan",
+		pendingOrders + ".ordered_at",
 	}
 )
 
@@ -283,6 +365,10 @@ func (db *SQLDB) update(logger lager.Logger, q Queryable, table string, updates
 	bindings := make([]interface{}, 0, updateCount+len(whereBindings))
 
 	for column, value := range updates {
+		if expression, ok := value.(sqlExpression); ok {
+			updateQueries = append(updateQueries, fmt.Sprintf("%s = %s", column, expression))
+			continue
+		}
 		updateQueries = append(updateQueries, fmt.Sprintf("%s = ?", column))
 		bindings = append(bindings, value)
 	}
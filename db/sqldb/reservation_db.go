@@ -0,0 +1,550 @@
+package sqldb
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/go-openapi/strfmt"
+	"github.com/jianqiu/vps/models"
+)
+
+func (db *SQLDB) Reservations(logger lager.Logger) ([]*models.Reservation, error) {
+	logger = logger.Session("reservations")
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	rows, err := db.all(logger, db.db, reservations,
+		reservationColumns, NoLockRow,
+		"",
+	)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return nil, db.convertSQLError(err)
+	}
+	defer rows.Close()
+
+	results := []*models.Reservation{}
+	for rows.Next() {
+		reservation, err := db.fetchReservation(logger, rows)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, reservation)
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-getting-next-row", rows.Err())
+		return nil, db.convertSQLError(rows.Err())
+	}
+	rows.Close()
+
+	for _, reservation := range results {
+		reservation.Cids, err = db.reservedCids(logger, db.db, reservation)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return results, nil
+}
+
+func (db *SQLDB) ReservationByID(logger lager.Logger, id string) (*models.Reservation, error) {
+	logger = logger.Session("reservation-by-id", lager.Data{"id": id})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	row := db.one(logger, db.db, reservations,
+		reservationColumns, NoLockRow,
+		"id = ?", id,
+	)
+	reservation, err := db.fetchReservation(logger, row)
+	if err != nil {
+		return nil, err
+	}
+
+	reservation.Cids, err = db.reservedCids(logger, db.db, reservation)
+	if err != nil {
+		return nil, err
+	}
+	return reservation, nil
+}
+
+// ReserveVirtualGuests records reservation and, unless it starts later,
+// moves reservation.Count free VMs of reservation.Pool that match its filter
+// to reserved for its deployment, all at once: when fewer VMs match, none is
+// reserved and the conflict says how many there are. A reservation is
+// refused when its deployment holds another one of the pool that overlaps
+// it, and one naming a flavor is refused as well when the reservations of
+// the flavor overlapping it would then hold more VMs than the pool has of the
+// flavor. The reservation comes back with its status, the reserved cids and
+// when it starts and expires.
+func (db *SQLDB) ReserveVirtualGuests(logger lager.Logger, reservation *models.Reservation) error {
+	logger = logger.Session("reserve-vms", lager.Data{"id": reservation.ID, "deployment": reservation.DeploymentName, "count": reservation.Count})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	filter := models.VMFilter{}
+	if reservation.Filter != nil {
+		filter = *reservation.Filter
+	}
+	filter.Pool = reservation.Pool
+	filter.State = models.StateFree
+	filter.DeploymentName = ""
+
+	encodedFilter, err := json.Marshal(&filter)
+	if err != nil {
+		logger.Error("failed-to-marshal-filter", err)
+		return models.NewError(models.ErrorTypeInvalidJSON, err.Error())
+	}
+
+	return db.transact(logger, "reserve-vms", func(logger lager.Logger, tx *sql.Tx) error {
+		// the reservations of a pool are checked against each other one at
+		// a time
+		row := db.one(logger, tx, pools,
+			poolColumns, LockRow,
+			"name = ?", reservation.Pool,
+		)
+		_, err := db.fetchPool(logger, row)
+		if err != nil {
+			logger.Error("failed-locking-pool", err)
+			return err
+		}
+
+		now := db.clock.Now()
+		startsAt := time.Time(reservation.StartsAt)
+		if startsAt.Before(now) {
+			startsAt = now
+		}
+		expiresAt := startsAt.Add(time.Duration(reservation.TTL) * time.Second)
+
+		err = db.checkOverlap(logger, tx, reservation, startsAt, expiresAt)
+		if err != nil {
+			return err
+		}
+
+		if filter.Flavor != "" {
+			err = db.checkCapacity(logger, tx, filter, reservation.Count, startsAt, expiresAt)
+			if err != nil {
+				return err
+			}
+		}
+
+		status := models.ReservationStatusActive
+		if startsAt.After(now) {
+			status = models.ReservationStatusScheduled
+		}
+
+		_, err = db.insert(logger, tx, reservations,
+			SQLAttributes{
+				"id":              reservation.ID,
+				"deployment_name": reservation.DeploymentName,
+				"pool":            reservation.Pool,
+				"count":           reservation.Count,
+				"filter":          string(encodedFilter),
+				"starts_at":       startsAt.UnixNano(),
+				"expires_at":      expiresAt.UnixNano(),
+				"status":          string(status),
+				"created_at":      now.UnixNano(),
+			},
+		)
+		if err != nil {
+			logger.Error("failed-inserting-reservation", err)
+			return db.convertSQLError(err)
+		}
+
+		cids := []int32{}
+		if status == models.ReservationStatusActive {
+			cids, err = db.reserveFree(logger, tx, reservation, filter, true)
+			if err != nil {
+				return err
+			}
+		}
+
+		reservation.Filter = &filter
+		reservation.Cids = cids
+		reservation.Status = status
+		reservation.StartsAt = strfmt.DateTime(startsAt.UTC())
+		reservation.ExpiresAt = strfmt.DateTime(expiresAt.UTC())
+		return nil
+	})
+}
+
+// ActivateReservation reserves the VMs of a scheduled reservation that has
+// started. Free VMs matching its filter are reserved up to its count; when
+// fewer are free, the reservation holds what there is and is partial rather
+// than active.
+func (db *SQLDB) ActivateReservation(logger lager.Logger, id string) error {
+	logger = logger.Session("activate-reservation", lager.Data{"id": id})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	return db.transact(logger, "activate-reservation", func(logger lager.Logger, tx *sql.Tx) error {
+		row := db.one(logger, tx, reservations,
+			reservationColumns, LockRow,
+			"id = ?", id,
+		)
+		reservation, err := db.fetchReservation(logger, row)
+		if err != nil {
+			logger.Error("failed-locking-reservation", err)
+			return err
+		}
+		if reservation.Status != models.ReservationStatusScheduled {
+			logger.Debug("already-active")
+			return nil
+		}
+
+		cids, err := db.reserveFree(logger, tx, reservation, *reservation.Filter, false)
+		if err != nil {
+			return err
+		}
+		status := models.ReservationStatusActive
+		if int32(len(cids)) < reservation.Count {
+			logger.Info("reserved-fewer-vms", lager.Data{"count": reservation.Count, "reserved": len(cids)})
+			status = models.ReservationStatusPartial
+		}
+
+		_, err = db.update(logger, tx, reservations,
+			SQLAttributes{"status": string(status)},
+			"id = ?", id,
+		)
+		if err != nil {
+			logger.Error("failed-updating-reservation", err)
+			return db.convertSQLError(err)
+		}
+
+		return nil
+	})
+}
+
+// reserveFree moves up to reservation.Count free VMs matching filter to
+// reserved for the deployment of reservation, or none at all when all is
+// set and fewer match.
+func (db *SQLDB) reserveFree(logger lager.Logger, tx *sql.Tx, reservation *models.Reservation, filter models.VMFilter, all bool) ([]int32, error) {
+	// orderers skip the VMs locked here instead of waiting for the
+	// reservation, where the database allows it
+	lock := "FOR UPDATE"
+	if db.skipsLockedRows(logger) {
+		lock = "FOR UPDATE SKIP LOCKED"
+	}
+
+	wheres, values := filterWheres(filter)
+	vms, err := db.fetchVMs(logger, tx, db.rebind(candidatesQuery(wheres, "LIMIT ?\n"+lock)), append(values, reservation.Count)...)
+	if err != nil {
+		logger.Error("failed-locking-vms", err)
+		return nil, err
+	}
+	if all && int32(len(vms)) < reservation.Count {
+		logger.Info("not-enough-free-vms", lager.Data{"free": len(vms)})
+		return nil, models.NewResourceConflictError(models.ErrInvalidField{
+			Field:   "count",
+			Message: fmt.Sprintf("only %d free vms match", len(vms)),
+		})
+	}
+
+	now := db.clock.Now().UnixNano()
+	cids := []int32{}
+	for _, vm := range vms {
+		_, err = db.update(logger, tx, virtualGuests,
+			SQLAttributes{
+				"state":           string(models.StateReserved),
+				"deployment_name": reservation.DeploymentName,
+				"reservation_id":  reservation.ID,
+				"updated_at":      now,
+			},
+			"cid = ?", vm.Cid,
+		)
+		if err != nil {
+			return nil, db.convertSQLError(err)
+		}
+
+		vm.DeploymentName = reservation.DeploymentName
+		err = db.recordTransition(logger, tx, vm, models.StateReserved, now)
+		if err != nil {
+			return nil, err
+		}
+		cids = append(cids, vm.Cid)
+	}
+
+	return cids, nil
+}
+
+// checkOverlap refuses reservation when its deployment holds another
+// reservation of the pool between from and to.
+func (db *SQLDB) checkOverlap(logger lager.Logger, tx *sql.Tx, reservation *models.Reservation, from, to time.Time) error {
+	rows, err := db.all(logger, tx, reservations,
+		reservationColumns, NoLockRow,
+		"pool = ? AND deployment_name = ?", reservation.Pool, reservation.DeploymentName,
+	)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return db.convertSQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		other, err := db.fetchReservation(logger, rows)
+		if err != nil {
+			return err
+		}
+		if time.Time(other.StartsAt).Before(to) && time.Time(other.ExpiresAt).After(from) {
+			logger.Info("overlapping-reservation", lager.Data{"other": other.ID})
+			return models.NewResourceConflictError(models.ErrInvalidField{
+				Field: "startsAt",
+				Message: fmt.Sprintf("deployment %s already holds reservation %s from %s to %s",
+					other.DeploymentName, other.ID,
+					time.Time(other.StartsAt).UTC().Format(time.RFC3339), time.Time(other.ExpiresAt).UTC().Format(time.RFC3339)),
+			})
+		}
+	}
+	if rows.Err() != nil {
+		logger.Error("failed-getting-next-row", rows.Err())
+		return db.convertSQLError(rows.Err())
+	}
+
+	return nil
+}
+
+// checkCapacity refuses count more VMs of the flavor of filter between from
+// and to when the reservations of the flavor in its pool already hold so
+// many in that window that the pool has too few VMs of the flavor left.
+// Only the free and reserved VMs are counted: those in use, failed or on
+// their way out of the pool may not be back by the time a reservation
+// starts.
+func (db *SQLDB) checkCapacity(logger lager.Logger, tx *sql.Tx, filter models.VMFilter, count int32, from, to time.Time) error {
+	sizes := filter
+	sizes.State = ""
+	wheres, values := filterWheres(sizes)
+	wheres = append(wheres, "state IN (?, ?)")
+	values = append(values, string(models.StateFree), string(models.StateReserved))
+
+	var capacity int32
+	err := tx.QueryRow(db.rebind("SELECT COUNT(*) FROM "+virtualGuests+" WHERE "+strings.Join(wheres, " AND ")), values...).Scan(&capacity)
+	if err != nil {
+		logger.Error("failed-counting-vms", err)
+		return db.convertSQLError(err)
+	}
+
+	rows, err := db.all(logger, tx, reservations,
+		reservationColumns, NoLockRow,
+		"pool = ?", filter.Pool,
+	)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return db.convertSQLError(err)
+	}
+	defer rows.Close()
+
+	overlapping := []*models.Reservation{}
+	for rows.Next() {
+		reservation, err := db.fetchReservation(logger, rows)
+		if err != nil {
+			return err
+		}
+		if reservation.Filter.Flavor == filter.Flavor {
+			overlapping = append(overlapping, reservation)
+		}
+	}
+	if rows.Err() != nil {
+		logger.Error("failed-getting-next-row", rows.Err())
+		return db.convertSQLError(rows.Err())
+	}
+
+	left := capacity - peakReserved(overlapping, from, to)
+	if count > left {
+		if left < 0 {
+			left = 0
+		}
+		logger.Info("not-enough-capacity", lager.Data{"capacity": capacity, "left": left})
+		return models.NewResourceConflictError(models.ErrInvalidField{
+			Field: "count",
+			Message: fmt.Sprintf("only %d vms of flavor %s are left between %s and %s",
+				left, filter.Flavor, from.UTC().Format(time.RFC3339), to.UTC().Format(time.RFC3339)),
+		})
+	}
+
+	return nil
+}
+
+// peakReserved returns the most VMs the reservations hold at once between
+// from and to. A reservation holds its count from when it starts until it
+// expires, so the peak is either at from or where one of them starts.
+func peakReserved(reservations []*models.Reservation, from, to time.Time) int32 {
+	instants := []time.Time{from}
+	for _, r := range reservations {
+		startsAt := time.Time(r.StartsAt)
+		if startsAt.After(from) && startsAt.Before(to) {
+			instants = append(instants, startsAt)
+		}
+	}
+
+	var peak int32
+	for _, instant := range instants {
+		var held int32
+		for _, r := range reservations {
+			if !time.Time(r.StartsAt).After(instant) && time.Time(r.ExpiresAt).After(instant) {
+				held += r.Count
+			}
+		}
+		if held > peak {
+			peak = held
+		}
+	}
+	return peak
+}
+
+// ReleaseReservation frees the VMs of the reservation no order claimed and
+// forgets the reservation.
+func (db *SQLDB) ReleaseReservation(logger lager.Logger, id string) error {
+	logger = logger.Session("release-reservation", lager.Data{"id": id})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	return db.transact(logger, "release-reservation", func(logger lager.Logger, tx *sql.Tx) error {
+		row := db.one(logger, tx, reservations,
+			reservationColumns, LockRow,
+			"id = ?", id,
+		)
+		reservation, err := db.fetchReservation(logger, row)
+		if err != nil {
+			logger.Error("failed-locking-reservation", err)
+			return err
+		}
+
+		wheres, values := reservedWheres(reservation)
+		rows, err := db.all(logger, tx, virtualGuests,
+			virtualGuestColumns, LockRow,
+			wheres, values...,
+		)
+		if err != nil {
+			logger.Error("failed-locking-vms", err)
+			return db.convertSQLError(err)
+		}
+		vms, err := db.scanVMs(logger, rows, tx)
+		if err != nil {
+			return err
+		}
+
+		now := db.clock.Now().UnixNano()
+		for _, vm := range vms {
+			_, err = db.update(logger, tx, virtualGuests,
+				SQLAttributes{
+					"state":           string(models.StateFree),
+					"deployment_name": "",
+					"reservation_id":  "",
+					"updated_at":      now,
+				},
+				"cid = ?", vm.Cid,
+			)
+			if err != nil {
+				return db.convertSQLError(err)
+			}
+
+			err = db.recordTransition(logger, tx, vm, models.StateFree, now)
+			if err != nil {
+				return err
+			}
+		}
+		logger.Info("released-vms", lager.Data{"count": len(vms)})
+
+		_, err = db.delete(logger, tx, reservations, "id = ?", id)
+		if err != nil {
+			logger.Error("failed-removing-reservation", err)
+			return db.convertSQLError(err)
+		}
+
+		return nil
+	})
+}
+
+func (db *SQLDB) reservedCids(logger lager.Logger, q Queryable, reservation *models.Reservation) ([]int32, error) {
+	wheres, values := reservedWheres(reservation)
+	rows, err := q.Query(db.rebind("SELECT cid FROM "+virtualGuests+" WHERE "+wheres+" ORDER BY cid"), values...)
+	if err != nil {
+		logger.Error("failed-querying-reserved-vms", err)
+		return nil, db.convertSQLError(err)
+	}
+	defer rows.Close()
+
+	cids := []int32{}
+	for rows.Next() {
+		var cid int32
+		err = rows.Scan(&cid)
+		if err != nil {
+			logger.Error("failed-scanning-row", err)
+			return nil, db.convertSQLError(err)
+		}
+		cids = append(cids, cid)
+	}
+
+	if rows.Err() != nil {
+		return nil, db.convertSQLError(rows.Err())
+	}
+
+	return cids, nil
+}
+
+// reservedWheres selects the VMs still held by reservation.
+func reservedWheres(reservation *models.Reservation) (string, []interface{}) {
+	return "state = ? AND reservation_id = ?",
+		[]interface{}{string(models.StateReserved), reservation.ID}
+}
+
+func (db *SQLDB) fetchVMs(logger lager.Logger, tx *sql.Tx, query string, values ...interface{}) ([]*models.VM, error) {
+	rows, err := tx.Query(query, values...)
+	if err != nil {
+		return nil, db.convertSQLError(err)
+	}
+	return db.scanVMs(logger, rows, tx)
+}
+
+func (db *SQLDB) scanVMs(logger lager.Logger, rows *sql.Rows, tx Queryable) ([]*models.VM, error) {
+	defer rows.Close()
+
+	vms := []*models.VM{}
+	for rows.Next() {
+		vm, err := db.fetchVirtualGuest(logger, rows, tx)
+		if err != nil {
+			return nil, err
+		}
+		vms = append(vms, vm)
+	}
+
+	if rows.Err() != nil {
+		return nil, db.convertSQLError(rows.Err())
+	}
+
+	return vms, nil
+}
+
+func (db *SQLDB) fetchReservation(logger lager.Logger, scanner RowScanner) (*models.Reservation, error) {
+	var id, deploymentName, pool, filter, status string
+	var count int32
+	var startsAt, expiresAt int64
+	err := scanner.Scan(&id, &deploymentName, &pool, &count, &filter, &startsAt, &expiresAt, &status)
+	if err != nil {
+		logger.Error("failed-scanning-row", err)
+		return nil, models.ErrResourceNotFound
+	}
+
+	reservation := &models.Reservation{
+		ID:             id,
+		DeploymentName: deploymentName,
+		Pool:           pool,
+		Count:          count,
+		StartsAt:       strfmt.DateTime(time.Unix(0, startsAt).UTC()),
+		ExpiresAt:      strfmt.DateTime(time.Unix(0, expiresAt).UTC()),
+		Status:         models.ReservationStatus(status),
+		Filter:         &models.VMFilter{},
+	}
+	err = json.Unmarshal([]byte(filter), reservation.Filter)
+	if err != nil {
+		logger.Error("failed-unmarshaling-filter", err)
+		return nil, models.NewError(models.ErrorTypeInvalidJSON, err.Error())
+	}
+
+	return reservation, nil
+}
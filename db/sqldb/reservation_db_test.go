@@ -0,0 +1,221 @@
+package sqldb_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/go-openapi/strfmt"
+	"github.com/jianqiu/vps/db/sqldb"
+	"github.com/jianqiu/vps/db/sqldb/sqltest"
+	"github.com/jianqiu/vps/models"
+)
+
+var _ = Describe("Reservations", func() {
+	Describe("PeakReserved", func() {
+		var (
+			monday       time.Time
+			reservations []*models.Reservation
+		)
+
+		at := func(hours int) time.Time {
+			return monday.Add(time.Duration(hours) * time.Hour)
+		}
+
+		reservation := func(count int32, from, to int) *models.Reservation {
+			return &models.Reservation{
+				Count:     count,
+				StartsAt:  strfmt.DateTime(at(from)),
+				ExpiresAt: strfmt.DateTime(at(to)),
+			}
+		}
+
+		BeforeEach(func() {
+			monday = time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+			reservations = []*models.Reservation{
+				reservation(10, 0, 8),
+				reservation(20, 6, 12),
+				reservation(5, 10, 20),
+			}
+		})
+
+		It("adds up the reservations held at the same time", func() {
+			Expect(sqldb.PeakReserved(reservations, at(0), at(24))).To(Equal(int32(30)))
+		})
+
+		It("counts the reservations already running at the start of the window", func() {
+			Expect(sqldb.PeakReserved(reservations, at(7), at(9))).To(Equal(int32(30)))
+		})
+
+		It("ignores the reservations outside of the window", func() {
+			Expect(sqldb.PeakReserved(reservations, at(12), at(24))).To(Equal(int32(5)))
+			Expect(sqldb.PeakReserved(reservations, at(20), at(24))).To(Equal(int32(0)))
+		})
+
+		It("lets a reservation start when another one expires", func() {
+			Expect(sqldb.PeakReserved(reservations, at(8), at(10))).To(Equal(int32(20)))
+		})
+	})
+
+	Context("against the database", func() {
+		var (
+			logger    *lagertest.TestLogger
+			connector *sqltest.RecordingConnector
+			db        *sqldb.SQLDB
+		)
+
+		BeforeEach(func() {
+			logger = lagertest.NewTestLogger("test")
+			connector = sqltest.NewRecordingConnector()
+			db = sqldb.NewSQLDB(sql.OpenDB(connector), clock.NewClock(), sqldb.MySQL, sqldb.RetryPolicy{MaxAttempts: 1})
+			db.SetSkipsLockedRows(false)
+		})
+
+		Describe("ReserveVirtualGuests", func() {
+			var reservation *models.Reservation
+
+			BeforeEach(func() {
+				connector.SetRows("FROM pools", []driver.Value{models.DefaultPool, "", "", ""})
+				connector.SetRows("SELECT COUNT(*) FROM virtual_guests", []driver.Value{int64(2)})
+
+				reservation = &models.Reservation{
+					ID:             "reservation-1",
+					DeploymentName: "deployment",
+					Pool:           models.DefaultPool,
+					Count:          3,
+					TTL:            3600,
+					Filter:         &models.VMFilter{Flavor: "small", CPU: 4, MemoryMb: 8192},
+				}
+			})
+
+			It("counts only the free and reserved vms of the flavor as its capacity", func() {
+				err := db.ReserveVirtualGuests(logger, reservation)
+				Expect(models.ConvertError(err).Type).To(Equal(models.ErrorTypeResourceConflict))
+
+				counts := connector.Statements("SELECT COUNT(*) FROM virtual_guests")
+				Expect(counts).To(HaveLen(1))
+				Expect(counts[0].Query).To(ContainSubstring("state IN (?, ?)"))
+				Expect(counts[0].Args).To(ContainElement(string(models.StateFree)))
+				Expect(counts[0].Args).To(ContainElement(string(models.StateReserved)))
+				Expect(connector.Statements("INSERT INTO reservations")).To(BeEmpty())
+			})
+
+			Context("for a deployment that holds another reservation of the pool", func() {
+				var startsAt time.Time
+
+				BeforeEach(func() {
+					reservation.Filter = &models.VMFilter{CPU: 4, MemoryMb: 8192}
+					reservation.Count = 1
+					connector.SetRows("FROM virtual_guests", vmRow(1, models.StateFree))
+				})
+
+				JustBeforeEach(func() {
+					connector.SetRows("FROM reservations", []driver.Value{
+						"reservation-0", "deployment", models.DefaultPool, int64(1), "{}",
+						startsAt.UnixNano(), startsAt.Add(time.Hour).UnixNano(),
+						string(models.ReservationStatusActive),
+					})
+				})
+
+				Context("that overlaps it", func() {
+					BeforeEach(func() {
+						startsAt = time.Now().Add(-time.Minute)
+					})
+
+					It("refuses the reservation", func() {
+						err := db.ReserveVirtualGuests(logger, reservation)
+						Expect(models.ConvertError(err).Type).To(Equal(models.ErrorTypeResourceConflict))
+						Expect(err.Error()).To(ContainSubstring("reservation-0"))
+						Expect(connector.Statements("INSERT INTO reservations")).To(BeEmpty())
+					})
+				})
+
+				Context("that does not overlap it", func() {
+					BeforeEach(func() {
+						startsAt = time.Now().Add(2 * time.Hour)
+					})
+
+					It("reserves the vms for this reservation", func() {
+						Expect(db.ReserveVirtualGuests(logger, reservation)).To(Succeed())
+						Expect(reservation.Cids).To(Equal([]int32{1}))
+
+						updates := connector.Statements("UPDATE virtual_guests")
+						Expect(updates).To(HaveLen(1))
+						Expect(updates[0].Query).To(ContainSubstring("reservation_id = ?"))
+						Expect(updates[0].Args).To(ContainElement("reservation-1"))
+					})
+				})
+			})
+		})
+
+		Describe("ReleaseReservation", func() {
+			BeforeEach(func() {
+				connector.SetRows("FROM reservations", []driver.Value{
+					"reservation-1", "deployment", models.DefaultPool, int64(1), "{}",
+					time.Now().Add(-time.Hour).UnixNano(), time.Now().UnixNano(),
+					string(models.ReservationStatusActive),
+				})
+				connector.SetRows("FROM virtual_guests", vmRow(1, models.StateReserved))
+			})
+
+			It("frees the vms reserved by this reservation, not by the deployment", func() {
+				Expect(db.ReleaseReservation(logger, "reservation-1")).To(Succeed())
+
+				selects := connector.Statements("SELECT virtual_guests.cid")
+				Expect(selects).To(HaveLen(1))
+				Expect(selects[0].Query).To(ContainSubstring("reservation_id = ?"))
+				Expect(selects[0].Query).NotTo(ContainSubstring("deployment_name = ?"))
+				Expect(selects[0].Args).To(ContainElement("reservation-1"))
+
+				updates := connector.Statements("UPDATE virtual_guests")
+				Expect(updates).To(HaveLen(1))
+				Expect(updates[0].Args).To(ContainElement(string(models.StateFree)))
+				Expect(connector.Statements("DELETE FROM reservations")).To(HaveLen(1))
+			})
+		})
+
+		Describe("ActivateReservation", func() {
+			BeforeEach(func() {
+				connector.SetRows("FROM reservations", []driver.Value{
+					"reservation-1", "deployment", models.DefaultPool, int64(3), "{}",
+					time.Now().Add(-time.Minute).UnixNano(), time.Now().Add(time.Hour).UnixNano(),
+					string(models.ReservationStatusScheduled),
+				})
+			})
+
+			Context("when there are enough free vms", func() {
+				BeforeEach(func() {
+					connector.SetRows("FROM virtual_guests", vmRow(1, models.StateFree), vmRow(2, models.StateFree), vmRow(3, models.StateFree))
+				})
+
+				It("makes the reservation active", func() {
+					Expect(db.ActivateReservation(logger, "reservation-1")).To(Succeed())
+
+					updates := connector.Statements("UPDATE reservations")
+					Expect(updates).To(HaveLen(1))
+					Expect(updates[0].Args).To(ContainElement(string(models.ReservationStatusActive)))
+				})
+			})
+
+			Context("when fewer vms are free than the reservation asks for", func() {
+				BeforeEach(func() {
+					connector.SetRows("FROM virtual_guests", vmRow(1, models.StateFree))
+				})
+
+				It("reserves what there is and makes the reservation partial", func() {
+					Expect(db.ActivateReservation(logger, "reservation-1")).To(Succeed())
+
+					Expect(connector.Statements("UPDATE virtual_guests")).To(HaveLen(1))
+					updates := connector.Statements("UPDATE reservations")
+					Expect(updates).To(HaveLen(1))
+					Expect(updates[0].Args).To(ContainElement(string(models.ReservationStatusPartial)))
+				})
+			})
+		})
+	})
+})
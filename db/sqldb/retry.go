@@ -0,0 +1,153 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/runtimeschema/metric"
+	"github.com/jianqiu/vps/models"
+)
+
+const (
+	transactionRetries          = metric.Counter("TransactionRetries")
+	transactionRetriesExhausted = metric.Counter("TransactionRetriesExhausted")
+)
+
+// IsolationLevel is the isolation a transaction runs with. The empty level
+// keeps the database's default.
+type IsolationLevel string
+
+const (
+	DefaultIsolation IsolationLevel = ""
+	ReadCommitted    IsolationLevel = "READ COMMITTED"
+	RepeatableRead   IsolationLevel = "REPEATABLE READ"
+	Serializable     IsolationLevel = "SERIALIZABLE"
+)
+
+// RetryPolicy decides how often a transaction that failed with a deadlock or
+// a serialization failure runs again, and how long apart. The wait doubles
+// from InitialBackoff up to MaxBackoff; up to Jitter of it is taken off at
+// random so that the transactions that collided do not collide again.
+//
+// Isolation sets the isolation level of single operations, by the name they
+// log their session with, e.g. "order-free-vm".
+type RetryPolicy struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Jitter         float64
+	Isolation      map[string]IsolationLevel
+}
+
+// Delay returns how long to wait after the given number of failed attempts,
+// with random, between 0 and 1, picking how much jitter is taken off.
+func (p RetryPolicy) Delay(failedAttempts int, random float64) time.Duration {
+	backoff := p.InitialBackoff
+	for i := 1; i < failedAttempts && backoff < p.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > p.MaxBackoff {
+		backoff = p.MaxBackoff
+	}
+	return backoff - time.Duration(float64(backoff)*p.Jitter*random)
+}
+
+// ParseIsolationLevels reads operation:level pairs, e.g.
+// "order-free-vm:SERIALIZABLE", into a map for RetryPolicy.Isolation.
+func ParseIsolationLevels(pairs []string) (map[string]IsolationLevel, error) {
+	levels := map[string]IsolationLevel{}
+	for _, pair := range pairs {
+		parts := strings.SplitN(pair, ":", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("isolation level %q is not of the form operation:level", pair)
+		}
+
+		level := IsolationLevel(strings.ToUpper(strings.Replace(parts[1], "_", " ", -1)))
+		switch level {
+		case ReadCommitted, RepeatableRead, Serializable:
+			levels[parts[0]] = level
+		default:
+			return nil, fmt.Errorf("isolation level %q of %s is not one of READ COMMITTED, REPEATABLE READ or SERIALIZABLE", parts[1], parts[0])
+		}
+	}
+	return levels, nil
+}
+
+// transact runs f in a transaction, named operation for its isolation level
+// and logs, and runs it again while it fails with a deadlock or a
+// serialization failure, as the retry policy allows. Once the attempts are
+// used up it returns ErrDeadlock.
+func (db *SQLDB) transact(logger lager.Logger, operation string, f func(logger lager.Logger, tx *sql.Tx) error) error {
+	isolation := db.retryPolicy.Isolation[operation]
+
+	for attempt := 1; ; attempt++ {
+		err := db.attempt(logger, isolation, f)
+		if err == nil || !db.deadlocked(err) {
+			return err
+		}
+
+		if attempt >= db.retryPolicy.MaxAttempts {
+			transactionRetriesExhausted.Increment()
+			logger.Error("transaction-retries-exhausted", err, lager.Data{"operation": operation, "attempts": attempt})
+			return models.ErrDeadlock
+		}
+
+		delay := db.retryPolicy.Delay(attempt, rand.Float64())
+		transactionRetries.Increment()
+		logger.Error("deadlock-transaction", err, lager.Data{"operation": operation, "attempts": attempt, "retry-in": delay.String()})
+		db.clock.Sleep(delay)
+	}
+}
+
+func (db *SQLDB) attempt(logger lager.Logger, isolation IsolationLevel, f func(logger lager.Logger, tx *sql.Tx) error) error {
+	ctx := context.Background()
+
+	// the isolation level has to be set on the connection the transaction
+	// runs on: before it starts on MySQL, as its first statement on Postgres
+	conn, err := db.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	setIsolation := "SET TRANSACTION ISOLATION LEVEL " + string(isolation)
+	if isolation != DefaultIsolation && db.flavor == MySQL {
+		if _, err := conn.ExecContext(ctx, setIsolation); err != nil {
+			return err
+		}
+	}
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if isolation != DefaultIsolation && db.flavor == Postgres {
+		if _, err := tx.Exec(setIsolation); err != nil {
+			return err
+		}
+	}
+
+	err = f(logger, tx)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// deadlocked reports whether err, from the database or already converted,
+// means the transaction collided with another one and may succeed when run
+// again.
+func (db *SQLDB) deadlocked(err error) bool {
+	if modelErr, ok := err.(*models.Error); ok {
+		return modelErr.Equal(models.ErrDeadlock)
+	}
+	return db.convertSQLError(err).Equal(models.ErrDeadlock)
+}
@@ -0,0 +1,102 @@
+package sqldb_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jianqiu/vps/db/sqldb"
+	"github.com/jianqiu/vps/models"
+)
+
+var _ = Describe("Retry", func() {
+	Describe("RetryPolicy.Delay", func() {
+		var policy sqldb.RetryPolicy
+
+		BeforeEach(func() {
+			policy = sqldb.RetryPolicy{
+				MaxAttempts:    5,
+				InitialBackoff: 100 * time.Millisecond,
+				MaxBackoff:     time.Second,
+				Jitter:         0.5,
+			}
+		})
+
+		It("doubles the backoff for every failed attempt", func() {
+			Expect(policy.Delay(1, 0)).To(Equal(100 * time.Millisecond))
+			Expect(policy.Delay(2, 0)).To(Equal(200 * time.Millisecond))
+			Expect(policy.Delay(3, 0)).To(Equal(400 * time.Millisecond))
+		})
+
+		It("never waits longer than the max backoff", func() {
+			Expect(policy.Delay(5, 0)).To(Equal(time.Second))
+			Expect(policy.Delay(50, 0)).To(Equal(time.Second))
+		})
+
+		It("takes up to the jitter off the backoff", func() {
+			Expect(policy.Delay(1, 1)).To(Equal(50 * time.Millisecond))
+			Expect(policy.Delay(2, 0.5)).To(Equal(150 * time.Millisecond))
+		})
+
+		It("waits the full backoff without jitter", func() {
+			policy.Jitter = 0
+			Expect(policy.Delay(2, 1)).To(Equal(200 * time.Millisecond))
+		})
+	})
+
+	Describe("ParseIsolationLevels", func() {
+		It("reads operation:level pairs", func() {
+			levels, err := sqldb.ParseIsolationLevels([]string{
+				"order-free-vm:SERIALIZABLE",
+				"retire-vm:repeatable_read",
+				"delete-webhook:READ COMMITTED",
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(levels).To(Equal(map[string]sqldb.IsolationLevel{
+				"order-free-vm":  sqldb.Serializable,
+				"retire-vm":      sqldb.RepeatableRead,
+				"delete-webhook": sqldb.ReadCommitted,
+			}))
+		})
+
+		It("returns an empty map without pairs", func() {
+			levels, err := sqldb.ParseIsolationLevels(nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(levels).To(BeEmpty())
+		})
+
+		for _, pair := range []string{"SERIALIZABLE", ":SERIALIZABLE", "order-free-vm:", "order-free-vm:READ UNCOMMITTED"} {
+			pair := pair
+			It("rejects "+pair, func() {
+				_, err := sqldb.ParseIsolationLevels([]string{pair})
+				Expect(err).To(HaveOccurred())
+			})
+		}
+	})
+
+	Describe("converting errors", func() {
+		var db *sqldb.SQLDB
+
+		BeforeEach(func() {
+			db = sqldb.NewSQLDB(nil, clock.NewClock(), sqldb.Postgres, sqldb.RetryPolicy{})
+		})
+
+		for _, code := range []pq.ErrorCode{"40001", "40P01"} {
+			code := code
+			It("treats Postgres error "+string(code)+" as a deadlock", func() {
+				Expect(db.ConvertSQLError(&pq.Error{Code: code})).To(Equal(models.ErrDeadlock))
+			})
+		}
+
+		for _, number := range []uint16{1205, 1213} {
+			number := number
+			It("treats MySQL errors 1205 and 1213 as deadlocks", func() {
+				Expect(db.ConvertSQLError(&mysql.MySQLError{Number: number})).To(Equal(models.ErrDeadlock))
+			})
+		}
+	})
+})
@@ -0,0 +1,189 @@
+package sqldb
+
+import (
+	"database/sql"
+	"encoding/base64"
+
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/models"
+)
+
+var vmSecretColumns = ColumnList{
+	vmSecrets + ".key_label",
+	vmSecrets + ".nonce",
+	vmSecrets + ".cipher_text",
+}
+
+func (db *SQLDB) VMSecret(logger lager.Logger, cid int32) (encryption.Encrypted, error) {
+	logger = logger.Session("vm-secret", lager.Data{"cid": cid})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	row := db.one(logger, db.db, vmSecrets,
+		vmSecretColumns, NoLockRow,
+		"cid = ?", cid,
+	)
+	return db.fetchVMSecret(logger, row)
+}
+
+// SetVMSecret locks the VM while it stores its secret, so that the secret
+// cannot outlive a VM that is deleted at the same time.
+func (db *SQLDB) SetVMSecret(logger lager.Logger, cid int32, secret encryption.Encrypted) error {
+	logger = logger.Session("set-vm-secret", lager.Data{"cid": cid, "key-label": secret.KeyLabel})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	return db.transact(logger, "set-vm-secret", func(logger lager.Logger, tx *sql.Tx) error {
+		_, err := db.fetchVMForUpdate(logger, cid, tx)
+		if err != nil {
+			logger.Error("failed-locking-vm", err)
+			return err
+		}
+
+		_, err = db.upsert(logger, tx, vmSecrets,
+			SQLAttributes{"cid": cid},
+			vmSecretAttributes(secret, db.clock.Now().UnixNano()),
+		)
+		if err != nil {
+			logger.Error("failed-upserting-vm-secret", err)
+			return db.convertSQLError(err)
+		}
+		return nil
+	})
+}
+
+func (db *SQLDB) RemoveVMSecret(logger lager.Logger, cid int32) error {
+	logger = logger.Session("remove-vm-secret", lager.Data{"cid": cid})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	_, err := db.delete(logger, db.db, vmSecrets, "cid = ?", cid)
+	if err != nil {
+		logger.Error("failed-deleting-vm-secret", err)
+		return db.convertSQLError(err)
+	}
+	return nil
+}
+
+// ReEncryptVMSecrets encrypts one secret per transaction, so that a large
+// pool does not hold its locks for long, and checks the label again under
+// the lock in case the secret was replaced in the meantime.
+func (db *SQLDB) ReEncryptVMSecrets(logger lager.Logger, activeLabel string, cryptor encryption.Cryptor) (int, error) {
+	logger = logger.Session("re-encrypt-vm-secrets", lager.Data{"active-key-label": activeLabel})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	cids, err := db.vmSecretCids(logger, "key_label <> ?", activeLabel)
+	if err != nil {
+		return 0, err
+	}
+
+	encrypted := 0
+	for _, cid := range cids {
+		err := db.transact(logger, "re-encrypt-vm-secret", func(logger lager.Logger, tx *sql.Tx) error {
+			row := db.one(logger, tx, vmSecrets,
+				vmSecretColumns, LockRow,
+				"cid = ? AND key_label <> ?", cid, activeLabel,
+			)
+			secret, err := db.fetchVMSecret(logger, row)
+			if err == models.ErrResourceNotFound {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+
+			plaintext, err := cryptor.Decrypt(secret)
+			if err != nil {
+				logger.Error("failed-decrypting-vm-secret", err, lager.Data{"cid": cid, "key-label": secret.KeyLabel})
+				return models.NewError(models.ErrorTypeFailedToOpenEnvelope, err.Error())
+			}
+			secret, err = cryptor.Encrypt(plaintext)
+			if err != nil {
+				logger.Error("failed-encrypting-vm-secret", err, lager.Data{"cid": cid})
+				return models.NewError(models.ErrorTypeUnknownError, err.Error())
+			}
+
+			_, err = db.update(logger, tx, vmSecrets,
+				vmSecretAttributes(secret, db.clock.Now().UnixNano()),
+				"cid = ?", cid,
+			)
+			if err != nil {
+				logger.Error("failed-updating-vm-secret", err, lager.Data{"cid": cid})
+				return db.convertSQLError(err)
+			}
+
+			encrypted++
+			return nil
+		})
+		if err != nil {
+			return encrypted, err
+		}
+	}
+
+	return encrypted, nil
+}
+
+func (db *SQLDB) vmSecretCids(logger lager.Logger, wheres string, whereBindings ...interface{}) ([]int32, error) {
+	rows, err := db.all(logger, db.db, vmSecrets,
+		ColumnList{"cid"}, NoLockRow,
+		wheres, whereBindings...,
+	)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return nil, db.convertSQLError(err)
+	}
+	defer rows.Close()
+
+	cids := []int32{}
+	for rows.Next() {
+		var cid int32
+		err = rows.Scan(&cid)
+		if err != nil {
+			logger.Error("failed-scanning-row", err)
+			return nil, db.convertSQLError(err)
+		}
+		cids = append(cids, cid)
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-getting-next-row", rows.Err())
+		return nil, db.convertSQLError(rows.Err())
+	}
+
+	return cids, nil
+}
+
+func (db *SQLDB) fetchVMSecret(logger lager.Logger, scanner RowScanner) (encryption.Encrypted, error) {
+	var label, nonce, cipherText string
+	err := scanner.Scan(&label, &nonce, &cipherText)
+	if err == sql.ErrNoRows {
+		return encryption.Encrypted{}, models.ErrResourceNotFound
+	}
+	if err != nil {
+		logger.Error("failed-scanning-row", err)
+		return encryption.Encrypted{}, db.convertSQLError(err)
+	}
+
+	secret := encryption.Encrypted{KeyLabel: label}
+	secret.Nonce, err = base64.StdEncoding.DecodeString(nonce)
+	if err == nil {
+		secret.CipherText, err = base64.StdEncoding.DecodeString(cipherText)
+	}
+	if err != nil {
+		logger.Error("failed-decoding-vm-secret", err)
+		return encryption.Encrypted{}, models.NewError(models.ErrorTypeDeserialize, err.Error())
+	}
+
+	return secret, nil
+}
+
+func vmSecretAttributes(secret encryption.Encrypted, now int64) SQLAttributes {
+	return SQLAttributes{
+		"key_label":   secret.KeyLabel,
+		"nonce":       base64.StdEncoding.EncodeToString(secret.Nonce),
+		"cipher_text": base64.StdEncoding.EncodeToString(secret.CipherText),
+		"updated_at":  now,
+	}
+}
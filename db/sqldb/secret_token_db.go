@@ -0,0 +1,68 @@
+package sqldb
+
+import (
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/models"
+)
+
+func (db *SQLDB) VMSecretTokenHash(logger lager.Logger, cid int32) (string, error) {
+	logger = logger.Session("vm-secret-token-hash", lager.Data{"cid": cid})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	var hash string
+	row := db.one(logger, db.db, vmSecretTokens,
+		ColumnList{"token_hash"}, NoLockRow,
+		"cid = ?", cid,
+	)
+	err := row.Scan(&hash)
+	if err == sql.ErrNoRows {
+		return "", models.ErrResourceNotFound
+	}
+	if err != nil {
+		logger.Error("failed-scanning-row", err)
+		return "", db.convertSQLError(err)
+	}
+
+	return hash, nil
+}
+
+// issueSecretToken gives vm a new random secret token, replacing the one
+// of its previous order, and stores its hash.
+func (db *SQLDB) issueSecretToken(logger lager.Logger, tx *sql.Tx, vm *models.VM, now int64) error {
+	random := make([]byte, 32)
+	_, err := rand.Read(random)
+	if err != nil {
+		logger.Error("failed-to-generate-secret-token", err)
+		return err
+	}
+	token := hex.EncodeToString(random)
+
+	_, err = db.upsert(logger, tx, vmSecretTokens,
+		SQLAttributes{"cid": vm.Cid},
+		SQLAttributes{
+			"token_hash": models.HashSecretToken(token),
+			"updated_at": now,
+		},
+	)
+	if err != nil {
+		logger.Error("failed-upserting-secret-token", err, lager.Data{"cid": vm.Cid})
+		return db.convertSQLError(err)
+	}
+
+	vm.SecretToken = token
+	return nil
+}
+
+func (db *SQLDB) removeSecretToken(logger lager.Logger, tx *sql.Tx, cid int32) error {
+	_, err := db.delete(logger, tx, vmSecretTokens, "cid = ?", cid)
+	if err != nil {
+		logger.Error("failed-removing-secret-token", err, lager.Data{"cid": cid})
+		return db.convertSQLError(err)
+	}
+	return nil
+}
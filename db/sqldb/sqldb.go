@@ -2,11 +2,10 @@ package sqldb
 
 import (
 	"database/sql"
-	"time"
+	"sync"
 
 	"github.com/jianqiu/vps/models"
 	"code.cloudfoundry.org/clock"
-	"code.cloudfoundry.org/lager"
 	"github.com/go-sql-driver/mysql"
 	"github.com/lib/pq"
 )
@@ -15,6 +14,10 @@ type SQLDB struct {
 	db                     *sql.DB
 	clock                  clock.Clock
 	flavor                 string
+	retryPolicy            RetryPolicy
+
+	skipLockedMutex        sync.Mutex
+	skipLocked             *bool
 }
 
 type RowScanner interface {
@@ -37,44 +40,16 @@ func NewSQLDB(
 db *sql.DB,
 clock clock.Clock,
 flavor string,
+retryPolicy RetryPolicy,
 ) *SQLDB {
 	return &SQLDB{
 		db: db,
 		clock:                  clock,
 		flavor:                 flavor,
+		retryPolicy:            retryPolicy,
 	}
 }
 
-func (db *SQLDB) transact(logger lager.Logger, f func(logger lager.Logger, tx *sql.Tx) error) error {
-	var err error
-
-	for attempts := 0; attempts < 6; attempts++ {
-		err = func() error {
-			tx, err := db.db.Begin()
-			if err != nil {
-				return err
-			}
-			defer tx.Rollback()
-
-			err = f(logger, tx)
-			if err != nil {
-				return err
-			}
-
-			return tx.Commit()
-		}()
-
-		if attempts >= 2 || db.convertSQLError(err) != models.ErrDeadlock {
-			break
-		} else {
-			logger.Error("deadlock-transaction", err, lager.Data{"attempts": attempts})
-			time.Sleep(500 * time.Millisecond)
-		}
-	}
-
-	return err
-}
-
 func (db *SQLDB) convertSQLError(err error) *models.Error {
 	if err != nil {
 		switch err.(type) {
@@ -92,7 +67,7 @@ func (db *SQLDB) convertMySQLError(err *mysql.MySQLError) *models.Error {
 	switch err.Number {
 	case 1062:
 		return models.ErrResourceExists
-	case 1213:
+	case 1205, 1213:
 		return models.ErrDeadlock
 	case 1406:
 		return models.ErrBadRequest
@@ -109,6 +84,8 @@ func (db *SQLDB) convertPostgresError(err *pq.Error) *models.Error {
 		return models.ErrBadRequest
 	case "23505":
 		return models.ErrResourceExists
+	case "40001", "40P01":
+		return models.ErrDeadlock
 	case "42P01":
 		return models.NewUnrecoverableError(err)
 	default:
@@ -0,0 +1,13 @@
+package sqldb_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestSqldb(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Sqldb Suite")
+}
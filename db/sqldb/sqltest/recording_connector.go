@@ -0,0 +1,165 @@
+// Package sqltest has a database/sql driver for the tests of code that
+// runs SQL, without a database server.
+package sqltest
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+)
+
+// RecordingConnector stands in for a database: it keeps every statement run
+// against it and answers each query with the rows set up for the longest
+// part of it they were set up for, or with none. Other statements affect a
+// single row unless set up otherwise.
+type RecordingConnector struct {
+	mutex      sync.Mutex
+	rows       map[string][][]driver.Value
+	affected   map[string][]int64
+	statements []Statement
+}
+
+// Statement is a query run against a RecordingConnector, with its args.
+type Statement struct {
+	Query string
+	Args  []driver.Value
+}
+
+func NewRecordingConnector() *RecordingConnector {
+	return &RecordingConnector{rows: map[string][][]driver.Value{}, affected: map[string][]int64{}}
+}
+
+// SetRows has the queries that contain match answered with rows.
+func (c *RecordingConnector) SetRows(match string, rows ...[]driver.Value) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.rows[match] = rows
+}
+
+// SetRowsAffected has the next statements that contain match report the
+// counts of affected rows, one count per statement in turn.
+func (c *RecordingConnector) SetRowsAffected(match string, counts ...int64) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.affected[match] = counts
+}
+
+// Statements returns the statements run so far that start with prefix,
+// once leading white space is trimmed.
+func (c *RecordingConnector) Statements(prefix string) []Statement {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	statements := []Statement{}
+	for _, statement := range c.statements {
+		if strings.HasPrefix(strings.TrimSpace(statement.Query), prefix) {
+			statements = append(statements, statement)
+		}
+	}
+	return statements
+}
+
+func (c *RecordingConnector) record(query string, args []driver.Value) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.statements = append(c.statements, Statement{Query: query, Args: args})
+}
+
+func (c *RecordingConnector) rowsFor(query string) [][]driver.Value {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	longest := ""
+	for match := range c.rows {
+		if strings.Contains(query, match) && len(match) > len(longest) {
+			longest = match
+		}
+	}
+	if longest == "" {
+		return nil
+	}
+	return c.rows[longest]
+}
+
+func (c *RecordingConnector) rowsAffectedBy(query string) int64 {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for match, counts := range c.affected {
+		if strings.Contains(query, match) && len(counts) > 0 {
+			c.affected[match] = counts[1:]
+			return counts[0]
+		}
+	}
+	return 1
+}
+
+func (c *RecordingConnector) Connect(context.Context) (driver.Conn, error) {
+	return &recordingConn{connector: c}, nil
+}
+
+func (c *RecordingConnector) Driver() driver.Driver {
+	return recordingDriver{}
+}
+
+type recordingDriver struct{}
+
+func (recordingDriver) Open(string) (driver.Conn, error) {
+	return nil, errors.New("open the recording database through its connector")
+}
+
+type recordingConn struct {
+	connector *RecordingConnector
+}
+
+func (c *recordingConn) Prepare(query string) (driver.Stmt, error) {
+	return &recordingStmt{connector: c.connector, query: query}, nil
+}
+
+func (c *recordingConn) Close() error              { return nil }
+func (c *recordingConn) Begin() (driver.Tx, error) { return recordingTx{}, nil }
+
+type recordingTx struct{}
+
+func (recordingTx) Commit() error   { return nil }
+func (recordingTx) Rollback() error { return nil }
+
+type recordingStmt struct {
+	connector *RecordingConnector
+	query     string
+}
+
+func (s *recordingStmt) Close() error  { return nil }
+func (s *recordingStmt) NumInput() int { return -1 }
+
+func (s *recordingStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.connector.record(s.query, args)
+	return driver.RowsAffected(s.connector.rowsAffectedBy(s.query)), nil
+}
+
+func (s *recordingStmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.connector.record(s.query, args)
+	return &recordingRows{rows: s.connector.rowsFor(s.query)}, nil
+}
+
+type recordingRows struct {
+	rows [][]driver.Value
+}
+
+func (r *recordingRows) Columns() []string {
+	if len(r.rows) == 0 {
+		return nil
+	}
+	return make([]string, len(r.rows[0]))
+}
+
+func (r *recordingRows) Close() error { return nil }
+
+func (r *recordingRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
@@ -0,0 +1,56 @@
+package sqldb
+
+import (
+	"database/sql"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/models"
+)
+
+func (db *SQLDB) VMUserData(logger lager.Logger, cid int32) (string, error) {
+	logger = logger.Session("vm-user-data", lager.Data{"cid": cid})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	var userData string
+	row := db.one(logger, db.db, vmUserData,
+		ColumnList{"user_data"}, NoLockRow,
+		"cid = ?", cid,
+	)
+	err := row.Scan(&userData)
+	if err == sql.ErrNoRows {
+		return "", models.ErrResourceNotFound
+	}
+	if err != nil {
+		logger.Error("failed-scanning-row", err)
+		return "", db.convertSQLError(err)
+	}
+
+	return userData, nil
+}
+
+// storeUserData keeps the agent settings vm was ordered with, replacing
+// any it still had.
+func (db *SQLDB) storeUserData(logger lager.Logger, tx *sql.Tx, cid int32, userData string, now int64) error {
+	_, err := db.upsert(logger, tx, vmUserData,
+		SQLAttributes{"cid": cid},
+		SQLAttributes{
+			"user_data":  userData,
+			"updated_at": now,
+		},
+	)
+	if err != nil {
+		logger.Error("failed-upserting-user-data", err, lager.Data{"cid": cid})
+		return db.convertSQLError(err)
+	}
+	return nil
+}
+
+func (db *SQLDB) removeUserData(logger lager.Logger, tx *sql.Tx, cid int32) error {
+	_, err := db.delete(logger, tx, vmUserData, "cid = ?", cid)
+	if err != nil {
+		logger.Error("failed-removing-user-data", err, lager.Data{"cid": cid})
+		return db.convertSQLError(err)
+	}
+	return nil
+}
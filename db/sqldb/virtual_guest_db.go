@@ -2,7 +2,9 @@ package sqldb
 
 import (
 	"database/sql"
+	"fmt"
 	"strings"
+	"time"
 	"github.com/go-openapi/strfmt"
 
 	"github.com/jianqiu/vps/models"
@@ -14,46 +16,7 @@ func (db *SQLDB) VirtualGuests(logger lager.Logger, filter models.VMFilter) ([]*
 	logger.Debug("starting")
 	defer logger.Debug("complete")
 
-	wheres := []string{}
-	values := []interface{}{}
-
-	if filter.CPU > 0 {
-		wheres = append(wheres, "cpu = ?")
-		values = append(values, filter.CPU)
-	}
-
-	if filter.MemoryMb > 0 {
-		wheres = append(wheres, "memory_mb = ?")
-		values = append(values, filter.MemoryMb)
-	}
-
-	if filter.PrivateVlan >0 {
-		wheres = append(wheres, "private_vlan = ?")
-		values = append(values, filter.PrivateVlan)
-	}
-
-	if filter.PublicVlan > 0 {
-		wheres = append(wheres, "public_vlan = ?")
-		values = append(values, filter.PublicVlan)
-	}
-
-	if filter.DeploymentName != "" {
-		wheres = append(wheres, "deployment_name = ?")
-		values = append(values, filter.DeploymentName)
-	}
-
-	switch filter.State {
-	case models.StateUsing:
-		wheres = append(wheres, "state = ?")
-		values = append(values, "using")
-	case models.StateProvisioning:
-		wheres = append(wheres, "state = ?")
-		values = append(values, "provisioning")
-	case models.StateFree:
-		wheres = append(wheres, "state = ?")
-		values = append(values, "free")
-	default:
-	}
+	wheres, values := filterWheres(filter)
 
 	rows, err := db.all(logger, db.db, virtualGuests,
 		virtualGuestColumns, NoLockRow,
@@ -83,44 +46,62 @@ func (db *SQLDB) VirtualGuests(logger lager.Logger, filter models.VMFilter) ([]*
 	return results, nil
 }
 
-func (db *SQLDB) OrderVirtualGuestToProvision(logger lager.Logger, filter models.VMFilter) (*models.VM, error) {
-	logger = logger.Session("order-free-vm", lager.Data{"filter": filter})
+// OrderVirtualGuestsToProvision claims every VM of pool with one of the
+// given cids or ips at once. When any of them is not in the pool or not free,
+// none of them is claimed and the conflict lists each one that is
+// unavailable.
+func (db *SQLDB) OrderVirtualGuestsToProvision(logger lager.Logger, pool string, cids []int32, ips []string) ([]*models.VM, error) {
+	logger = logger.Session("order-free-vms", lager.Data{"pool": pool, "cids": cids, "ips": ips})
 	logger.Debug("starting")
 	defer logger.Debug("complete")
 
-	var vm *models.VM
-	var err error
+	if len(cids) == 0 && len(ips) == 0 {
+		return nil, models.ErrBadRequest
+	}
 
-	err = db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
-		vm, err = db.fetchOneVMWithFilter(logger, filter, tx)
+	var vms []*models.VM
+
+	err := db.transact(logger, "order-free-vms", func(logger lager.Logger, tx *sql.Tx) error {
+		var err error
+		vms, err = db.fetchVMsForUpdate(logger, pool, cids, ips, tx)
 		if err != nil {
-			logger.Error("failed-locking-vm", err)
+			logger.Error("failed-locking-vms", err)
 			return err
 		}
 
-		if err = vm.ValidateTransitionTo(models.StateProvisioning); err != nil {
-			logger.Error("failed-to-transition-vm-to-provisioning", err)
-			return err
+		unavailable := unavailableVMs(vms, cids, ips)
+		if len(unavailable) > 0 {
+			return models.NewResourceConflictError(unavailable...)
 		}
 
 		logger.Info("starting")
 		defer logger.Info("complete")
 		now := db.clock.Now().UnixNano()
-		_, err = db.update(logger, tx, virtualGuests,
-			SQLAttributes{
-				"state":      "provisioning",
-				"updated_at": now,
-			},
-			"cid = ?", vm.Cid,
-		)
-		if err != nil {
-			return db.convertSQLError(err)
+		for _, vm := range vms {
+			_, err = db.update(logger, tx, virtualGuests,
+				SQLAttributes{
+					"state":      string(models.StateProvisioning),
+					"updated_at": now,
+				},
+				"cid = ?", vm.Cid,
+			)
+			if err != nil {
+				return db.convertSQLError(err)
+			}
+
+			err = db.recordTransition(logger, tx, vm, models.StateProvisioning, now)
+			if err != nil {
+				return err
+			}
 		}
 
 		return nil
 	})
+	if err != nil {
+		return nil, err
+	}
 
-	return vm, err
+	return vms, nil
 }
 
 func (db *SQLDB) VirtualGuestByCID(logger lager.Logger, cid int32) (*models.VM, error) {
@@ -147,6 +128,61 @@ func (db *SQLDB) VirtualGuestByIP(logger lager.Logger, ip string) (*models.VM, e
 	return db.fetchVirtualGuest(logger, row, db.db)
 }
 
+func (db *SQLDB) VirtualGuestByHostname(logger lager.Logger, hostname string) (*models.VM, error) {
+	logger = logger.Session("vm-by-hostname", lager.Data{"hostname": hostname})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	row := db.one(logger, db.db, virtualGuests,
+		virtualGuestColumns, NoLockRow,
+		"hostname = ?", hostname,
+	)
+	return db.fetchVirtualGuest(logger, row, db.db)
+}
+
+// VirtualGuestsByHostnamePattern finds the VMs whose hostname matches
+// pattern, where a * matches any run of characters. A pattern without one
+// has to match the hostname exactly.
+func (db *SQLDB) VirtualGuestsByHostnamePattern(logger lager.Logger, pattern string) ([]*models.VM, error) {
+	logger = logger.Session("vms-by-hostname-pattern", lager.Data{"pattern": pattern})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	where := "hostname = ?"
+	value := pattern
+	if strings.Contains(pattern, "*") {
+		where = "hostname LIKE ?"
+		value = likePattern(pattern)
+	}
+
+	rows, err := db.all(logger, db.db, virtualGuests,
+		virtualGuestColumns, NoLockRow,
+		where, value,
+	)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return nil, db.convertSQLError(err)
+	}
+	defer rows.Close()
+
+	results := []*models.VM{}
+	for rows.Next() {
+		vm, err := db.fetchVirtualGuest(logger, rows, db.db)
+		if err != nil {
+			logger.Error("failed-fetch", err)
+			return nil, err
+		}
+		results = append(results, vm)
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-getting-next-row", rows.Err())
+		return nil, db.convertSQLError(rows.Err())
+	}
+
+	return results, nil
+}
+
 func (db *SQLDB) VirtualGuestsByDeployments(logger lager.Logger, names []string) ([]*models.VM, error) {
 	logger = logger.Session("vms-by-deployment", lager.Data{"filter": names})
 	logger.Debug("starting")
@@ -196,18 +232,10 @@ func (db *SQLDB) VirtualGuestsByStates(logger lager.Logger, states []string) ([]
 	wheres := []string{}
 	values := []interface{}{}
 
-	for _, state := range states {
-		switch state {
-		case "using":
-			wheres = append(wheres, "state = ?")
-			values = append(values, "using")
-		case "provisioning":
-			wheres = append(wheres, "state = ?")
-			values = append(values, "provisioning")
-		case "free":
+	for _, name := range states {
+		if state, ok := models.ParseState(name); ok {
 			wheres = append(wheres, "state = ?")
-			values = append(values, "free")
-		default:
+			values = append(values, string(state))
 		}
 	}
 
@@ -246,17 +274,13 @@ func (db *SQLDB) InsertVirtualGuestToPool(logger lager.Logger, virtualGuest *mod
 
 	now := db.clock.Now().UnixNano()
 
-	var stateString string
+	state, _ := models.ParseState(string(virtualGuest.State))
 
-	switch virtualGuest.State {
-	case models.StateUsing:
-		stateString = "using"
-	case models.StateProvisioning:
-		stateString = "provisioning"
-	case models.StateFree:
-		stateString = "free"
-	default:
-		stateString = "unknown"
+	// VMs added without a pool, like those the replenisher orders, go to the
+	// default pool
+	pool := virtualGuest.Pool
+	if pool == "" {
+		pool = models.DefaultPool
 	}
 
 	_, err := db.insert(logger, db.db, virtualGuests,
@@ -271,7 +295,8 @@ func (db *SQLDB) InsertVirtualGuestToPool(logger lager.Logger, virtualGuest *mod
 			"created_at":         now,
 			"updated_at":         now,
 			"deployment_name":    virtualGuest.DeploymentName,
-			"state":              stateString,
+			"state":              string(state),
+			"pool":               pool,
 		},
 	)
 	if err != nil {
@@ -285,49 +310,60 @@ func (db *SQLDB) InsertVirtualGuestToPool(logger lager.Logger, virtualGuest *mod
 func (db *SQLDB) UpdateVirtualGuestInPool(logger lager.Logger, virtualGuest *models.VM) error {
 	logger = logger.Session("update-vm-in-pool", lager.Data{"cid":virtualGuest.Cid})
 
-	err := db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
-		_, err := db.fetchVMForUpdate(logger, virtualGuest.Cid, tx)
+	err := db.transact(logger, "update-vm-in-pool", func(logger lager.Logger, tx *sql.Tx) error {
+		vm, err := db.fetchVMForUpdate(logger, virtualGuest.Cid, tx)
 		if err != nil {
 			logger.Error("failed-locking-vm", err)
 			return err
 		}
 
+		// a new state goes through the lifecycle like the ChangeVirtualGuestTo
+		// calls do, so the agent settings of a VM freed here are removed too
+		state, known := models.ParseState(string(virtualGuest.State))
+		changesState := known && state != vm.State
+		if changesState {
+			if err = vm.ValidateTransitionTo(state); err != nil {
+				logger.Error("failed-to-transition-vm", err, lager.Data{"from": vm.State})
+				return err
+			}
+		}
+
 		logger.Info("starting")
 		defer logger.Info("complete")
 		now := db.clock.Now().UnixNano()
 
-		var stateString string
-
-		switch virtualGuest.State {
-		case models.StateUsing:
-			stateString = "using"
-		case models.StateProvisioning:
-			stateString = "provisioning"
-		case models.StateFree:
-			stateString = "free"
-		default:
-			stateString = "unknown"
+		updates := SQLAttributes{
+			"hostname": virtualGuest.Hostname,
+			"ip":  virtualGuest.IP,
+			"cpu":  virtualGuest.CPU,
+			"memory_mb":  virtualGuest.MemoryMb,
+			"deployment_name":  virtualGuest.DeploymentName,
+			"public_vlan":  virtualGuest.PublicVlan,
+			"private_vlan":  virtualGuest.PrivateVlan,
+			"updated_at": now,
+		}
+		if changesState {
+			updates["state"] = string(state)
 		}
 
-		_, err = db.update(logger, tx, virtualGuests,
-			SQLAttributes{
-				"hostname": virtualGuest.Hostname,
-				"ip":  virtualGuest.IP,
-				"cpu":  virtualGuest.CPU,
-				"memory_mb":  virtualGuest.MemoryMb,
-				"deployment_name":  virtualGuest.DeploymentName,
-				"public_vlan":  virtualGuest.PublicVlan,
-				"private_vlan":  virtualGuest.PrivateVlan,
-				"state": stateString,
-				"updated_at": now,
-			},
-			"cid = ?", virtualGuest.Cid,
-		)
+		_, err = db.update(logger, tx, virtualGuests, updates, "cid = ?", virtualGuest.Cid)
 		if err != nil {
 			return db.convertSQLError(err)
 		}
 
-		return nil
+		if !changesState {
+			return nil
+		}
+
+		updated := *vm
+		updated.Hostname = virtualGuest.Hostname
+		updated.IP = virtualGuest.IP
+		updated.CPU = virtualGuest.CPU
+		updated.MemoryMb = virtualGuest.MemoryMb
+		updated.DeploymentName = virtualGuest.DeploymentName
+		updated.PublicVlan = virtualGuest.PublicVlan
+		updated.PrivateVlan = virtualGuest.PrivateVlan
+		return db.recordTransition(logger, tx, &updated, state, now)
 	})
 
 	return err
@@ -335,52 +371,40 @@ func (db *SQLDB) UpdateVirtualGuestInPool(logger lager.Logger, virtualGuest *mod
 
 func (db *SQLDB) ChangeVirtualGuestToProvision(logger lager.Logger, cid int32) error {
 	logger = logger.Session("update-vm-to-provisioning", lager.Data{"cid": cid})
+	return db.changeVirtualGuestState(logger, cid, models.StateProvisioning)
+}
 
-	err := db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
-		vm, err := db.fetchVMForUpdate(logger, cid, tx)
-		if err != nil {
-			logger.Error("failed-locking-vm", err)
-			return err
-		}
-
-		if err = vm.ValidateTransitionTo(models.StateProvisioning); err != nil {
-			logger.Error("failed-to-transition-vm-to-provisioning", err)
-			return err
-		}
-
-		logger.Info("starting")
-		defer logger.Info("complete")
-		now := db.clock.Now().UnixNano()
-		_, err = db.update(logger, tx, virtualGuests,
-			SQLAttributes{
-				"state":      "provisioning",
-				"updated_at": now,
-			},
-			"cid = ?", cid,
-		)
-		if err != nil {
-			return db.convertSQLError(err)
-		}
+func (db *SQLDB) ChangeVirtualGuestToUse(logger lager.Logger, cid int32) error {
+	logger = logger.Session("update-vm-to-use", lager.Data{"cid": cid})
+	return db.changeVirtualGuestState(logger, cid, models.StateUsing)
+}
 
-		return nil
-	})
+func (db *SQLDB) ChangeVirtualGuestToFree(logger lager.Logger, cid int32) error {
+	logger = logger.Session("update-vm-to-free", lager.Data{"cid": cid})
+	return db.changeVirtualGuestState(logger, cid, models.StateFree)
+}
 
-	return err
+func (db *SQLDB) TransitionVirtualGuest(logger lager.Logger, cid int32, state models.State) error {
+	logger = logger.Session("transition-vm", lager.Data{"cid": cid, "state": state})
+	return db.changeVirtualGuestState(logger, cid, state)
 }
 
-func (db *SQLDB) ChangeVirtualGuestToUse(logger lager.Logger, cid int32) error {
-	logger = logger.Session("update-vm-to-use", lager.Data{"cid": cid})
+// RetireVirtualGuest moves a free VM that has not changed since idleBefore to
+// retiring, so it can no longer be ordered. VMs that were touched since come
+// back with a conflict.
+func (db *SQLDB) RetireVirtualGuest(logger lager.Logger, cid int32, idleBefore time.Time) error {
+	logger = logger.Session("retire-vm", lager.Data{"cid": cid, "idle-before": idleBefore})
 
-	err := db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
+	return db.transact(logger, "retire-vm", func(logger lager.Logger, tx *sql.Tx) error {
 		vm, err := db.fetchVMForUpdate(logger, cid, tx)
 		if err != nil {
 			logger.Error("failed-locking-vm", err)
 			return err
 		}
 
-		if err = vm.ValidateTransitionTo(models.StateUsing); err != nil {
-			logger.Error("failed-to-transition-vm-to-running", err)
-			return err
+		if vm.State != models.StateFree || time.Time(vm.ModifyDate).After(idleBefore) {
+			logger.Info("vm-no-longer-idle", lager.Data{"state": vm.State, "modified": vm.ModifyDate})
+			return models.ErrResourceConflict
 		}
 
 		logger.Info("starting")
@@ -388,7 +412,7 @@ func (db *SQLDB) ChangeVirtualGuestToUse(logger lager.Logger, cid int32) error {
 		now := db.clock.Now().UnixNano()
 		_, err = db.update(logger, tx, virtualGuests,
 			SQLAttributes{
-				"state":      "using",
+				"state":      string(models.StateRetiring),
 				"updated_at": now,
 			},
 			"cid = ?", cid,
@@ -397,24 +421,20 @@ func (db *SQLDB) ChangeVirtualGuestToUse(logger lager.Logger, cid int32) error {
 			return db.convertSQLError(err)
 		}
 
-		return nil
+		return db.recordTransition(logger, tx, vm, models.StateRetiring, now)
 	})
-
-	return err
 }
 
-func (db *SQLDB) ChangeVirtualGuestToFree(logger lager.Logger, cid int32) error {
-	logger = logger.Session("update-vm-to-free", lager.Data{"cid": cid})
-
-	err := db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
+func (db *SQLDB) changeVirtualGuestState(logger lager.Logger, cid int32, state models.State) error {
+	return db.transact(logger, "change-vm-state", func(logger lager.Logger, tx *sql.Tx) error {
 		vm, err := db.fetchVMForUpdate(logger, cid, tx)
 		if err != nil {
 			logger.Error("failed-locking-vm", err)
 			return err
 		}
 
-		if err = vm.ValidateTransitionTo(models.StateFree); err != nil {
-			logger.Error("failed-to-transition-vm-to-free", err)
+		if err = vm.ValidateTransitionTo(state); err != nil {
+			logger.Error("failed-to-transition-vm", err, lager.Data{"from": vm.State})
 			return err
 		}
 
@@ -423,7 +443,7 @@ func (db *SQLDB) ChangeVirtualGuestToFree(logger lager.Logger, cid int32) error
 		now := db.clock.Now().UnixNano()
 		_, err = db.update(logger, tx, virtualGuests,
 			SQLAttributes{
-				"state":      "free",
+				"state":      string(state),
 				"updated_at": now,
 			},
 			"cid = ?", cid,
@@ -432,10 +452,8 @@ func (db *SQLDB) ChangeVirtualGuestToFree(logger lager.Logger, cid int32) error
 			return db.convertSQLError(err)
 		}
 
-		return nil
+		return db.recordTransition(logger, tx, vm, state, now)
 	})
-
-	return err
 }
 
 func (db *SQLDB) DeleteVirtualGuestFromPool(logger lager.Logger, cid int32) error {
@@ -443,14 +461,14 @@ func (db *SQLDB) DeleteVirtualGuestFromPool(logger lager.Logger, cid int32) erro
 	logger.Info("starting")
 	defer logger.Info("complete")
 
-	return db.transact(logger, func(logger lager.Logger, tx *sql.Tx) error {
+	return db.transact(logger, "delete-vm-from-pool", func(logger lager.Logger, tx *sql.Tx) error {
 		vm, err := db.fetchVMForUpdate(logger, cid, tx)
 		if err != nil {
 			logger.Error("failed-locking-vm", err)
 			return err
 		}
 
-		if vm.State != models.StateFree {
+		if vm.State != models.StateFree && vm.State != models.StateRetiring {
 			err = models.NewTaskTransitionError(vm.State, models.StateFree)
 			logger.Error("invalid-state-transition", err)
 			return err
@@ -462,10 +480,56 @@ func (db *SQLDB) DeleteVirtualGuestFromPool(logger lager.Logger, cid int32) erro
 			return db.convertSQLError(err)
 		}
 
-		return nil
+		_, err = db.delete(logger, tx, vmSecrets, "cid = ?", cid)
+		if err != nil {
+			logger.Error("failed-removing-vm-secret", err)
+			return db.convertSQLError(err)
+		}
+
+		err = db.removeUserData(logger, tx, cid)
+		if err != nil {
+			return err
+		}
+
+		err = db.removeSecretToken(logger, tx, cid)
+		if err != nil {
+			return err
+		}
+
+		return db.insertOutboxEvent(logger, tx, models.WebhookEventTypeVMRemoved, vm)
 	})
 }
 
+// recordTransition queues the webhook event for vm moving to state at now.
+// Every change of state goes through it, so it also forgets the agent
+// settings and the secret token of a VM that is free again, before anyone
+// can order it, and issues a new secret token, on vm, to a VM that is
+// provisioned.
+func (db *SQLDB) recordTransition(logger lager.Logger, tx *sql.Tx, vm *models.VM, state models.State, now int64) error {
+	switch state {
+	case models.StateFree:
+		err := db.removeUserData(logger, tx, vm.Cid)
+		if err != nil {
+			return err
+		}
+		err = db.removeSecretToken(logger, tx, vm.Cid)
+		if err != nil {
+			return err
+		}
+	case models.StateProvisioning:
+		err := db.issueSecretToken(logger, tx, vm, now)
+		if err != nil {
+			return err
+		}
+	}
+
+	changed := *vm
+	changed.State = state
+	changed.SecretToken = ""
+	changed.ModifyDate = strfmt.DateTime(time.Unix(0, now).UTC())
+	return db.insertOutboxEvent(logger, tx, stateEventType(state), &changed)
+}
+
 func (db *SQLDB) fetchVMForUpdate(logger lager.Logger, cid int32, tx *sql.Tx) (*models.VM, error) {
 	row := db.one(logger, tx, virtualGuests,
 		virtualGuestColumns, LockRow,
@@ -474,58 +538,87 @@ func (db *SQLDB) fetchVMForUpdate(logger lager.Logger, cid int32, tx *sql.Tx) (*
 	return db.fetchVirtualGuest(logger, row, tx)
 }
 
-func (db *SQLDB) fetchOneVMWithFilter(logger lager.Logger, filter models.VMFilter, tx *sql.Tx) (*models.VM, error) {
+func (db *SQLDB) fetchVMsForUpdate(logger lager.Logger, pool string, cids []int32, ips []string, tx *sql.Tx) ([]*models.VM, error) {
 	wheres := []string{}
 	values := []interface{}{}
 
-	if filter.CPU > 0 {
-		wheres = append(wheres, "cpu = ?")
-		values = append(values, filter.CPU)
+	if len(cids) > 0 {
+		wheres = append(wheres, "cid IN ("+questionMarks(len(cids))+")")
+		for _, cid := range cids {
+			values = append(values, cid)
+		}
 	}
 
-	if filter.MemoryMb > 0 {
-		wheres = append(wheres, "memory_mb = ?")
-		values = append(values, filter.MemoryMb)
+	if len(ips) > 0 {
+		wheres = append(wheres, "ip IN ("+questionMarks(len(ips))+")")
+		for _, ip := range ips {
+			values = append(values, ip)
+		}
 	}
 
-	if filter.PrivateVlan >0 {
-		wheres = append(wheres, "private_vlan = ?")
-		values = append(values, filter.PrivateVlan)
+	rows, err := db.all(logger, tx, virtualGuests,
+		virtualGuestColumns, LockRow,
+		"pool = ? AND ("+strings.Join(wheres, " OR ")+")", append([]interface{}{pool}, values...)...,
+	)
+	if err != nil {
+		return nil, db.convertSQLError(err)
 	}
+	defer rows.Close()
 
-	if filter.PublicVlan > 0 {
-		wheres = append(wheres, "public_vlan = ?")
-		values = append(values, filter.PublicVlan)
+	results := []*models.VM{}
+	for rows.Next() {
+		vm, err := db.fetchVirtualGuest(logger, rows, tx)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, vm)
 	}
 
-	if filter.DeploymentName != "" {
-		wheres = append(wheres, "deployment_name = ?")
-		values = append(values, filter.DeploymentName)
+	if rows.Err() != nil {
+		return nil, db.convertSQLError(rows.Err())
 	}
 
-	switch filter.State {
-	case models.StateUsing:
-		wheres = append(wheres, "state = ?")
-		values = append(values, "using")
-	case models.StateProvisioning:
-		wheres = append(wheres, "state = ?")
-		values = append(values, "provisioning")
-	case models.StateFree:
-		wheres = append(wheres, "state = ?")
-		values = append(values, "free")
-	default:
+	return results, nil
+}
+
+// unavailableVMs lists the requested cids and ips that are not in the pool
+// or whose VM is not free.
+func unavailableVMs(vms []*models.VM, cids []int32, ips []string) []models.ErrInvalidField {
+	byCid := map[int32]*models.VM{}
+	byIP := map[string]*models.VM{}
+	for _, vm := range vms {
+		byCid[vm.Cid] = vm
+		byIP[string(vm.IP)] = vm
 	}
 
-	row := db.one(logger, tx, virtualGuests,
-		virtualGuestColumns, LockRow,
-		strings.Join(wheres, " AND "), values...,
-	)
-	return db.fetchVirtualGuest(logger, row, tx)
+	var unavailable []models.ErrInvalidField
+	for _, cid := range cids {
+		if message := unavailableMessage(byCid[cid]); message != "" {
+			unavailable = append(unavailable, models.ErrInvalidField{Field: "cids", Message: fmt.Sprintf("%d %s", cid, message)})
+		}
+	}
+	for _, ip := range ips {
+		if message := unavailableMessage(byIP[ip]); message != "" {
+			unavailable = append(unavailable, models.ErrInvalidField{Field: "ips", Message: fmt.Sprintf("%s %s", ip, message)})
+		}
+	}
+	return unavailable
+}
+
+func unavailableMessage(vm *models.VM) string {
+	if vm == nil {
+		return "is not in the pool"
+	}
+	if vm.State != models.StateFree {
+		return fmt.Sprintf("is %s", vm.State)
+	}
+	return ""
 }
 
 func (db *SQLDB) fetchVirtualGuest(logger lager.Logger, scanner RowScanner, tx Queryable) (*models.VM, error) {
-	var hostname, deployment_name, state string
+	var hostname, deployment_name, state, pool string
 	var cpu, memory_mb, cid, public_vlan, private_vlan int32
+	var created_at, updated_at int64
 	var ip strfmt.IPv4
 	err := scanner.Scan(
 		&cid,
@@ -537,6 +630,9 @@ func (db *SQLDB) fetchVirtualGuest(logger lager.Logger, scanner RowScanner, tx Q
 		&public_vlan,
 		&deployment_name,
 		&state,
+		&created_at,
+		&updated_at,
+		&pool,
 	)
 	if err != nil {
 		logger.Error("failed-scanning-row", err)
@@ -552,17 +648,71 @@ func (db *SQLDB) fetchVirtualGuest(logger lager.Logger, scanner RowScanner, tx Q
 		PrivateVlan:      private_vlan,
 		PublicVlan:       public_vlan,
 		DeploymentName:   deployment_name,
+		Pool:             pool,
+		CreateDate:       strfmt.DateTime(time.Unix(0, created_at).UTC()),
+		ModifyDate:       strfmt.DateTime(time.Unix(0, updated_at).UTC()),
 	}
-	switch state {
-	case "free":
-		virtualGuest.State = models.StateFree
-	case "provisioning":
-		virtualGuest.State = models.StateProvisioning
-	case "using":
-		virtualGuest.State = models.StateUsing
-	default:
-		virtualGuest.State = models.StateUnknown
-	}
+	virtualGuest.State, _ = models.ParseState(state)
 
 	return virtualGuest, nil
 }
+
+func filterWheres(filter models.VMFilter) ([]string, []interface{}) {
+	wheres := []string{}
+	values := []interface{}{}
+
+	if filter.Cid > 0 {
+		wheres = append(wheres, "cid = ?")
+		values = append(values, filter.Cid)
+	}
+
+	if filter.IP != "" {
+		wheres = append(wheres, "ip = ?")
+		values = append(values, string(filter.IP))
+	}
+
+	if filter.CPU > 0 {
+		wheres = append(wheres, "cpu = ?")
+		values = append(values, filter.CPU)
+	}
+
+	if filter.MemoryMb > 0 {
+		wheres = append(wheres, "memory_mb = ?")
+		values = append(values, filter.MemoryMb)
+	}
+
+	if filter.PrivateVlan > 0 {
+		wheres = append(wheres, "private_vlan = ?")
+		values = append(values, filter.PrivateVlan)
+	}
+
+	if filter.PublicVlan > 0 {
+		wheres = append(wheres, "public_vlan = ?")
+		values = append(values, filter.PublicVlan)
+	}
+
+	if filter.Pool != "" {
+		wheres = append(wheres, "pool = ?")
+		values = append(values, filter.Pool)
+	}
+
+	if filter.DeploymentName != "" {
+		wheres = append(wheres, "deployment_name = ?")
+		values = append(values, filter.DeploymentName)
+	}
+
+	// an unknown state in a filter means "any state"
+	if state, ok := models.ParseState(string(filter.State)); ok && state != models.StateUnknown {
+		wheres = append(wheres, "state = ?")
+		values = append(values, string(state))
+	}
+
+	return wheres, values
+}
+
+// likePattern turns a hostname pattern into a LIKE pattern: * becomes % and
+// the LIKE wildcards already in it are escaped so they match literally.
+func likePattern(pattern string) string {
+	escaped := strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(pattern)
+	return strings.Replace(escaped, "*", "%", -1)
+}
@@ -0,0 +1,184 @@
+package sqldb_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/lagertest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jianqiu/vps/db/sqldb"
+	"github.com/jianqiu/vps/db/sqldb/sqltest"
+	"github.com/jianqiu/vps/models"
+)
+
+// vmRow is how the virtual_guests table returns the VM with cid, of the
+// default pool and the deployment named deployment.
+func vmRow(cid int32, state models.State) []driver.Value {
+	return []driver.Value{
+		int64(cid), "vm-1234", "10.0.0.1", int64(4), int64(8192), int64(100), int64(200),
+		"deployment", string(state), int64(0), int64(0), models.DefaultPool,
+	}
+}
+
+var _ = Describe("VirtualGuestDB", func() {
+	Describe("UpdateVirtualGuestInPool", func() {
+		var (
+			logger    *lagertest.TestLogger
+			connector *sqltest.RecordingConnector
+			db        *sqldb.SQLDB
+			vm        *models.VM
+		)
+
+		BeforeEach(func() {
+			logger = lagertest.NewTestLogger("test")
+			connector = sqltest.NewRecordingConnector()
+			db = sqldb.NewSQLDB(sql.OpenDB(connector), clock.NewClock(), sqldb.MySQL, sqldb.RetryPolicy{MaxAttempts: 1})
+
+			vm = &models.VM{
+				Cid:            1234,
+				Hostname:       "vm-1234",
+				IP:             "10.0.0.1",
+				CPU:            4,
+				MemoryMb:       8192,
+				PrivateVlan:    100,
+				PublicVlan:     200,
+				DeploymentName: "deployment",
+				Pool:           models.DefaultPool,
+			}
+		})
+
+		Context("when the vm is set free", func() {
+			BeforeEach(func() {
+				connector.SetRows("FROM virtual_guests", vmRow(1234, models.StateUsing))
+				vm.State = models.StateFree
+			})
+
+			It("removes its agent settings and queues the event", func() {
+				Expect(db.UpdateVirtualGuestInPool(logger, vm)).To(Succeed())
+
+				Expect(connector.Statements("UPDATE virtual_guests")).To(HaveLen(1))
+				Expect(connector.Statements("UPDATE virtual_guests")[0].Query).To(ContainSubstring("state = ?"))
+				Expect(connector.Statements("DELETE FROM vm_user_data")).To(HaveLen(1))
+				Expect(connector.Statements("DELETE FROM vm_secret_tokens")).To(HaveLen(1))
+				Expect(connector.Statements("INSERT INTO outbox_events")).To(HaveLen(1))
+			})
+		})
+
+		Context("when the state is not changed", func() {
+			BeforeEach(func() {
+				connector.SetRows("FROM virtual_guests", vmRow(1234, models.StateUsing))
+				vm.State = models.StateUsing
+			})
+
+			It("only updates the fields of the vm", func() {
+				Expect(db.UpdateVirtualGuestInPool(logger, vm)).To(Succeed())
+
+				Expect(connector.Statements("UPDATE virtual_guests")).To(HaveLen(1))
+				Expect(connector.Statements("UPDATE virtual_guests")[0].Query).NotTo(ContainSubstring("state = ?"))
+				Expect(connector.Statements("DELETE FROM vm_user_data")).To(BeEmpty())
+				Expect(connector.Statements("INSERT INTO outbox_events")).To(BeEmpty())
+			})
+		})
+
+		Context("when the lifecycle does not allow the new state", func() {
+			BeforeEach(func() {
+				connector.SetRows("FROM virtual_guests", vmRow(1234, models.StateRetiring))
+				vm.State = models.StateUsing
+			})
+
+			It("refuses the update", func() {
+				err := db.UpdateVirtualGuestInPool(logger, vm)
+				Expect(err).To(HaveOccurred())
+
+				Expect(connector.Statements("UPDATE virtual_guests")).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("DeleteVirtualGuestFromPool", func() {
+		var (
+			logger    *lagertest.TestLogger
+			connector *sqltest.RecordingConnector
+			db        *sqldb.SQLDB
+		)
+
+		BeforeEach(func() {
+			logger = lagertest.NewTestLogger("test")
+			connector = sqltest.NewRecordingConnector()
+			db = sqldb.NewSQLDB(sql.OpenDB(connector), clock.NewClock(), sqldb.MySQL, sqldb.RetryPolicy{MaxAttempts: 1})
+		})
+
+		It("removes the vm along with its secret, agent settings and secret token", func() {
+			connector.SetRows("FROM virtual_guests", vmRow(1234, models.StateRetiring))
+
+			Expect(db.DeleteVirtualGuestFromPool(logger, 1234)).To(Succeed())
+
+			for _, table := range []string{"virtual_guests", "vm_secrets", "vm_user_data", "vm_secret_tokens"} {
+				deletes := connector.Statements("DELETE FROM " + table)
+				Expect(deletes).To(HaveLen(1), table)
+				Expect(deletes[0].Args).To(Equal([]driver.Value{int64(1234)}), table)
+			}
+			Expect(connector.Statements("INSERT INTO outbox_events")).To(HaveLen(1))
+		})
+
+		It("keeps a vm that is in use", func() {
+			connector.SetRows("FROM virtual_guests", vmRow(1234, models.StateUsing))
+
+			Expect(db.DeleteVirtualGuestFromPool(logger, 1234)).NotTo(Succeed())
+			Expect(connector.Statements("DELETE FROM")).To(BeEmpty())
+		})
+	})
+
+	Describe("OrderVirtualGuestsToProvision", func() {
+		var (
+			logger    *lagertest.TestLogger
+			connector *sqltest.RecordingConnector
+			db        *sqldb.SQLDB
+		)
+
+		BeforeEach(func() {
+			logger = lagertest.NewTestLogger("test")
+			connector = sqltest.NewRecordingConnector()
+			db = sqldb.NewSQLDB(sql.OpenDB(connector), clock.NewClock(), sqldb.MySQL, sqldb.RetryPolicy{MaxAttempts: 1})
+
+			connector.SetRows("FROM virtual_guests", vmRow(1234, models.StateFree), vmRow(1235, models.StateFree))
+		})
+
+		It("returns every vm with a secret token of its own and stores their hashes", func() {
+			vms, err := db.OrderVirtualGuestsToProvision(logger, models.DefaultPool, []int32{1234, 1235}, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vms).To(HaveLen(2))
+			Expect(vms[0].SecretToken).NotTo(BeEmpty())
+			Expect(vms[1].SecretToken).NotTo(BeEmpty())
+			Expect(vms[0].SecretToken).NotTo(Equal(vms[1].SecretToken))
+
+			upserts := connector.Statements("INSERT INTO vm_secret_tokens")
+			Expect(upserts).To(HaveLen(2))
+			Expect(upserts[0].Args).To(ContainElement(models.HashSecretToken(vms[0].SecretToken)))
+			Expect(upserts[0].Args).NotTo(ContainElement(vms[0].SecretToken))
+			Expect(upserts[1].Args).To(ContainElement(models.HashSecretToken(vms[1].SecretToken)))
+		})
+
+		It("rejects a request for no vms without building a query", func() {
+			_, err := db.OrderVirtualGuestsToProvision(logger, models.DefaultPool, nil, []string{})
+			Expect(err).To(Equal(models.ErrBadRequest))
+			Expect(connector.Statements("")).To(BeEmpty())
+		})
+
+		It("keeps the tokens out of the webhook events", func() {
+			vms, err := db.OrderVirtualGuestsToProvision(logger, models.DefaultPool, []int32{1234, 1235}, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			events := connector.Statements("INSERT INTO outbox_events")
+			Expect(events).To(HaveLen(2))
+			for _, event := range events {
+				Expect(fmt.Sprint(event.Args)).NotTo(ContainSubstring(vms[0].SecretToken))
+				Expect(fmt.Sprint(event.Args)).NotTo(ContainSubstring(vms[1].SecretToken))
+			}
+		})
+	})
+})
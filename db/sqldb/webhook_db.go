@@ -0,0 +1,510 @@
+package sqldb
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/models"
+	"github.com/nu7hatch/gouuid"
+)
+
+func (db *SQLDB) Webhooks(logger lager.Logger) ([]*models.Webhook, error) {
+	logger = logger.Session("webhooks")
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	return db.fetchWebhooks(logger, db.db)
+}
+
+func (db *SQLDB) WebhookByID(logger lager.Logger, id string) (*models.Webhook, error) {
+	logger = logger.Session("webhook-by-id", lager.Data{"id": id})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	row := db.one(logger, db.db, webhooks,
+		webhookColumns, NoLockRow,
+		"id = ?", id,
+	)
+	return db.fetchWebhook(logger, row)
+}
+
+func (db *SQLDB) InsertWebhook(logger lager.Logger, webhook *models.Webhook) error {
+	logger = logger.Session("insert-webhook", lager.Data{"id": webhook.ID, "url": webhook.URL})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	events := []string{}
+	for _, event := range webhook.Events {
+		events = append(events, string(event))
+	}
+
+	_, err := db.insert(logger, db.db, webhooks,
+		SQLAttributes{
+			"id":         webhook.ID,
+			"url":        webhook.URL,
+			"secret":     webhook.Secret,
+			"events":     strings.Join(events, ","),
+			"created_at": db.clock.Now().UnixNano(),
+		},
+	)
+	if err != nil {
+		logger.Error("failed-inserting-webhook", err)
+		return db.convertSQLError(err)
+	}
+
+	return nil
+}
+
+// DeleteWebhook removes the webhook together with any deliveries it still
+// has queued.
+func (db *SQLDB) DeleteWebhook(logger lager.Logger, id string) error {
+	logger = logger.Session("delete-webhook", lager.Data{"id": id})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	return db.transact(logger, "delete-webhook", func(logger lager.Logger, tx *sql.Tx) error {
+		result, err := db.delete(logger, tx, webhooks, "id = ?", id)
+		if err != nil {
+			logger.Error("failed-removing-webhook", err)
+			return db.convertSQLError(err)
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return db.convertSQLError(err)
+		}
+		if rowsAffected == 0 {
+			return models.ErrResourceNotFound
+		}
+
+		_, err = db.delete(logger, tx, webhookDeliveries, "webhook_id = ? AND state = ?", id, string(models.WebhookDeliveryStatePending))
+		if err != nil {
+			logger.Error("failed-removing-deliveries", err)
+			return db.convertSQLError(err)
+		}
+
+		return nil
+	})
+}
+
+// OutboxRetention is how long an event stays in the outbox once it is
+// dispatched, for the watchers that read the outbox to catch up with it.
+const OutboxRetention = 10 * time.Minute
+
+// DispatchOutboxEvents turns up to limit of the oldest outbox events not yet
+// dispatched into one pending delivery per subscribed webhook, and marks
+// them dispatched. Events dispatched longer than OutboxRetention ago are
+// removed. It returns the number of events dispatched.
+func (db *SQLDB) DispatchOutboxEvents(logger lager.Logger, limit int) (int, error) {
+	logger = logger.Session("dispatch-outbox-events")
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	dispatched := 0
+	err := db.transact(logger, "dispatch-outbox-events", func(logger lager.Logger, tx *sql.Tx) error {
+		dispatched = 0
+
+		rows, err := tx.Query(db.rebind(fmt.Sprintf(
+			"SELECT %s FROM %s WHERE dispatched_at = 0 ORDER BY created_at LIMIT ? FOR UPDATE",
+			strings.Join(outboxEventColumns, ", "), outboxEvents,
+		)), limit)
+		if err != nil {
+			logger.Error("failed-query", err)
+			return db.convertSQLError(err)
+		}
+
+		type outboxEvent struct {
+			id, eventType, payload string
+		}
+		events := []outboxEvent{}
+		for rows.Next() {
+			var event outboxEvent
+			err := rows.Scan(&event.id, &event.eventType, &event.payload)
+			if err != nil {
+				rows.Close()
+				logger.Error("failed-scanning-row", err)
+				return db.convertSQLError(err)
+			}
+			events = append(events, event)
+		}
+		rows.Close()
+		if rows.Err() != nil {
+			return db.convertSQLError(rows.Err())
+		}
+
+		now := db.clock.Now().UnixNano()
+		_, err = db.delete(logger, tx, outboxEvents, "dispatched_at > 0 AND dispatched_at < ?", now-OutboxRetention.Nanoseconds())
+		if err != nil {
+			logger.Error("failed-removing-outbox-events", err)
+			return db.convertSQLError(err)
+		}
+		if len(events) == 0 {
+			return nil
+		}
+
+		subscribers, err := db.fetchWebhooks(logger, tx)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			for _, webhook := range subscribers {
+				if !webhookSubscribed(webhook, models.WebhookEventType(event.eventType)) {
+					continue
+				}
+
+				guid, err := uuid.NewV4()
+				if err != nil {
+					logger.Error("failed-to-generate-guid", err)
+					return models.NewError(models.ErrorTypeGUIDGeneration, err.Error())
+				}
+
+				_, err = db.insert(logger, tx, webhookDeliveries,
+					SQLAttributes{
+						"id":              guid.String(),
+						"webhook_id":      webhook.ID,
+						"event_id":        event.id,
+						"event_type":      event.eventType,
+						"url":             webhook.URL,
+						"payload":         event.payload,
+						"state":           string(models.WebhookDeliveryStatePending),
+						"attempts":        0,
+						"last_error":      "",
+						"next_attempt_at": now,
+						"created_at":      now,
+						"updated_at":      now,
+					},
+				)
+				if err != nil {
+					logger.Error("failed-inserting-delivery", err)
+					return db.convertSQLError(err)
+				}
+			}
+
+			_, err = db.update(logger, tx, outboxEvents,
+				SQLAttributes{"dispatched_at": now},
+				"id = ?", event.id,
+			)
+			if err != nil {
+				logger.Error("failed-marking-outbox-event", err)
+				return db.convertSQLError(err)
+			}
+			dispatched++
+		}
+
+		return nil
+	})
+
+	return dispatched, err
+}
+
+// OutboxEventsSince returns the events recorded in the outbox at or after
+// since, a time in Unix nanoseconds, the oldest first. Dispatched events are
+// only kept for OutboxRetention.
+func (db *SQLDB) OutboxEventsSince(logger lager.Logger, since int64) ([]*models.WebhookEvent, error) {
+	logger = logger.Session("outbox-events-since", lager.Data{"since": since})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	rows, err := db.db.Query(db.rebind(fmt.Sprintf(
+		"SELECT %s.payload FROM %s WHERE created_at >= ? ORDER BY created_at, id",
+		outboxEvents, outboxEvents,
+	)), since)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return nil, db.convertSQLError(err)
+	}
+	defer rows.Close()
+
+	events := []*models.WebhookEvent{}
+	for rows.Next() {
+		var payload string
+		err := rows.Scan(&payload)
+		if err != nil {
+			logger.Error("failed-scanning-row", err)
+			return nil, db.convertSQLError(err)
+		}
+
+		event := &models.WebhookEvent{}
+		err = json.Unmarshal([]byte(payload), event)
+		if err != nil {
+			logger.Error("failed-to-unmarshal-event", err)
+			return nil, models.NewError(models.ErrorTypeInvalidJSON, err.Error())
+		}
+		events = append(events, event)
+	}
+	if rows.Err() != nil {
+		return nil, db.convertSQLError(rows.Err())
+	}
+
+	return events, nil
+}
+
+// PendingWebhookDeliveries returns up to limit pending deliveries that are
+// due, the longest waiting first.
+func (db *SQLDB) PendingWebhookDeliveries(logger lager.Logger, limit int) ([]*models.WebhookDelivery, error) {
+	logger = logger.Session("pending-webhook-deliveries")
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	rows, err := db.db.Query(db.rebind(fmt.Sprintf(
+		"SELECT %s FROM %s WHERE state = ? AND next_attempt_at <= ? ORDER BY next_attempt_at LIMIT ?",
+		strings.Join(webhookDeliveryColumns, ", "), webhookDeliveries,
+	)), string(models.WebhookDeliveryStatePending), db.clock.Now().UnixNano(), limit)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return nil, db.convertSQLError(err)
+	}
+
+	return db.fetchWebhookDeliveries(logger, rows)
+}
+
+func (db *SQLDB) CompleteWebhookDelivery(logger lager.Logger, id string) error {
+	logger = logger.Session("complete-webhook-delivery", lager.Data{"id": id})
+
+	_, err := db.update(logger, db.db, webhookDeliveries,
+		SQLAttributes{
+			"state":      string(models.WebhookDeliveryStateDelivered),
+			"attempts":   sqlExpression("attempts + 1"),
+			"last_error": "",
+			"updated_at": db.clock.Now().UnixNano(),
+		},
+		"id = ?", id,
+	)
+	if err != nil {
+		logger.Error("failed-updating-delivery", err)
+		return db.convertSQLError(err)
+	}
+
+	return nil
+}
+
+// FailWebhookDelivery records a failed attempt. The delivery is tried again
+// at nextAttemptAt, or moved to the dead letters when dead is set.
+func (db *SQLDB) FailWebhookDelivery(logger lager.Logger, id string, reason string, nextAttemptAt time.Time, dead bool) error {
+	logger = logger.Session("fail-webhook-delivery", lager.Data{"id": id, "dead": dead})
+
+	state := models.WebhookDeliveryStatePending
+	if dead {
+		state = models.WebhookDeliveryStateDead
+	}
+
+	_, err := db.update(logger, db.db, webhookDeliveries,
+		SQLAttributes{
+			"state":           string(state),
+			"attempts":        sqlExpression("attempts + 1"),
+			"last_error":      reason,
+			"next_attempt_at": nextAttemptAt.UnixNano(),
+			"updated_at":      db.clock.Now().UnixNano(),
+		},
+		"id = ?", id,
+	)
+	if err != nil {
+		logger.Error("failed-updating-delivery", err)
+		return db.convertSQLError(err)
+	}
+
+	return nil
+}
+
+func (db *SQLDB) DeadWebhookDeliveries(logger lager.Logger) ([]*models.WebhookDelivery, error) {
+	logger = logger.Session("dead-webhook-deliveries")
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	rows, err := db.all(logger, db.db, webhookDeliveries,
+		webhookDeliveryColumns, NoLockRow,
+		"state = ?", string(models.WebhookDeliveryStateDead),
+	)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return nil, db.convertSQLError(err)
+	}
+
+	return db.fetchWebhookDeliveries(logger, rows)
+}
+
+// RetryWebhookDelivery queues a dead delivery again with a fresh set of
+// attempts.
+func (db *SQLDB) RetryWebhookDelivery(logger lager.Logger, id string) error {
+	logger = logger.Session("retry-webhook-delivery", lager.Data{"id": id})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	now := db.clock.Now().UnixNano()
+	result, err := db.update(logger, db.db, webhookDeliveries,
+		SQLAttributes{
+			"state":           string(models.WebhookDeliveryStatePending),
+			"attempts":        0,
+			"next_attempt_at": now,
+			"updated_at":      now,
+		},
+		"id = ? AND state = ?", id, string(models.WebhookDeliveryStateDead),
+	)
+	if err != nil {
+		logger.Error("failed-updating-delivery", err)
+		return db.convertSQLError(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return db.convertSQLError(err)
+	}
+	if rowsAffected == 0 {
+		return models.ErrResourceNotFound
+	}
+
+	return nil
+}
+
+// insertOutboxEvent records a VM event in the outbox as part of tx, so it is
+// only delivered if the change that caused it commits.
+func (db *SQLDB) insertOutboxEvent(logger lager.Logger, tx *sql.Tx, eventType models.WebhookEventType, vm *models.VM) error {
+	guid, err := uuid.NewV4()
+	if err != nil {
+		logger.Error("failed-to-generate-guid", err)
+		return models.NewError(models.ErrorTypeGUIDGeneration, err.Error())
+	}
+
+	now := db.clock.Now().UnixNano()
+	payload, err := json.Marshal(&models.WebhookEvent{
+		ID:        guid.String(),
+		Type:      eventType,
+		Timestamp: now,
+		VM:        vm,
+	})
+	if err != nil {
+		logger.Error("failed-to-marshal-event", err)
+		return models.NewError(models.ErrorTypeInvalidJSON, err.Error())
+	}
+
+	_, err = db.insert(logger, tx, outboxEvents,
+		SQLAttributes{
+			"id":         guid.String(),
+			"event_type": string(eventType),
+			"payload":    string(payload),
+			"created_at": now,
+		},
+	)
+	if err != nil {
+		logger.Error("failed-inserting-outbox-event", err)
+		return db.convertSQLError(err)
+	}
+
+	return nil
+}
+
+func stateEventType(state models.State) models.WebhookEventType {
+	switch state {
+	case models.StateProvisioning:
+		return models.WebhookEventTypeVMOrdered
+	case models.StateFree:
+		return models.WebhookEventTypeVMReleased
+	default:
+		return models.WebhookEventTypeVMStateChanged
+	}
+}
+
+func webhookSubscribed(webhook *models.Webhook, eventType models.WebhookEventType) bool {
+	if len(webhook.Events) == 0 {
+		return true
+	}
+	for _, event := range webhook.Events {
+		if event == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func (db *SQLDB) fetchWebhooks(logger lager.Logger, q Queryable) ([]*models.Webhook, error) {
+	rows, err := db.all(logger, q, webhooks,
+		webhookColumns, NoLockRow,
+		"",
+	)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return nil, db.convertSQLError(err)
+	}
+	defer rows.Close()
+
+	results := []*models.Webhook{}
+	for rows.Next() {
+		webhook, err := db.fetchWebhook(logger, rows)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, webhook)
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-getting-next-row", rows.Err())
+		return nil, db.convertSQLError(rows.Err())
+	}
+
+	return results, nil
+}
+
+func (db *SQLDB) fetchWebhook(logger lager.Logger, scanner RowScanner) (*models.Webhook, error) {
+	var id, url, secret, events string
+	err := scanner.Scan(&id, &url, &secret, &events)
+	if err != nil {
+		logger.Error("failed-scanning-row", err)
+		return nil, models.ErrResourceNotFound
+	}
+
+	webhook := &models.Webhook{
+		ID:     id,
+		URL:    url,
+		Secret: secret,
+		Events: []models.WebhookEventType{},
+	}
+	if events != "" {
+		for _, event := range strings.Split(events, ",") {
+			webhook.Events = append(webhook.Events, models.WebhookEventType(event))
+		}
+	}
+
+	return webhook, nil
+}
+
+func (db *SQLDB) fetchWebhookDeliveries(logger lager.Logger, rows *sql.Rows) ([]*models.WebhookDelivery, error) {
+	defer rows.Close()
+
+	results := []*models.WebhookDelivery{}
+	for rows.Next() {
+		var id, webhookID, eventID, eventType, url, payload, state string
+		var lastError sql.NullString
+		var attempts int32
+		var nextAttemptAt int64
+		err := rows.Scan(&id, &webhookID, &eventID, &eventType, &url, &payload, &state, &attempts, &lastError, &nextAttemptAt)
+		if err != nil {
+			logger.Error("failed-scanning-row", err)
+			return nil, db.convertSQLError(err)
+		}
+
+		results = append(results, &models.WebhookDelivery{
+			ID:            id,
+			WebhookID:     webhookID,
+			EventID:       eventID,
+			EventType:     models.WebhookEventType(eventType),
+			URL:           url,
+			Payload:       payload,
+			State:         models.WebhookDeliveryState(state),
+			Attempts:      attempts,
+			LastError:     lastError.String,
+			NextAttemptAt: nextAttemptAt,
+		})
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-getting-next-row", rows.Err())
+		return nil, db.convertSQLError(rows.Err())
+	}
+
+	return results, nil
+}
@@ -0,0 +1,94 @@
+package sqldb_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/lagertest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jianqiu/vps/db/sqldb"
+	"github.com/jianqiu/vps/db/sqldb/sqltest"
+	"github.com/jianqiu/vps/models"
+)
+
+var _ = Describe("WebhookDB", func() {
+	var (
+		logger    *lagertest.TestLogger
+		connector *sqltest.RecordingConnector
+		db        *sqldb.SQLDB
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		connector = sqltest.NewRecordingConnector()
+		db = sqldb.NewSQLDB(sql.OpenDB(connector), clock.NewClock(), sqldb.MySQL, sqldb.RetryPolicy{MaxAttempts: 1})
+	})
+
+	Describe("DispatchOutboxEvents", func() {
+		BeforeEach(func() {
+			connector.SetRows("FROM outbox_events", []driver.Value{"event-1", "vm-released", `{"id":"event-1"}`})
+			connector.SetRows("FROM webhooks", []driver.Value{"webhook-1", "http://example.com", "secret", ""})
+		})
+
+		It("queues a delivery and keeps the event in the outbox, marked dispatched", func() {
+			before := time.Now().UnixNano()
+			dispatched, err := db.DispatchOutboxEvents(logger, 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dispatched).To(Equal(1))
+
+			Expect(connector.Statements("SELECT")[0].Query).To(ContainSubstring("WHERE dispatched_at = 0"))
+			Expect(connector.Statements("INSERT INTO webhook_deliveries")).To(HaveLen(1))
+
+			updates := connector.Statements("UPDATE outbox_events")
+			Expect(updates).To(HaveLen(1))
+			Expect(updates[0].Query).To(ContainSubstring("dispatched_at = ?"))
+			Expect(updates[0].Args[0]).To(BeNumerically(">=", before))
+			Expect(updates[0].Args[1]).To(Equal("event-1"))
+		})
+
+		It("removes the events dispatched longer than the retention ago", func() {
+			before := time.Now().UnixNano()
+			_, err := db.DispatchOutboxEvents(logger, 10)
+			Expect(err).NotTo(HaveOccurred())
+
+			deletes := connector.Statements("DELETE FROM outbox_events")
+			Expect(deletes).To(HaveLen(1))
+			Expect(deletes[0].Query).To(ContainSubstring("dispatched_at > 0 AND dispatched_at < ?"))
+			Expect(deletes[0].Args[0]).To(BeNumerically(">=", before-sqldb.OutboxRetention.Nanoseconds()))
+			Expect(deletes[0].Args[0]).To(BeNumerically("<=", time.Now().UnixNano()-sqldb.OutboxRetention.Nanoseconds()))
+		})
+	})
+
+	Describe("OutboxEventsSince", func() {
+		It("returns the events recorded since the time, oldest first", func() {
+			connector.SetRows("FROM outbox_events",
+				[]driver.Value{`{"id":"event-1","type":"vm-ordered","timestamp":10,"vm":{"cid":1,"state":"provisioning"}}`},
+				[]driver.Value{`{"id":"event-2","type":"vm-removed","timestamp":20,"vm":{"cid":2,"state":"using"}}`},
+			)
+
+			events, err := db.OutboxEventsSince(logger, 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(events).To(Equal([]*models.WebhookEvent{
+				{ID: "event-1", Type: models.WebhookEventTypeVMOrdered, Timestamp: 10, VM: &models.VM{Cid: 1, State: models.StateProvisioning}},
+				{ID: "event-2", Type: models.WebhookEventTypeVMRemoved, Timestamp: 20, VM: &models.VM{Cid: 2, State: models.StateUsing}},
+			}))
+
+			selects := connector.Statements("SELECT")
+			Expect(selects).To(HaveLen(1))
+			Expect(selects[0].Query).To(ContainSubstring("WHERE created_at >= ? ORDER BY created_at, id"))
+			Expect(selects[0].Args).To(Equal([]driver.Value{int64(10)}))
+		})
+
+		It("fails on an event it cannot read", func() {
+			connector.SetRows("FROM outbox_events", []driver.Value{"{"})
+
+			_, err := db.OutboxEventsSince(logger, 0)
+			Expect(err).To(HaveOccurred())
+			Expect(err.(*models.Error).Type).To(Equal(models.ErrorTypeInvalidJSON))
+		})
+	})
+})
@@ -0,0 +1,14 @@
+package db
+
+import (
+	"code.cloudfoundry.org/lager"
+)
+
+//go:generate counterfeiter . UserDataDB
+type UserDataDB interface {
+	// VMUserData returns the agent settings the VM with cid was ordered
+	// with, or ErrResourceNotFound when it was ordered without or has been
+	// free since. The settings are stored along with the order of a
+	// VMFilter that carries them.
+	VMUserData(logger lager.Logger, cid int32) (string, error)
+}
@@ -1,6 +1,8 @@
 package db
 
 import (
+	"time"
+
 	"github.com/jianqiu/vps/models"
 	"code.cloudfoundry.org/lager"
 )
@@ -9,16 +11,21 @@ import (
 type VirtualGuestDB interface {
 	VirtualGuests(logger lager.Logger, filter models.VMFilter) ([]*models.VM, error)
 	OrderVirtualGuestToProvision(logger lager.Logger, filter models.VMFilter) (*models.VM, error)
+	OrderVirtualGuestsToProvision(logger lager.Logger, pool string, cids []int32, ips []string) ([]*models.VM, error)
 	VirtualGuestsByStates(logger lager.Logger, states []string) ([]*models.VM, error)
 	VirtualGuestsByDeployments(logger lager.Logger, names []string) ([]*models.VM, error)
 	VirtualGuestByCID(logger lager.Logger, cid int32) (*models.VM, error)
 	VirtualGuestByIP(logger lager.Logger, ip string) (*models.VM, error)
+	VirtualGuestByHostname(logger lager.Logger, hostname string) (*models.VM, error)
+	VirtualGuestsByHostnamePattern(logger lager.Logger, pattern string) ([]*models.VM, error)
 
 	InsertVirtualGuestToPool(logger lager.Logger,virtualGuest *models.VM) error
 	UpdateVirtualGuestInPool(logger lager.Logger, virtualGuest *models.VM) error
 	ChangeVirtualGuestToProvision(logger lager.Logger, cid int32) error
 	ChangeVirtualGuestToUse(logger lager.Logger, cid int32) error
 	ChangeVirtualGuestToFree(logger lager.Logger, cid int32) error
+	TransitionVirtualGuest(logger lager.Logger, cid int32, state models.State) error
+	RetireVirtualGuest(logger lager.Logger, cid int32, idleBefore time.Time) error
 	DeleteVirtualGuestFromPool(logger lager.Logger, cid int32) error
 }
 
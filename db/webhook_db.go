@@ -0,0 +1,24 @@
+package db
+
+import (
+	"time"
+
+	"github.com/jianqiu/vps/models"
+	"code.cloudfoundry.org/lager"
+)
+
+//go:generate counterfeiter . WebhookDB
+type WebhookDB interface {
+	Webhooks(logger lager.Logger) ([]*models.Webhook, error)
+	WebhookByID(logger lager.Logger, id string) (*models.Webhook, error)
+	InsertWebhook(logger lager.Logger, webhook *models.Webhook) error
+	DeleteWebhook(logger lager.Logger, id string) error
+
+	DispatchOutboxEvents(logger lager.Logger, limit int) (int, error)
+	OutboxEventsSince(logger lager.Logger, since int64) ([]*models.WebhookEvent, error)
+	PendingWebhookDeliveries(logger lager.Logger, limit int) ([]*models.WebhookDelivery, error)
+	CompleteWebhookDelivery(logger lager.Logger, id string) error
+	FailWebhookDelivery(logger lager.Logger, id string, reason string, nextAttemptAt time.Time, dead bool) error
+	DeadWebhookDeliveries(logger lager.Logger) ([]*models.WebhookDelivery, error)
+	RetryWebhookDelivery(logger lager.Logger, id string) error
+}
@@ -0,0 +1,142 @@
+package grpcapi
+
+import (
+	"net"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+
+	"github.com/jianqiu/vps/models"
+)
+
+func vmFromModel(vm *models.VM) *Vm {
+	if vm == nil {
+		return nil
+	}
+	return &Vm{
+		Cid:            vm.Cid,
+		Hostname:       vm.Hostname,
+		Cpu:            vm.CPU,
+		MemoryMb:       vm.MemoryMb,
+		PublicVlan:     vm.PublicVlan,
+		PrivateVlan:    vm.PrivateVlan,
+		Ip:             string(vm.IP),
+		State:          string(vm.State),
+		DeploymentName: vm.DeploymentName,
+		Pool:           vm.Pool,
+		CreateDate:     unixNano(vm.CreateDate),
+		ModifyDate:     unixNano(vm.ModifyDate),
+		SecretToken:    vm.SecretToken,
+	}
+}
+
+func vmsFromModels(vms []*models.VM) *VmsResponse {
+	response := &VmsResponse{Vms: make([]*Vm, 0, len(vms))}
+	for _, vm := range vms {
+		response.Vms = append(response.Vms, vmFromModel(vm))
+	}
+	return response
+}
+
+func vmToModel(vm *Vm) (*models.VM, error) {
+	state, err := parseOptionalState(vm.State)
+	if err != nil {
+		return nil, err
+	}
+	if vm.Ip != "" && !isIPv4(vm.Ip) {
+		return nil, invalidMessage("ip", "must be an ipv4 address")
+	}
+	return &models.VM{
+		Cid:            vm.Cid,
+		Hostname:       vm.Hostname,
+		CPU:            vm.Cpu,
+		MemoryMb:       vm.MemoryMb,
+		PublicVlan:     vm.PublicVlan,
+		PrivateVlan:    vm.PrivateVlan,
+		IP:             strfmt.IPv4(vm.Ip),
+		State:          state,
+		DeploymentName: vm.DeploymentName,
+		Pool:           vm.Pool,
+		CreateDate:     dateTime(vm.CreateDate),
+		ModifyDate:     dateTime(vm.ModifyDate),
+		SecretToken:    vm.SecretToken,
+	}, nil
+}
+
+func filterToModel(filter *VmFilter) (*models.VMFilter, error) {
+	state, err := parseOptionalState(filter.State)
+	if err != nil {
+		return nil, err
+	}
+	return &models.VMFilter{
+		CPU:            filter.Cpu,
+		MemoryMb:       filter.MemoryMb,
+		PublicVlan:     filter.PublicVlan,
+		PrivateVlan:    filter.PrivateVlan,
+		DeploymentName: filter.DeploymentName,
+		State:          state,
+		Flavor:         filter.Flavor,
+		UserData:       filter.UserData,
+	}, nil
+}
+
+func errorFromModel(err *models.Error) *Error {
+	converted := &Error{Type: string(err.Type), Message: err.Message}
+	for _, field := range err.Fields {
+		converted.Fields = append(converted.Fields, &FieldError{Field: field.Field, Message: field.Message})
+	}
+	return converted
+}
+
+func errorToModel(err *Error) *models.Error {
+	converted := models.NewError(models.ErrorType(err.Type), err.Message)
+	for _, field := range err.Fields {
+		converted.Fields = append(converted.Fields, &models.FieldError{Field: field.Field, Message: field.Message})
+	}
+	return converted
+}
+
+func parseState(name string) (models.State, error) {
+	state := models.State(name)
+	if err := state.Validate(strfmt.Default); err != nil {
+		return "", invalidMessage("state", name+" is not a vm state")
+	}
+	return state, nil
+}
+
+// parseOptionalState is parseState for the messages that leave the state
+// out when it does not matter.
+func parseOptionalState(name string) (models.State, error) {
+	if name == "" {
+		return "", nil
+	}
+	return parseState(name)
+}
+
+func invalidMessage(field, message string) *models.Error {
+	return &models.Error{
+		Type:    models.ErrorTypeInvalidProtobufMessage,
+		Message: "Invalid field: " + field + ": " + message,
+		Fields:  []*models.FieldError{{Field: field, Message: message}},
+	}
+}
+
+func isIPv4(ip string) bool {
+	parsed := net.ParseIP(ip)
+	return parsed != nil && parsed.To4() != nil
+}
+
+// unixNano is 0 for dates that were never set.
+func unixNano(date strfmt.DateTime) int64 {
+	if time.Time(date).IsZero() {
+		return 0
+	}
+	return time.Time(date).UnixNano()
+}
+
+func dateTime(nanos int64) strfmt.DateTime {
+	if nanos == 0 {
+		return strfmt.DateTime{}
+	}
+	return strfmt.DateTime(time.Unix(0, nanos).UTC())
+}
@@ -0,0 +1,82 @@
+// This file was generated by counterfeiter
+package grpcapifakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/grpcapi"
+	"github.com/jianqiu/vps/models"
+)
+
+type FakeEventSource struct {
+	OutboxEventsSinceStub        func(logger lager.Logger, since int64) ([]*models.WebhookEvent, error)
+	outboxEventsSinceMutex       sync.RWMutex
+	outboxEventsSinceArgsForCall []struct {
+		logger lager.Logger
+		since  int64
+	}
+	outboxEventsSinceReturns struct {
+		result1 []*models.WebhookEvent
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeEventSource) OutboxEventsSince(logger lager.Logger, since int64) ([]*models.WebhookEvent, error) {
+	fake.outboxEventsSinceMutex.Lock()
+	fake.outboxEventsSinceArgsForCall = append(fake.outboxEventsSinceArgsForCall, struct {
+		logger lager.Logger
+		since  int64
+	}{logger, since})
+	fake.recordInvocation("OutboxEventsSince", []interface{}{logger, since})
+	fake.outboxEventsSinceMutex.Unlock()
+	if fake.OutboxEventsSinceStub != nil {
+		return fake.OutboxEventsSinceStub(logger, since)
+	} else {
+		return fake.outboxEventsSinceReturns.result1, fake.outboxEventsSinceReturns.result2
+	}
+}
+
+func (fake *FakeEventSource) OutboxEventsSinceCallCount() int {
+	fake.outboxEventsSinceMutex.RLock()
+	defer fake.outboxEventsSinceMutex.RUnlock()
+	return len(fake.outboxEventsSinceArgsForCall)
+}
+
+func (fake *FakeEventSource) OutboxEventsSinceArgsForCall(i int) (lager.Logger, int64) {
+	fake.outboxEventsSinceMutex.RLock()
+	defer fake.outboxEventsSinceMutex.RUnlock()
+	return fake.outboxEventsSinceArgsForCall[i].logger, fake.outboxEventsSinceArgsForCall[i].since
+}
+
+func (fake *FakeEventSource) OutboxEventsSinceReturns(result1 []*models.WebhookEvent, result2 error) {
+	fake.OutboxEventsSinceStub = nil
+	fake.outboxEventsSinceReturns = struct {
+		result1 []*models.WebhookEvent
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEventSource) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.outboxEventsSinceMutex.RLock()
+	defer fake.outboxEventsSinceMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeEventSource) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ grpcapi.EventSource = new(FakeEventSource)
@@ -0,0 +1,65 @@
+package grpcapi
+
+import (
+	"net"
+	"os"
+
+	"code.cloudfoundry.org/lager"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// Runner serves a VmPoolServer on its own address until it is signalled,
+// then stops, cutting off the streams still open. Calls carry basic auth
+// credentials, so the server is meant to be given TLS credentials; without
+// them it serves in plaintext.
+type Runner struct {
+	logger  lager.Logger
+	address string
+	creds   credentials.TransportCredentials
+	server  VmPoolServer
+}
+
+func NewRunner(logger lager.Logger, address string, creds credentials.TransportCredentials, server VmPoolServer) *Runner {
+	return &Runner{
+		logger:  logger,
+		address: address,
+		creds:   creds,
+		server:  server,
+	}
+}
+
+func (r *Runner) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	logger := r.logger.Session("grpc-api", lager.Data{"address": r.address, "tls": r.creds != nil})
+	logger.Info("starting")
+	defer logger.Info("exited")
+
+	listener, err := net.Listen("tcp", r.address)
+	if err != nil {
+		logger.Error("failed-to-listen", err)
+		return err
+	}
+
+	options := []grpc.ServerOption{}
+	if r.creds != nil {
+		options = append(options, grpc.Creds(r.creds))
+	}
+	server := grpc.NewServer(options...)
+	RegisterVmPoolServer(server, r.server)
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- server.Serve(listener)
+	}()
+
+	close(ready)
+
+	select {
+	case err := <-errs:
+		logger.Error("failed-to-serve", err)
+		return err
+	case <-signals:
+		server.Stop()
+		return nil
+	}
+}
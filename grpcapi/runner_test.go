@@ -0,0 +1,120 @@
+package grpcapi_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jianqiu/vps/grpcapi"
+	"github.com/jianqiu/vps/grpcapi/grpcapifakes"
+	"github.com/jianqiu/vps/models"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/tedsuo/ifrit"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+)
+
+var _ = Describe("Runner", func() {
+	var (
+		dir     string
+		address string
+		certPEM []byte
+		process ifrit.Process
+		ctx     context.Context
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "grpcapi")
+		Expect(err).NotTo(HaveOccurred())
+
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+		template := &x509.Certificate{
+			SerialNumber:          big.NewInt(1),
+			Subject:               pkix.Name{CommonName: "vps"},
+			IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+			NotBefore:             time.Now().Add(-time.Hour),
+			NotAfter:              time.Now().Add(time.Hour),
+			KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+			ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+			IsCA:                  true,
+			BasicConstraintsValid: true,
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+		Expect(err).NotTo(HaveOccurred())
+		keyDER, err := x509.MarshalECPrivateKey(key)
+		Expect(err).NotTo(HaveOccurred())
+
+		certPEM = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+		certFile := filepath.Join(dir, "vps.crt")
+		keyFile := filepath.Join(dir, "vps.key")
+		Expect(ioutil.WriteFile(certFile, certPEM, 0600)).To(Succeed())
+		Expect(ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600)).To(Succeed())
+
+		creds, err := credentials.NewServerTLSFromFile(certFile, keyFile)
+		Expect(err).NotTo(HaveOccurred())
+
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+		address = listener.Addr().String()
+		Expect(listener.Close()).To(Succeed())
+
+		fakeController := new(grpcapifakes.FakeVirtualGuestController)
+		fakeController.VirtualGuestByCidReturns(&models.VM{Cid: 1234}, nil)
+		authenticate := func(username, password string) (*models.User, error) {
+			return &models.User{Username: username}, nil
+		}
+		logger := lagertest.NewTestLogger("test")
+		server := grpcapi.NewVMPoolServer(logger, fakeController, authenticate, new(grpcapifakes.FakeEventSource), clock.NewClock(), time.Second)
+		process = ifrit.Invoke(grpcapi.NewRunner(logger, address, creds, server))
+
+		ctx = metadata.NewContext(context.Background(), metadata.Pairs("authorization", "Basic YWRtaW46c2VjcmV0"))
+	})
+
+	AfterEach(func() {
+		process.Signal(os.Interrupt)
+		Eventually(process.Wait()).Should(Receive(BeNil()))
+		os.RemoveAll(dir)
+	})
+
+	It("serves over TLS", func() {
+		pool := x509.NewCertPool()
+		Expect(pool.AppendCertsFromPEM(certPEM)).To(BeTrue())
+
+		conn, err := grpc.Dial(address, grpc.WithTransportCredentials(credentials.NewClientTLSFromCert(pool, "")))
+		Expect(err).NotTo(HaveOccurred())
+		defer conn.Close()
+
+		vm, err := grpcapi.NewVmPoolClient(conn).GetVm(ctx, &grpcapi.VmRequest{Cid: 1234})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(vm.Cid).To(Equal(int32(1234)))
+	})
+
+	It("does not serve plaintext calls", func() {
+		conn, err := grpc.Dial(address, grpc.WithInsecure())
+		Expect(err).NotTo(HaveOccurred())
+		defer conn.Close()
+
+		timeout, cancel := context.WithTimeout(ctx, time.Second)
+		defer cancel()
+		_, err = grpcapi.NewVmPoolClient(conn).GetVm(timeout, &grpcapi.VmRequest{Cid: 1234})
+		Expect(err).To(HaveOccurred())
+	})
+})
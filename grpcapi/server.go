@@ -0,0 +1,522 @@
+package grpcapi
+
+import (
+	"encoding/base64"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"github.com/go-openapi/strfmt"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/jianqiu/vps/models"
+)
+
+//go:generate counterfeiter -o grpcapifakes/fake_virtual_guest_controller.go . VirtualGuestController
+type VirtualGuestController interface {
+	AllVirtualGuests(logger lager.Logger) ([]*models.VM, error)
+	VirtualGuests(logger lager.Logger, publicVlan, privateVlan, cpu, memory_mb int32, state models.State) ([]*models.VM, error)
+	OrderVirtualGuest(logger lager.Logger, vmFilter *models.VMFilter) (*models.VM, error)
+	OrderVirtualGuests(logger lager.Logger, cids []int32, ips []strfmt.IPv4) ([]*models.VM, error)
+	VirtualGuestsByDeployments(logger lager.Logger, names []string) ([]*models.VM, error)
+	VirtualGuestsByStates(logger lager.Logger, states []string) ([]*models.VM, error)
+	CreateVM(logger lager.Logger, vm *models.VM) error
+	DeleteVM(logger lager.Logger, cid int32) error
+	UpdateVM(logger lager.Logger, vm *models.VM) error
+	UpdateVMWithState(logger lager.Logger, cid int32, updateData *models.State) error
+	TransitionVM(logger lager.Logger, cid int32, state models.State) error
+	VirtualGuestByCid(logger lager.Logger, cid int32) (*models.VM, error)
+	VirtualGuestByIP(logger lager.Logger, ip string) (*models.VM, error)
+	VirtualGuestByHostname(logger lager.Logger, hostname string) (*models.VM, error)
+	VirtualGuestsByHostname(logger lager.Logger, pattern string) ([]*models.VM, error)
+}
+
+// Authenticator checks basic auth credentials the way the REST API does,
+// returning the user they belong to.
+type Authenticator func(username, password string) (*models.User, error)
+
+// ErrorTrailer is the trailer a failed call carries its Error in.
+const ErrorTrailer = "vps-error-bin"
+
+//go:generate counterfeiter -o grpcapifakes/fake_event_source.go . EventSource
+
+// EventSource reads the VM events recorded in the outbox.
+type EventSource interface {
+	OutboxEventsSince(logger lager.Logger, since int64) ([]*models.WebhookEvent, error)
+}
+
+// watchLookback is how far back Watch reads the outbox, for the events of
+// changes that took that long to commit.
+const watchLookback = 30 * time.Second
+
+var errUnauthenticated = grpc.Errorf(codes.Unauthenticated, "basic auth credentials are required")
+
+// NewVMPoolServer serves the VMs of the default pool through controller,
+// to the users that authenticate authenticates. Watch streams the events
+// read from events every watchInterval.
+func NewVMPoolServer(
+	logger lager.Logger,
+	controller VirtualGuestController,
+	authenticate Authenticator,
+	events EventSource,
+	clock clock.Clock,
+	watchInterval time.Duration,
+) VmPoolServer {
+	return &vmPoolServer{
+		logger:        logger,
+		controller:    controller,
+		authenticate:  authenticate,
+		events:        events,
+		clock:         clock,
+		watchInterval: watchInterval,
+	}
+}
+
+type vmPoolServer struct {
+	logger        lager.Logger
+	controller    VirtualGuestController
+	authenticate  Authenticator
+	events        EventSource
+	clock         clock.Clock
+	watchInterval time.Duration
+}
+
+func (s *vmPoolServer) ListVms(ctx context.Context, request *VmFilter) (*VmsResponse, error) {
+	logger := s.logger.Session("list-vms")
+	if err := s.authorize(ctx); err != nil {
+		return nil, s.fail(ctx, logger, err)
+	}
+
+	filter, err := filterToModel(request)
+	if err != nil {
+		return nil, s.fail(ctx, logger, err)
+	}
+
+	var vms []*models.VM
+	if request.Equal(&VmFilter{}) {
+		vms, err = s.controller.AllVirtualGuests(logger)
+	} else {
+		vms, err = s.controller.VirtualGuests(logger, filter.PublicVlan, filter.PrivateVlan, filter.CPU, filter.MemoryMb, filter.State)
+	}
+	if err != nil {
+		return nil, s.fail(ctx, logger, err)
+	}
+	return vmsFromModels(vms), nil
+}
+
+func (s *vmPoolServer) GetVm(ctx context.Context, request *VmRequest) (*Vm, error) {
+	logger := s.logger.Session("get-vm", lager.Data{"cid": request.Cid})
+	if err := s.authorize(ctx); err != nil {
+		return nil, s.fail(ctx, logger, err)
+	}
+
+	vm, err := s.controller.VirtualGuestByCid(logger, request.Cid)
+	return s.foundVM(ctx, logger, vm, err)
+}
+
+func (s *vmPoolServer) GetVmByIp(ctx context.Context, request *VmByIpRequest) (*Vm, error) {
+	logger := s.logger.Session("get-vm-by-ip", lager.Data{"ip": request.Ip})
+	if err := s.authorize(ctx); err != nil {
+		return nil, s.fail(ctx, logger, err)
+	}
+
+	vm, err := s.controller.VirtualGuestByIP(logger, request.Ip)
+	return s.foundVM(ctx, logger, vm, err)
+}
+
+func (s *vmPoolServer) GetVmByHostname(ctx context.Context, request *VmByHostnameRequest) (*Vm, error) {
+	logger := s.logger.Session("get-vm-by-hostname", lager.Data{"hostname": request.Hostname})
+	if err := s.authorize(ctx); err != nil {
+		return nil, s.fail(ctx, logger, err)
+	}
+
+	vm, err := s.controller.VirtualGuestByHostname(logger, request.Hostname)
+	return s.foundVM(ctx, logger, vm, err)
+}
+
+func (s *vmPoolServer) FindVmsByHostname(ctx context.Context, request *VmsByHostnameRequest) (*VmsResponse, error) {
+	logger := s.logger.Session("find-vms-by-hostname")
+	if err := s.authorize(ctx); err != nil {
+		return nil, s.fail(ctx, logger, err)
+	}
+
+	vms, err := s.controller.VirtualGuestsByHostname(logger, request.Pattern)
+	if err != nil {
+		return nil, s.fail(ctx, logger, err)
+	}
+	return vmsFromModels(vms), nil
+}
+
+func (s *vmPoolServer) FindVmsByDeployments(ctx context.Context, request *VmsByDeploymentsRequest) (*VmsResponse, error) {
+	logger := s.logger.Session("find-vms-by-deployments")
+	if err := s.authorize(ctx); err != nil {
+		return nil, s.fail(ctx, logger, err)
+	}
+
+	vms, err := s.controller.VirtualGuestsByDeployments(logger, request.DeploymentNames)
+	if err != nil {
+		return nil, s.fail(ctx, logger, err)
+	}
+	return vmsFromModels(vms), nil
+}
+
+func (s *vmPoolServer) FindVmsByStates(ctx context.Context, request *VmsByStatesRequest) (*VmsResponse, error) {
+	logger := s.logger.Session("find-vms-by-states")
+	if err := s.authorize(ctx); err != nil {
+		return nil, s.fail(ctx, logger, err)
+	}
+
+	for _, name := range request.States {
+		if _, err := parseState(name); err != nil {
+			return nil, s.fail(ctx, logger, err)
+		}
+	}
+
+	vms, err := s.controller.VirtualGuestsByStates(logger, request.States)
+	if err != nil {
+		return nil, s.fail(ctx, logger, err)
+	}
+	return vmsFromModels(vms), nil
+}
+
+func (s *vmPoolServer) OrderVm(ctx context.Context, request *VmFilter) (*Vm, error) {
+	logger := s.logger.Session("order-vm")
+	if err := s.authorize(ctx); err != nil {
+		return nil, s.fail(ctx, logger, err)
+	}
+
+	filter, err := filterToModel(request)
+	if err != nil {
+		return nil, s.fail(ctx, logger, err)
+	}
+
+	vm, err := s.controller.OrderVirtualGuest(logger, filter)
+	return s.foundVM(ctx, logger, vm, err)
+}
+
+func (s *vmPoolServer) OrderVms(ctx context.Context, request *OrderVmsRequest) (*VmsResponse, error) {
+	logger := s.logger.Session("order-vms")
+	if err := s.authorize(ctx); err != nil {
+		return nil, s.fail(ctx, logger, err)
+	}
+
+	ips := make([]strfmt.IPv4, 0, len(request.Ips))
+	for _, ip := range request.Ips {
+		if !isIPv4(ip) {
+			return nil, s.fail(ctx, logger, invalidMessage("ips", "must be ipv4 addresses"))
+		}
+		ips = append(ips, strfmt.IPv4(ip))
+	}
+
+	vms, err := s.controller.OrderVirtualGuests(logger, request.Cids, ips)
+	if err != nil {
+		return nil, s.fail(ctx, logger, err)
+	}
+	return vmsFromModels(vms), nil
+}
+
+func (s *vmPoolServer) AddVm(ctx context.Context, request *Vm) (*Empty, error) {
+	logger := s.logger.Session("add-vm", lager.Data{"cid": request.Cid})
+	if err := s.authorize(ctx); err != nil {
+		return nil, s.fail(ctx, logger, err)
+	}
+
+	vm, err := vmToModel(request)
+	if err == nil {
+		err = s.controller.CreateVM(logger, vm)
+	}
+	if err != nil {
+		return nil, s.fail(ctx, logger, err)
+	}
+	return &Empty{}, nil
+}
+
+func (s *vmPoolServer) UpdateVm(ctx context.Context, request *Vm) (*Empty, error) {
+	logger := s.logger.Session("update-vm", lager.Data{"cid": request.Cid})
+	if err := s.authorize(ctx); err != nil {
+		return nil, s.fail(ctx, logger, err)
+	}
+
+	vm, err := vmToModel(request)
+	if err == nil {
+		err = s.controller.UpdateVM(logger, vm)
+	}
+	if err != nil {
+		return nil, s.fail(ctx, logger, err)
+	}
+	return &Empty{}, nil
+}
+
+func (s *vmPoolServer) UpdateVmState(ctx context.Context, request *UpdateVmStateRequest) (*Empty, error) {
+	logger := s.logger.Session("update-vm-state", lager.Data{"cid": request.Cid})
+	if err := s.authorize(ctx); err != nil {
+		return nil, s.fail(ctx, logger, err)
+	}
+
+	state, err := parseState(request.State)
+	if err == nil {
+		err = s.controller.UpdateVMWithState(logger, request.Cid, &state)
+	}
+	if err != nil {
+		return nil, s.fail(ctx, logger, err)
+	}
+	return &Empty{}, nil
+}
+
+func (s *vmPoolServer) TransitionVm(ctx context.Context, request *UpdateVmStateRequest) (*Empty, error) {
+	logger := s.logger.Session("transition-vm", lager.Data{"cid": request.Cid})
+	if err := s.authorize(ctx); err != nil {
+		return nil, s.fail(ctx, logger, err)
+	}
+
+	state, err := parseState(request.State)
+	if err == nil {
+		err = s.controller.TransitionVM(logger, request.Cid, state)
+	}
+	if err != nil {
+		return nil, s.fail(ctx, logger, err)
+	}
+	return &Empty{}, nil
+}
+
+func (s *vmPoolServer) DeleteVm(ctx context.Context, request *VmRequest) (*Empty, error) {
+	logger := s.logger.Session("delete-vm", lager.Data{"cid": request.Cid})
+	if err := s.authorize(ctx); err != nil {
+		return nil, s.fail(ctx, logger, err)
+	}
+
+	err := s.controller.DeleteVM(logger, request.Cid)
+	if err != nil {
+		return nil, s.fail(ctx, logger, err)
+	}
+	return &Empty{}, nil
+}
+
+// Watch compares the pool with what it was at the previous poll and sends
+// an event for every VM whose state changed, was added or was removed. A
+// poll that fails is skipped: the next one catches up.
+func (s *vmPoolServer) Watch(request *WatchRequest, stream VmPool_WatchServer) error {
+	logger := s.logger.Session("watch", lager.Data{"types": request.Types})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	if err := s.authorize(stream.Context()); err != nil {
+		return s.failStream(stream, logger, err)
+	}
+
+	types := map[models.WebhookEventType]bool{}
+	for _, name := range request.Types {
+		eventType := models.WebhookEventType(name)
+		if err := eventType.Validate(strfmt.Default); err != nil {
+			return s.failStream(stream, logger, invalidMessage("types", "must be vm-ordered, vm-released, vm-removed or vm-state-changed"))
+		}
+		types[eventType] = true
+	}
+
+	// an event is only read once the change that recorded it commits, which
+	// can be after newer events are read, so each poll looks back a while
+	since := s.clock.Now().UnixNano()
+	sent := map[string]int64{}
+
+	ticker := s.clock.NewTicker(s.watchInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-ticker.C():
+			events, err := s.events.OutboxEventsSince(logger, since)
+			if err != nil {
+				logger.Error("failed-to-read-events", err)
+				continue
+			}
+
+			for _, event := range events {
+				if _, ok := sent[event.ID]; ok {
+					continue
+				}
+				sent[event.ID] = event.Timestamp
+				if event.VM == nil || poolOf(event.VM) != models.DefaultPool {
+					continue
+				}
+				if len(types) > 0 && !types[event.Type] {
+					continue
+				}
+
+				err = stream.Send(&VmEvent{
+					Id:        event.ID,
+					Type:      string(event.Type),
+					Timestamp: event.Timestamp,
+					Vm:        vmFromModel(event.VM),
+				})
+				if err != nil {
+					logger.Error("failed-to-send-event", err)
+					return err
+				}
+			}
+
+			if lookback := s.clock.Now().Add(-watchLookback).UnixNano(); lookback > since {
+				since = lookback
+			}
+			for id, timestamp := range sent {
+				if timestamp < since {
+					delete(sent, id)
+				}
+			}
+		}
+	}
+}
+
+// poolOf is the pool of vm, the default pool for VMs stored without one.
+func poolOf(vm *models.VM) string {
+	if vm.Pool == "" {
+		return models.DefaultPool
+	}
+	return vm.Pool
+}
+
+// authorize checks the basic auth credentials in the authorization metadata
+// of ctx, which must grant access to the default pool.
+func (s *vmPoolServer) authorize(ctx context.Context) error {
+	md, ok := metadata.FromContext(ctx)
+	if !ok || len(md["authorization"]) == 0 {
+		return errUnauthenticated
+	}
+
+	username, password, ok := parseBasicAuth(md["authorization"][0])
+	if !ok {
+		return errUnauthenticated
+	}
+
+	user, err := s.authenticate(username, password)
+	if err != nil || user == nil {
+		return grpc.Errorf(codes.Unauthenticated, "unauthenticated for user %s", username)
+	}
+	if user.Pool != "" && user.Pool != models.DefaultPool {
+		return models.ErrUnauthorized
+	}
+	return nil
+}
+
+func parseBasicAuth(header string) (string, string, bool) {
+	const prefix = "Basic "
+	if !strings.HasPrefix(header, prefix) {
+		return "", "", false
+	}
+	decoded, err := base64.StdEncoding.DecodeString(header[len(prefix):])
+	if err != nil {
+		return "", "", false
+	}
+	parts := strings.SplitN(string(decoded), ":", 2)
+	if len(parts) != 2 {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
+
+// foundVM answers with vm, or with a not found error when there is none.
+func (s *vmPoolServer) foundVM(ctx context.Context, logger lager.Logger, vm *models.VM, err error) (*Vm, error) {
+	if err == nil && vm == nil {
+		err = models.ErrResourceNotFound
+	}
+	if err != nil {
+		return nil, s.fail(ctx, logger, err)
+	}
+	return vmFromModel(vm), nil
+}
+
+// fail ends a unary call with err: its code tells what kind of error it
+// is, the ErrorTrailer the Error itself.
+func (s *vmPoolServer) fail(ctx context.Context, logger lager.Logger, err error) error {
+	if isStatus(err) {
+		return err
+	}
+	modelErr := models.ConvertError(err)
+	trailer, marshalErr := errorTrailer(modelErr)
+	if marshalErr == nil {
+		marshalErr = grpc.SetTrailer(ctx, trailer)
+	}
+	if marshalErr != nil {
+		logger.Error("failed-to-set-error-trailer", marshalErr)
+	}
+	return grpc.Errorf(Code(modelErr), "%s", modelErr.Message)
+}
+
+func (s *vmPoolServer) failStream(stream grpc.ServerStream, logger lager.Logger, err error) error {
+	if isStatus(err) {
+		return err
+	}
+	modelErr := models.ConvertError(err)
+	trailer, marshalErr := errorTrailer(modelErr)
+	if marshalErr != nil {
+		logger.Error("failed-to-set-error-trailer", marshalErr)
+	} else {
+		stream.SetTrailer(trailer)
+	}
+	return grpc.Errorf(Code(modelErr), "%s", modelErr.Message)
+}
+
+// isStatus tells the errors that already carry their gRPC code, like those
+// of calls without valid credentials, which have no Error to report.
+func isStatus(err error) bool {
+	if _, ok := err.(*models.Error); ok {
+		return false
+	}
+	return grpc.Code(err) != codes.Unknown
+}
+
+func errorTrailer(err *models.Error) (metadata.MD, error) {
+	payload, marshalErr := errorFromModel(err).Marshal()
+	if marshalErr != nil {
+		return nil, marshalErr
+	}
+	return metadata.Pairs(ErrorTrailer, string(payload)), nil
+}
+
+// TrailerError is the Error a failed call carries in its ErrorTrailer, the
+// one the REST API would have answered with; nil when there is none.
+func TrailerError(trailer metadata.MD) *models.Error {
+	payloads := trailer[ErrorTrailer]
+	if len(payloads) == 0 {
+		return nil
+	}
+	err := &Error{}
+	if err.Unmarshal([]byte(payloads[0])) != nil {
+		return nil
+	}
+	return errorToModel(err)
+}
+
+// Code is the gRPC code a call that fails with err ends with, the
+// counterpart of the status the REST API responds with.
+func Code(err error) codes.Code {
+	switch models.ConvertError(err).Type {
+	case models.ErrorTypeResourceNotFound:
+		return codes.NotFound
+	case models.ErrorTypeUnauthorized:
+		return codes.PermissionDenied
+	case models.ErrorTypeInvalidRequest,
+		models.ErrorTypeInvalidRecord,
+		models.ErrorTypeInvalidJSON,
+		models.ErrorTypeInvalidProtobufMessage,
+		models.ErrorTypeDeserialize:
+		return codes.InvalidArgument
+	case models.ErrorTypeResourceConflict:
+		return codes.Aborted
+	case models.ErrorTypeResourceExist:
+		return codes.AlreadyExists
+	case models.ErrorTypeInvalidStateTransition:
+		return codes.FailedPrecondition
+	case models.ErrorTypeDeadlock,
+		models.ErrorTypeUnrecoverable,
+		models.ErrorTypeMaintenance:
+		return codes.Unavailable
+	default:
+		return codes.Internal
+	}
+}
@@ -0,0 +1,357 @@
+package grpcapi_test
+
+import (
+	"encoding/base64"
+	"errors"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jianqiu/vps/grpcapi"
+	"github.com/jianqiu/vps/grpcapi/grpcapifakes"
+	"github.com/jianqiu/vps/models"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/go-openapi/strfmt"
+	"github.com/tedsuo/ifrit"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+var _ = Describe("VmPool server", func() {
+	var (
+		logger         *lagertest.TestLogger
+		fakeController *grpcapifakes.FakeVirtualGuestController
+		fakeEvents     *grpcapifakes.FakeEventSource
+		users          map[string]*models.User
+		process        ifrit.Process
+		conn           *grpc.ClientConn
+		client         grpcapi.VmPoolClient
+		ctx            context.Context
+	)
+
+	withCredentials := func(username, password string) context.Context {
+		credentials := base64.StdEncoding.EncodeToString([]byte(username + ":" + password))
+		return metadata.NewContext(context.Background(), metadata.Pairs("authorization", "Basic "+credentials))
+	}
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		fakeController = new(grpcapifakes.FakeVirtualGuestController)
+		fakeEvents = new(grpcapifakes.FakeEventSource)
+		users = map[string]*models.User{
+			"admin": {Username: "admin"},
+			"other": {Username: "other", Pool: "other"},
+		}
+		authenticate := func(username, password string) (*models.User, error) {
+			if user, ok := users[username]; ok && password == "secret" {
+				return user, nil
+			}
+			return nil, errors.New("unknown user")
+		}
+
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+		address := listener.Addr().String()
+		Expect(listener.Close()).To(Succeed())
+
+		server := grpcapi.NewVMPoolServer(logger, fakeController, authenticate, fakeEvents, clock.NewClock(), 10*time.Millisecond)
+		process = ifrit.Invoke(grpcapi.NewRunner(logger, address, nil, server))
+
+		conn, err = grpc.Dial(address, grpc.WithInsecure())
+		Expect(err).NotTo(HaveOccurred())
+		client = grpcapi.NewVmPoolClient(conn)
+		ctx = withCredentials("admin", "secret")
+	})
+
+	AfterEach(func() {
+		conn.Close()
+		process.Signal(os.Interrupt)
+		Eventually(process.Wait()).Should(Receive(BeNil()))
+	})
+
+	Describe("GetVm", func() {
+		It("returns the vm", func() {
+			created := time.Date(2016, 11, 1, 12, 0, 0, 0, time.UTC)
+			fakeController.VirtualGuestByCidReturns(&models.VM{
+				Cid:        1234,
+				Hostname:   "vm-1",
+				IP:         strfmt.IPv4("10.0.0.1"),
+				State:      models.StateUsing,
+				CreateDate: strfmt.DateTime(created),
+			}, nil)
+
+			vm, err := client.GetVm(ctx, &grpcapi.VmRequest{Cid: 1234})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vm).To(Equal(&grpcapi.Vm{
+				Cid:        1234,
+				Hostname:   "vm-1",
+				Ip:         "10.0.0.1",
+				State:      "using",
+				CreateDate: created.UnixNano(),
+			}))
+
+			_, cid := fakeController.VirtualGuestByCidArgsForCall(0)
+			Expect(cid).To(Equal(int32(1234)))
+		})
+
+		It("fails with not found and the Error in the trailer when there is no such vm", func() {
+			fakeController.VirtualGuestByCidReturns(nil, nil)
+
+			var trailer metadata.MD
+			_, err := client.GetVm(ctx, &grpcapi.VmRequest{Cid: 1234}, grpc.Trailer(&trailer))
+			Expect(grpc.Code(err)).To(Equal(codes.NotFound))
+			Expect(grpcapi.TrailerError(trailer)).To(Equal(models.ErrResourceNotFound))
+		})
+
+		It("fails with the code the controller error maps to", func() {
+			fakeController.VirtualGuestByCidReturns(nil, models.ErrDeadlock)
+
+			var trailer metadata.MD
+			_, err := client.GetVm(ctx, &grpcapi.VmRequest{Cid: 1234}, grpc.Trailer(&trailer))
+			Expect(grpc.Code(err)).To(Equal(codes.Unavailable))
+			Expect(grpcapi.TrailerError(trailer).Type).To(Equal(models.ErrorTypeDeadlock))
+		})
+
+		It("refuses calls without valid credentials", func() {
+			_, err := client.GetVm(context.Background(), &grpcapi.VmRequest{Cid: 1234})
+			Expect(grpc.Code(err)).To(Equal(codes.Unauthenticated))
+
+			_, err = client.GetVm(withCredentials("admin", "wrong"), &grpcapi.VmRequest{Cid: 1234})
+			Expect(grpc.Code(err)).To(Equal(codes.Unauthenticated))
+			Expect(fakeController.VirtualGuestByCidCallCount()).To(Equal(0))
+		})
+
+		It("refuses the credentials of another pool", func() {
+			var trailer metadata.MD
+			_, err := client.GetVm(withCredentials("other", "secret"), &grpcapi.VmRequest{Cid: 1234}, grpc.Trailer(&trailer))
+			Expect(grpc.Code(err)).To(Equal(codes.PermissionDenied))
+			Expect(grpcapi.TrailerError(trailer)).To(Equal(models.ErrUnauthorized))
+			Expect(fakeController.VirtualGuestByCidCallCount()).To(Equal(0))
+		})
+	})
+
+	Describe("ListVms", func() {
+		BeforeEach(func() {
+			fakeController.AllVirtualGuestsReturns([]*models.VM{{Cid: 1}, {Cid: 2}}, nil)
+			fakeController.VirtualGuestsReturns([]*models.VM{{Cid: 2}}, nil)
+		})
+
+		It("lists every vm without a filter", func() {
+			response, err := client.ListVms(ctx, &grpcapi.VmFilter{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Vms).To(Equal([]*grpcapi.Vm{{Cid: 1}, {Cid: 2}}))
+			Expect(fakeController.VirtualGuestsCallCount()).To(Equal(0))
+		})
+
+		It("lists the vms that match the filter", func() {
+			response, err := client.ListVms(ctx, &grpcapi.VmFilter{Cpu: 4, MemoryMb: 8192, State: "free"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Vms).To(Equal([]*grpcapi.Vm{{Cid: 2}}))
+
+			_, _, _, cpu, memory, state := fakeController.VirtualGuestsArgsForCall(0)
+			Expect(cpu).To(Equal(int32(4)))
+			Expect(memory).To(Equal(int32(8192)))
+			Expect(state).To(Equal(models.StateFree))
+		})
+
+		It("rejects an unknown state", func() {
+			var trailer metadata.MD
+			_, err := client.ListVms(ctx, &grpcapi.VmFilter{State: "sleeping"}, grpc.Trailer(&trailer))
+			Expect(grpc.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(grpcapi.TrailerError(trailer).Fields).To(ConsistOf(&models.FieldError{Field: "state", Message: "sleeping is not a vm state"}))
+		})
+	})
+
+	Describe("OrderVm", func() {
+		It("orders a vm by the filter and returns its secret token", func() {
+			fakeController.OrderVirtualGuestReturns(&models.VM{Cid: 1, State: models.StateProvisioning, SecretToken: "token"}, nil)
+
+			vm, err := client.OrderVm(ctx, &grpcapi.VmFilter{Flavor: "small", DeploymentName: "cf", UserData: `{"agent":"settings"}`})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vm.SecretToken).To(Equal("token"))
+
+			_, filter := fakeController.OrderVirtualGuestArgsForCall(0)
+			Expect(filter).To(Equal(&models.VMFilter{Flavor: "small", DeploymentName: "cf", UserData: `{"agent":"settings"}`}))
+		})
+
+		It("fails with not found when no vm is free", func() {
+			fakeController.OrderVirtualGuestReturns(nil, nil)
+
+			_, err := client.OrderVm(ctx, &grpcapi.VmFilter{Cpu: 4})
+			Expect(grpc.Code(err)).To(Equal(codes.NotFound))
+		})
+	})
+
+	Describe("OrderVms", func() {
+		It("orders the vms by cid and ip", func() {
+			fakeController.OrderVirtualGuestsReturns([]*models.VM{{Cid: 1}, {Cid: 2}}, nil)
+
+			response, err := client.OrderVms(ctx, &grpcapi.OrderVmsRequest{Cids: []int32{1}, Ips: []string{"10.0.0.2"}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.Vms).To(HaveLen(2))
+
+			_, cids, ips := fakeController.OrderVirtualGuestsArgsForCall(0)
+			Expect(cids).To(Equal([]int32{1}))
+			Expect(ips).To(Equal([]strfmt.IPv4{"10.0.0.2"}))
+		})
+
+		It("maps conflicts to aborted", func() {
+			fakeController.OrderVirtualGuestsReturns(nil, models.ErrResourceConflict)
+
+			_, err := client.OrderVms(ctx, &grpcapi.OrderVmsRequest{Cids: []int32{1}})
+			Expect(grpc.Code(err)).To(Equal(codes.Aborted))
+		})
+	})
+
+	Describe("TransitionVm", func() {
+		It("transitions the vm", func() {
+			_, err := client.TransitionVm(ctx, &grpcapi.UpdateVmStateRequest{Cid: 1, State: "using"})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, cid, state := fakeController.TransitionVMArgsForCall(0)
+			Expect(cid).To(Equal(int32(1)))
+			Expect(state).To(Equal(models.StateUsing))
+		})
+
+		It("fails with failed precondition when the transition is not allowed", func() {
+			fakeController.TransitionVMReturns(models.NewTaskTransitionError(models.StateFree, models.StateUsing))
+
+			_, err := client.TransitionVm(ctx, &grpcapi.UpdateVmStateRequest{Cid: 1, State: "using"})
+			Expect(grpc.Code(err)).To(Equal(codes.FailedPrecondition))
+		})
+	})
+
+	Describe("Watch", func() {
+		var (
+			lock   sync.Mutex
+			events []*models.WebhookEvent
+		)
+
+		record := func(id string, eventType models.WebhookEventType, vm *models.VM) {
+			lock.Lock()
+			defer lock.Unlock()
+			events = append(events, &models.WebhookEvent{ID: id, Type: eventType, Timestamp: time.Now().UnixNano(), VM: vm})
+		}
+
+		BeforeEach(func() {
+			events = nil
+			fakeEvents.OutboxEventsSinceStub = func(_ lager.Logger, since int64) ([]*models.WebhookEvent, error) {
+				lock.Lock()
+				defer lock.Unlock()
+				recorded := []*models.WebhookEvent{}
+				for _, event := range events {
+					if event.Timestamp >= since {
+						recorded = append(recorded, event)
+					}
+				}
+				return recorded, nil
+			}
+		})
+
+		// watch waits for the stream to read the outbox, so that the events
+		// recorded next are newer than the call
+		watch := func(request *grpcapi.WatchRequest) grpcapi.VmPool_WatchClient {
+			calls := fakeEvents.OutboxEventsSinceCallCount()
+			stream, err := client.Watch(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(fakeEvents.OutboxEventsSinceCallCount).Should(BeNumerically(">", calls))
+			return stream
+		}
+
+		It("streams the outbox events with their types", func() {
+			started := time.Now().UnixNano()
+			stream := watch(&grpcapi.WatchRequest{})
+			_, since := fakeEvents.OutboxEventsSinceArgsForCall(0)
+			Expect(since).To(BeNumerically(">=", started))
+
+			record("event-1", models.WebhookEventTypeVMOrdered, &models.VM{Cid: 1, State: models.StateProvisioning})
+			record("event-2", models.WebhookEventTypeVMStateChanged, &models.VM{Cid: 1, State: models.StateUsing})
+			record("event-3", models.WebhookEventTypeVMRemoved, &models.VM{Cid: 2, State: models.StateUsing})
+
+			received := []*grpcapi.VmEvent{}
+			for len(received) < 3 {
+				event, err := stream.Recv()
+				Expect(err).NotTo(HaveOccurred())
+				received = append(received, event)
+			}
+
+			Expect(received[0].Id).To(Equal("event-1"))
+			Expect(received[0].Type).To(Equal("vm-ordered"))
+			Expect(received[1].Id).To(Equal("event-2"))
+			Expect(received[1].Type).To(Equal("vm-state-changed"))
+			Expect(received[1].Vm).To(Equal(&grpcapi.Vm{Cid: 1, State: "using"}))
+			Expect(received[2].Id).To(Equal("event-3"))
+			Expect(received[2].Type).To(Equal("vm-removed"))
+			Expect(received[2].Vm.Cid).To(Equal(int32(2)))
+			for _, event := range received {
+				Expect(event.Timestamp).To(BeNumerically(">=", started))
+			}
+		})
+
+		It("streams every event once", func() {
+			stream := watch(&grpcapi.WatchRequest{})
+
+			record("event-1", models.WebhookEventTypeVMReleased, &models.VM{Cid: 1, State: models.StateFree})
+			event, err := stream.Recv()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(event.Id).To(Equal("event-1"))
+
+			calls := fakeEvents.OutboxEventsSinceCallCount()
+			Eventually(fakeEvents.OutboxEventsSinceCallCount).Should(BeNumerically(">", calls+1))
+			record("event-2", models.WebhookEventTypeVMOrdered, &models.VM{Cid: 1, State: models.StateProvisioning})
+
+			event, err = stream.Recv()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(event.Id).To(Equal("event-2"))
+		})
+
+		It("only streams the requested types", func() {
+			stream := watch(&grpcapi.WatchRequest{Types: []string{"vm-released"}})
+
+			record("event-1", models.WebhookEventTypeVMStateChanged, &models.VM{Cid: 2, State: models.StateUsing})
+			record("event-2", models.WebhookEventTypeVMReleased, &models.VM{Cid: 2, State: models.StateFree})
+
+			event, err := stream.Recv()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(event.Type).To(Equal("vm-released"))
+			Expect(event.Vm.Cid).To(Equal(int32(2)))
+		})
+
+		It("leaves out the vms of other pools", func() {
+			stream := watch(&grpcapi.WatchRequest{})
+
+			record("event-1", models.WebhookEventTypeVMReleased, &models.VM{Cid: 1, State: models.StateFree, Pool: "other"})
+			record("event-2", models.WebhookEventTypeVMReleased, &models.VM{Cid: 2, State: models.StateFree, Pool: models.DefaultPool})
+
+			event, err := stream.Recv()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(event.Id).To(Equal("event-2"))
+		})
+
+		It("rejects unknown types", func() {
+			stream, err := client.Watch(ctx, &grpcapi.WatchRequest{Types: []string{"vm-exploded"}})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = stream.Recv()
+			Expect(grpc.Code(err)).To(Equal(codes.InvalidArgument))
+			Expect(grpcapi.TrailerError(stream.Trailer()).Type).To(Equal(models.ErrorTypeInvalidProtobufMessage))
+		})
+
+		It("refuses the credentials of another pool", func() {
+			stream, err := client.Watch(withCredentials("other", "secret"), &grpcapi.WatchRequest{})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = stream.Recv()
+			Expect(grpc.Code(err)).To(Equal(codes.PermissionDenied))
+		})
+	})
+})
@@ -0,0 +1,4432 @@
+// Code generated by protoc-gen-gogo.
+// source: grpcapi/vm_pool.proto
+// DO NOT EDIT!
+
+/*
+Package grpcapi is a generated protocol buffer package.
+
+It is generated from these files:
+
+	grpcapi/vm_pool.proto
+
+It has these top-level messages:
+
+	Vm
+	VmFilter
+	FieldError
+	Error
+	VmsResponse
+	VmRequest
+	VmByIpRequest
+	VmByHostnameRequest
+	VmsByHostnameRequest
+	VmsByDeploymentsRequest
+	VmsByStatesRequest
+	OrderVmsRequest
+	UpdateVmStateRequest
+	Empty
+	WatchRequest
+	VmEvent
+*/
+package grpcapi
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/gogo/protobuf/gogoproto"
+
+import bytes "bytes"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type Vm struct {
+	Cid            int32  `protobuf:"varint,1,opt,name=cid" json:"cid"`
+	Hostname       string `protobuf:"bytes,2,opt,name=hostname" json:"hostname"`
+	Cpu            int32  `protobuf:"varint,3,opt,name=cpu" json:"cpu"`
+	MemoryMb       int32  `protobuf:"varint,4,opt,name=memory_mb,json=memoryMb" json:"memory_mb"`
+	PublicVlan     int32  `protobuf:"varint,5,opt,name=public_vlan,json=publicVlan" json:"public_vlan"`
+	PrivateVlan    int32  `protobuf:"varint,6,opt,name=private_vlan,json=privateVlan" json:"private_vlan"`
+	Ip             string `protobuf:"bytes,7,opt,name=ip" json:"ip"`
+	State          string `protobuf:"bytes,8,opt,name=state" json:"state"`
+	DeploymentName string `protobuf:"bytes,9,opt,name=deployment_name,json=deploymentName" json:"deployment_name"`
+	Pool           string `protobuf:"bytes,10,opt,name=pool" json:"pool"`
+	// unix nanoseconds
+	CreateDate int64 `protobuf:"varint,11,opt,name=create_date,json=createDate" json:"create_date"`
+	ModifyDate int64 `protobuf:"varint,12,opt,name=modify_date,json=modifyDate" json:"modify_date"`
+	// reads the secret of the vm; only returned by the order that provisions
+	// it
+	SecretToken      string `protobuf:"bytes,13,opt,name=secret_token,json=secretToken" json:"secret_token"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *Vm) Reset()                    { *m = Vm{} }
+func (m *Vm) String() string            { return proto.CompactTextString(m) }
+func (*Vm) ProtoMessage()               {}
+func (*Vm) Descriptor() ([]byte, []int) { return fileDescriptorVmPool, []int{0} }
+
+type VmFilter struct {
+	Cpu            int32  `protobuf:"varint,1,opt,name=cpu" json:"cpu"`
+	MemoryMb       int32  `protobuf:"varint,2,opt,name=memory_mb,json=memoryMb" json:"memory_mb"`
+	PublicVlan     int32  `protobuf:"varint,3,opt,name=public_vlan,json=publicVlan" json:"public_vlan"`
+	PrivateVlan    int32  `protobuf:"varint,4,opt,name=private_vlan,json=privateVlan" json:"private_vlan"`
+	DeploymentName string `protobuf:"bytes,5,opt,name=deployment_name,json=deploymentName" json:"deployment_name"`
+	State          string `protobuf:"bytes,6,opt,name=state" json:"state"`
+	// when ordering, the name of a flavor whose cpu, memory and vlans the vm
+	// must have
+	Flavor string `protobuf:"bytes,7,opt,name=flavor" json:"flavor"`
+	// when ordering, JSON agent settings the vm reads from /metadata until it
+	// is free again
+	UserData         string `protobuf:"bytes,8,opt,name=user_data,json=userData" json:"user_data"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *VmFilter) Reset()                    { *m = VmFilter{} }
+func (m *VmFilter) String() string            { return proto.CompactTextString(m) }
+func (*VmFilter) ProtoMessage()               {}
+func (*VmFilter) Descriptor() ([]byte, []int) { return fileDescriptorVmPool, []int{1} }
+
+type FieldError struct {
+	Field            string `protobuf:"bytes,1,opt,name=field" json:"field"`
+	Message          string `protobuf:"bytes,2,opt,name=message" json:"message"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *FieldError) Reset()                    { *m = FieldError{} }
+func (m *FieldError) String() string            { return proto.CompactTextString(m) }
+func (*FieldError) ProtoMessage()               {}
+func (*FieldError) Descriptor() ([]byte, []int) { return fileDescriptorVmPool, []int{2} }
+
+// Error mirrors models.Error; type is one of the ErrorType names.
+type Error struct {
+	Type             string        `protobuf:"bytes,1,opt,name=type" json:"type"`
+	Message          string        `protobuf:"bytes,2,opt,name=message" json:"message"`
+	Fields           []*FieldError `protobuf:"bytes,3,rep,name=fields" json:"fields,omitempty"`
+	XXX_unrecognized []byte        `json:"-"`
+}
+
+func (m *Error) Reset()                    { *m = Error{} }
+func (m *Error) String() string            { return proto.CompactTextString(m) }
+func (*Error) ProtoMessage()               {}
+func (*Error) Descriptor() ([]byte, []int) { return fileDescriptorVmPool, []int{3} }
+
+type VmsResponse struct {
+	Vms              []*Vm  `protobuf:"bytes,1,rep,name=vms" json:"vms,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *VmsResponse) Reset()                    { *m = VmsResponse{} }
+func (m *VmsResponse) String() string            { return proto.CompactTextString(m) }
+func (*VmsResponse) ProtoMessage()               {}
+func (*VmsResponse) Descriptor() ([]byte, []int) { return fileDescriptorVmPool, []int{4} }
+
+type VmRequest struct {
+	Cid              int32  `protobuf:"varint,1,opt,name=cid" json:"cid"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *VmRequest) Reset()                    { *m = VmRequest{} }
+func (m *VmRequest) String() string            { return proto.CompactTextString(m) }
+func (*VmRequest) ProtoMessage()               {}
+func (*VmRequest) Descriptor() ([]byte, []int) { return fileDescriptorVmPool, []int{5} }
+
+type VmByIpRequest struct {
+	Ip               string `protobuf:"bytes,1,opt,name=ip" json:"ip"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *VmByIpRequest) Reset()                    { *m = VmByIpRequest{} }
+func (m *VmByIpRequest) String() string            { return proto.CompactTextString(m) }
+func (*VmByIpRequest) ProtoMessage()               {}
+func (*VmByIpRequest) Descriptor() ([]byte, []int) { return fileDescriptorVmPool, []int{6} }
+
+type VmByHostnameRequest struct {
+	Hostname         string `protobuf:"bytes,1,opt,name=hostname" json:"hostname"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *VmByHostnameRequest) Reset()                    { *m = VmByHostnameRequest{} }
+func (m *VmByHostnameRequest) String() string            { return proto.CompactTextString(m) }
+func (*VmByHostnameRequest) ProtoMessage()               {}
+func (*VmByHostnameRequest) Descriptor() ([]byte, []int) { return fileDescriptorVmPool, []int{7} }
+
+type VmsByHostnameRequest struct {
+	// * matches any run of characters
+	Pattern          string `protobuf:"bytes,1,opt,name=pattern" json:"pattern"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *VmsByHostnameRequest) Reset()                    { *m = VmsByHostnameRequest{} }
+func (m *VmsByHostnameRequest) String() string            { return proto.CompactTextString(m) }
+func (*VmsByHostnameRequest) ProtoMessage()               {}
+func (*VmsByHostnameRequest) Descriptor() ([]byte, []int) { return fileDescriptorVmPool, []int{8} }
+
+type VmsByDeploymentsRequest struct {
+	DeploymentNames  []string `protobuf:"bytes,1,rep,name=deployment_names,json=deploymentNames" json:"deployment_names,omitempty"`
+	XXX_unrecognized []byte   `json:"-"`
+}
+
+func (m *VmsByDeploymentsRequest) Reset()                    { *m = VmsByDeploymentsRequest{} }
+func (m *VmsByDeploymentsRequest) String() string            { return proto.CompactTextString(m) }
+func (*VmsByDeploymentsRequest) ProtoMessage()               {}
+func (*VmsByDeploymentsRequest) Descriptor() ([]byte, []int) { return fileDescriptorVmPool, []int{9} }
+
+type VmsByStatesRequest struct {
+	States           []string `protobuf:"bytes,1,rep,name=states" json:"states,omitempty"`
+	XXX_unrecognized []byte   `json:"-"`
+}
+
+func (m *VmsByStatesRequest) Reset()                    { *m = VmsByStatesRequest{} }
+func (m *VmsByStatesRequest) String() string            { return proto.CompactTextString(m) }
+func (*VmsByStatesRequest) ProtoMessage()               {}
+func (*VmsByStatesRequest) Descriptor() ([]byte, []int) { return fileDescriptorVmPool, []int{10} }
+
+type OrderVmsRequest struct {
+	Cids             []int32  `protobuf:"varint,1,rep,name=cids" json:"cids,omitempty"`
+	Ips              []string `protobuf:"bytes,2,rep,name=ips" json:"ips,omitempty"`
+	XXX_unrecognized []byte   `json:"-"`
+}
+
+func (m *OrderVmsRequest) Reset()                    { *m = OrderVmsRequest{} }
+func (m *OrderVmsRequest) String() string            { return proto.CompactTextString(m) }
+func (*OrderVmsRequest) ProtoMessage()               {}
+func (*OrderVmsRequest) Descriptor() ([]byte, []int) { return fileDescriptorVmPool, []int{11} }
+
+type UpdateVmStateRequest struct {
+	Cid              int32  `protobuf:"varint,1,opt,name=cid" json:"cid"`
+	State            string `protobuf:"bytes,2,opt,name=state" json:"state"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *UpdateVmStateRequest) Reset()                    { *m = UpdateVmStateRequest{} }
+func (m *UpdateVmStateRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpdateVmStateRequest) ProtoMessage()               {}
+func (*UpdateVmStateRequest) Descriptor() ([]byte, []int) { return fileDescriptorVmPool, []int{12} }
+
+type Empty struct {
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *Empty) Reset()                    { *m = Empty{} }
+func (m *Empty) String() string            { return proto.CompactTextString(m) }
+func (*Empty) ProtoMessage()               {}
+func (*Empty) Descriptor() ([]byte, []int) { return fileDescriptorVmPool, []int{13} }
+
+type WatchRequest struct {
+	// only stream events of these types, every type when empty
+	Types            []string `protobuf:"bytes,1,rep,name=types" json:"types,omitempty"`
+	XXX_unrecognized []byte   `json:"-"`
+}
+
+func (m *WatchRequest) Reset()                    { *m = WatchRequest{} }
+func (m *WatchRequest) String() string            { return proto.CompactTextString(m) }
+func (*WatchRequest) ProtoMessage()               {}
+func (*WatchRequest) Descriptor() ([]byte, []int) { return fileDescriptorVmPool, []int{14} }
+
+// VmEvent is what the webhooks deliver: type is vm-ordered, vm-released,
+// vm-removed or vm-state-changed.
+type VmEvent struct {
+	Id   string `protobuf:"bytes,1,opt,name=id" json:"id"`
+	Type string `protobuf:"bytes,2,opt,name=type" json:"type"`
+	// unix nanoseconds
+	Timestamp        int64  `protobuf:"varint,3,opt,name=timestamp" json:"timestamp"`
+	Vm               *Vm    `protobuf:"bytes,4,opt,name=vm" json:"vm,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *VmEvent) Reset()                    { *m = VmEvent{} }
+func (m *VmEvent) String() string            { return proto.CompactTextString(m) }
+func (*VmEvent) ProtoMessage()               {}
+func (*VmEvent) Descriptor() ([]byte, []int) { return fileDescriptorVmPool, []int{15} }
+
+func init() {
+	proto.RegisterType((*Vm)(nil), "grpcapi.Vm")
+	proto.RegisterType((*VmFilter)(nil), "grpcapi.VmFilter")
+	proto.RegisterType((*FieldError)(nil), "grpcapi.FieldError")
+	proto.RegisterType((*Error)(nil), "grpcapi.Error")
+	proto.RegisterType((*VmsResponse)(nil), "grpcapi.VmsResponse")
+	proto.RegisterType((*VmRequest)(nil), "grpcapi.VmRequest")
+	proto.RegisterType((*VmByIpRequest)(nil), "grpcapi.VmByIpRequest")
+	proto.RegisterType((*VmByHostnameRequest)(nil), "grpcapi.VmByHostnameRequest")
+	proto.RegisterType((*VmsByHostnameRequest)(nil), "grpcapi.VmsByHostnameRequest")
+	proto.RegisterType((*VmsByDeploymentsRequest)(nil), "grpcapi.VmsByDeploymentsRequest")
+	proto.RegisterType((*VmsByStatesRequest)(nil), "grpcapi.VmsByStatesRequest")
+	proto.RegisterType((*OrderVmsRequest)(nil), "grpcapi.OrderVmsRequest")
+	proto.RegisterType((*UpdateVmStateRequest)(nil), "grpcapi.UpdateVmStateRequest")
+	proto.RegisterType((*Empty)(nil), "grpcapi.Empty")
+	proto.RegisterType((*WatchRequest)(nil), "grpcapi.WatchRequest")
+	proto.RegisterType((*VmEvent)(nil), "grpcapi.VmEvent")
+}
+func (this *Vm) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*Vm)
+	if !ok {
+		that2, ok := that.(Vm)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Cid != that1.Cid {
+		return false
+	}
+	if this.Hostname != that1.Hostname {
+		return false
+	}
+	if this.Cpu != that1.Cpu {
+		return false
+	}
+	if this.MemoryMb != that1.MemoryMb {
+		return false
+	}
+	if this.PublicVlan != that1.PublicVlan {
+		return false
+	}
+	if this.PrivateVlan != that1.PrivateVlan {
+		return false
+	}
+	if this.Ip != that1.Ip {
+		return false
+	}
+	if this.State != that1.State {
+		return false
+	}
+	if this.DeploymentName != that1.DeploymentName {
+		return false
+	}
+	if this.Pool != that1.Pool {
+		return false
+	}
+	if this.CreateDate != that1.CreateDate {
+		return false
+	}
+	if this.ModifyDate != that1.ModifyDate {
+		return false
+	}
+	if this.SecretToken != that1.SecretToken {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *VmFilter) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*VmFilter)
+	if !ok {
+		that2, ok := that.(VmFilter)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Cpu != that1.Cpu {
+		return false
+	}
+	if this.MemoryMb != that1.MemoryMb {
+		return false
+	}
+	if this.PublicVlan != that1.PublicVlan {
+		return false
+	}
+	if this.PrivateVlan != that1.PrivateVlan {
+		return false
+	}
+	if this.DeploymentName != that1.DeploymentName {
+		return false
+	}
+	if this.State != that1.State {
+		return false
+	}
+	if this.Flavor != that1.Flavor {
+		return false
+	}
+	if this.UserData != that1.UserData {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *FieldError) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*FieldError)
+	if !ok {
+		that2, ok := that.(FieldError)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Field != that1.Field {
+		return false
+	}
+	if this.Message != that1.Message {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *Error) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*Error)
+	if !ok {
+		that2, ok := that.(Error)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.Message != that1.Message {
+		return false
+	}
+	if len(this.Fields) != len(that1.Fields) {
+		return false
+	}
+	for i := range this.Fields {
+		if !this.Fields[i].Equal(that1.Fields[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *VmsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*VmsResponse)
+	if !ok {
+		that2, ok := that.(VmsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if len(this.Vms) != len(that1.Vms) {
+		return false
+	}
+	for i := range this.Vms {
+		if !this.Vms[i].Equal(that1.Vms[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *VmRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*VmRequest)
+	if !ok {
+		that2, ok := that.(VmRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Cid != that1.Cid {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *VmByIpRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*VmByIpRequest)
+	if !ok {
+		that2, ok := that.(VmByIpRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Ip != that1.Ip {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *VmByHostnameRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*VmByHostnameRequest)
+	if !ok {
+		that2, ok := that.(VmByHostnameRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Hostname != that1.Hostname {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *VmsByHostnameRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*VmsByHostnameRequest)
+	if !ok {
+		that2, ok := that.(VmsByHostnameRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Pattern != that1.Pattern {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *VmsByDeploymentsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*VmsByDeploymentsRequest)
+	if !ok {
+		that2, ok := that.(VmsByDeploymentsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if len(this.DeploymentNames) != len(that1.DeploymentNames) {
+		return false
+	}
+	for i := range this.DeploymentNames {
+		if this.DeploymentNames[i] != that1.DeploymentNames[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *VmsByStatesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*VmsByStatesRequest)
+	if !ok {
+		that2, ok := that.(VmsByStatesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if len(this.States) != len(that1.States) {
+		return false
+	}
+	for i := range this.States {
+		if this.States[i] != that1.States[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *OrderVmsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*OrderVmsRequest)
+	if !ok {
+		that2, ok := that.(OrderVmsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if len(this.Cids) != len(that1.Cids) {
+		return false
+	}
+	for i := range this.Cids {
+		if this.Cids[i] != that1.Cids[i] {
+			return false
+		}
+	}
+	if len(this.Ips) != len(that1.Ips) {
+		return false
+	}
+	for i := range this.Ips {
+		if this.Ips[i] != that1.Ips[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *UpdateVmStateRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*UpdateVmStateRequest)
+	if !ok {
+		that2, ok := that.(UpdateVmStateRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Cid != that1.Cid {
+		return false
+	}
+	if this.State != that1.State {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *Empty) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*Empty)
+	if !ok {
+		that2, ok := that.(Empty)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *WatchRequest) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*WatchRequest)
+	if !ok {
+		that2, ok := that.(WatchRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if len(this.Types) != len(that1.Types) {
+		return false
+	}
+	for i := range this.Types {
+		if this.Types[i] != that1.Types[i] {
+			return false
+		}
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+func (this *VmEvent) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*VmEvent)
+	if !ok {
+		that2, ok := that.(VmEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	if !this.Vm.Equal(that1.Vm) {
+		return false
+	}
+	if !bytes.Equal(this.XXX_unrecognized, that1.XXX_unrecognized) {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for VmPool service
+
+type VmPoolClient interface {
+	ListVms(ctx context.Context, in *VmFilter, opts ...grpc.CallOption) (*VmsResponse, error)
+	GetVm(ctx context.Context, in *VmRequest, opts ...grpc.CallOption) (*Vm, error)
+	GetVmByIp(ctx context.Context, in *VmByIpRequest, opts ...grpc.CallOption) (*Vm, error)
+	GetVmByHostname(ctx context.Context, in *VmByHostnameRequest, opts ...grpc.CallOption) (*Vm, error)
+	FindVmsByHostname(ctx context.Context, in *VmsByHostnameRequest, opts ...grpc.CallOption) (*VmsResponse, error)
+	FindVmsByDeployments(ctx context.Context, in *VmsByDeploymentsRequest, opts ...grpc.CallOption) (*VmsResponse, error)
+	FindVmsByStates(ctx context.Context, in *VmsByStatesRequest, opts ...grpc.CallOption) (*VmsResponse, error)
+	OrderVm(ctx context.Context, in *VmFilter, opts ...grpc.CallOption) (*Vm, error)
+	OrderVms(ctx context.Context, in *OrderVmsRequest, opts ...grpc.CallOption) (*VmsResponse, error)
+	AddVm(ctx context.Context, in *Vm, opts ...grpc.CallOption) (*Empty, error)
+	UpdateVm(ctx context.Context, in *Vm, opts ...grpc.CallOption) (*Empty, error)
+	UpdateVmState(ctx context.Context, in *UpdateVmStateRequest, opts ...grpc.CallOption) (*Empty, error)
+	TransitionVm(ctx context.Context, in *UpdateVmStateRequest, opts ...grpc.CallOption) (*Empty, error)
+	DeleteVm(ctx context.Context, in *VmRequest, opts ...grpc.CallOption) (*Empty, error)
+	// Watch streams the events of the vms as they happen, starting with the
+	// first event after the call. The pool is polled, so a vm that changes
+	// more than once between two polls is seen in its last state.
+	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (VmPool_WatchClient, error)
+}
+
+type vmPoolClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewVmPoolClient(cc *grpc.ClientConn) VmPoolClient {
+	return &vmPoolClient{cc}
+}
+
+func (c *vmPoolClient) ListVms(ctx context.Context, in *VmFilter, opts ...grpc.CallOption) (*VmsResponse, error) {
+	out := new(VmsResponse)
+	err := grpc.Invoke(ctx, "/grpcapi.VmPool/ListVms", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vmPoolClient) GetVm(ctx context.Context, in *VmRequest, opts ...grpc.CallOption) (*Vm, error) {
+	out := new(Vm)
+	err := grpc.Invoke(ctx, "/grpcapi.VmPool/GetVm", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vmPoolClient) GetVmByIp(ctx context.Context, in *VmByIpRequest, opts ...grpc.CallOption) (*Vm, error) {
+	out := new(Vm)
+	err := grpc.Invoke(ctx, "/grpcapi.VmPool/GetVmByIp", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vmPoolClient) GetVmByHostname(ctx context.Context, in *VmByHostnameRequest, opts ...grpc.CallOption) (*Vm, error) {
+	out := new(Vm)
+	err := grpc.Invoke(ctx, "/grpcapi.VmPool/GetVmByHostname", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vmPoolClient) FindVmsByHostname(ctx context.Context, in *VmsByHostnameRequest, opts ...grpc.CallOption) (*VmsResponse, error) {
+	out := new(VmsResponse)
+	err := grpc.Invoke(ctx, "/grpcapi.VmPool/FindVmsByHostname", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vmPoolClient) FindVmsByDeployments(ctx context.Context, in *VmsByDeploymentsRequest, opts ...grpc.CallOption) (*VmsResponse, error) {
+	out := new(VmsResponse)
+	err := grpc.Invoke(ctx, "/grpcapi.VmPool/FindVmsByDeployments", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vmPoolClient) FindVmsByStates(ctx context.Context, in *VmsByStatesRequest, opts ...grpc.CallOption) (*VmsResponse, error) {
+	out := new(VmsResponse)
+	err := grpc.Invoke(ctx, "/grpcapi.VmPool/FindVmsByStates", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vmPoolClient) OrderVm(ctx context.Context, in *VmFilter, opts ...grpc.CallOption) (*Vm, error) {
+	out := new(Vm)
+	err := grpc.Invoke(ctx, "/grpcapi.VmPool/OrderVm", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vmPoolClient) OrderVms(ctx context.Context, in *OrderVmsRequest, opts ...grpc.CallOption) (*VmsResponse, error) {
+	out := new(VmsResponse)
+	err := grpc.Invoke(ctx, "/grpcapi.VmPool/OrderVms", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vmPoolClient) AddVm(ctx context.Context, in *Vm, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/grpcapi.VmPool/AddVm", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vmPoolClient) UpdateVm(ctx context.Context, in *Vm, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/grpcapi.VmPool/UpdateVm", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vmPoolClient) UpdateVmState(ctx context.Context, in *UpdateVmStateRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/grpcapi.VmPool/UpdateVmState", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vmPoolClient) TransitionVm(ctx context.Context, in *UpdateVmStateRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/grpcapi.VmPool/TransitionVm", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vmPoolClient) DeleteVm(ctx context.Context, in *VmRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := grpc.Invoke(ctx, "/grpcapi.VmPool/DeleteVm", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vmPoolClient) Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (VmPool_WatchClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_VmPool_serviceDesc.Streams[0], c.cc, "/grpcapi.VmPool/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vmPoolWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type VmPool_WatchClient interface {
+	Recv() (*VmEvent, error)
+	grpc.ClientStream
+}
+
+type vmPoolWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *vmPoolWatchClient) Recv() (*VmEvent, error) {
+	m := new(VmEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Server API for VmPool service
+
+type VmPoolServer interface {
+	ListVms(context.Context, *VmFilter) (*VmsResponse, error)
+	GetVm(context.Context, *VmRequest) (*Vm, error)
+	GetVmByIp(context.Context, *VmByIpRequest) (*Vm, error)
+	GetVmByHostname(context.Context, *VmByHostnameRequest) (*Vm, error)
+	FindVmsByHostname(context.Context, *VmsByHostnameRequest) (*VmsResponse, error)
+	FindVmsByDeployments(context.Context, *VmsByDeploymentsRequest) (*VmsResponse, error)
+	FindVmsByStates(context.Context, *VmsByStatesRequest) (*VmsResponse, error)
+	OrderVm(context.Context, *VmFilter) (*Vm, error)
+	OrderVms(context.Context, *OrderVmsRequest) (*VmsResponse, error)
+	AddVm(context.Context, *Vm) (*Empty, error)
+	UpdateVm(context.Context, *Vm) (*Empty, error)
+	UpdateVmState(context.Context, *UpdateVmStateRequest) (*Empty, error)
+	TransitionVm(context.Context, *UpdateVmStateRequest) (*Empty, error)
+	DeleteVm(context.Context, *VmRequest) (*Empty, error)
+	// Watch streams the events of the vms as they happen, starting with the
+	// first event after the call. The pool is polled, so a vm that changes
+	// more than once between two polls is seen in its last state.
+	Watch(*WatchRequest, VmPool_WatchServer) error
+}
+
+func RegisterVmPoolServer(s *grpc.Server, srv VmPoolServer) {
+	s.RegisterService(&_VmPool_serviceDesc, srv)
+}
+
+func _VmPool_ListVms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VmFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VmPoolServer).ListVms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.VmPool/ListVms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VmPoolServer).ListVms(ctx, req.(*VmFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VmPool_GetVm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VmRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VmPoolServer).GetVm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.VmPool/GetVm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VmPoolServer).GetVm(ctx, req.(*VmRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VmPool_GetVmByIp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VmByIpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VmPoolServer).GetVmByIp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.VmPool/GetVmByIp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VmPoolServer).GetVmByIp(ctx, req.(*VmByIpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VmPool_GetVmByHostname_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VmByHostnameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VmPoolServer).GetVmByHostname(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.VmPool/GetVmByHostname",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VmPoolServer).GetVmByHostname(ctx, req.(*VmByHostnameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VmPool_FindVmsByHostname_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VmsByHostnameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VmPoolServer).FindVmsByHostname(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.VmPool/FindVmsByHostname",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VmPoolServer).FindVmsByHostname(ctx, req.(*VmsByHostnameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VmPool_FindVmsByDeployments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VmsByDeploymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VmPoolServer).FindVmsByDeployments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.VmPool/FindVmsByDeployments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VmPoolServer).FindVmsByDeployments(ctx, req.(*VmsByDeploymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VmPool_FindVmsByStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VmsByStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VmPoolServer).FindVmsByStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.VmPool/FindVmsByStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VmPoolServer).FindVmsByStates(ctx, req.(*VmsByStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VmPool_OrderVm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VmFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VmPoolServer).OrderVm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.VmPool/OrderVm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VmPoolServer).OrderVm(ctx, req.(*VmFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VmPool_OrderVms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OrderVmsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VmPoolServer).OrderVms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.VmPool/OrderVms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VmPoolServer).OrderVms(ctx, req.(*OrderVmsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VmPool_AddVm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Vm)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VmPoolServer).AddVm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.VmPool/AddVm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VmPoolServer).AddVm(ctx, req.(*Vm))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VmPool_UpdateVm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Vm)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VmPoolServer).UpdateVm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.VmPool/UpdateVm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VmPoolServer).UpdateVm(ctx, req.(*Vm))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VmPool_UpdateVmState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVmStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VmPoolServer).UpdateVmState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.VmPool/UpdateVmState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VmPoolServer).UpdateVmState(ctx, req.(*UpdateVmStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VmPool_TransitionVm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVmStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VmPoolServer).TransitionVm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.VmPool/TransitionVm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VmPoolServer).TransitionVm(ctx, req.(*UpdateVmStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VmPool_DeleteVm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VmRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VmPoolServer).DeleteVm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.VmPool/DeleteVm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VmPoolServer).DeleteVm(ctx, req.(*VmRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VmPool_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VmPoolServer).Watch(m, &vmPoolWatchServer{stream})
+}
+
+type VmPool_WatchServer interface {
+	Send(*VmEvent) error
+	grpc.ServerStream
+}
+
+type vmPoolWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *vmPoolWatchServer) Send(m *VmEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _VmPool_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "grpcapi.VmPool",
+	HandlerType: (*VmPoolServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListVms",
+			Handler:    _VmPool_ListVms_Handler,
+		},
+		{
+			MethodName: "GetVm",
+			Handler:    _VmPool_GetVm_Handler,
+		},
+		{
+			MethodName: "GetVmByIp",
+			Handler:    _VmPool_GetVmByIp_Handler,
+		},
+		{
+			MethodName: "GetVmByHostname",
+			Handler:    _VmPool_GetVmByHostname_Handler,
+		},
+		{
+			MethodName: "FindVmsByHostname",
+			Handler:    _VmPool_FindVmsByHostname_Handler,
+		},
+		{
+			MethodName: "FindVmsByDeployments",
+			Handler:    _VmPool_FindVmsByDeployments_Handler,
+		},
+		{
+			MethodName: "FindVmsByStates",
+			Handler:    _VmPool_FindVmsByStates_Handler,
+		},
+		{
+			MethodName: "OrderVm",
+			Handler:    _VmPool_OrderVm_Handler,
+		},
+		{
+			MethodName: "OrderVms",
+			Handler:    _VmPool_OrderVms_Handler,
+		},
+		{
+			MethodName: "AddVm",
+			Handler:    _VmPool_AddVm_Handler,
+		},
+		{
+			MethodName: "UpdateVm",
+			Handler:    _VmPool_UpdateVm_Handler,
+		},
+		{
+			MethodName: "UpdateVmState",
+			Handler:    _VmPool_UpdateVmState_Handler,
+		},
+		{
+			MethodName: "TransitionVm",
+			Handler:    _VmPool_TransitionVm_Handler,
+		},
+		{
+			MethodName: "DeleteVm",
+			Handler:    _VmPool_DeleteVm_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _VmPool_Watch_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "grpcapi/vm_pool.proto",
+}
+
+func (m *Vm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Vm) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintVmPool(dAtA, i, uint64(m.Cid))
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintVmPool(dAtA, i, uint64(len(m.Hostname)))
+	i += copy(dAtA[i:], m.Hostname)
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintVmPool(dAtA, i, uint64(m.Cpu))
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintVmPool(dAtA, i, uint64(m.MemoryMb))
+	dAtA[i] = 0x28
+	i++
+	i = encodeVarintVmPool(dAtA, i, uint64(m.PublicVlan))
+	dAtA[i] = 0x30
+	i++
+	i = encodeVarintVmPool(dAtA, i, uint64(m.PrivateVlan))
+	dAtA[i] = 0x3a
+	i++
+	i = encodeVarintVmPool(dAtA, i, uint64(len(m.Ip)))
+	i += copy(dAtA[i:], m.Ip)
+	dAtA[i] = 0x42
+	i++
+	i = encodeVarintVmPool(dAtA, i, uint64(len(m.State)))
+	i += copy(dAtA[i:], m.State)
+	dAtA[i] = 0x4a
+	i++
+	i = encodeVarintVmPool(dAtA, i, uint64(len(m.DeploymentName)))
+	i += copy(dAtA[i:], m.DeploymentName)
+	dAtA[i] = 0x52
+	i++
+	i = encodeVarintVmPool(dAtA, i, uint64(len(m.Pool)))
+	i += copy(dAtA[i:], m.Pool)
+	dAtA[i] = 0x58
+	i++
+	i = encodeVarintVmPool(dAtA, i, uint64(m.CreateDate))
+	dAtA[i] = 0x60
+	i++
+	i = encodeVarintVmPool(dAtA, i, uint64(m.ModifyDate))
+	dAtA[i] = 0x6a
+	i++
+	i = encodeVarintVmPool(dAtA, i, uint64(len(m.SecretToken)))
+	i += copy(dAtA[i:], m.SecretToken)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *VmFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VmFilter) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintVmPool(dAtA, i, uint64(m.Cpu))
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintVmPool(dAtA, i, uint64(m.MemoryMb))
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintVmPool(dAtA, i, uint64(m.PublicVlan))
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintVmPool(dAtA, i, uint64(m.PrivateVlan))
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintVmPool(dAtA, i, uint64(len(m.DeploymentName)))
+	i += copy(dAtA[i:], m.DeploymentName)
+	dAtA[i] = 0x32
+	i++
+	i = encodeVarintVmPool(dAtA, i, uint64(len(m.State)))
+	i += copy(dAtA[i:], m.State)
+	dAtA[i] = 0x3a
+	i++
+	i = encodeVarintVmPool(dAtA, i, uint64(len(m.Flavor)))
+	i += copy(dAtA[i:], m.Flavor)
+	dAtA[i] = 0x42
+	i++
+	i = encodeVarintVmPool(dAtA, i, uint64(len(m.UserData)))
+	i += copy(dAtA[i:], m.UserData)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *FieldError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FieldError) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintVmPool(dAtA, i, uint64(len(m.Field)))
+	i += copy(dAtA[i:], m.Field)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintVmPool(dAtA, i, uint64(len(m.Message)))
+	i += copy(dAtA[i:], m.Message)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Error) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Error) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintVmPool(dAtA, i, uint64(len(m.Type)))
+	i += copy(dAtA[i:], m.Type)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintVmPool(dAtA, i, uint64(len(m.Message)))
+	i += copy(dAtA[i:], m.Message)
+	if len(m.Fields) > 0 {
+		for _, msg := range m.Fields {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintVmPool(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *VmsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VmsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Vms) > 0 {
+		for _, msg := range m.Vms {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintVmPool(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *VmRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VmRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintVmPool(dAtA, i, uint64(m.Cid))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *VmByIpRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VmByIpRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintVmPool(dAtA, i, uint64(len(m.Ip)))
+	i += copy(dAtA[i:], m.Ip)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *VmByHostnameRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VmByHostnameRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintVmPool(dAtA, i, uint64(len(m.Hostname)))
+	i += copy(dAtA[i:], m.Hostname)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *VmsByHostnameRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VmsByHostnameRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintVmPool(dAtA, i, uint64(len(m.Pattern)))
+	i += copy(dAtA[i:], m.Pattern)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *VmsByDeploymentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VmsByDeploymentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeploymentNames) > 0 {
+		for _, s := range m.DeploymentNames {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *VmsByStatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VmsByStatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.States) > 0 {
+		for _, s := range m.States {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *OrderVmsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderVmsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Cids) > 0 {
+		for _, num := range m.Cids {
+			dAtA[i] = 0x8
+			i++
+			i = encodeVarintVmPool(dAtA, i, uint64(num))
+		}
+	}
+	if len(m.Ips) > 0 {
+		for _, s := range m.Ips {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *UpdateVmStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateVmStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintVmPool(dAtA, i, uint64(m.Cid))
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintVmPool(dAtA, i, uint64(len(m.State)))
+	i += copy(dAtA[i:], m.State)
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Empty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Empty) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *WatchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Types) > 0 {
+		for _, s := range m.Types {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *VmEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VmEvent) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	dAtA[i] = 0xa
+	i++
+	i = encodeVarintVmPool(dAtA, i, uint64(len(m.Id)))
+	i += copy(dAtA[i:], m.Id)
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintVmPool(dAtA, i, uint64(len(m.Type)))
+	i += copy(dAtA[i:], m.Type)
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintVmPool(dAtA, i, uint64(m.Timestamp))
+	if m.Vm != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintVmPool(dAtA, i, uint64(m.Vm.Size()))
+		n1, err := m.Vm.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func encodeFixed64VmPool(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32VmPool(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintVmPool(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Vm) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovVmPool(uint64(m.Cid))
+	l = len(m.Hostname)
+	n += 1 + l + sovVmPool(uint64(l))
+	n += 1 + sovVmPool(uint64(m.Cpu))
+	n += 1 + sovVmPool(uint64(m.MemoryMb))
+	n += 1 + sovVmPool(uint64(m.PublicVlan))
+	n += 1 + sovVmPool(uint64(m.PrivateVlan))
+	l = len(m.Ip)
+	n += 1 + l + sovVmPool(uint64(l))
+	l = len(m.State)
+	n += 1 + l + sovVmPool(uint64(l))
+	l = len(m.DeploymentName)
+	n += 1 + l + sovVmPool(uint64(l))
+	l = len(m.Pool)
+	n += 1 + l + sovVmPool(uint64(l))
+	n += 1 + sovVmPool(uint64(m.CreateDate))
+	n += 1 + sovVmPool(uint64(m.ModifyDate))
+	l = len(m.SecretToken)
+	n += 1 + l + sovVmPool(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VmFilter) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovVmPool(uint64(m.Cpu))
+	n += 1 + sovVmPool(uint64(m.MemoryMb))
+	n += 1 + sovVmPool(uint64(m.PublicVlan))
+	n += 1 + sovVmPool(uint64(m.PrivateVlan))
+	l = len(m.DeploymentName)
+	n += 1 + l + sovVmPool(uint64(l))
+	l = len(m.State)
+	n += 1 + l + sovVmPool(uint64(l))
+	l = len(m.Flavor)
+	n += 1 + l + sovVmPool(uint64(l))
+	l = len(m.UserData)
+	n += 1 + l + sovVmPool(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *FieldError) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Field)
+	n += 1 + l + sovVmPool(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovVmPool(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Error) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Type)
+	n += 1 + l + sovVmPool(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovVmPool(uint64(l))
+	if len(m.Fields) > 0 {
+		for _, e := range m.Fields {
+			l = e.Size()
+			n += 1 + l + sovVmPool(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VmsResponse) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Vms) > 0 {
+		for _, e := range m.Vms {
+			l = e.Size()
+			n += 1 + l + sovVmPool(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VmRequest) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovVmPool(uint64(m.Cid))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VmByIpRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Ip)
+	n += 1 + l + sovVmPool(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VmByHostnameRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Hostname)
+	n += 1 + l + sovVmPool(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VmsByHostnameRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Pattern)
+	n += 1 + l + sovVmPool(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VmsByDeploymentsRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.DeploymentNames) > 0 {
+		for _, s := range m.DeploymentNames {
+			l = len(s)
+			n += 1 + l + sovVmPool(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VmsByStatesRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.States) > 0 {
+		for _, s := range m.States {
+			l = len(s)
+			n += 1 + l + sovVmPool(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *OrderVmsRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Cids) > 0 {
+		for _, e := range m.Cids {
+			n += 1 + sovVmPool(uint64(e))
+		}
+	}
+	if len(m.Ips) > 0 {
+		for _, s := range m.Ips {
+			l = len(s)
+			n += 1 + l + sovVmPool(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UpdateVmStateRequest) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovVmPool(uint64(m.Cid))
+	l = len(m.State)
+	n += 1 + l + sovVmPool(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Empty) Size() (n int) {
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WatchRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Types) > 0 {
+		for _, s := range m.Types {
+			l = len(s)
+			n += 1 + l + sovVmPool(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VmEvent) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	n += 1 + l + sovVmPool(uint64(l))
+	l = len(m.Type)
+	n += 1 + l + sovVmPool(uint64(l))
+	n += 1 + sovVmPool(uint64(m.Timestamp))
+	if m.Vm != nil {
+		l = m.Vm.Size()
+		n += 1 + l + sovVmPool(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovVmPool(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozVmPool(x uint64) (n int) {
+	return sovVmPool(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Vm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVmPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Vm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Vm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
+			}
+			m.Cid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVmPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cid |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hostname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVmPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVmPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hostname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cpu", wireType)
+			}
+			m.Cpu = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVmPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cpu |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryMb", wireType)
+			}
+			m.MemoryMb = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVmPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemoryMb |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicVlan", wireType)
+			}
+			m.PublicVlan = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVmPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PublicVlan |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrivateVlan", wireType)
+			}
+			m.PrivateVlan = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVmPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrivateVlan |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ip", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVmPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVmPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ip = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVmPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVmPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeploymentName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVmPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVmPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeploymentName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVmPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVmPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pool = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateDate", wireType)
+			}
+			m.CreateDate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVmPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreateDate |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModifyDate", wireType)
+			}
+			m.ModifyDate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVmPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ModifyDate |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVmPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVmPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecretToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVmPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthVmPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VmFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVmPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VmFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VmFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cpu", wireType)
+			}
+			m.Cpu = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVmPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cpu |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryMb", wireType)
+			}
+			m.MemoryMb = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVmPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemoryMb |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicVlan", wireType)
+			}
+			m.PublicVlan = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVmPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PublicVlan |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrivateVlan", wireType)
+			}
+			m.PrivateVlan = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVmPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrivateVlan |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeploymentName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVmPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVmPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeploymentName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVmPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVmPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flavor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVmPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVmPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Flavor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserData", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVmPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVmPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserData = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVmPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthVmPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FieldError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVmPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FieldError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FieldError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVmPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVmPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVmPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVmPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVmPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthVmPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Error) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVmPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Error: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Error: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVmPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVmPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVmPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVmPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVmPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVmPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, &FieldError{})
+			if err := m.Fields[len(m.Fields)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVmPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthVmPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VmsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVmPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VmsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VmsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVmPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVmPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vms = append(m.Vms, &Vm{})
+			if err := m.Vms[len(m.Vms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVmPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthVmPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VmRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVmPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VmRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VmRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
+			}
+			m.Cid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVmPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cid |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVmPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthVmPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VmByIpRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVmPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VmByIpRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VmByIpRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ip", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVmPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVmPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ip = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVmPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthVmPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VmByHostnameRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVmPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VmByHostnameRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VmByHostnameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hostname", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVmPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVmPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hostname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVmPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthVmPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VmsByHostnameRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVmPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VmsByHostnameRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VmsByHostnameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVmPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVmPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVmPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthVmPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VmsByDeploymentsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVmPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VmsByDeploymentsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VmsByDeploymentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeploymentNames", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVmPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVmPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeploymentNames = append(m.DeploymentNames, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVmPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthVmPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VmsByStatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVmPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VmsByStatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VmsByStatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field States", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVmPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVmPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.States = append(m.States, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVmPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthVmPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderVmsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVmPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderVmsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderVmsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowVmPool
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Cids = append(m.Cids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowVmPool
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthVmPool
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowVmPool
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Cids = append(m.Cids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cids", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ips", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVmPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVmPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ips = append(m.Ips, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVmPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthVmPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateVmStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVmPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateVmStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateVmStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cid", wireType)
+			}
+			m.Cid = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVmPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cid |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVmPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVmPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVmPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthVmPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Empty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVmPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Empty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Empty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVmPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthVmPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WatchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVmPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WatchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVmPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVmPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Types = append(m.Types, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVmPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthVmPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VmEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVmPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VmEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VmEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVmPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVmPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVmPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVmPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVmPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vm", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVmPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVmPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Vm == nil {
+				m.Vm = &Vm{}
+			}
+			if err := m.Vm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVmPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthVmPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipVmPool(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowVmPool
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVmPool
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVmPool
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthVmPool
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowVmPool
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipVmPool(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthVmPool = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowVmPool   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() { proto.RegisterFile("grpcapi/vm_pool.proto", fileDescriptorVmPool) }
+
+var fileDescriptorVmPool = []byte{
+	// 961 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xdd, 0x8e, 0xdb, 0x44,
+	0x14, 0xae, 0xed, 0x38, 0x3f, 0x27, 0xbb, 0xcd, 0x76, 0x9a, 0x16, 0x2b, 0x6d, 0x43, 0x30, 0x5d,
+	0x6d, 0x2a, 0x68, 0xb6, 0xda, 0x0b, 0x2a, 0x21, 0x40, 0x62, 0x95, 0x5d, 0x16, 0x04, 0x05, 0x85,
+	0x62, 0x2e, 0x23, 0x27, 0x9e, 0xcd, 0x5a, 0x78, 0xec, 0xc1, 0x33, 0x89, 0x14, 0xde, 0x86, 0x3b,
+	0xde, 0x81, 0x17, 0xd8, 0x3b, 0x78, 0x02, 0x04, 0xfb, 0x24, 0x68, 0x66, 0x6c, 0x67, 0x9c, 0x9f,
+	0x6d, 0x7b, 0xe7, 0x39, 0xe7, 0xfb, 0xce, 0xf8, 0x9c, 0xf3, 0x7d, 0x03, 0x0f, 0x66, 0x29, 0x9d,
+	0xfa, 0x34, 0x3c, 0x5e, 0x90, 0x31, 0x4d, 0x92, 0x68, 0x40, 0xd3, 0x84, 0x27, 0xa8, 0x96, 0x85,
+	0x3b, 0xcf, 0x67, 0x21, 0xbf, 0x9a, 0x4f, 0x06, 0xd3, 0x84, 0x1c, 0xcf, 0x92, 0x59, 0x72, 0x2c,
+	0xf3, 0x93, 0xf9, 0xa5, 0x3c, 0xc9, 0x83, 0xfc, 0x52, 0x3c, 0xf7, 0x4f, 0x0b, 0x4c, 0x8f, 0xa0,
+	0x87, 0x60, 0x4d, 0xc3, 0xc0, 0x31, 0x7a, 0x46, 0xdf, 0x3e, 0xad, 0x5c, 0xff, 0xf3, 0xfe, 0x9d,
+	0x91, 0x08, 0xa0, 0x1e, 0xd4, 0xaf, 0x12, 0xc6, 0x63, 0x9f, 0x60, 0xc7, 0xec, 0x19, 0xfd, 0x46,
+	0x96, 0x2c, 0xa2, 0x92, 0x49, 0xe7, 0x8e, 0x55, 0x62, 0xd2, 0x39, 0xfa, 0x00, 0x1a, 0x04, 0x93,
+	0x24, 0x5d, 0x8e, 0xc9, 0xc4, 0xa9, 0x68, 0xd9, 0xba, 0x0a, 0x7f, 0x37, 0x41, 0x87, 0xd0, 0xa4,
+	0xf3, 0x49, 0x14, 0x4e, 0xc7, 0x8b, 0xc8, 0x8f, 0x1d, 0x5b, 0x03, 0x81, 0x4a, 0x78, 0x91, 0x1f,
+	0xa3, 0x23, 0xd8, 0xa3, 0x69, 0xb8, 0xf0, 0x39, 0x56, 0xb8, 0xaa, 0x86, 0x6b, 0x66, 0x19, 0x09,
+	0x6c, 0x83, 0x19, 0x52, 0xa7, 0xa6, 0xfd, 0xa6, 0x19, 0x52, 0xd4, 0x01, 0x9b, 0x71, 0x9f, 0x63,
+	0xa7, 0xae, 0x25, 0x54, 0x08, 0x3d, 0x87, 0x56, 0x80, 0x69, 0x94, 0x2c, 0x09, 0x8e, 0xf9, 0x58,
+	0x76, 0xd9, 0xd0, 0x50, 0x77, 0x57, 0xc9, 0x57, 0xa2, 0x57, 0x07, 0x2a, 0x62, 0xe4, 0x0e, 0x68,
+	0x18, 0x19, 0x11, 0xad, 0x4c, 0x53, 0x2c, 0x7e, 0x31, 0x10, 0x57, 0x35, 0x7b, 0x46, 0xdf, 0xca,
+	0x5b, 0x51, 0x89, 0xa1, 0xb8, 0xef, 0x10, 0x9a, 0x24, 0x09, 0xc2, 0xcb, 0xa5, 0x82, 0xed, 0xe9,
+	0x30, 0x95, 0x90, 0xb0, 0x23, 0xd8, 0x63, 0x78, 0x9a, 0x62, 0x3e, 0xe6, 0xc9, 0x2f, 0x38, 0x76,
+	0xf6, 0xb5, 0xfb, 0x9a, 0x2a, 0xf3, 0x5a, 0x24, 0xdc, 0xdf, 0x4d, 0xa8, 0x7b, 0xe4, 0x3c, 0x8c,
+	0x38, 0x4e, 0xf3, 0x4d, 0x18, 0xb7, 0x6e, 0xc2, 0x7c, 0x9b, 0x4d, 0x58, 0x6f, 0xb9, 0x89, 0xca,
+	0xae, 0x4d, 0x6c, 0x99, 0xab, 0x7d, 0xcb, 0x5c, 0x8b, 0x15, 0x55, 0x37, 0x57, 0xf4, 0x18, 0xaa,
+	0x97, 0x91, 0xbf, 0x48, 0xd2, 0xd2, 0x62, 0xb3, 0x98, 0xe8, 0x6d, 0xce, 0x70, 0x2a, 0xc6, 0xe9,
+	0x97, 0x16, 0x5c, 0x17, 0xe1, 0xa1, 0xcf, 0x7d, 0xf7, 0x02, 0xe0, 0x3c, 0xc4, 0x51, 0x70, 0x96,
+	0xa6, 0x49, 0x2a, 0xae, 0xba, 0x14, 0x27, 0xc7, 0xd0, 0xc0, 0x2a, 0x84, 0xba, 0x50, 0x23, 0x98,
+	0x31, 0x7f, 0x56, 0xd6, 0x7a, 0x1e, 0x74, 0x63, 0xb0, 0x55, 0x11, 0x07, 0x2a, 0x7c, 0x49, 0x71,
+	0xa9, 0x86, 0x8c, 0xbc, 0xa9, 0x04, 0xfa, 0x08, 0xaa, 0xf2, 0x2e, 0xe6, 0x58, 0x3d, 0xab, 0xdf,
+	0x3c, 0xb9, 0x3f, 0xc8, 0x7c, 0x3b, 0x58, 0xfd, 0xe3, 0x28, 0x83, 0xb8, 0x1f, 0x43, 0xd3, 0x23,
+	0x6c, 0x84, 0x19, 0x4d, 0x62, 0x86, 0xd1, 0x13, 0xb0, 0x16, 0x84, 0x39, 0x86, 0x24, 0x36, 0x0b,
+	0xa2, 0x47, 0x46, 0x22, 0xee, 0x7e, 0x08, 0x0d, 0x8f, 0x8c, 0xf0, 0xaf, 0x73, 0xcc, 0xf8, 0x2e,
+	0x3f, 0xbb, 0x87, 0xb0, 0xef, 0x91, 0xd3, 0xe5, 0xd7, 0x34, 0x07, 0x2a, 0xcf, 0x18, 0x65, 0xcf,
+	0xb8, 0x2f, 0xe1, 0xbe, 0x80, 0x5d, 0x64, 0x26, 0xcf, 0xc1, 0xfa, 0x6b, 0x60, 0x6c, 0x7b, 0x0d,
+	0xdc, 0x4f, 0xa0, 0xed, 0x11, 0xb6, 0xc9, 0xec, 0x42, 0x8d, 0xfa, 0x9c, 0xe3, 0x34, 0x2e, 0x11,
+	0xf3, 0xa0, 0x3b, 0x84, 0xf7, 0x24, 0x6f, 0x58, 0x08, 0x83, 0xe5, 0xd4, 0x67, 0x70, 0xb0, 0xa6,
+	0x25, 0x35, 0x83, 0xc6, 0xa8, 0x55, 0x96, 0x91, 0x18, 0x18, 0x92, 0x55, 0x7e, 0x14, 0xca, 0x61,
+	0xab, 0x59, 0x54, 0xa5, 0x94, 0x72, 0x5a, 0x76, 0x72, 0x5f, 0x42, 0xeb, 0xfb, 0x34, 0xc0, 0xa9,
+	0x47, 0x0a, 0x28, 0x82, 0xca, 0x34, 0x0c, 0x14, 0xd0, 0x1e, 0xc9, 0x6f, 0x74, 0x00, 0x56, 0x48,
+	0x99, 0x63, 0x4a, 0xae, 0xf8, 0x74, 0xbf, 0x81, 0xf6, 0x4f, 0x54, 0x18, 0xd8, 0x23, 0xf2, 0xa6,
+	0x37, 0x0c, 0x7d, 0x25, 0x6f, 0x73, 0x43, 0xde, 0x6e, 0x0d, 0xec, 0x33, 0x42, 0xf9, 0xd2, 0x7d,
+	0x0a, 0x7b, 0x3f, 0xfb, 0x7c, 0x7a, 0xb5, 0x5a, 0x8c, 0x2d, 0x14, 0x95, 0xff, 0xb4, 0x3a, 0xb8,
+	0xbf, 0x41, 0xcd, 0x23, 0x67, 0x0b, 0x1c, 0xab, 0xcd, 0x05, 0x6b, 0x9b, 0x0b, 0x0a, 0x69, 0x9a,
+	0x1b, 0xd2, 0x74, 0xa1, 0xc1, 0x43, 0x82, 0x19, 0xf7, 0x09, 0x75, 0x2c, 0xed, 0xe5, 0x59, 0x85,
+	0xd1, 0x23, 0x30, 0x17, 0x44, 0xda, 0x7a, 0x4d, 0x61, 0xe6, 0x82, 0x9c, 0xfc, 0x55, 0x85, 0xaa,
+	0x47, 0x7e, 0x10, 0xcf, 0xdd, 0x09, 0xd4, 0xbe, 0x0d, 0x19, 0xf7, 0x08, 0x43, 0xf7, 0x34, 0x98,
+	0x7a, 0x88, 0x3a, 0x6d, 0x2d, 0xb4, 0x92, 0x6f, 0x1f, 0xec, 0xaf, 0x30, 0xf7, 0x08, 0x42, 0x7a,
+	0x61, 0xd5, 0x6d, 0x47, 0xbf, 0x0c, 0xbd, 0x80, 0x86, 0x44, 0x0a, 0x9d, 0xa2, 0x87, 0x5a, 0x46,
+	0x13, 0x6e, 0x99, 0xf1, 0x19, 0xb4, 0x32, 0x46, 0x2e, 0x3c, 0xf4, 0xb8, 0xc4, 0x5b, 0xd3, 0x63,
+	0x99, 0x7d, 0x01, 0xf7, 0xce, 0xc3, 0x38, 0x28, 0x09, 0x17, 0x3d, 0xd1, 0x9b, 0xd8, 0x2c, 0xb0,
+	0xbd, 0xc7, 0x57, 0xd0, 0x2e, 0x2a, 0x69, 0x52, 0x46, 0xbd, 0x72, 0xb1, 0x4d, 0x95, 0xef, 0xa8,
+	0x37, 0x84, 0x56, 0x51, 0x4f, 0x89, 0x1a, 0x3d, 0x2a, 0x97, 0x2a, 0x49, 0x7d, 0x47, 0x95, 0x67,
+	0x50, 0xcb, 0x84, 0xbe, 0x6d, 0x5b, 0xa5, 0x51, 0x7c, 0x0a, 0xf5, 0xdc, 0x13, 0xc8, 0x29, 0x12,
+	0x6b, 0x36, 0xd9, 0x71, 0xcd, 0x53, 0xb0, 0xbf, 0x0c, 0x02, 0x8f, 0x20, 0xbd, 0x62, 0xe7, 0x6e,
+	0x71, 0x90, 0x3a, 0x47, 0x47, 0x50, 0xcf, 0xcd, 0x73, 0x3b, 0xf0, 0x0b, 0xd8, 0x2f, 0xb9, 0x4c,
+	0xdb, 0xc8, 0x36, 0xf7, 0x6d, 0xf0, 0x3f, 0x87, 0xbd, 0xd7, 0xa9, 0x1f, 0xb3, 0x90, 0x87, 0x49,
+	0xec, 0x91, 0x77, 0xa5, 0x0f, 0xa0, 0x3e, 0xc4, 0x11, 0xe6, 0x78, 0x87, 0x62, 0xd7, 0xf1, 0x27,
+	0x60, 0x4b, 0xff, 0xa2, 0x07, 0x45, 0x42, 0xf7, 0x73, 0xe7, 0x40, 0xab, 0x21, 0x0d, 0xfc, 0xc2,
+	0x38, 0x6d, 0x5f, 0xff, 0xd7, 0xbd, 0xf3, 0xc7, 0x4d, 0xd7, 0xb8, 0xbe, 0xe9, 0x1a, 0x7f, 0xdf,
+	0x74, 0x8d, 0x7f, 0x6f, 0xba, 0xc6, 0xff, 0x03, 0x00, 0x9c, 0xf4, 0x62, 0xc1, 0xe2, 0x09, 0x00,
+	0x00,
+}
@@ -0,0 +1,153 @@
+package leader
+
+import (
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/db"
+	"github.com/tedsuo/ifrit"
+)
+
+// Runner runs a background member only while this server holds the named
+// lock, so that a job runs on a single server when several of them share the
+// database.
+//
+// The runner is ready straight away. It tries to take the lock every
+// retryInterval; once it has it, it starts the member and renews the lease
+// at the same interval. If another owner took the lock over, or the lease
+// cannot be renewed before it expires, the member is stopped and the runner
+// goes back to waiting for the lock.
+type Runner struct {
+	logger        lager.Logger
+	db            db.LockDB
+	clock         clock.Clock
+	name          string
+	owner         string
+	ttl           time.Duration
+	retryInterval time.Duration
+	member        ifrit.Runner
+}
+
+func NewRunner(
+	logger lager.Logger,
+	db db.LockDB,
+	clock clock.Clock,
+	name string,
+	owner string,
+	ttl time.Duration,
+	retryInterval time.Duration,
+	member ifrit.Runner,
+) *Runner {
+	return &Runner{
+		logger:        logger,
+		db:            db,
+		clock:         clock,
+		name:          name,
+		owner:         owner,
+		ttl:           ttl,
+		retryInterval: retryInterval,
+		member:        member,
+	}
+}
+
+func (r *Runner) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	logger := r.logger.Session("leader", lager.Data{"lock": r.name, "owner": r.owner})
+	logger.Info("starting", lager.Data{"ttl": r.ttl.String(), "retry-interval": r.retryInterval.String()})
+	defer logger.Info("exited")
+
+	ticker := r.clock.NewTicker(r.retryInterval)
+	defer ticker.Stop()
+
+	close(ready)
+
+	var process ifrit.Process
+	var leaseExpiresAt time.Time
+
+	for {
+		if process == nil {
+			acquiredAt := r.clock.Now()
+			if r.acquire(logger) {
+				logger.Info("acquired-lock")
+				leaseExpiresAt = acquiredAt.Add(r.ttl)
+				process = ifrit.Background(r.member)
+			}
+		}
+
+		var exited <-chan error
+		if process != nil {
+			exited = process.Wait()
+		}
+
+		select {
+		case signal := <-signals:
+			if process != nil {
+				process.Signal(signal)
+				err := <-process.Wait()
+				r.release(logger)
+				return err
+			}
+			return nil
+
+		case err := <-exited:
+			logger.Info("member-exited", lager.Data{"error": errorString(err)})
+			r.release(logger)
+			return err
+
+		case <-ticker.C():
+			if process == nil {
+				continue
+			}
+
+			renewedAt := r.clock.Now()
+			renewed, err := r.db.AcquireLock(logger, r.name, r.owner, r.ttl)
+			if err != nil {
+				logger.Error("failed-renewing-lock", err)
+				if !r.expiring(renewedAt, leaseExpiresAt) {
+					continue
+				}
+			} else if renewed {
+				leaseExpiresAt = renewedAt.Add(r.ttl)
+				continue
+			}
+
+			logger.Info("lost-lock")
+			process.Signal(os.Interrupt)
+			err = <-process.Wait()
+			if err != nil {
+				logger.Error("member-failed-stopping", err)
+			}
+			process = nil
+		}
+	}
+}
+
+func (r *Runner) acquire(logger lager.Logger) bool {
+	acquired, err := r.db.AcquireLock(logger, r.name, r.owner, r.ttl)
+	if err != nil {
+		logger.Error("failed-acquiring-lock", err)
+		return false
+	}
+	return acquired
+}
+
+// expiring reports whether the lease runs out before the next renewal, in
+// which case another server may take the lock over at any moment.
+func (r *Runner) expiring(now, leaseExpiresAt time.Time) bool {
+	return !now.Add(r.retryInterval).Before(leaseExpiresAt)
+}
+
+func (r *Runner) release(logger lager.Logger) {
+	err := r.db.ReleaseLock(logger, r.name, r.owner)
+	if err != nil {
+		logger.Error("failed-releasing-lock", err)
+	}
+}
+
+func errorString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
@@ -0,0 +1,181 @@
+package leader_test
+
+import (
+	"errors"
+	"os"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jianqiu/vps/db/dbfakes"
+	"github.com/jianqiu/vps/leader"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/tedsuo/ifrit"
+)
+
+var _ = Describe("Runner", func() {
+	var (
+		logger     *lagertest.TestLogger
+		fakeLockDB *dbfakes.FakeLockDB
+		running    int32
+		starts     int32
+		memberErr  error
+		member     ifrit.Runner
+		process    ifrit.Process
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		fakeLockDB = new(dbfakes.FakeLockDB)
+		atomic.StoreInt32(&running, 0)
+		atomic.StoreInt32(&starts, 0)
+		memberErr = nil
+
+		member = ifrit.RunFunc(func(signals <-chan os.Signal, ready chan<- struct{}) error {
+			atomic.AddInt32(&starts, 1)
+			atomic.StoreInt32(&running, 1)
+			defer atomic.StoreInt32(&running, 0)
+			close(ready)
+			<-signals
+			return memberErr
+		})
+	})
+
+	isRunning := func() bool {
+		return atomic.LoadInt32(&running) == 1
+	}
+
+	JustBeforeEach(func() {
+		runner := leader.NewRunner(logger, fakeLockDB, clock.NewClock(), "reaper", "server-1", 100*time.Millisecond, 20*time.Millisecond, member)
+		process = ifrit.Invoke(runner)
+	})
+
+	AfterEach(func() {
+		process.Signal(os.Interrupt)
+		Eventually(process.Wait()).Should(Receive())
+	})
+
+	Context("when the lock is free", func() {
+		BeforeEach(func() {
+			fakeLockDB.AcquireLockReturns(true, nil)
+		})
+
+		It("starts the member", func() {
+			Eventually(isRunning).Should(BeTrue())
+
+			_, name, owner, ttl := fakeLockDB.AcquireLockArgsForCall(0)
+			Expect(name).To(Equal("reaper"))
+			Expect(owner).To(Equal("server-1"))
+			Expect(ttl).To(Equal(100 * time.Millisecond))
+		})
+
+		It("keeps renewing the lease", func() {
+			Eventually(fakeLockDB.AcquireLockCallCount).Should(BeNumerically(">=", 3))
+			Consistently(isRunning, 200*time.Millisecond).Should(BeTrue())
+		})
+
+		Context("when signalled", func() {
+			BeforeEach(func() {
+				memberErr = errors.New("stopped")
+			})
+
+			It("stops the member and releases the lock", func() {
+				Eventually(isRunning).Should(BeTrue())
+
+				process.Signal(os.Interrupt)
+				Eventually(process.Wait()).Should(Receive(MatchError("stopped")))
+				Expect(isRunning()).To(BeFalse())
+
+				Expect(fakeLockDB.ReleaseLockCallCount()).To(Equal(1))
+				_, name, owner := fakeLockDB.ReleaseLockArgsForCall(0)
+				Expect(name).To(Equal("reaper"))
+				Expect(owner).To(Equal("server-1"))
+			})
+		})
+
+		Context("when another server takes the lock over", func() {
+			BeforeEach(func() {
+				var calls int32
+				fakeLockDB.AcquireLockStub = func(lager.Logger, string, string, time.Duration) (bool, error) {
+					return atomic.AddInt32(&calls, 1) == 1, nil
+				}
+			})
+
+			It("stops the member and waits for the lock again", func() {
+				Eventually(isRunning).Should(BeTrue())
+				Eventually(isRunning).Should(BeFalse())
+				Eventually(fakeLockDB.AcquireLockCallCount).Should(BeNumerically(">=", 4))
+				Expect(atomic.LoadInt32(&starts)).To(Equal(int32(1)))
+			})
+		})
+
+		Context("when renewing fails", func() {
+			BeforeEach(func() {
+				var calls int32
+				fakeLockDB.AcquireLockStub = func(lager.Logger, string, string, time.Duration) (bool, error) {
+					if atomic.AddInt32(&calls, 1) == 1 {
+						return true, nil
+					}
+					return false, errors.New("connection lost")
+				}
+			})
+
+			It("keeps the member running until the lease is about to expire", func() {
+				Eventually(isRunning).Should(BeTrue())
+				Consistently(isRunning, 40*time.Millisecond).Should(BeTrue())
+				Eventually(isRunning, 150*time.Millisecond).Should(BeFalse())
+			})
+		})
+	})
+
+	Context("when another server holds the lock", func() {
+		BeforeEach(func() {
+			fakeLockDB.AcquireLockReturns(false, nil)
+		})
+
+		It("is ready without starting the member", func() {
+			Eventually(process.Ready()).Should(BeClosed())
+			Eventually(fakeLockDB.AcquireLockCallCount).Should(BeNumerically(">=", 3))
+			Expect(isRunning()).To(BeFalse())
+		})
+
+		Context("and releases it", func() {
+			BeforeEach(func() {
+				var calls int32
+				fakeLockDB.AcquireLockStub = func(lager.Logger, string, string, time.Duration) (bool, error) {
+					return atomic.AddInt32(&calls, 1) > 2, nil
+				}
+			})
+
+			It("starts the member", func() {
+				Eventually(isRunning).Should(BeTrue())
+			})
+		})
+
+		It("does not release the lock when signalled", func() {
+			process.Signal(os.Interrupt)
+			Eventually(process.Wait()).Should(Receive(BeNil()))
+			Expect(fakeLockDB.ReleaseLockCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the member exits on its own", func() {
+		BeforeEach(func() {
+			fakeLockDB.AcquireLockReturns(true, nil)
+			member = ifrit.RunFunc(func(signals <-chan os.Signal, ready chan<- struct{}) error {
+				close(ready)
+				return errors.New("crashed")
+			})
+		})
+
+		It("releases the lock and exits with its error", func() {
+			Eventually(process.Wait()).Should(Receive(MatchError("crashed")))
+			Expect(fakeLockDB.ReleaseLockCallCount()).To(Equal(1))
+		})
+	})
+})
@@ -2,11 +2,15 @@ package migration
 
 import (
 	"database/sql"
+	"fmt"
 	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/jianqiu/vps/db"
 	"github.com/jianqiu/vps/db/sqldb"
+	"github.com/jianqiu/vps/models"
 	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/runtimeschema/metric"
@@ -72,23 +76,47 @@ readyChan chan<- struct{},
 		"init":   "virtual_guest_db",
 	})
 
-        if checkTables(logger, m.rawSQLDB) {
-	       err := createTables(logger, m.rawSQLDB, m.databaseDriver)
-	       if err != nil {
-		       errorChan <- err
-		       return
-	       }
+	version, err := m.sqlDB.SchemaVersion(logger)
+	if err != nil {
+		errorChan <- err
+		return
+	}
+
+	existing := map[string]bool{}
+	for _, table := range tables {
+		if tableExists(logger, m.rawSQLDB, m.databaseDriver, table.name) {
+			existing[table.name] = true
+			continue
+		}
+
+		err := createTable(logger, m.rawSQLDB, m.databaseDriver, table)
+		if err != nil {
+			errorChan <- err
+			return
+		}
+	}
 
-	       err = createIndices(logger, m.rawSQLDB)
-	       if err != nil {
-		       errorChan <- err
-		       return
-	       }
-        }
+	for _, change := range changes {
+		if change.version <= version || !existing[change.table] {
+			continue
+		}
+
+		err := applyChange(logger, m.rawSQLDB, m.databaseDriver, change)
+		if err != nil {
+			errorChan <- err
+			return
+		}
+	}
+
+	err = m.sqlDB.SetSchemaVersion(logger, SchemaVersion)
+	if err != nil {
+		errorChan <- err
+		return
+	}
 
 	logger.Debug("migrations-finished")
 
-	err := migrationDuration.Send(time.Since(migrateStart))
+	err = migrationDuration.Send(time.Since(migrateStart))
 	if err != nil {
 		logger.Error("failed-to-send-migration-duration-metric", err)
 	}
@@ -103,54 +131,130 @@ func (m *Manager) finish(logger lager.Logger, ready chan<- struct{}) {
 }
 
 
-func checkTables(logger lager.Logger, db *sql.DB) bool {
-	var value int
-	db.QueryRow("SELECT 1 FROM information_schema.tables WHERE table_name = 'virtual_guests' LIMIT 1").Scan(&value)
-	if value == 0 {
-		// check whether the table exists before truncating
-		return true
-	}
+// SchemaVersion is recorded in the configurations table once the migrations
+// finished. Bump it with every change to the tables below.
+const SchemaVersion int32 = 17
+
+// tableDefinition is a table the server needs along with its indices, and
+// any rows it starts out with. Tables are created, in order, the first time
+// the server finds them missing.
+type tableDefinition struct {
+	name      string
+	createSQL string
+	indices   []string
+}
+
+var tables = []tableDefinition{
+	{poolsTable, createPoolsSQL, createPoolsStatements},
+	{virtualGuestsTable, createVirtualGuestsSQL, createVirtualGuestsIndices},
+	{flavorsTable, createFlavorsSQL, nil},
+	{reservationsTable, createReservationsSQL, createReservationsIndices},
+	{webhooksTable, createWebhooksSQL, nil},
+	{outboxEventsTable, createOutboxEventsSQL, createOutboxEventsIndices},
+	{webhookDeliveriesTable, createWebhookDeliveriesSQL, createWebhookDeliveriesIndices},
+	{locksTable, createLocksSQL, nil},
+	{vmSecretsTable, createVMSecretsSQL, createVMSecretsIndices},
+	{vmUserDataTable, createVMUserDataSQL, nil},
+	{pendingOrdersTable, createPendingOrdersSQL, nil},
+	{vmSecretTokensTable, createVMSecretTokensSQL, nil},
+}
+
+// tableChange alters a table that was created by an older schema version.
+// Tables created from scratch already come with every change, so a change
+// only runs on tables that existed before, and only once: when the recorded
+// schema version is older than the change. Statements whose syntax differs
+// between the databases go in byFlavor and run after the others. A check
+// runs first and stops the migration, leaving the table as it was, when the
+// rows are not fit for the change.
+type tableChange struct {
+	version    int32
+	table      string
+	statements []string
+	byFlavor   map[string][]string
+	check      func(logger lager.Logger, db *sql.DB) error
+}
 
-	return false
+var changes = []tableChange{
+	{6, virtualGuestsTable, []string{createVirtualGuestsIPIndex, createVirtualGuestsHostnameIndex}, nil, checkUniqueVirtualGuests},
+	{7, virtualGuestsTable, []string{createVirtualGuestsOrderIndex}, nil, nil},
+	{8, virtualGuestsTable, []string{addVirtualGuestsPoolColumn, createVirtualGuestsPoolIndex}, nil, nil},
+	{11, reservationsTable, []string{addReservationsStartsAtColumn, addReservationsStatusColumn}, nil, nil},
+	{15, virtualGuestsTable, []string{addVirtualGuestsReservationIDColumn, fillVirtualGuestsReservationID, createVirtualGuestsReservationIndex}, nil, nil},
+	{15, reservationsTable, nil, replaceReservationsDeploymentIndex, nil},
+	{17, outboxEventsTable, []string{addOutboxEventsDispatchedAtColumn, createOutboxEventsDispatchedIndex}, nil, nil},
 }
 
-func createTables(logger lager.Logger, db *sql.DB, flavor string) error {
-	var createTablesSQL = []string{
-		sqldb.RebindForFlavor(createVirtualGuestsSQL, flavor),
+func tableExists(logger lager.Logger, db *sql.DB, flavor, table string) bool {
+	var value int
+	query := sqldb.RebindForFlavor("SELECT 1 FROM information_schema.tables WHERE table_name = ? LIMIT 1", flavor)
+	db.QueryRow(query, table).Scan(&value)
+	return value != 0
+}
+
+func createTable(logger lager.Logger, db *sql.DB, flavor string, table tableDefinition) error {
+	logger = logger.Session("create-table", lager.Data{"table": table.name})
+
+	query := sqldb.RebindForFlavor(table.createSQL, flavor)
+	logger.Info("creating the table", lager.Data{"query": query})
+	_, err := db.Exec(query)
+	if err != nil {
+		logger.Error("failed-creating-table", err)
+		return err
 	}
 
-	logger.Info("creating-tables")
-	for _, query := range createTablesSQL {
-		logger.Info("creating the table", lager.Data{"query": query})
+	for _, query := range table.indices {
+		logger.Info("creating the index", lager.Data{"query": query})
 		_, err := db.Exec(query)
 		if err != nil {
-			logger.Error("failed-creating-tables", err)
+			logger.Error("failed-creating-index", err)
 			return err
 		}
-		logger.Info("created the table", lager.Data{"query": query})
 	}
 
+	logger.Info("created the table")
 	return nil
 }
 
-func createIndices(logger lager.Logger, db *sql.DB) error {
-	logger.Info("creating-indices")
-	createIndicesSQL := []string{}
-	createIndicesSQL = append(createIndicesSQL, createVirtualGuestsIndices...)
+func applyChange(logger lager.Logger, db *sql.DB, flavor string, change tableChange) error {
+	logger = logger.Session("change-table", lager.Data{"table": change.table, "version": change.version})
 
-	for _, query := range createIndicesSQL {
-		logger.Info("creating the index", lager.Data{"query": query})
+	if change.check != nil {
+		err := change.check(logger, db)
+		if err != nil {
+			logger.Error("failed-checking-table", err)
+			return err
+		}
+	}
+
+	for _, statement := range append(change.statements, change.byFlavor[flavor]...) {
+		query := sqldb.RebindForFlavor(statement, flavor)
+		logger.Info("changing the table", lager.Data{"query": query})
 		_, err := db.Exec(query)
 		if err != nil {
-			logger.Error("failed-creating-index", err)
+			logger.Error("failed-changing-table", err)
 			return err
 		}
-		logger.Info("created the index", lager.Data{"query": query})
 	}
 
+	logger.Info("changed the table")
 	return nil
 }
 
+const (
+	poolsTable             = "pools"
+	virtualGuestsTable     = "virtual_guests"
+	flavorsTable           = "flavors"
+	reservationsTable      = "reservations"
+	webhooksTable          = "webhooks"
+	outboxEventsTable      = "outbox_events"
+	webhookDeliveriesTable = "webhook_deliveries"
+	locksTable             = "locks"
+	vmSecretsTable         = "vm_secrets"
+	vmUserDataTable        = "vm_user_data"
+	pendingOrdersTable     = "pending_orders"
+	vmSecretTokensTable    = "vm_secret_tokens"
+)
+
 const createVirtualGuestsSQL = `CREATE TABLE virtual_guests(
 	cid INT PRIMARY KEY,
 	hostname VARCHAR(255) NOT NULL,
@@ -162,13 +266,278 @@ const createVirtualGuestsSQL = `CREATE TABLE virtual_guests(
 	deployment_name VARCHAR(255) NOT NULL DEFAULT '',
 	state VARCHAR(255) NOT NULL,
 	updated_at BIGINT DEFAULT 0,
-	created_at BIGINT DEFAULT 0
+	created_at BIGINT DEFAULT 0,
+	pool VARCHAR(255) NOT NULL DEFAULT 'default',
+	reservation_id VARCHAR(255) NOT NULL DEFAULT ''
 );`
 
+// VMs from before pools existed belong to the default pool.
+const addVirtualGuestsPoolColumn = `ALTER TABLE virtual_guests ADD COLUMN pool VARCHAR(255) NOT NULL DEFAULT 'default'`
+
+// A reserved VM is held by the reservation with its reservation_id; in any
+// other state, the column means nothing. The VMs reserved before it existed
+// go to the one reservation their deployment had in their pool.
+const (
+	addVirtualGuestsReservationIDColumn = `ALTER TABLE virtual_guests ADD COLUMN reservation_id VARCHAR(255) NOT NULL DEFAULT ''`
+	fillVirtualGuestsReservationID      = `UPDATE virtual_guests SET reservation_id = COALESCE((
+	SELECT reservations.id FROM reservations
+	WHERE reservations.pool = virtual_guests.pool AND reservations.deployment_name = virtual_guests.deployment_name
+), '') WHERE state = 'reserved'`
+	createVirtualGuestsReservationIndex = `CREATE INDEX virtual_guests_reservation_idx ON virtual_guests (reservation_id)`
+)
+
+// IPs and hostnames identify a VM just like its cid, operators look VMs up
+// by either of them. Orders look for free VMs of a given size and vlans, in
+// cid order.
+const (
+	createVirtualGuestsIPIndex       = `CREATE UNIQUE INDEX virtual_guests_ip_idx ON virtual_guests (ip)`
+	createVirtualGuestsHostnameIndex = `CREATE UNIQUE INDEX virtual_guests_hostname_idx ON virtual_guests (hostname)`
+	createVirtualGuestsOrderIndex    = `CREATE INDEX virtual_guests_order_idx ON virtual_guests (state, cpu, memory_mb, public_vlan, private_vlan, cid)`
+	createVirtualGuestsPoolIndex     = `CREATE INDEX virtual_guests_pool_idx ON virtual_guests (pool)`
+)
+
+// checkUniqueVirtualGuests refuses to add the unique indices on the ips and
+// hostnames of the VMs while some of them share one, listing the VMs that
+// do: which of them to keep is up to the operator.
+func checkUniqueVirtualGuests(logger lager.Logger, db *sql.DB) error {
+	conflicts := []models.ErrInvalidField{}
+	for _, column := range []string{"ip", "hostname"} {
+		duplicates, err := findDuplicates(logger, db, column)
+		if err != nil {
+			return err
+		}
+		conflicts = append(conflicts, duplicateFields(column, duplicates)...)
+	}
+	if len(conflicts) == 0 {
+		return nil
+	}
+
+	err := models.NewResourceConflictError(conflicts...)
+	err.Message = "vms share ips or hostnames, which must be unique; delete or change all but one vm of each before starting again: " + err.Message
+	return err
+}
+
+// duplicate is a value of a column that more than one VM has.
+type duplicate struct {
+	value string
+	cids  []int32
+}
+
+// findDuplicates returns, in order, the values of column that more than one
+// VM has, along with those VMs.
+func findDuplicates(logger lager.Logger, db *sql.DB, column string) ([]duplicate, error) {
+	query := fmt.Sprintf(`SELECT %[1]s, cid FROM virtual_guests WHERE %[1]s IN (
+	SELECT %[1]s FROM virtual_guests GROUP BY %[1]s HAVING COUNT(*) > 1
+) ORDER BY %[1]s, cid`, column)
+
+	rows, err := db.Query(query)
+	if err != nil {
+		logger.Error("failed-finding-duplicates", err, lager.Data{"column": column})
+		return nil, err
+	}
+	defer rows.Close()
+
+	duplicates := []duplicate{}
+	for rows.Next() {
+		var value string
+		var cid int32
+		err := rows.Scan(&value, &cid)
+		if err != nil {
+			logger.Error("failed-scanning-row", err)
+			return nil, err
+		}
+
+		last := len(duplicates) - 1
+		if last < 0 || duplicates[last].value != value {
+			duplicates = append(duplicates, duplicate{value: value})
+			last++
+		}
+		duplicates[last].cids = append(duplicates[last].cids, cid)
+	}
+
+	return duplicates, rows.Err()
+}
+
+func duplicateFields(column string, duplicates []duplicate) []models.ErrInvalidField {
+	fields := []models.ErrInvalidField{}
+	for _, d := range duplicates {
+		cids := make([]string, 0, len(d.cids))
+		for _, cid := range d.cids {
+			cids = append(cids, strconv.Itoa(int(cid)))
+		}
+		fields = append(fields, models.ErrInvalidField{
+			Field:   column,
+			Message: fmt.Sprintf("%q is shared by the vms with cids %s", d.value, strings.Join(cids, ", ")),
+		})
+	}
+	return fields
+}
+
 var createVirtualGuestsIndices = []string{
 	`CREATE INDEX virtual_guests_cid_idx ON virtual_guests (cid)`,
 	`CREATE INDEX virtual_guests_state_idx ON virtual_guests (state)`,
+	createVirtualGuestsIPIndex,
+	createVirtualGuestsHostnameIndex,
+	createVirtualGuestsOrderIndex,
+	createVirtualGuestsPoolIndex,
+	createVirtualGuestsReservationIndex,
+}
+
+const createPoolsSQL = `CREATE TABLE pools(
+	name VARCHAR(255) PRIMARY KEY,
+	description VARCHAR(1024) NOT NULL DEFAULT '',
+	username VARCHAR(255) NOT NULL DEFAULT '',
+	password_hash VARCHAR(255) NOT NULL DEFAULT '',
+	created_at BIGINT DEFAULT 0
+);`
+
+// The default pool is served by the /vms routes and cannot be deleted.
+var createPoolsStatements = []string{
+	`INSERT INTO pools (name, description) VALUES ('default', 'the pool of the /vms routes')`,
+}
+
+// Labels are kept as a JSON object.
+const createFlavorsSQL = `CREATE TABLE flavors(
+	name VARCHAR(255) PRIMARY KEY,
+	cpu INT NOT NULL,
+	memory_mb INT NOT NULL,
+	public_vlan INT NOT NULL DEFAULT 0,
+	private_vlan INT NOT NULL DEFAULT 0,
+	labels MEDIUMTEXT NOT NULL,
+	created_at BIGINT DEFAULT 0
+);`
+
+// The filter is kept as a JSON VmFilter to pick the VMs of a scheduled
+// reservation when it starts. From then on, the VMs of a reservation are the
+// reserved VMs with its id.
+const createReservationsSQL = `CREATE TABLE reservations(
+	id VARCHAR(255) PRIMARY KEY,
+	deployment_name VARCHAR(255) NOT NULL,
+	pool VARCHAR(255) NOT NULL,
+	count INT NOT NULL,
+	filter MEDIUMTEXT NOT NULL,
+	starts_at BIGINT NOT NULL DEFAULT 0,
+	expires_at BIGINT NOT NULL DEFAULT 0,
+	status VARCHAR(255) NOT NULL DEFAULT 'active',
+	created_at BIGINT DEFAULT 0
+);`
+
+// Reservations from before they could be scheduled started when they were
+// made.
+const addReservationsStartsAtColumn = `ALTER TABLE reservations ADD COLUMN starts_at BIGINT NOT NULL DEFAULT 0`
+const addReservationsStatusColumn = `ALTER TABLE reservations ADD COLUMN status VARCHAR(255) NOT NULL DEFAULT 'active'`
+
+// A deployment may hold several reservations of a pool as long as they do
+// not overlap, which is checked with the pool locked rather than by an
+// index.
+var createReservationsIndices = []string{
+	`CREATE INDEX reservations_deployment_idx ON reservations (pool, deployment_name)`,
+}
+
+// The unique index of the deployments from when they held one reservation
+// per pool is replaced by a plain one.
+var replaceReservationsDeploymentIndex = map[string][]string{
+	sqldb.MySQL: {
+		`DROP INDEX reservations_deployment_idx ON reservations`,
+		`CREATE INDEX reservations_deployment_idx ON reservations (pool, deployment_name)`,
+	},
+	sqldb.Postgres: {
+		`DROP INDEX reservations_deployment_idx`,
+		`CREATE INDEX reservations_deployment_idx ON reservations (pool, deployment_name)`,
+	},
+}
+
+const createWebhooksSQL = `CREATE TABLE webhooks(
+	id VARCHAR(255) PRIMARY KEY,
+	url VARCHAR(2048) NOT NULL,
+	secret VARCHAR(255) NOT NULL,
+	events VARCHAR(1024) NOT NULL DEFAULT '',
+	created_at BIGINT DEFAULT 0
+);`
+
+const createOutboxEventsSQL = `CREATE TABLE outbox_events(
+	id VARCHAR(255) PRIMARY KEY,
+	event_type VARCHAR(255) NOT NULL,
+	payload MEDIUMTEXT NOT NULL,
+	created_at BIGINT DEFAULT 0,
+	dispatched_at BIGINT NOT NULL DEFAULT 0
+);`
+
+const (
+	addOutboxEventsDispatchedAtColumn = `ALTER TABLE outbox_events ADD COLUMN dispatched_at BIGINT NOT NULL DEFAULT 0`
+	createOutboxEventsDispatchedIndex = `CREATE INDEX outbox_events_dispatched_idx ON outbox_events (dispatched_at, created_at)`
+)
+
+var createOutboxEventsIndices = []string{
+	`CREATE INDEX outbox_events_created_at_idx ON outbox_events (created_at)`,
+	createOutboxEventsDispatchedIndex,
 }
 
+const createWebhookDeliveriesSQL = `CREATE TABLE webhook_deliveries(
+	id VARCHAR(255) PRIMARY KEY,
+	webhook_id VARCHAR(255) NOT NULL,
+	event_id VARCHAR(255) NOT NULL,
+	event_type VARCHAR(255) NOT NULL,
+	url VARCHAR(2048) NOT NULL,
+	payload MEDIUMTEXT NOT NULL,
+	state VARCHAR(255) NOT NULL,
+	attempts INT NOT NULL DEFAULT 0,
+	last_error MEDIUMTEXT,
+	next_attempt_at BIGINT DEFAULT 0,
+	created_at BIGINT DEFAULT 0,
+	updated_at BIGINT DEFAULT 0
+);`
+
+var createWebhookDeliveriesIndices = []string{
+	`CREATE INDEX webhook_deliveries_state_idx ON webhook_deliveries (state, next_attempt_at)`,
+	`CREATE INDEX webhook_deliveries_webhook_id_idx ON webhook_deliveries (webhook_id)`,
+}
 
+const createLocksSQL = `CREATE TABLE locks(
+	name VARCHAR(255) PRIMARY KEY,
+	owner VARCHAR(255) NOT NULL,
+	expires_at BIGINT NOT NULL DEFAULT 0
+);`
 
+// The nonce and the cipher text of a secret are base64 encoded. The label
+// names the key it was encrypted with, to find the secrets that are still
+// encrypted with an older key.
+const createVMSecretsSQL = `CREATE TABLE vm_secrets(
+	cid INT PRIMARY KEY,
+	key_label VARCHAR(127) NOT NULL,
+	nonce VARCHAR(255) NOT NULL,
+	cipher_text MEDIUMTEXT NOT NULL,
+	updated_at BIGINT DEFAULT 0
+);`
+
+var createVMSecretsIndices = []string{
+	`CREATE INDEX vm_secrets_key_label_idx ON vm_secrets (key_label)`,
+}
+
+// The agent settings of the VMs that were ordered with them, until they are
+// free again.
+const createVMUserDataSQL = `CREATE TABLE vm_user_data(
+	cid INT PRIMARY KEY,
+	user_data MEDIUMTEXT NOT NULL,
+	updated_at BIGINT DEFAULT 0
+);`
+
+// The hash of the token the order of a VM returned, which its deployment
+// reads the secret of the VM with, until the VM is free again.
+const createVMSecretTokensSQL = `CREATE TABLE vm_secret_tokens(
+	cid INT PRIMARY KEY,
+	token_hash VARCHAR(64) NOT NULL,
+	updated_at BIGINT DEFAULT 0
+);`
+
+// The VMs the replenisher ordered and has not added to the pool yet, so
+// that the next leader picks them up instead of ordering them again.
+const createPendingOrdersSQL = `CREATE TABLE pending_orders(
+	cid INT PRIMARY KEY,
+	pool VARCHAR(255) NOT NULL,
+	cpu INT NOT NULL,
+	memory_mb INT NOT NULL,
+	public_vlan INT NOT NULL DEFAULT 0,
+	private_vlan INT NOT NULL DEFAULT 0,
+	ordered_at BIGINT NOT NULL DEFAULT 0
+);`
@@ -0,0 +1,95 @@
+package migration_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jianqiu/vps/db/dbfakes"
+	"github.com/jianqiu/vps/db/sqldb"
+	"github.com/jianqiu/vps/db/sqldb/sqltest"
+	"github.com/jianqiu/vps/migration"
+	"github.com/jianqiu/vps/models"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/tedsuo/ifrit"
+)
+
+var _ = Describe("Manager", func() {
+	var (
+		logger         *lagertest.TestLogger
+		connector      *sqltest.RecordingConnector
+		fakeDB         *dbfakes.FakeDB
+		migrationsDone chan struct{}
+		process        ifrit.Process
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		connector = sqltest.NewRecordingConnector()
+		fakeDB = new(dbfakes.FakeDB)
+		migrationsDone = make(chan struct{})
+
+		// every table exists, as of the schema before the unique indices
+		connector.SetRows("information_schema", []driver.Value{int64(1)})
+		fakeDB.SchemaVersionReturns(5, nil)
+	})
+
+	JustBeforeEach(func() {
+		manager := migration.NewManager(logger, fakeDB, sql.OpenDB(connector), migrationsDone, clock.NewClock(), sqldb.MySQL)
+		process = ifrit.Background(manager)
+	})
+
+	Context("when the vms have distinct ips and hostnames", func() {
+		It("adds the unique indices and records the schema version", func() {
+			Eventually(migrationsDone).Should(BeClosed())
+			Expect(connector.Statements("CREATE UNIQUE INDEX virtual_guests_ip_idx")).To(HaveLen(1))
+			Expect(connector.Statements("CREATE UNIQUE INDEX virtual_guests_hostname_idx")).To(HaveLen(1))
+
+			Expect(fakeDB.SetSchemaVersionCallCount()).To(Equal(1))
+			_, version := fakeDB.SetSchemaVersionArgsForCall(0)
+			Expect(version).To(Equal(migration.SchemaVersion))
+		})
+	})
+
+	Context("when vms share ips or hostnames", func() {
+		BeforeEach(func() {
+			connector.SetRows("SELECT ip, cid",
+				[]driver.Value{"10.0.0.1", int64(1)},
+				[]driver.Value{"10.0.0.1", int64(2)},
+				[]driver.Value{"10.0.0.1", int64(3)},
+			)
+			connector.SetRows("SELECT hostname, cid",
+				[]driver.Value{"vm-a", int64(4)},
+				[]driver.Value{"vm-a", int64(5)},
+				[]driver.Value{"vm-b", int64(6)},
+				[]driver.Value{"vm-b", int64(7)},
+			)
+		})
+
+		It("fails listing the vms that share them", func() {
+			var err error
+			Eventually(process.Wait()).Should(Receive(&err))
+
+			modelErr := models.ConvertError(err)
+			Expect(modelErr.Type).To(Equal(models.ErrorTypeResourceConflict))
+			Expect(modelErr.Message).To(ContainSubstring("must be unique"))
+			Expect(modelErr.Fields).To(ConsistOf(
+				&models.FieldError{Field: "ip", Message: `"10.0.0.1" is shared by the vms with cids 1, 2, 3`},
+				&models.FieldError{Field: "hostname", Message: `"vm-a" is shared by the vms with cids 4, 5`},
+				&models.FieldError{Field: "hostname", Message: `"vm-b" is shared by the vms with cids 6, 7`},
+			))
+		})
+
+		It("leaves the table and the schema version as they were", func() {
+			Eventually(process.Wait()).Should(Receive())
+			Expect(connector.Statements("CREATE UNIQUE INDEX")).To(BeEmpty())
+			Expect(connector.Statements("ALTER TABLE virtual_guests")).To(BeEmpty())
+			Expect(fakeDB.SetSchemaVersionCallCount()).To(Equal(0))
+			Expect(migrationsDone).NotTo(BeClosed())
+		})
+	})
+})
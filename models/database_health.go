@@ -0,0 +1,40 @@
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+)
+
+// DatabaseHealth database health
+// swagger:model DatabaseHealth
+type DatabaseHealth struct {
+
+	// error
+	Error string `json:"error,omitempty"`
+
+	// flavor
+	Flavor string `json:"flavor,omitempty"`
+
+	// latency ms
+	LatencyMs float64 `json:"latencyMs,omitempty"`
+
+	// reachable
+	Reachable bool `json:"reachable,omitempty"`
+
+	// schema version
+	SchemaVersion int32 `json:"schemaVersion,omitempty"`
+}
+
+// Validate validates this database health
+func (m *DatabaseHealth) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -14,6 +14,9 @@ import (
 // swagger:model Error
 type Error struct {
 
+	// fields of the request that failed validation
+	Fields []*FieldError `json:"fields,omitempty"`
+
 	// message
 	Message string `json:"message,omitempty"`
 
@@ -25,6 +28,11 @@ type Error struct {
 func (m *Error) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateFields(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
 	if err := m.validateType(formats); err != nil {
 		// prop
 		res = append(res, err)
@@ -36,6 +44,30 @@ func (m *Error) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Error) validateFields(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Fields) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Fields); i++ {
+
+		if swag.IsZero(m.Fields[i]) { // not required
+			continue
+		}
+
+		if m.Fields[i] != nil {
+
+			if err := m.Fields[i].Validate(formats); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *Error) validateType(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Type) { // not required
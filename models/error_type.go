@@ -37,6 +37,7 @@ const (
 	ErrorTypeDeserialize            ErrorType = "Deserialize"
 	ErrorTypeDeadlock               ErrorType = "Deadlock"
 	ErrorTypeUnrecoverable          ErrorType = "Unrecoverable"
+	ErrorTypeMaintenance            ErrorType = "Maintenance"
 )
 
 // for schema
@@ -44,7 +45,7 @@ var errorTypeEnum []interface{}
 
 func init() {
 	var res []ErrorType
-	if err := json.Unmarshal([]byte(`["UnknownError","InvalidDomain","UnkownVersion","InvalidRecord","InvalidRequest","InvalidResponse","InvalidProtobufMessage","InvalidJSON","FailedToOpenEnvelope","InvalidStateTransition","Unauthorized","ResourceConflict","ResourceExist","ResourceNotFound","RouterError","SoftLayerAPIError","GUIDGeneration","Deserialize","Deadlock","Unrecoverable"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["UnknownError","InvalidDomain","UnkownVersion","InvalidRecord","InvalidRequest","InvalidResponse","InvalidProtobufMessage","InvalidJSON","FailedToOpenEnvelope","InvalidStateTransition","Unauthorized","ResourceConflict","ResourceExist","ResourceNotFound","RouterError","SoftLayerAPIError","GUIDGeneration","Deserialize","Deadlock","Unrecoverable","Maintenance"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
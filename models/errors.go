@@ -2,6 +2,7 @@ package models
 
 import (
 	"fmt"
+	"strings"
 )
 
 func NewError(errType ErrorType, msg string) *Error {
@@ -65,6 +66,11 @@ var (
 		Message: "the request failed due to deadlock",
 	}
 
+	ErrUnauthorized = &Error{
+		Type:    ErrorTypeUnauthorized,
+		Message: "the credentials do not grant access to the requested resource",
+	}
+
 	ErrBadRequest = &Error{
 		Type:    ErrorTypeInvalidRequest,
 		Message: "the request received is invalid",
@@ -74,14 +80,23 @@ var (
 		Type:    ErrorTypeUnknownError,
 		Message: "the request failed for an unknown reason",
 	}
+
+	ErrMaintenance = &Error{
+		Type:    ErrorTypeMaintenance,
+		Message: "the pool is in maintenance mode and takes no orders",
+	}
 )
 
 type ErrInvalidField struct {
-	Field string
+	Field   string
+	Message string
 }
 
 func (err ErrInvalidField) Error() string {
-	return "Invalid field: " + err.Field
+	if err.Message == "" {
+		return "Invalid field: " + err.Field
+	}
+	return "Invalid field: " + err.Field + ": " + err.Message
 }
 
 type ErrInvalidModification struct {
@@ -105,3 +120,26 @@ func NewUnrecoverableError(err error) *Error {
 		Message: fmt.Sprint("Unrecoverable Error: ", err),
 	}
 }
+
+// NewInvalidRecordError reports every field of a record that failed
+// validation, each of them listed in the error's Fields.
+func NewInvalidRecordError(fields ...ErrInvalidField) *Error {
+	return newFieldsError(ErrorTypeInvalidRecord, fields)
+}
+
+// NewResourceConflictError reports every field of a request that conflicts
+// with the pool, e.g. the requested VMs that are not free.
+func NewResourceConflictError(fields ...ErrInvalidField) *Error {
+	return newFieldsError(ErrorTypeResourceConflict, fields)
+}
+
+func newFieldsError(errType ErrorType, fields []ErrInvalidField) *Error {
+	messages := make([]string, 0, len(fields))
+	modelErr := &Error{Type: errType}
+	for _, field := range fields {
+		messages = append(messages, field.Error())
+		modelErr.Fields = append(modelErr.Fields, &FieldError{Field: field.Field, Message: field.Message})
+	}
+	modelErr.Message = strings.Join(messages, "; ")
+	return modelErr
+}
@@ -0,0 +1,3 @@
+package models
+
+var LifecycleStates = lifecycleStates
@@ -0,0 +1,43 @@
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+)
+
+// Flavor flavor
+// swagger:model Flavor
+type Flavor struct {
+
+	// cpu
+	CPU int32 `json:"cpu,omitempty"`
+
+	// free form labels describing the flavor
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// memory mb
+	MemoryMb int32 `json:"memory_mb,omitempty"`
+
+	// names the flavor in orders and in the /flavors/{name} routes
+	Name string `json:"name,omitempty"`
+
+	// vlan the vms of the flavor are on, 0 for any
+	PrivateVlan int32 `json:"private_vlan,omitempty"`
+
+	// vlan the vms of the flavor are on, 0 for any
+	PublicVlan int32 `json:"public_vlan,omitempty"`
+}
+
+// Validate validates this flavor
+func (m *Flavor) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
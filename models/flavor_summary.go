@@ -0,0 +1,34 @@
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+)
+
+// FlavorSummary flavor summary
+// swagger:model FlavorSummary
+type FlavorSummary struct {
+
+	// name of the flavor, empty for the vms that match no flavor
+	Flavor string `json:"flavor,omitempty"`
+
+	// number of vms in each state
+	States map[string]int32 `json:"states,omitempty"`
+
+	// total
+	Total int32 `json:"total,omitempty"`
+}
+
+// Validate validates this flavor summary
+func (m *FlavorSummary) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
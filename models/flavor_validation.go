@@ -0,0 +1,47 @@
+package models
+
+import "regexp"
+
+var flavorName = regexp.MustCompile(`^[a-z0-9]([a-z0-9.-]{0,61}[a-z0-9])?$`)
+
+// ValidateRecord checks the fields a flavor needs before it is kept in the
+// catalog and returns one ErrInvalidField for each field that is wrong.
+func (m *Flavor) ValidateRecord() []ErrInvalidField {
+	var invalid []ErrInvalidField
+
+	if m.Name == "" {
+		invalid = append(invalid, ErrInvalidField{Field: "name", Message: "is required"})
+	} else if !flavorName.MatchString(m.Name) {
+		invalid = append(invalid, ErrInvalidField{Field: "name", Message: "must be lower case letters, digits, dots and dashes"})
+	}
+	if m.CPU <= 0 {
+		invalid = append(invalid, ErrInvalidField{Field: "cpu", Message: "must be positive"})
+	}
+	if m.MemoryMb <= 0 {
+		invalid = append(invalid, ErrInvalidField{Field: "memory_mb", Message: "must be positive"})
+	}
+	if m.PublicVlan < 0 {
+		invalid = append(invalid, ErrInvalidField{Field: "public_vlan", Message: "cannot be negative"})
+	}
+	if m.PrivateVlan < 0 {
+		invalid = append(invalid, ErrInvalidField{Field: "private_vlan", Message: "cannot be negative"})
+	}
+	for key := range m.Labels {
+		if key == "" {
+			invalid = append(invalid, ErrInvalidField{Field: "labels", Message: "cannot have an empty key"})
+			break
+		}
+	}
+
+	return invalid
+}
+
+// Matches reports whether vm has the sizes of the flavor and sits on its
+// VLANs. A flavor without a VLAN matches VMs on any VLAN, the same way a
+// VMFilter without one does.
+func (m *Flavor) Matches(vm *VM) bool {
+	return vm.CPU == m.CPU &&
+		vm.MemoryMb == m.MemoryMb &&
+		(m.PublicVlan == 0 || vm.PublicVlan == m.PublicVlan) &&
+		(m.PrivateVlan == 0 || vm.PrivateVlan == m.PrivateVlan)
+}
@@ -0,0 +1,58 @@
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/go-openapi/errors"
+)
+
+// FlavorsResponse flavors response
+// swagger:model FlavorsResponse
+type FlavorsResponse struct {
+
+	// flavors
+	Flavors []*Flavor `json:"flavors"`
+}
+
+// Validate validates this flavors response
+func (m *FlavorsResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateFlavors(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *FlavorsResponse) validateFlavors(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Flavors) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Flavors); i++ {
+
+		if swag.IsZero(m.Flavors[i]) { // not required
+			continue
+		}
+
+		if m.Flavors[i] != nil {
+
+			if err := m.Flavors[i].Validate(formats); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
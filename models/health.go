@@ -0,0 +1,74 @@
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/go-openapi/errors"
+)
+
+// Health health
+// swagger:model Health
+type Health struct {
+
+	// database
+	Database *DatabaseHealth `json:"database,omitempty"`
+
+	// migrated
+	Migrated bool `json:"migrated,omitempty"`
+
+	// status
+	Status HealthStatus `json:"status,omitempty"`
+}
+
+// Validate validates this health
+func (m *Health) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDatabase(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Health) validateDatabase(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Database) { // not required
+		return nil
+	}
+
+	if m.Database != nil {
+
+		if err := m.Database.Validate(formats); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Health) validateStatus(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	if err := m.Status.Validate(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
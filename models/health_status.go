@@ -0,0 +1,57 @@
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/validate"
+)
+
+// HealthStatus health status
+// swagger:model HealthStatus
+type HealthStatus string
+
+const (
+	HealthStatusOk          HealthStatus = "ok"
+	HealthStatusUnavailable HealthStatus = "unavailable"
+)
+
+// for schema
+var healthStatusEnum []interface{}
+
+func init() {
+	var res []HealthStatus
+	if err := json.Unmarshal([]byte(`["ok","unavailable"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		healthStatusEnum = append(healthStatusEnum, v)
+	}
+}
+
+func (m HealthStatus) validateHealthStatusEnum(path, location string, value HealthStatus) error {
+	if err := validate.Enum(path, location, value, healthStatusEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this health status
+func (m HealthStatus) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateHealthStatusEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+)
+
+// LogLevel log level
+// swagger:model LogLevel
+type LogLevel struct {
+
+	// one of debug, info, error and fatal
+	Level string `json:"level,omitempty"`
+}
+
+// Validate validates this log level
+func (m *LogLevel) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
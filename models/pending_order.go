@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+// PendingOrder is a VM the replenisher ordered that has not been added to
+// the pool yet, with the pool and the flavor it was ordered for.
+type PendingOrder struct {
+	Cid         int32
+	Pool        string
+	CPU         int32
+	MemoryMb    int32
+	PublicVlan  int32
+	PrivateVlan int32
+	OrderedAt   time.Time
+}
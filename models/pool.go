@@ -0,0 +1,37 @@
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+)
+
+// Pool pool
+// swagger:model Pool
+type Pool struct {
+
+	// description
+	Description string `json:"description,omitempty"`
+
+	// names the pool in the /pools/{pool} routes
+	Name string `json:"name,omitempty"`
+
+	// password of the pool credentials, never returned
+	Password string `json:"password,omitempty"`
+
+	// username of the credentials that grant access to this pool only
+	Username string `json:"username,omitempty"`
+}
+
+// Validate validates this pool
+func (m *Pool) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package models
+
+import "regexp"
+
+// DefaultPool holds the VMs from before pools existed and those added without
+// a pool. The /vms routes work on it, and it cannot be deleted.
+const DefaultPool = "default"
+
+var poolName = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+// ValidateRecord checks the fields a pool needs before it is kept and returns
+// one ErrInvalidField for each field that is wrong. A pool has credentials
+// of its own only when it has both a username and a password.
+func (m *Pool) ValidateRecord() []ErrInvalidField {
+	var invalid []ErrInvalidField
+
+	if m.Name == "" {
+		invalid = append(invalid, ErrInvalidField{Field: "name", Message: "is required"})
+	} else if !poolName.MatchString(m.Name) {
+		invalid = append(invalid, ErrInvalidField{Field: "name", Message: "must be lower case letters, digits and dashes"})
+	}
+	if m.Username == "" && m.Password != "" {
+		invalid = append(invalid, ErrInvalidField{Field: "username", Message: "is required with a password"})
+	}
+	if m.Username != "" && m.Password == "" {
+		invalid = append(invalid, ErrInvalidField{Field: "password", Message: "is required with a username"})
+	}
+
+	return invalid
+}
@@ -0,0 +1,95 @@
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/go-openapi/errors"
+)
+
+// Reservation reservation
+// swagger:model Reservation
+type Reservation struct {
+
+	// the reserved vms no order has claimed yet
+	Cids []int32 `json:"cids"`
+
+	// number of vms to reserve
+	Count int32 `json:"count,omitempty"`
+
+	// the deployment whose orders may claim the reserved vms
+	DeploymentName string `json:"deploymentName,omitempty"`
+
+	// expires at
+	ExpiresAt strfmt.DateTime `json:"expiresAt,omitempty"`
+
+	// filter
+	Filter *VMFilter `json:"filter,omitempty"`
+
+	// id
+	ID string `json:"id,omitempty"`
+
+	// the pool to reserve vms of, the default pool when empty
+	Pool string `json:"pool,omitempty"`
+
+	// when the vms are reserved, right away when empty or past
+	StartsAt strfmt.DateTime `json:"startsAt,omitempty"`
+
+	// status
+	Status ReservationStatus `json:"status,omitempty"`
+
+	// seconds the vms stay reserved, counted from startsAt
+	TTL int32 `json:"ttl,omitempty"`
+}
+
+// Validate validates this reservation
+func (m *Reservation) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateFilter(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Reservation) validateFilter(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Filter) { // not required
+		return nil
+	}
+
+	if m.Filter != nil {
+
+		if err := m.Filter.Validate(formats); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Reservation) validateStatus(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	if err := m.Status.Validate(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/validate"
+)
+
+// ReservationStatus Reservation Status, scheduled until the reservation starts and active while it holds vms, or partial when it started with fewer free vms than its count
+// swagger:model ReservationStatus
+type ReservationStatus string
+
+const (
+	ReservationStatusScheduled ReservationStatus = "scheduled"
+	ReservationStatusActive    ReservationStatus = "active"
+	ReservationStatusPartial   ReservationStatus = "partial"
+)
+
+// for schema
+var reservationStatusEnum []interface{}
+
+func init() {
+	var res []ReservationStatus
+	if err := json.Unmarshal([]byte(`["scheduled","active","partial"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		reservationStatusEnum = append(reservationStatusEnum, v)
+	}
+}
+
+func (m ReservationStatus) validateReservationStatusEnum(path, location string, value ReservationStatus) error {
+	if err := validate.Enum(path, location, value, reservationStatusEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this reservation status
+func (m ReservationStatus) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateReservationStatusEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+package models
+
+// ValidateRecord checks the fields a reservation needs before VMs are
+// reserved for it and returns one ErrInvalidField for each field that is
+// wrong. Whether enough free VMs match is left to the caller.
+func (m *Reservation) ValidateRecord() []ErrInvalidField {
+	var invalid []ErrInvalidField
+
+	if m.DeploymentName == "" {
+		invalid = append(invalid, ErrInvalidField{Field: "deploymentName", Message: "is required"})
+	}
+	if m.Count <= 0 {
+		invalid = append(invalid, ErrInvalidField{Field: "count", Message: "must be positive"})
+	}
+	if m.TTL <= 0 {
+		invalid = append(invalid, ErrInvalidField{Field: "ttl", Message: "must be positive"})
+	}
+	if m.Filter != nil && (len(m.Filter.Cids) > 0 || len(m.Filter.Ips) > 0) {
+		invalid = append(invalid, ErrInvalidField{Field: "filter", Message: "cannot name specific vms"})
+	}
+
+	return invalid
+}
@@ -0,0 +1,58 @@
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/go-openapi/errors"
+)
+
+// ReservationsResponse reservations response
+// swagger:model ReservationsResponse
+type ReservationsResponse struct {
+
+	// reservations
+	Reservations []*Reservation `json:"reservations"`
+}
+
+// Validate validates this reservations response
+func (m *ReservationsResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateReservations(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ReservationsResponse) validateReservations(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Reservations) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Reservations); i++ {
+
+		if swag.IsZero(m.Reservations[i]) { // not required
+			continue
+		}
+
+		if m.Reservations[i] != nil {
+
+			if err := m.Reservations[i].Validate(formats); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
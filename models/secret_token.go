@@ -0,0 +1,13 @@
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// HashSecretToken is how the secret token of a VM is stored: the tokens
+// are random enough that a plain hash keeps them from being guessed.
+func HashSecretToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,37 @@
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+)
+
+// Setting setting
+// swagger:model Setting
+type Setting struct {
+
+	// default
+	Default string `json:"default,omitempty"`
+
+	// description
+	Description string `json:"description,omitempty"`
+
+	// name
+	Name string `json:"name,omitempty"`
+
+	// the value in effect, the default when the setting was never changed
+	Value string `json:"value,omitempty"`
+}
+
+// Validate validates this setting
+func (m *Setting) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
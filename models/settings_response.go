@@ -0,0 +1,58 @@
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/go-openapi/errors"
+)
+
+// SettingsResponse settings response
+// swagger:model SettingsResponse
+type SettingsResponse struct {
+
+	// settings
+	Settings []*Setting `json:"settings"`
+}
+
+// Validate validates this settings response
+func (m *SettingsResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateSettings(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *SettingsResponse) validateSettings(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Settings) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Settings); i++ {
+
+		if swag.IsZero(m.Settings[i]) { // not required
+			continue
+		}
+
+		if m.Settings[i] != nil {
+
+			if err := m.Settings[i].Validate(formats); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
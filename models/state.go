@@ -4,8 +4,6 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
-	"encoding/json"
-
 	strfmt "github.com/go-openapi/strfmt"
 
 	"github.com/go-openapi/errors"
@@ -20,21 +18,15 @@ const (
 	StateFree         State = "free"
 	StateProvisioning State = "provisioning"
 	StateUsing        State = "using"
+	StateMaintenance  State = "maintenance"
+	StateReloading    State = "reloading"
+	StateRetiring     State = "retiring"
+	StateFailed       State = "failed"
 	StateUnknown      State = "unknown"
+	StateReserved     State = "reserved"
 )
 
-// for schema
-var stateEnum []interface{}
-
-func init() {
-	var res []State
-	if err := json.Unmarshal([]byte(`["free","provisioning","using","unknown"]`), &res); err != nil {
-		panic(err)
-	}
-	for _, v := range res {
-		stateEnum = append(stateEnum, v)
-	}
-}
+// for schema: stateEnum is built from the lifecycle, in vm_lifecycle.go
 
 func (m State) validateStateEnum(path, location string, value State) error {
 	if err := validate.Enum(path, location, value, stateEnum); err != nil {
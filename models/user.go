@@ -16,6 +16,9 @@ type User struct {
 	// password
 	Password string `json:"password,omitempty"`
 
+	// the only pool the credentials grant access to, empty for administrators
+	Pool string `json:"pool,omitempty"`
+
 	// username
 	Username string `json:"username,omitempty"`
 }
@@ -38,12 +38,18 @@ type VM struct {
 	// modify date
 	ModifyDate strfmt.DateTime `json:"modifyDate,omitempty"`
 
+	// the pool the vm belongs to
+	Pool string `json:"pool,omitempty"`
+
 	// private vlan
 	PrivateVlan int32 `json:"private_vlan,omitempty"`
 
 	// public vlan
 	PublicVlan int32 `json:"public_vlan,omitempty"`
 
+	// proves the order the vm was provisioned by when reading its secret; only returned by that order
+	SecretToken string `json:"secretToken,omitempty"`
+
 	// state
 	State State `json:"state,omitempty"`
 }
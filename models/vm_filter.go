@@ -17,18 +17,30 @@ type VMFilter struct {
 	// cid
 	Cid int32 `json:"cid,omitempty"`
 
+	// when ordering, claims exactly the vms with these cids, the other criteria are ignored
+	Cids []int32 `json:"cids"`
+
 	// cpu
 	CPU int32 `json:"cpu,omitempty"`
 
 	// deployment name
 	DeploymentName string `json:"deploymentName,omitempty"`
 
+	// when ordering, the name of a flavor whose cpu, memory and vlans the vm must have
+	Flavor string `json:"flavor,omitempty"`
+
 	// ip
 	IP strfmt.IPv4 `json:"ip,omitempty"`
 
+	// when ordering, claims exactly the vms with these ips, the other criteria are ignored
+	Ips []strfmt.IPv4 `json:"ips"`
+
 	// memory mb
 	MemoryMb int32 `json:"memory_mb,omitempty"`
 
+	// the pool to search, taken from the route
+	Pool string `json:"pool,omitempty"`
+
 	// private vlan
 	PrivateVlan int32 `json:"private_vlan,omitempty"`
 
@@ -37,6 +49,9 @@ type VMFilter struct {
 
 	// state
 	State State `json:"state,omitempty"`
+
+	// when ordering a single vm by its criteria, JSON agent settings the vm reads from /metadata until it is free again
+	UserData string `json:"userData,omitempty"`
 }
 
 // Validate validates this Vm filter
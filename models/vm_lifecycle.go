@@ -0,0 +1,64 @@
+package models
+
+import (
+	"sort"
+)
+
+// vmLifecycle lists, for every state a VM can be in, the states it may move
+// to next. ParseState and CanTransition, and through them every change of
+// state the DB makes, go by it, and a State is validated against its states.
+// The State enum of swagger.yaml lists the same states; the tests check it
+// does.
+var vmLifecycle = map[State][]State{
+	StateFree:         {StateProvisioning, StateMaintenance, StateRetiring, StateReserved},
+	StateProvisioning: {StateFree, StateUsing, StateMaintenance},
+	StateUsing:        {StateFree, StateProvisioning, StateReloading, StateMaintenance},
+	StateMaintenance:  {StateFree, StateRetiring},
+	StateReloading:    {StateFree, StateMaintenance, StateFailed},
+	StateRetiring:     {},
+	StateFailed:       {StateReloading, StateMaintenance, StateRetiring},
+	StateUnknown:      {StateMaintenance},
+	StateReserved:     {StateFree, StateProvisioning, StateMaintenance},
+}
+
+// stateEnum is what State validates against: the states of vmLifecycle, in
+// the order of their names.
+var stateEnum = lifecycleStates()
+
+func lifecycleStates() []interface{} {
+	names := make([]string, 0, len(vmLifecycle))
+	for state := range vmLifecycle {
+		names = append(names, string(state))
+	}
+	sort.Strings(names)
+
+	enum := make([]interface{}, 0, len(names))
+	for _, name := range names {
+		enum = append(enum, State(name))
+	}
+	return enum
+}
+
+// ParseState maps a stored or requested state name onto the lifecycle. Names
+// that are not part of it come back as StateUnknown and false.
+func ParseState(name string) (State, bool) {
+	state := State(name)
+	if _, ok := vmLifecycle[state]; !ok {
+		return StateUnknown, false
+	}
+	return state, true
+}
+
+// CanTransition reports whether a VM in state from may be moved to state to.
+// Any state may fall back to StateUnknown.
+func CanTransition(from, to State) bool {
+	if to == StateUnknown {
+		return true
+	}
+	for _, next := range vmLifecycle[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,66 @@
+package models_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	"github.com/go-openapi/strfmt"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v2"
+
+	"github.com/jianqiu/vps/models"
+)
+
+var _ = Describe("VM lifecycle", func() {
+	var states []interface{}
+
+	BeforeEach(func() {
+		states = []interface{}{}
+		for _, state := range models.LifecycleStates() {
+			states = append(states, string(state.(models.State)))
+		}
+	})
+
+	// specEnum is the State enum of the swagger spec, after it went through
+	// unmarshal.
+	specEnum := func(unmarshal func([]byte, interface{}) error, path string) []interface{} {
+		content, err := ioutil.ReadFile(path)
+		Expect(err).NotTo(HaveOccurred())
+
+		var spec struct {
+			Definitions struct {
+				State struct {
+					Enum []interface{} `json:"enum" yaml:"enum"`
+				} `json:"State" yaml:"State"`
+			} `json:"definitions" yaml:"definitions"`
+		}
+		Expect(unmarshal(content, &spec)).To(Succeed())
+		return spec.Definitions.State.Enum
+	}
+
+	It("has the states of swagger.yaml", func() {
+		Expect(specEnum(yaml.Unmarshal, "../swagger.yaml")).To(ConsistOf(states...))
+	})
+
+	It("has the states of swagger.json", func() {
+		Expect(specEnum(json.Unmarshal, "../swagger.json")).To(ConsistOf(states...))
+	})
+
+	It("validates a state against its states", func() {
+		for _, state := range states {
+			Expect(models.State(state.(string)).Validate(strfmt.Default)).To(Succeed())
+		}
+		Expect(models.State("exploded").Validate(strfmt.Default)).NotTo(Succeed())
+	})
+
+	It("parses only its states", func() {
+		state, ok := models.ParseState("reserved")
+		Expect(ok).To(BeTrue())
+		Expect(state).To(Equal(models.StateReserved))
+
+		state, ok = models.ParseState("exploded")
+		Expect(ok).To(BeFalse())
+		Expect(state).To(Equal(models.StateUnknown))
+	})
+})
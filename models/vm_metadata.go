@@ -0,0 +1,40 @@
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+)
+
+// VMMetadata what a vm learns about itself from /metadata
+// swagger:model VmMetadata
+type VMMetadata struct {
+
+	// cid
+	Cid int32 `json:"cid,omitempty"`
+
+	// deployment name
+	DeploymentName string `json:"deploymentName,omitempty"`
+
+	// hostname
+	Hostname string `json:"hostname,omitempty"`
+
+	// ip
+	IP strfmt.IPv4 `json:"ip,omitempty"`
+
+	// the JSON agent settings the vm was ordered with, empty when it was ordered without
+	UserData string `json:"userData,omitempty"`
+}
+
+// Validate validates this Vm metadata
+func (m *VMMetadata) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -16,6 +16,9 @@ type VMResponse struct {
 
 	// vm
 	VM *VM `json:"vm,omitempty"`
+
+	// every vm claimed by an order for specific cids or ips
+	Vms []*VM `json:"vms"`
 }
 
 // Validate validates this Vm response
@@ -27,6 +30,11 @@ func (m *VMResponse) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateVms(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -48,3 +56,27 @@ func (m *VMResponse) validateVM(formats strfmt.Registry) error {
 
 	return nil
 }
+
+func (m *VMResponse) validateVms(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Vms) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Vms); i++ {
+
+		if swag.IsZero(m.Vms[i]) { // not required
+			continue
+		}
+
+		if m.Vms[i] != nil {
+
+			if err := m.Vms[i].Validate(formats); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
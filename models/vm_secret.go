@@ -0,0 +1,37 @@
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+)
+
+// VMSecret what it takes to log in to a vm, kept encrypted
+// swagger:model VmSecret
+type VMSecret struct {
+
+	// cid
+	Cid int32 `json:"cid,omitempty"`
+
+	// password
+	Password string `json:"password,omitempty"`
+
+	// private keys in PEM format
+	SSHKeys []string `json:"sshKeys"`
+
+	// root unless given
+	Username string `json:"username,omitempty"`
+}
+
+// Validate validates this Vm secret
+func (m *VMSecret) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package models
+
+import (
+	"net"
+	"regexp"
+	"strings"
+)
+
+// vmInitialStates are the states a VM may be added to the pool in. Every
+// other state is only reached through the lifecycle.
+var vmInitialStates = []State{StateFree, StateMaintenance}
+
+var hostnameLabel = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`)
+
+// ValidateRecord checks the fields a VM needs before it is kept in the pool
+// and returns one ErrInvalidField for each field that is wrong. Checks that
+// need the rest of the pool, like a unique IP, are left to the caller.
+func (m *VM) ValidateRecord() []ErrInvalidField {
+	var invalid []ErrInvalidField
+
+	if m.Cid <= 0 {
+		invalid = append(invalid, ErrInvalidField{Field: "cid", Message: "must be positive"})
+	}
+	if m.Hostname == "" {
+		invalid = append(invalid, ErrInvalidField{Field: "hostname", Message: "is required"})
+	} else if !validHostname(m.Hostname) {
+		invalid = append(invalid, ErrInvalidField{Field: "hostname", Message: "is not a valid hostname"})
+	}
+	if m.IP == "" {
+		invalid = append(invalid, ErrInvalidField{Field: "ip", Message: "is required"})
+	} else if ip := net.ParseIP(string(m.IP)); ip == nil || ip.To4() == nil {
+		invalid = append(invalid, ErrInvalidField{Field: "ip", Message: "is not an IPv4 address"})
+	}
+	if m.CPU <= 0 {
+		invalid = append(invalid, ErrInvalidField{Field: "cpu", Message: "must be positive"})
+	}
+	if m.MemoryMb <= 0 {
+		invalid = append(invalid, ErrInvalidField{Field: "memory_mb", Message: "must be positive"})
+	}
+	if m.PublicVlan < 0 {
+		invalid = append(invalid, ErrInvalidField{Field: "public_vlan", Message: "cannot be negative"})
+	}
+	if m.PrivateVlan < 0 {
+		invalid = append(invalid, ErrInvalidField{Field: "private_vlan", Message: "cannot be negative"})
+	}
+	if _, ok := ParseState(string(m.State)); !ok {
+		invalid = append(invalid, ErrInvalidField{Field: "state", Message: "is not a known state"})
+	}
+
+	return invalid
+}
+
+// IsInitialState reports whether a VM may be added to the pool in state.
+func IsInitialState(state State) bool {
+	for _, initial := range vmInitialStates {
+		if initial == state {
+			return true
+		}
+	}
+	return false
+}
+
+func validHostname(hostname string) bool {
+	if len(hostname) > 253 {
+		return false
+	}
+	for _, label := range strings.Split(hostname, ".") {
+		if !hostnameLabel.MatchString(label) {
+			return false
+		}
+	}
+	return true
+}
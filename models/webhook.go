@@ -0,0 +1,60 @@
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/go-openapi/errors"
+)
+
+// Webhook webhook
+// swagger:model Webhook
+type Webhook struct {
+
+	// events to deliver, all events when empty
+	Events []WebhookEventType `json:"events"`
+
+	// id
+	ID string `json:"id,omitempty"`
+
+	// HMAC-SHA256 key for the X-Vps-Signature header
+	Secret string `json:"secret,omitempty"`
+
+	// url
+	URL string `json:"url,omitempty"`
+}
+
+// Validate validates this webhook
+func (m *Webhook) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateEvents(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Webhook) validateEvents(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Events) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Events); i++ {
+
+		if err := m.Events[i].Validate(formats); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/go-openapi/errors"
+)
+
+// WebhookDeliveriesResponse webhook deliveries response
+// swagger:model WebhookDeliveriesResponse
+type WebhookDeliveriesResponse struct {
+
+	// deliveries
+	Deliveries []*WebhookDelivery `json:"deliveries"`
+}
+
+// Validate validates this webhook deliveries response
+func (m *WebhookDeliveriesResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDeliveries(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *WebhookDeliveriesResponse) validateDeliveries(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Deliveries) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Deliveries); i++ {
+
+		if swag.IsZero(m.Deliveries[i]) { // not required
+			continue
+		}
+
+		if m.Deliveries[i] != nil {
+
+			if err := m.Deliveries[i].Validate(formats); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
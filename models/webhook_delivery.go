@@ -0,0 +1,92 @@
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/go-openapi/errors"
+)
+
+// WebhookDelivery webhook delivery
+// swagger:model WebhookDelivery
+type WebhookDelivery struct {
+
+	// attempts
+	Attempts int32 `json:"attempts,omitempty"`
+
+	// event Id
+	EventID string `json:"eventId,omitempty"`
+
+	// event type
+	EventType WebhookEventType `json:"eventType,omitempty"`
+
+	// id
+	ID string `json:"id,omitempty"`
+
+	// last error
+	LastError string `json:"lastError,omitempty"`
+
+	// next attempt at
+	NextAttemptAt int64 `json:"nextAttemptAt,omitempty"`
+
+	// payload
+	Payload string `json:"payload,omitempty"`
+
+	// state
+	State WebhookDeliveryState `json:"state,omitempty"`
+
+	// url
+	URL string `json:"url,omitempty"`
+
+	// webhook Id
+	WebhookID string `json:"webhookId,omitempty"`
+}
+
+// Validate validates this webhook delivery
+func (m *WebhookDelivery) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateEventType(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
+	if err := m.validateState(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *WebhookDelivery) validateEventType(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.EventType) { // not required
+		return nil
+	}
+
+	if err := m.EventType.Validate(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *WebhookDelivery) validateState(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.State) { // not required
+		return nil
+	}
+
+	if err := m.State.Validate(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/validate"
+)
+
+// WebhookDeliveryState Webhook Delivery State
+// swagger:model WebhookDeliveryState
+type WebhookDeliveryState string
+
+const (
+	WebhookDeliveryStatePending   WebhookDeliveryState = "pending"
+	WebhookDeliveryStateDelivered WebhookDeliveryState = "delivered"
+	WebhookDeliveryStateDead      WebhookDeliveryState = "dead"
+)
+
+// for schema
+var webhookDeliveryStateEnum []interface{}
+
+func init() {
+	var res []WebhookDeliveryState
+	if err := json.Unmarshal([]byte(`["pending","delivered","dead"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		webhookDeliveryStateEnum = append(webhookDeliveryStateEnum, v)
+	}
+}
+
+func (m WebhookDeliveryState) validateWebhookDeliveryStateEnum(path, location string, value WebhookDeliveryState) error {
+	if err := validate.Enum(path, location, value, webhookDeliveryStateEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this webhook delivery state
+func (m WebhookDeliveryState) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateWebhookDeliveryStateEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
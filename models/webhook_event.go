@@ -0,0 +1,77 @@
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/go-openapi/errors"
+)
+
+// WebhookEvent Body posted to webhooks
+// swagger:model WebhookEvent
+type WebhookEvent struct {
+
+	// id
+	ID string `json:"id,omitempty"`
+
+	// timestamp
+	Timestamp int64 `json:"timestamp,omitempty"`
+
+	// type
+	Type WebhookEventType `json:"type,omitempty"`
+
+	// vm
+	VM *VM `json:"vm,omitempty"`
+}
+
+// Validate validates this webhook event
+func (m *WebhookEvent) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateType(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
+	if err := m.validateVM(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *WebhookEvent) validateType(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Type) { // not required
+		return nil
+	}
+
+	if err := m.Type.Validate(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *WebhookEvent) validateVM(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.VM) { // not required
+		return nil
+	}
+
+	if m.VM != nil {
+
+		if err := m.VM.Validate(formats); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/validate"
+)
+
+// WebhookEventType Webhook Event Types
+// swagger:model WebhookEventType
+type WebhookEventType string
+
+const (
+	WebhookEventTypeVMOrdered      WebhookEventType = "vm-ordered"
+	WebhookEventTypeVMReleased     WebhookEventType = "vm-released"
+	WebhookEventTypeVMRemoved      WebhookEventType = "vm-removed"
+	WebhookEventTypeVMStateChanged WebhookEventType = "vm-state-changed"
+)
+
+// for schema
+var webhookEventTypeEnum []interface{}
+
+func init() {
+	var res []WebhookEventType
+	if err := json.Unmarshal([]byte(`["vm-ordered","vm-released","vm-removed","vm-state-changed"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		webhookEventTypeEnum = append(webhookEventTypeEnum, v)
+	}
+}
+
+func (m WebhookEventType) validateWebhookEventTypeEnum(path, location string, value WebhookEventType) error {
+	if err := validate.Enum(path, location, value, webhookEventTypeEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this webhook event type
+func (m WebhookEventType) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateWebhookEventTypeEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
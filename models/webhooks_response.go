@@ -0,0 +1,58 @@
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/go-openapi/errors"
+)
+
+// WebhooksResponse webhooks response
+// swagger:model WebhooksResponse
+type WebhooksResponse struct {
+
+	// webhooks
+	Webhooks []*Webhook `json:"webhooks"`
+}
+
+// Validate validates this webhooks response
+func (m *WebhooksResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateWebhooks(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *WebhooksResponse) validateWebhooks(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Webhooks) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Webhooks); i++ {
+
+		if swag.IsZero(m.Webhooks[i]) { // not required
+			continue
+		}
+
+		if m.Webhooks[i] != nil {
+
+			if err := m.Webhooks[i].Validate(formats); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
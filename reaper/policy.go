@@ -0,0 +1,108 @@
+package reaper
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/jianqiu/vps/models"
+)
+
+// Policy caps the number of free VMs of one flavor in one pool, the default
+// pool unless Pool names another. Free VMs beyond MaxFree that have not been
+// touched for IdleTime are cancelled, oldest first.
+type Policy struct {
+	Pool        string
+	CPU         int32
+	MemoryMb    int32
+	PublicVlan  int32
+	PrivateVlan int32
+	MaxFree     int
+	IdleTime    time.Duration
+}
+
+type policyJSON struct {
+	Pool        string `json:"pool"`
+	CPU         int32  `json:"cpu"`
+	MemoryMb    int32  `json:"memory_mb"`
+	PublicVlan  int32  `json:"public_vlan"`
+	PrivateVlan int32  `json:"private_vlan"`
+	MaxFree     int    `json:"max_free"`
+	IdleTime    string `json:"idle_time"`
+}
+
+func (p *Policy) UnmarshalJSON(data []byte) error {
+	var raw policyJSON
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+
+	idleTime, err := time.ParseDuration(raw.IdleTime)
+	if err != nil {
+		return fmt.Errorf("invalid idle_time %q: %s", raw.IdleTime, err.Error())
+	}
+
+	*p = Policy{
+		Pool:        raw.Pool,
+		CPU:         raw.CPU,
+		MemoryMb:    raw.MemoryMb,
+		PublicVlan:  raw.PublicVlan,
+		PrivateVlan: raw.PrivateVlan,
+		MaxFree:     raw.MaxFree,
+		IdleTime:    idleTime,
+	}
+	return nil
+}
+
+func (p Policy) Validate() error {
+	if p.CPU <= 0 || p.MemoryMb <= 0 {
+		return models.NewError(models.ErrorTypeInvalidRecord, "policy needs a cpu and memory_mb")
+	}
+	if p.MaxFree < 0 || p.IdleTime < 0 {
+		return models.NewError(models.ErrorTypeInvalidRecord, "policy limits cannot be negative")
+	}
+	return nil
+}
+
+// PoolName is the pool the policy reaps.
+func (p Policy) PoolName() string {
+	if p.Pool == "" {
+		return models.DefaultPool
+	}
+	return p.Pool
+}
+
+func (p Policy) Filter(state models.State) models.VMFilter {
+	return models.VMFilter{
+		Pool:        p.PoolName(),
+		CPU:         p.CPU,
+		MemoryMb:    p.MemoryMb,
+		PublicVlan:  p.PublicVlan,
+		PrivateVlan: p.PrivateVlan,
+		State:       state,
+	}
+}
+
+// LoadPolicies reads a JSON array of policies from path.
+func LoadPolicies(path string) ([]Policy, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var policies []Policy
+	err = json.Unmarshal(data, &policies)
+	if err != nil {
+		return nil, models.NewError(models.ErrorTypeInvalidJSON, err.Error())
+	}
+
+	for i, policy := range policies {
+		if err := policy.Validate(); err != nil {
+			return nil, models.NewError(models.ErrorTypeInvalidRecord, fmt.Sprintf("policy %d: %s", i, err.Error()))
+		}
+	}
+
+	return policies, nil
+}
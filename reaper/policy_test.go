@@ -0,0 +1,92 @@
+package reaper_test
+
+import (
+	"io/ioutil"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jianqiu/vps/models"
+	"github.com/jianqiu/vps/reaper"
+)
+
+var _ = Describe("LoadPolicies", func() {
+	var (
+		path     string
+		contents string
+		policies []reaper.Policy
+		err      error
+	)
+
+	JustBeforeEach(func() {
+		file, tmpErr := ioutil.TempFile("", "policies")
+		Expect(tmpErr).NotTo(HaveOccurred())
+		_, tmpErr = file.WriteString(contents)
+		Expect(tmpErr).NotTo(HaveOccurred())
+		file.Close()
+		path = file.Name()
+
+		policies, err = reaper.LoadPolicies(path)
+	})
+
+	AfterEach(func() {
+		os.Remove(path)
+	})
+
+	Context("when the file holds valid policies", func() {
+		BeforeEach(func() {
+			contents = `[{"cpu":4,"memory_mb":8192,"private_vlan":222,"max_free":3,"idle_time":"336h"}]`
+		})
+
+		It("returns them", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(policies).To(Equal([]reaper.Policy{
+				{CPU: 4, MemoryMb: 8192, PrivateVlan: 222, MaxFree: 3, IdleTime: 336 * time.Hour},
+			}))
+		})
+	})
+
+	Context("when a policy names a pool", func() {
+		BeforeEach(func() {
+			contents = `[{"pool":"staging","cpu":4,"memory_mb":8192,"max_free":3,"idle_time":"1h"}]`
+		})
+
+		It("reaps that pool only", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(policies[0].Filter(models.StateFree).Pool).To(Equal("staging"))
+		})
+	})
+
+	Context("when a policy names no pool", func() {
+		BeforeEach(func() {
+			contents = `[{"cpu":4,"memory_mb":8192,"max_free":3,"idle_time":"1h"}]`
+		})
+
+		It("reaps the default pool", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(policies[0].Filter(models.StateFree).Pool).To(Equal(models.DefaultPool))
+		})
+	})
+
+	Context("when the idle time is not a duration", func() {
+		BeforeEach(func() {
+			contents = `[{"cpu":4,"memory_mb":8192,"max_free":3,"idle_time":"two weeks"}]`
+		})
+
+		It("returns an invalid JSON error", func() {
+			Expect(models.ConvertError(err).Type).To(Equal(models.ErrorTypeInvalidJSON))
+		})
+	})
+
+	Context("when a policy is invalid", func() {
+		BeforeEach(func() {
+			contents = `[{"memory_mb":8192,"max_free":3,"idle_time":"1h"}]`
+		})
+
+		It("returns an invalid record error", func() {
+			Expect(models.ConvertError(err).Type).To(Equal(models.ErrorTypeInvalidRecord))
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package reaper
+
+import (
+	"code.cloudfoundry.org/lager"
+)
+
+//go:generate counterfeiter . Provider
+
+// Provider cancels VMs that are being removed from the pool. Cancelling a VM
+// that is already gone must succeed. softlayer.SoftLayerClient satisfies it.
+type Provider interface {
+	CancelVirtualGuest(logger lager.Logger, cid int32) error
+}
@@ -0,0 +1,170 @@
+package reaper
+
+import (
+	"os"
+	"sort"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/db"
+	"github.com/jianqiu/vps/models"
+)
+
+// Reaper cancels surplus free VMs. On every tick it first finishes off VMs
+// left in retiring by an earlier pass, then retires and cancels the VMs the
+// current plan selects.
+type Reaper struct {
+	logger   lager.Logger
+	db       db.VirtualGuestDB
+	provider Provider
+	clock    clock.Clock
+	interval time.Duration
+	policies []Policy
+}
+
+type candidate struct {
+	vm         *models.VM
+	idleBefore time.Time
+}
+
+func NewReaper(
+	logger lager.Logger,
+	db db.VirtualGuestDB,
+	provider Provider,
+	clock clock.Clock,
+	interval time.Duration,
+	policies []Policy,
+) *Reaper {
+	return &Reaper{
+		logger:   logger,
+		db:       db,
+		provider: provider,
+		clock:    clock,
+		interval: interval,
+		policies: policies,
+	}
+}
+
+func (r *Reaper) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	logger := r.logger.Session("reaper")
+	logger.Info("starting", lager.Data{"policies": r.policies, "interval": r.interval.String()})
+	defer logger.Info("exited")
+
+	ticker := r.clock.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	close(ready)
+
+	for {
+		select {
+		case <-ticker.C():
+			r.Reap(logger)
+		case <-signals:
+			return nil
+		}
+	}
+}
+
+// Plan returns the free VMs the next pass would retire, without touching
+// them.
+func (r *Reaper) Plan(logger lager.Logger) ([]*models.VM, error) {
+	candidates, err := r.plan(logger.Session("plan"))
+	if err != nil {
+		return nil, err
+	}
+
+	vms := []*models.VM{}
+	for _, c := range candidates {
+		vms = append(vms, c.vm)
+	}
+	return vms, nil
+}
+
+// Reap runs a single pass.
+func (r *Reaper) Reap(logger lager.Logger) {
+	logger = logger.Session("reap")
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	for _, policy := range r.policies {
+		retiring, err := r.db.VirtualGuests(logger, policy.Filter(models.StateRetiring))
+		if err != nil {
+			logger.Error("failed-to-fetch-retiring-vms", err)
+			continue
+		}
+		for _, vm := range retiring {
+			r.remove(logger, vm.Cid)
+		}
+	}
+
+	candidates, err := r.plan(logger)
+	if err != nil {
+		return
+	}
+
+	for _, c := range candidates {
+		err := r.db.RetireVirtualGuest(logger, c.vm.Cid, c.idleBefore)
+		if err != nil {
+			logger.Error("failed-to-retire-vm", err, lager.Data{"cid": c.vm.Cid})
+			continue
+		}
+		r.remove(logger, c.vm.Cid)
+	}
+}
+
+func (r *Reaper) plan(logger lager.Logger) ([]candidate, error) {
+	now := r.clock.Now()
+	candidates := []candidate{}
+
+	for _, policy := range r.policies {
+		free, err := r.db.VirtualGuests(logger, policy.Filter(models.StateFree))
+		if err != nil {
+			logger.Error("failed-to-fetch-free-vms", err, lager.Data{"policy": policy})
+			return nil, err
+		}
+
+		surplus := len(free) - policy.MaxFree
+		if surplus <= 0 {
+			continue
+		}
+
+		sort.Sort(byModifyDate(free))
+		idleBefore := now.Add(-policy.IdleTime)
+		for _, vm := range free {
+			if surplus == 0 || time.Time(vm.ModifyDate).After(idleBefore) {
+				break
+			}
+			candidates = append(candidates, candidate{vm: vm, idleBefore: idleBefore})
+			surplus--
+		}
+	}
+
+	return candidates, nil
+}
+
+func (r *Reaper) remove(logger lager.Logger, cid int32) {
+	logger = logger.Session("remove", lager.Data{"cid": cid})
+
+	err := r.provider.CancelVirtualGuest(logger, cid)
+	if err != nil {
+		logger.Error("failed-to-cancel-vm", err)
+		return
+	}
+
+	err = r.db.DeleteVirtualGuestFromPool(logger, cid)
+	if err != nil {
+		logger.Error("failed-to-delete-vm", err)
+		return
+	}
+
+	logger.Info("removed-vm")
+}
+
+type byModifyDate []*models.VM
+
+func (vms byModifyDate) Len() int      { return len(vms) }
+func (vms byModifyDate) Swap(i, j int) { vms[i], vms[j] = vms[j], vms[i] }
+func (vms byModifyDate) Less(i, j int) bool {
+	return time.Time(vms[i].ModifyDate).Before(time.Time(vms[j].ModifyDate))
+}
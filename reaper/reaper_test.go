@@ -0,0 +1,202 @@
+package reaper_test
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jianqiu/vps/db/dbfakes"
+	"github.com/jianqiu/vps/models"
+	"github.com/jianqiu/vps/reaper"
+	"github.com/jianqiu/vps/reaper/reaperfakes"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/go-openapi/strfmt"
+	"github.com/tedsuo/ifrit"
+)
+
+var _ = Describe("Reaper", func() {
+	var (
+		logger             *lagertest.TestLogger
+		fakeVirtualGuestDB *dbfakes.FakeVirtualGuestDB
+		fakeProvider       *reaperfakes.FakeProvider
+		policy             reaper.Policy
+		r                  *reaper.Reaper
+
+		freeVMs     []*models.VM
+		retiringVMs []*models.VM
+	)
+
+	idleVM := func(cid int32, idle time.Duration) *models.VM {
+		return &models.VM{Cid: cid, State: models.StateFree, ModifyDate: strfmt.DateTime(time.Now().Add(-idle))}
+	}
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		fakeVirtualGuestDB = new(dbfakes.FakeVirtualGuestDB)
+		fakeProvider = new(reaperfakes.FakeProvider)
+		policy = reaper.Policy{CPU: 4, MemoryMb: 8192, MaxFree: 2, IdleTime: time.Hour}
+
+		freeVMs = []*models.VM{
+			idleVM(1, 2*time.Hour),
+			idleVM(2, time.Minute),
+			idleVM(3, 5*time.Hour),
+			idleVM(4, 3*time.Hour),
+		}
+		retiringVMs = []*models.VM{}
+		fakeVirtualGuestDB.VirtualGuestsStub = func(_ lager.Logger, filter models.VMFilter) ([]*models.VM, error) {
+			if filter.State == models.StateRetiring {
+				return retiringVMs, nil
+			}
+			return freeVMs, nil
+		}
+	})
+
+	JustBeforeEach(func() {
+		r = reaper.NewReaper(logger, fakeVirtualGuestDB, fakeProvider, clock.NewClock(), 10*time.Millisecond, []reaper.Policy{policy})
+	})
+
+	Describe("Plan", func() {
+		var (
+			vms []*models.VM
+			err error
+		)
+
+		JustBeforeEach(func() {
+			vms, err = r.Plan(logger)
+		})
+
+		It("selects the longest idle surplus vms", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vms).To(HaveLen(2))
+			Expect(vms[0].Cid).To(Equal(int32(3)))
+			Expect(vms[1].Cid).To(Equal(int32(4)))
+		})
+
+		It("only looks at free vms of the policy flavor", func() {
+			_, filter := fakeVirtualGuestDB.VirtualGuestsArgsForCall(0)
+			Expect(filter).To(Equal(models.VMFilter{Pool: models.DefaultPool, CPU: 4, MemoryMb: 8192, State: models.StateFree}))
+		})
+
+		It("does not change anything", func() {
+			Expect(fakeVirtualGuestDB.RetireVirtualGuestCallCount()).To(Equal(0))
+			Expect(fakeProvider.CancelVirtualGuestCallCount()).To(Equal(0))
+		})
+
+		Context("when fewer surplus vms have been idle long enough", func() {
+			BeforeEach(func() {
+				policy.IdleTime = 4 * time.Hour
+			})
+
+			It("only selects those", func() {
+				Expect(vms).To(HaveLen(1))
+				Expect(vms[0].Cid).To(Equal(int32(3)))
+			})
+		})
+
+		Context("when there is no surplus", func() {
+			BeforeEach(func() {
+				policy.MaxFree = 4
+			})
+
+			It("selects nothing", func() {
+				Expect(vms).To(BeEmpty())
+			})
+		})
+
+		Context("when fetching the vms fails", func() {
+			BeforeEach(func() {
+				fakeVirtualGuestDB.VirtualGuestsStub = nil
+				fakeVirtualGuestDB.VirtualGuestsReturns(nil, errors.New("kaboom"))
+			})
+
+			It("returns the error", func() {
+				Expect(err).To(MatchError("kaboom"))
+			})
+		})
+	})
+
+	Describe("Reap", func() {
+		JustBeforeEach(func() {
+			r.Reap(logger)
+		})
+
+		It("retires the selected vms before cancelling them", func() {
+			Expect(fakeVirtualGuestDB.RetireVirtualGuestCallCount()).To(Equal(2))
+			_, cid, idleBefore := fakeVirtualGuestDB.RetireVirtualGuestArgsForCall(0)
+			Expect(cid).To(Equal(int32(3)))
+			Expect(idleBefore).To(BeTemporally("~", time.Now().Add(-time.Hour), time.Minute))
+		})
+
+		It("cancels and deletes the retired vms", func() {
+			Expect(fakeProvider.CancelVirtualGuestCallCount()).To(Equal(2))
+			_, cid := fakeProvider.CancelVirtualGuestArgsForCall(1)
+			Expect(cid).To(Equal(int32(4)))
+			Expect(fakeVirtualGuestDB.DeleteVirtualGuestFromPoolCallCount()).To(Equal(2))
+			_, cid = fakeVirtualGuestDB.DeleteVirtualGuestFromPoolArgsForCall(1)
+			Expect(cid).To(Equal(int32(4)))
+		})
+
+		Context("when a vm cannot be retired", func() {
+			BeforeEach(func() {
+				fakeVirtualGuestDB.RetireVirtualGuestStub = func(_ lager.Logger, cid int32, _ time.Time) error {
+					if cid == 3 {
+						return models.ErrResourceConflict
+					}
+					return nil
+				}
+			})
+
+			It("leaves it alone", func() {
+				Expect(fakeProvider.CancelVirtualGuestCallCount()).To(Equal(1))
+				_, cid := fakeProvider.CancelVirtualGuestArgsForCall(0)
+				Expect(cid).To(Equal(int32(4)))
+			})
+		})
+
+		Context("when cancelling fails", func() {
+			BeforeEach(func() {
+				fakeProvider.CancelVirtualGuestReturns(errors.New("kaboom"))
+			})
+
+			It("keeps the vms in the pool", func() {
+				Expect(fakeVirtualGuestDB.DeleteVirtualGuestFromPoolCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when vms were left retiring by an earlier pass", func() {
+			BeforeEach(func() {
+				policy.MaxFree = 4
+				retiringVMs = []*models.VM{{Cid: 9, State: models.StateRetiring}}
+			})
+
+			It("cancels and deletes them", func() {
+				Expect(fakeProvider.CancelVirtualGuestCallCount()).To(Equal(1))
+				_, cid := fakeVirtualGuestDB.DeleteVirtualGuestFromPoolArgsForCall(0)
+				Expect(cid).To(Equal(int32(9)))
+			})
+		})
+	})
+
+	Describe("Run", func() {
+		var process ifrit.Process
+
+		JustBeforeEach(func() {
+			process = ifrit.Invoke(r)
+		})
+
+		AfterEach(func() {
+			process.Signal(os.Interrupt)
+			Eventually(process.Wait()).Should(Receive(BeNil()))
+		})
+
+		It("reaps on every tick", func() {
+			Eventually(fakeVirtualGuestDB.RetireVirtualGuestCallCount).Should(BeNumerically(">=", 2))
+		})
+	})
+})
@@ -0,0 +1,79 @@
+// This file was generated by counterfeiter
+package reaperfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/reaper"
+)
+
+type FakeProvider struct {
+	CancelVirtualGuestStub        func(logger lager.Logger, cid int32) error
+	cancelVirtualGuestMutex       sync.RWMutex
+	cancelVirtualGuestArgsForCall []struct {
+		logger lager.Logger
+		cid    int32
+	}
+	cancelVirtualGuestReturns struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeProvider) CancelVirtualGuest(logger lager.Logger, cid int32) error {
+	fake.cancelVirtualGuestMutex.Lock()
+	fake.cancelVirtualGuestArgsForCall = append(fake.cancelVirtualGuestArgsForCall, struct {
+		logger lager.Logger
+		cid    int32
+	}{logger, cid})
+	fake.recordInvocation("CancelVirtualGuest", []interface{}{logger, cid})
+	fake.cancelVirtualGuestMutex.Unlock()
+	if fake.CancelVirtualGuestStub != nil {
+		return fake.CancelVirtualGuestStub(logger, cid)
+	} else {
+		return fake.cancelVirtualGuestReturns.result1
+	}
+}
+
+func (fake *FakeProvider) CancelVirtualGuestCallCount() int {
+	fake.cancelVirtualGuestMutex.RLock()
+	defer fake.cancelVirtualGuestMutex.RUnlock()
+	return len(fake.cancelVirtualGuestArgsForCall)
+}
+
+func (fake *FakeProvider) CancelVirtualGuestArgsForCall(i int) (lager.Logger, int32) {
+	fake.cancelVirtualGuestMutex.RLock()
+	defer fake.cancelVirtualGuestMutex.RUnlock()
+	return fake.cancelVirtualGuestArgsForCall[i].logger, fake.cancelVirtualGuestArgsForCall[i].cid
+}
+
+func (fake *FakeProvider) CancelVirtualGuestReturns(result1 error) {
+	fake.CancelVirtualGuestStub = nil
+	fake.cancelVirtualGuestReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeProvider) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.cancelVirtualGuestMutex.RLock()
+	defer fake.cancelVirtualGuestMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeProvider) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ reaper.Provider = new(FakeProvider)
@@ -0,0 +1,140 @@
+package registration
+
+import (
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/consuladapter"
+	"code.cloudfoundry.org/lager"
+	"github.com/hashicorp/consul/api"
+	"github.com/jianqiu/vps/models"
+)
+
+//go:generate counterfeiter -o registrationfakes/fake_consul_client.go ../vendor/code.cloudfoundry.org/consuladapter Client
+//go:generate counterfeiter -o registrationfakes/fake_consul_agent.go ../vendor/code.cloudfoundry.org/consuladapter Agent
+
+//go:generate counterfeiter . Readiness
+
+// Readiness tells whether the server can serve requests.
+// *controllers.HealthController satisfies it.
+type Readiness interface {
+	Readiness(logger lager.Logger) (*models.Health, bool)
+}
+
+// Registrar registers the api as a service with the local Consul agent,
+// along with a TTL check that it passes twice per TTL while the server is
+// ready and fails while it is not. Consul marks the service critical when
+// the server stops reporting, and the registrar deregisters it on a signal.
+//
+// The registrar is ready straight away, so that a Consul agent that cannot
+// be reached keeps the api out of the catalog but not from serving. It
+// registers again whenever the agent has lost the service, e.g. after a
+// restart.
+type Registrar struct {
+	logger    lager.Logger
+	client    consuladapter.Client
+	service   api.AgentServiceRegistration
+	readiness Readiness
+	clock     clock.Clock
+	ttl       time.Duration
+}
+
+func NewRegistrar(
+	logger lager.Logger,
+	client consuladapter.Client,
+	service api.AgentServiceRegistration,
+	readiness Readiness,
+	clock clock.Clock,
+	ttl time.Duration,
+) *Registrar {
+	service.Check = &api.AgentServiceCheck{TTL: ttl.String()}
+	return &Registrar{
+		logger:    logger,
+		client:    client,
+		service:   service,
+		readiness: readiness,
+		clock:     clock,
+		ttl:       ttl,
+	}
+}
+
+func (r *Registrar) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	logger := r.logger.Session("registration", lager.Data{"service": r.service.Name, "id": r.service.ID})
+	logger.Info("starting", lager.Data{"address": r.service.Address, "port": r.service.Port, "ttl": r.ttl.String()})
+	defer logger.Info("exited")
+
+	agent := r.client.Agent()
+
+	ticker := r.clock.NewTicker(r.ttl / 2)
+	defer ticker.Stop()
+
+	registered := r.register(logger, agent) && r.report(logger, agent)
+	close(ready)
+
+	for {
+		select {
+		case <-ticker.C():
+			if !registered {
+				registered = r.register(logger, agent)
+			}
+			if registered {
+				registered = r.report(logger, agent)
+			}
+		case <-signals:
+			logger.Info("deregistering")
+			err := agent.ServiceDeregister(r.service.ID)
+			if err != nil {
+				// the check goes critical once the TTL is over
+				logger.Error("failed-deregistering", err)
+			}
+			return nil
+		}
+	}
+}
+
+func (r *Registrar) register(logger lager.Logger, agent consuladapter.Agent) bool {
+	err := agent.ServiceRegister(&r.service)
+	if err != nil {
+		logger.Error("failed-registering", err)
+		return false
+	}
+	logger.Info("registered")
+	return true
+}
+
+// report updates the check with the readiness of the server. It returns
+// false when the agent does not know the check, so that the service is
+// registered again.
+func (r *Registrar) report(logger lager.Logger, agent consuladapter.Agent) bool {
+	health, ready := r.readiness.Readiness(logger)
+
+	var err error
+	if ready {
+		err = agent.PassTTL(r.checkID(), "")
+	} else {
+		err = agent.FailTTL(r.checkID(), notReady(health))
+	}
+	if err != nil {
+		logger.Error("failed-updating-check", err, lager.Data{"ready": ready})
+		return false
+	}
+	return true
+}
+
+// checkID is the ID Consul gives the check of a service registered with a
+// single check.
+func (r *Registrar) checkID() string {
+	return "service:" + r.service.ID
+}
+
+func notReady(health *models.Health) string {
+	switch {
+	case !health.Migrated:
+		return "migrations are running"
+	case health.Database != nil && health.Database.Error != "":
+		return "database: " + health.Database.Error
+	default:
+		return "database unreachable"
+	}
+}
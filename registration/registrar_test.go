@@ -0,0 +1,140 @@
+package registration_test
+
+import (
+	"errors"
+	"os"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jianqiu/vps/models"
+	"github.com/jianqiu/vps/registration"
+	"github.com/jianqiu/vps/registration/registrationfakes"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/hashicorp/consul/api"
+	"github.com/tedsuo/ifrit"
+)
+
+var _ = Describe("Registrar", func() {
+	var (
+		logger        *lagertest.TestLogger
+		fakeClient    *registrationfakes.FakeClient
+		fakeAgent     *registrationfakes.FakeAgent
+		fakeReadiness *registrationfakes.FakeReadiness
+		ready         int32
+		process       ifrit.Process
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		fakeAgent = new(registrationfakes.FakeAgent)
+		fakeClient = new(registrationfakes.FakeClient)
+		fakeClient.AgentReturns(fakeAgent)
+
+		atomic.StoreInt32(&ready, 1)
+		fakeReadiness = new(registrationfakes.FakeReadiness)
+		fakeReadiness.ReadinessStub = func(lager.Logger) (*models.Health, bool) {
+			if atomic.LoadInt32(&ready) == 1 {
+				return &models.Health{Status: models.HealthStatusOk, Migrated: true}, true
+			}
+			return &models.Health{
+				Status:   models.HealthStatusUnavailable,
+				Migrated: true,
+				Database: &models.DatabaseHealth{Error: "connection refused"},
+			}, false
+		}
+	})
+
+	JustBeforeEach(func() {
+		registrar := registration.NewRegistrar(
+			logger,
+			fakeClient,
+			api.AgentServiceRegistration{ID: "vps-1", Name: "vps", Address: "10.0.0.1", Port: 8080},
+			fakeReadiness,
+			clock.NewClock(),
+			40*time.Millisecond,
+		)
+		process = ifrit.Invoke(registrar)
+	})
+
+	AfterEach(func() {
+		process.Signal(os.Interrupt)
+		Eventually(process.Wait()).Should(Receive(BeNil()))
+	})
+
+	It("registers the service with a TTL check", func() {
+		Eventually(fakeAgent.ServiceRegisterCallCount).Should(Equal(1))
+		Expect(fakeAgent.ServiceRegisterArgsForCall(0)).To(Equal(&api.AgentServiceRegistration{
+			ID:      "vps-1",
+			Name:    "vps",
+			Address: "10.0.0.1",
+			Port:    8080,
+			Check:   &api.AgentServiceCheck{TTL: "40ms"},
+		}))
+	})
+
+	It("passes the check for as long as the server is ready", func() {
+		Eventually(fakeAgent.PassTTLCallCount).Should(BeNumerically(">=", 3))
+		checkID, _ := fakeAgent.PassTTLArgsForCall(0)
+		Expect(checkID).To(Equal("service:vps-1"))
+		Expect(fakeAgent.FailTTLCallCount()).To(Equal(0))
+		Expect(fakeAgent.ServiceRegisterCallCount()).To(Equal(1))
+	})
+
+	It("fails the check while the server is not ready", func() {
+		atomic.StoreInt32(&ready, 0)
+
+		Eventually(fakeAgent.FailTTLCallCount).Should(BeNumerically(">=", 1))
+		checkID, note := fakeAgent.FailTTLArgsForCall(0)
+		Expect(checkID).To(Equal("service:vps-1"))
+		Expect(note).To(Equal("database: connection refused"))
+	})
+
+	It("deregisters the service on a signal", func() {
+		Eventually(fakeAgent.PassTTLCallCount).Should(BeNumerically(">=", 1))
+
+		process.Signal(os.Interrupt)
+		Eventually(process.Wait()).Should(Receive(BeNil()))
+
+		Expect(fakeAgent.ServiceDeregisterCallCount()).To(Equal(1))
+		Expect(fakeAgent.ServiceDeregisterArgsForCall(0)).To(Equal("vps-1"))
+	})
+
+	Context("when the agent cannot be reached", func() {
+		var failing int32
+
+		BeforeEach(func() {
+			atomic.StoreInt32(&failing, 1)
+			fakeAgent.ServiceRegisterStub = func(*api.AgentServiceRegistration) error {
+				if atomic.LoadInt32(&failing) == 1 {
+					return errors.New("connection refused")
+				}
+				return nil
+			}
+		})
+
+		It("is ready anyway and keeps trying to register", func() {
+			Eventually(process.Ready()).Should(BeClosed())
+			Eventually(fakeAgent.ServiceRegisterCallCount).Should(BeNumerically(">=", 2))
+			Expect(fakeAgent.PassTTLCallCount()).To(Equal(0))
+
+			atomic.StoreInt32(&failing, 0)
+			Eventually(fakeAgent.PassTTLCallCount).Should(BeNumerically(">=", 1))
+		})
+	})
+
+	Context("when the agent lost the service", func() {
+		BeforeEach(func() {
+			fakeAgent.PassTTLReturns(errors.New(`CheckID "service:vps-1" does not have associated TTL`))
+		})
+
+		It("registers it again", func() {
+			Eventually(fakeAgent.ServiceRegisterCallCount).Should(BeNumerically(">=", 2))
+		})
+	})
+})
@@ -0,0 +1,80 @@
+// This file was generated by counterfeiter
+package registrationfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/models"
+	"github.com/jianqiu/vps/registration"
+)
+
+type FakeReadiness struct {
+	ReadinessStub        func(logger lager.Logger) (*models.Health, bool)
+	readinessMutex       sync.RWMutex
+	readinessArgsForCall []struct {
+		logger lager.Logger
+	}
+	readinessReturns struct {
+		result1 *models.Health
+		result2 bool
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeReadiness) Readiness(logger lager.Logger) (*models.Health, bool) {
+	fake.readinessMutex.Lock()
+	fake.readinessArgsForCall = append(fake.readinessArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("Readiness", []interface{}{logger})
+	fake.readinessMutex.Unlock()
+	if fake.ReadinessStub != nil {
+		return fake.ReadinessStub(logger)
+	} else {
+		return fake.readinessReturns.result1, fake.readinessReturns.result2
+	}
+}
+
+func (fake *FakeReadiness) ReadinessCallCount() int {
+	fake.readinessMutex.RLock()
+	defer fake.readinessMutex.RUnlock()
+	return len(fake.readinessArgsForCall)
+}
+
+func (fake *FakeReadiness) ReadinessArgsForCall(i int) lager.Logger {
+	fake.readinessMutex.RLock()
+	defer fake.readinessMutex.RUnlock()
+	return fake.readinessArgsForCall[i].logger
+}
+
+func (fake *FakeReadiness) ReadinessReturns(result1 *models.Health, result2 bool) {
+	fake.ReadinessStub = nil
+	fake.readinessReturns = struct {
+		result1 *models.Health
+		result2 bool
+	}{result1, result2}
+}
+
+func (fake *FakeReadiness) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.readinessMutex.RLock()
+	defer fake.readinessMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeReadiness) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ registration.Readiness = new(FakeReadiness)
@@ -0,0 +1,101 @@
+package replenisher
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/jianqiu/vps/models"
+)
+
+// Policy keeps at least MinFree free VMs of one flavor in one pool, the
+// default pool unless Pool names another, without letting the number of VMs
+// of that flavor in the pool grow past MaxTotal. A MaxTotal of zero means no
+// limit.
+type Policy struct {
+	Pool        string `json:"pool"`
+	CPU         int32  `json:"cpu"`
+	MemoryMb    int32  `json:"memory_mb"`
+	PublicVlan  int32  `json:"public_vlan"`
+	PrivateVlan int32  `json:"private_vlan"`
+	MinFree     int    `json:"min_free"`
+	MaxTotal    int    `json:"max_total"`
+}
+
+func (p Policy) Validate() error {
+	if p.CPU <= 0 || p.MemoryMb <= 0 {
+		return models.NewError(models.ErrorTypeInvalidRecord, "policy needs a cpu and memory_mb")
+	}
+	if p.MinFree < 0 || p.MaxTotal < 0 {
+		return models.NewError(models.ErrorTypeInvalidRecord, "policy limits cannot be negative")
+	}
+	if p.MaxTotal > 0 && p.MinFree > p.MaxTotal {
+		return models.NewError(models.ErrorTypeInvalidRecord, "policy min_free is larger than max_total")
+	}
+	return nil
+}
+
+// PoolName is the pool the policy keeps topped up.
+func (p Policy) PoolName() string {
+	if p.Pool == "" {
+		return models.DefaultPool
+	}
+	return p.Pool
+}
+
+func (p Policy) Filter(state models.State) models.VMFilter {
+	return models.VMFilter{
+		Pool:        p.PoolName(),
+		CPU:         p.CPU,
+		MemoryMb:    p.MemoryMb,
+		PublicVlan:  p.PublicVlan,
+		PrivateVlan: p.PrivateVlan,
+		State:       state,
+	}
+}
+
+// Order is the record of the VM with cid, ordered for the policy at
+// orderedAt.
+func (p Policy) Order(cid int32, orderedAt time.Time) *models.PendingOrder {
+	return &models.PendingOrder{
+		Cid:         cid,
+		Pool:        p.PoolName(),
+		CPU:         p.CPU,
+		MemoryMb:    p.MemoryMb,
+		PublicVlan:  p.PublicVlan,
+		PrivateVlan: p.PrivateVlan,
+		OrderedAt:   orderedAt,
+	}
+}
+
+// Orders reports whether order is for a VM of the policy's flavor and pool.
+func (p Policy) Orders(order *models.PendingOrder) bool {
+	return order.Pool == p.PoolName() &&
+		order.CPU == p.CPU &&
+		order.MemoryMb == p.MemoryMb &&
+		order.PublicVlan == p.PublicVlan &&
+		order.PrivateVlan == p.PrivateVlan
+}
+
+// LoadPolicies reads a JSON array of policies from path.
+func LoadPolicies(path string) ([]Policy, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var policies []Policy
+	err = json.Unmarshal(data, &policies)
+	if err != nil {
+		return nil, models.NewError(models.ErrorTypeInvalidJSON, err.Error())
+	}
+
+	for i, policy := range policies {
+		if err := policy.Validate(); err != nil {
+			return nil, models.NewError(models.ErrorTypeInvalidRecord, fmt.Sprintf("policy %d: %s", i, err.Error()))
+		}
+	}
+
+	return policies, nil
+}
@@ -0,0 +1,70 @@
+package replenisher_test
+
+import (
+	"io/ioutil"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jianqiu/vps/models"
+	"github.com/jianqiu/vps/replenisher"
+)
+
+var _ = Describe("LoadPolicies", func() {
+	var (
+		path     string
+		contents string
+		policies []replenisher.Policy
+		err      error
+	)
+
+	JustBeforeEach(func() {
+		file, tmpErr := ioutil.TempFile("", "policies")
+		Expect(tmpErr).NotTo(HaveOccurred())
+		_, tmpErr = file.WriteString(contents)
+		Expect(tmpErr).NotTo(HaveOccurred())
+		file.Close()
+		path = file.Name()
+
+		policies, err = replenisher.LoadPolicies(path)
+	})
+
+	AfterEach(func() {
+		os.Remove(path)
+	})
+
+	Context("when the file holds valid policies", func() {
+		BeforeEach(func() {
+			contents = `[{"cpu":4,"memory_mb":8192,"public_vlan":111,"private_vlan":222,"min_free":3,"max_total":10}]`
+		})
+
+		It("returns them", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(policies).To(Equal([]replenisher.Policy{
+				{CPU: 4, MemoryMb: 8192, PublicVlan: 111, PrivateVlan: 222, MinFree: 3, MaxTotal: 10},
+			}))
+		})
+	})
+
+	Context("when the file is not JSON", func() {
+		BeforeEach(func() {
+			contents = `cpu: 4`
+		})
+
+		It("returns an invalid JSON error", func() {
+			Expect(models.ConvertError(err).Type).To(Equal(models.ErrorTypeInvalidJSON))
+		})
+	})
+
+	Context("when a policy is invalid", func() {
+		BeforeEach(func() {
+			contents = `[{"cpu":4,"memory_mb":8192,"min_free":11,"max_total":10}]`
+		})
+
+		It("returns an invalid record error", func() {
+			Expect(models.ConvertError(err).Type).To(Equal(models.ErrorTypeInvalidRecord))
+			Expect(err.Error()).To(ContainSubstring("policy 0"))
+		})
+	})
+})
@@ -0,0 +1,62 @@
+package replenisher
+
+import (
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/models"
+	"github.com/jianqiu/vps/softlayer"
+	"github.com/nu7hatch/gouuid"
+)
+
+//go:generate counterfeiter . Provider
+
+// Provider orders new VMs for the pool. OrderVirtualGuest returns as soon as
+// the order is placed; ProvisionedVirtualGuest returns nil until the ordered
+// VM is ready to be handed out. CancelVirtualGuest gives up on an order that
+// never got there.
+type Provider interface {
+	OrderVirtualGuest(logger lager.Logger, policy Policy) (int32, error)
+	ProvisionedVirtualGuest(logger lager.Logger, cid int32) (*models.VM, error)
+	CancelVirtualGuest(logger lager.Logger, cid int32) error
+}
+
+type softLayerProvider struct {
+	client   softlayer.SoftLayerClient
+	template softlayer.VirtualGuestTemplate
+}
+
+// NewSoftLayerProvider orders VMs through SoftLayer. Every order starts from
+// template, with the flavor taken from the policy and the hostname made
+// unique by appending a random suffix to template.Hostname.
+func NewSoftLayerProvider(client softlayer.SoftLayerClient, template softlayer.VirtualGuestTemplate) Provider {
+	return &softLayerProvider{
+		client:   client,
+		template: template,
+	}
+}
+
+func (p *softLayerProvider) OrderVirtualGuest(logger lager.Logger, policy Policy) (int32, error) {
+	guid, err := uuid.NewV4()
+	if err != nil {
+		return 0, models.NewError(models.ErrorTypeGUIDGeneration, err.Error())
+	}
+
+	template := p.template
+	template.Hostname = fmt.Sprintf("%s-%s", template.Hostname, strings.SplitN(guid.String(), "-", 2)[0])
+	template.CPU = policy.CPU
+	template.MemoryMb = policy.MemoryMb
+	template.PublicVlan = policy.PublicVlan
+	template.PrivateVlan = policy.PrivateVlan
+
+	return p.client.CreateVirtualGuest(logger, template)
+}
+
+func (p *softLayerProvider) ProvisionedVirtualGuest(logger lager.Logger, cid int32) (*models.VM, error) {
+	return p.client.ProvisionedVirtualGuest(logger, cid)
+}
+
+func (p *softLayerProvider) CancelVirtualGuest(logger lager.Logger, cid int32) error {
+	return p.client.CancelVirtualGuest(logger, cid)
+}
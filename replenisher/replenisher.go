@@ -0,0 +1,209 @@
+package replenisher
+
+import (
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/db"
+	"github.com/jianqiu/vps/models"
+)
+
+// Replenisher keeps the pool topped up according to its policies. On every
+// tick it adds VMs ordered earlier that have finished provisioning, then
+// orders enough new ones to cover each policy's shortfall. Orders are
+// recorded in the DB until their VM is added, so that they survive a
+// restart or a change of leader. An order that is not done within
+// orderTimeout is cancelled and no longer counts against its policy; a zero
+// orderTimeout waits for every order forever.
+type Replenisher struct {
+	logger       lager.Logger
+	db           db.DB
+	provider     Provider
+	clock        clock.Clock
+	interval     time.Duration
+	orderTimeout time.Duration
+	policies     []Policy
+
+	// ordered VMs that are not in the pool yet, by cid, as of the last pass
+	pending map[int32]*models.PendingOrder
+}
+
+func NewReplenisher(
+	logger lager.Logger,
+	db db.DB,
+	provider Provider,
+	clock clock.Clock,
+	interval time.Duration,
+	orderTimeout time.Duration,
+	policies []Policy,
+) *Replenisher {
+	return &Replenisher{
+		logger:       logger,
+		db:           db,
+		provider:     provider,
+		clock:        clock,
+		interval:     interval,
+		orderTimeout: orderTimeout,
+		policies:     policies,
+		pending:      map[int32]*models.PendingOrder{},
+	}
+}
+
+func (r *Replenisher) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	logger := r.logger.Session("replenisher")
+	logger.Info("starting", lager.Data{"policies": r.policies, "interval": r.interval.String(), "order-timeout": r.orderTimeout.String()})
+	defer logger.Info("exited")
+
+	ticker := r.clock.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	close(ready)
+
+	for {
+		select {
+		case <-ticker.C():
+			r.Replenish(logger)
+		case <-signals:
+			return nil
+		}
+	}
+}
+
+// Replenish runs a single pass. It is not safe to call concurrently with Run.
+// Without the recorded orders, it orders nothing rather than order them
+// again.
+func (r *Replenisher) Replenish(logger lager.Logger) {
+	logger = logger.Session("replenish")
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	orders, err := r.db.PendingOrders(logger)
+	if err != nil {
+		logger.Error("failed-to-fetch-pending-orders", err)
+		return
+	}
+	r.pending = map[int32]*models.PendingOrder{}
+	for _, order := range orders {
+		r.pending[order.Cid] = order
+	}
+
+	r.addProvisioned(logger)
+
+	for _, policy := range r.policies {
+		r.order(logger, policy)
+	}
+}
+
+// Pending returns the number of ordered VMs that are not in the pool yet.
+func (r *Replenisher) Pending() int {
+	return len(r.pending)
+}
+
+func (r *Replenisher) addProvisioned(logger lager.Logger) {
+	for cid, order := range r.pending {
+		if r.expired(order) {
+			r.giveUp(logger, order)
+			continue
+		}
+
+		vm, err := r.provider.ProvisionedVirtualGuest(logger, cid)
+		if err != nil {
+			logger.Error("failed-to-check-ordered-vm", err, lager.Data{"cid": cid})
+			continue
+		}
+		if vm == nil {
+			continue
+		}
+
+		vm.State = models.StateFree
+		vm.Pool = order.Pool
+		err = r.db.InsertVirtualGuestToPool(logger, vm)
+		if err != nil && !models.ErrResourceExists.Equal(err) {
+			logger.Error("failed-to-add-ordered-vm", err, lager.Data{"cid": cid})
+			continue
+		}
+
+		logger.Info("added-ordered-vm", lager.Data{"cid": cid})
+		r.forget(logger, cid)
+	}
+}
+
+func (r *Replenisher) expired(order *models.PendingOrder) bool {
+	return r.orderTimeout > 0 && r.clock.Since(order.OrderedAt) > r.orderTimeout
+}
+
+// giveUp cancels an order that took too long. Until the cancellation goes
+// through, it is tried again on every pass.
+func (r *Replenisher) giveUp(logger lager.Logger, order *models.PendingOrder) {
+	logger = logger.Session("give-up", lager.Data{"cid": order.Cid, "ordered-at": order.OrderedAt, "timeout": r.orderTimeout.String()})
+
+	err := r.provider.CancelVirtualGuest(logger, order.Cid)
+	if err != nil {
+		logger.Error("failed-to-cancel-ordered-vm", err)
+		return
+	}
+
+	logger.Info("cancelled-ordered-vm")
+	r.forget(logger, order.Cid)
+}
+
+func (r *Replenisher) forget(logger lager.Logger, cid int32) {
+	err := r.db.RemovePendingOrder(logger, cid)
+	if err != nil {
+		logger.Error("failed-to-remove-pending-order", err, lager.Data{"cid": cid})
+	}
+	delete(r.pending, cid)
+}
+
+func (r *Replenisher) order(logger lager.Logger, policy Policy) {
+	logger = logger.Session("order", lager.Data{"policy": policy})
+
+	free, err := r.db.VirtualGuests(logger, policy.Filter(models.StateFree))
+	if err != nil {
+		logger.Error("failed-to-count-free-vms", err)
+		return
+	}
+	all, err := r.db.VirtualGuests(logger, policy.Filter(""))
+	if err != nil {
+		logger.Error("failed-to-count-vms", err)
+		return
+	}
+
+	pending := 0
+	for _, order := range r.pending {
+		if policy.Orders(order) && !r.expired(order) {
+			pending++
+		}
+	}
+
+	count := policy.MinFree - len(free) - pending
+	if policy.MaxTotal > 0 {
+		if room := policy.MaxTotal - len(all) - pending; room < count {
+			count = room
+		}
+	}
+	if count <= 0 {
+		return
+	}
+
+	logger.Info("ordering-vms", lager.Data{"free": len(free), "total": len(all), "pending": pending, "count": count})
+	for i := 0; i < count; i++ {
+		cid, err := r.provider.OrderVirtualGuest(logger, policy)
+		if err != nil {
+			logger.Error("failed-to-order-vm", err)
+			return
+		}
+
+		order := policy.Order(cid, r.clock.Now())
+		r.pending[cid] = order
+		err = r.db.InsertPendingOrder(logger, order)
+		if err != nil {
+			// without its record the VM is not added once provisioned,
+			// the cid in the log is what it takes to add it by hand
+			logger.Error("failed-to-record-order", err, lager.Data{"cid": cid})
+			return
+		}
+	}
+}
@@ -0,0 +1,13 @@
+package replenisher_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestReplenisher(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Replenisher Suite")
+}
@@ -0,0 +1,319 @@
+package replenisher_test
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jianqiu/vps/db/dbfakes"
+	"github.com/jianqiu/vps/models"
+	"github.com/jianqiu/vps/replenisher"
+	"github.com/jianqiu/vps/replenisher/replenisherfakes"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/tedsuo/ifrit"
+)
+
+var _ = Describe("Replenisher", func() {
+	var (
+		logger       *lagertest.TestLogger
+		fakeDB       *dbfakes.FakeDB
+		fakeProvider *replenisherfakes.FakeProvider
+		policy       replenisher.Policy
+		r            *replenisher.Replenisher
+
+		freeVMs []*models.VM
+		allVMs  []*models.VM
+		nextCid int32
+		orders  map[int32]*models.PendingOrder
+	)
+
+	vms := func(n int) []*models.VM {
+		result := []*models.VM{}
+		for i := 0; i < n; i++ {
+			result = append(result, &models.VM{Cid: int32(i + 1)})
+		}
+		return result
+	}
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		fakeDB = new(dbfakes.FakeDB)
+		fakeProvider = new(replenisherfakes.FakeProvider)
+		policy = replenisher.Policy{CPU: 4, MemoryMb: 8192, PrivateVlan: 222, MinFree: 3, MaxTotal: 10}
+
+		freeVMs = vms(1)
+		allVMs = vms(5)
+		fakeDB.VirtualGuestsStub = func(_ lager.Logger, filter models.VMFilter) ([]*models.VM, error) {
+			if filter.State == models.StateFree {
+				return freeVMs, nil
+			}
+			return allVMs, nil
+		}
+
+		orders = map[int32]*models.PendingOrder{}
+		fakeDB.PendingOrdersStub = func(_ lager.Logger) ([]*models.PendingOrder, error) {
+			result := []*models.PendingOrder{}
+			for _, order := range orders {
+				result = append(result, order)
+			}
+			return result, nil
+		}
+		fakeDB.InsertPendingOrderStub = func(_ lager.Logger, order *models.PendingOrder) error {
+			orders[order.Cid] = order
+			return nil
+		}
+		fakeDB.RemovePendingOrderStub = func(_ lager.Logger, cid int32) error {
+			delete(orders, cid)
+			return nil
+		}
+
+		nextCid = 1000
+		fakeProvider.OrderVirtualGuestStub = func(_ lager.Logger, _ replenisher.Policy) (int32, error) {
+			nextCid++
+			return nextCid, nil
+		}
+	})
+
+	JustBeforeEach(func() {
+		r = replenisher.NewReplenisher(logger, fakeDB, fakeProvider, clock.NewClock(), 10*time.Millisecond, time.Hour, []replenisher.Policy{policy})
+	})
+
+	Describe("Replenish", func() {
+		JustBeforeEach(func() {
+			r.Replenish(logger)
+		})
+
+		It("counts free and total vms of the policy flavor", func() {
+			Expect(fakeDB.VirtualGuestsCallCount()).To(Equal(2))
+			_, freeFilter := fakeDB.VirtualGuestsArgsForCall(0)
+			Expect(freeFilter).To(Equal(models.VMFilter{Pool: models.DefaultPool, CPU: 4, MemoryMb: 8192, PrivateVlan: 222, State: models.StateFree}))
+			_, allFilter := fakeDB.VirtualGuestsArgsForCall(1)
+			Expect(allFilter).To(Equal(models.VMFilter{Pool: models.DefaultPool, CPU: 4, MemoryMb: 8192, PrivateVlan: 222}))
+		})
+
+		It("orders the missing free vms", func() {
+			Expect(fakeProvider.OrderVirtualGuestCallCount()).To(Equal(2))
+			_, orderedPolicy := fakeProvider.OrderVirtualGuestArgsForCall(0)
+			Expect(orderedPolicy).To(Equal(policy))
+			Expect(r.Pending()).To(Equal(2))
+		})
+
+		It("records the orders", func() {
+			Expect(fakeDB.InsertPendingOrderCallCount()).To(Equal(2))
+			_, order := fakeDB.InsertPendingOrderArgsForCall(0)
+			Expect(order.Cid).To(Equal(int32(1001)))
+			Expect(order.Pool).To(Equal(models.DefaultPool))
+			Expect(order.CPU).To(Equal(int32(4)))
+			Expect(order.MemoryMb).To(Equal(int32(8192)))
+			Expect(order.PrivateVlan).To(Equal(int32(222)))
+			Expect(order.OrderedAt).To(BeTemporally("~", time.Now(), time.Minute))
+		})
+
+		Context("when an order cannot be recorded", func() {
+			BeforeEach(func() {
+				fakeDB.InsertPendingOrderStub = nil
+				fakeDB.InsertPendingOrderReturns(errors.New("kaboom"))
+			})
+
+			It("stops ordering for this pass", func() {
+				Expect(fakeProvider.OrderVirtualGuestCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the recorded orders cannot be read", func() {
+			BeforeEach(func() {
+				fakeDB.PendingOrdersStub = nil
+				fakeDB.PendingOrdersReturns(nil, errors.New("kaboom"))
+			})
+
+			It("orders nothing", func() {
+				Expect(fakeProvider.OrderVirtualGuestCallCount()).To(Equal(0))
+				Expect(fakeProvider.ProvisionedVirtualGuestCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when orders were recorded before", func() {
+			BeforeEach(func() {
+				orders[900] = policy.Order(900, time.Now().Add(-time.Minute))
+				orders[901] = replenisher.Policy{CPU: 2, MemoryMb: 2048}.Order(901, time.Now().Add(-time.Minute))
+			})
+
+			It("counts those of the policy as pending", func() {
+				Expect(fakeProvider.OrderVirtualGuestCallCount()).To(Equal(1))
+				Expect(r.Pending()).To(Equal(3))
+			})
+
+			It("checks whether they have been provisioned", func() {
+				Expect(fakeProvider.ProvisionedVirtualGuestCallCount()).To(Equal(2))
+			})
+		})
+
+		Context("when an order has been pending for longer than the order timeout", func() {
+			BeforeEach(func() {
+				orders[900] = policy.Order(900, time.Now().Add(-2*time.Hour))
+			})
+
+			It("cancels it and orders another vm in its place", func() {
+				Expect(fakeProvider.CancelVirtualGuestCallCount()).To(Equal(1))
+				_, cid := fakeProvider.CancelVirtualGuestArgsForCall(0)
+				Expect(cid).To(Equal(int32(900)))
+				Expect(fakeProvider.ProvisionedVirtualGuestCallCount()).To(Equal(0))
+
+				Expect(fakeDB.RemovePendingOrderCallCount()).To(Equal(1))
+				Expect(orders).NotTo(HaveKey(int32(900)))
+				Expect(fakeProvider.OrderVirtualGuestCallCount()).To(Equal(2))
+			})
+
+			Context("and it cannot be cancelled", func() {
+				BeforeEach(func() {
+					fakeProvider.CancelVirtualGuestReturns(errors.New("kaboom"))
+				})
+
+				It("keeps it to cancel on the next pass without counting it against the policy", func() {
+					Expect(orders).To(HaveKey(int32(900)))
+					Expect(fakeProvider.OrderVirtualGuestCallCount()).To(Equal(2))
+				})
+			})
+		})
+
+		Context("when the pool already has enough free vms", func() {
+			BeforeEach(func() {
+				freeVMs = vms(3)
+			})
+
+			It("orders nothing", func() {
+				Expect(fakeProvider.OrderVirtualGuestCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when ordering would exceed the maximum total", func() {
+			BeforeEach(func() {
+				allVMs = vms(9)
+			})
+
+			It("only orders up to the maximum", func() {
+				Expect(fakeProvider.OrderVirtualGuestCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when counting the vms fails", func() {
+			BeforeEach(func() {
+				fakeDB.VirtualGuestsStub = nil
+				fakeDB.VirtualGuestsReturns(nil, errors.New("kaboom"))
+			})
+
+			It("orders nothing", func() {
+				Expect(fakeProvider.OrderVirtualGuestCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when an order fails", func() {
+			BeforeEach(func() {
+				fakeProvider.OrderVirtualGuestStub = nil
+				fakeProvider.OrderVirtualGuestReturns(0, errors.New("kaboom"))
+			})
+
+			It("stops ordering for this pass", func() {
+				Expect(fakeProvider.OrderVirtualGuestCallCount()).To(Equal(1))
+				Expect(r.Pending()).To(Equal(0))
+			})
+		})
+
+		Context("on the next pass", func() {
+			var provisioned *models.VM
+
+			BeforeEach(func() {
+				provisioned = nil
+				fakeProvider.ProvisionedVirtualGuestStub = func(_ lager.Logger, cid int32) (*models.VM, error) {
+					if cid == 1001 {
+						return provisioned, nil
+					}
+					return nil, nil
+				}
+			})
+
+			JustBeforeEach(func() {
+				r.Replenish(logger)
+			})
+
+			It("counts pending orders and does not order again", func() {
+				Expect(fakeProvider.OrderVirtualGuestCallCount()).To(Equal(2))
+				Expect(fakeDB.InsertVirtualGuestToPoolCallCount()).To(Equal(0))
+			})
+
+			Context("when an ordered vm has been provisioned", func() {
+				BeforeEach(func() {
+					provisioned = &models.VM{Cid: 1001, CPU: 4, MemoryMb: 8192, PrivateVlan: 222, IP: "10.0.0.5"}
+				})
+
+				It("adds it to the pool as free", func() {
+					Expect(fakeDB.InsertVirtualGuestToPoolCallCount()).To(Equal(1))
+					_, vm := fakeDB.InsertVirtualGuestToPoolArgsForCall(0)
+					Expect(vm.Cid).To(Equal(int32(1001)))
+					Expect(vm.State).To(Equal(models.StateFree))
+					Expect(r.Pending()).To(Equal(2))
+				})
+
+				Context("for a policy of another pool", func() {
+					BeforeEach(func() {
+						policy.Pool = "staging"
+					})
+
+					It("adds it to that pool", func() {
+						_, vm := fakeDB.InsertVirtualGuestToPoolArgsForCall(0)
+						Expect(vm.Pool).To(Equal("staging"))
+
+						_, freeFilter := fakeDB.VirtualGuestsArgsForCall(0)
+						Expect(freeFilter.Pool).To(Equal("staging"))
+					})
+				})
+
+				Context("and adding it fails", func() {
+					BeforeEach(func() {
+						fakeDB.InsertVirtualGuestToPoolReturns(errors.New("kaboom"))
+					})
+
+					It("keeps it pending", func() {
+						Expect(r.Pending()).To(Equal(2))
+						Expect(fakeProvider.OrderVirtualGuestCallCount()).To(Equal(2))
+					})
+				})
+
+				Context("and it is already in the pool", func() {
+					BeforeEach(func() {
+						fakeDB.InsertVirtualGuestToPoolReturns(models.ErrResourceExists)
+					})
+
+					It("stops tracking it", func() {
+						Expect(r.Pending()).To(Equal(2))
+						Expect(fakeProvider.OrderVirtualGuestCallCount()).To(Equal(3))
+					})
+				})
+			})
+		})
+	})
+
+	Describe("Run", func() {
+		var process ifrit.Process
+
+		JustBeforeEach(func() {
+			process = ifrit.Invoke(r)
+		})
+
+		AfterEach(func() {
+			process.Signal(os.Interrupt)
+			Eventually(process.Wait()).Should(Receive(BeNil()))
+		})
+
+		It("replenishes on every tick", func() {
+			Eventually(fakeProvider.OrderVirtualGuestCallCount).Should(Equal(2))
+			Eventually(fakeProvider.ProvisionedVirtualGuestCallCount).Should(BeNumerically(">=", 2))
+		})
+	})
+})
@@ -0,0 +1,174 @@
+// This file was generated by counterfeiter
+package replenisherfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/models"
+	"github.com/jianqiu/vps/replenisher"
+)
+
+type FakeProvider struct {
+	OrderVirtualGuestStub        func(logger lager.Logger, policy replenisher.Policy) (int32, error)
+	orderVirtualGuestMutex       sync.RWMutex
+	orderVirtualGuestArgsForCall []struct {
+		logger lager.Logger
+		policy replenisher.Policy
+	}
+	orderVirtualGuestReturns struct {
+		result1 int32
+		result2 error
+	}
+	ProvisionedVirtualGuestStub        func(logger lager.Logger, cid int32) (*models.VM, error)
+	provisionedVirtualGuestMutex       sync.RWMutex
+	provisionedVirtualGuestArgsForCall []struct {
+		logger lager.Logger
+		cid    int32
+	}
+	provisionedVirtualGuestReturns struct {
+		result1 *models.VM
+		result2 error
+	}
+	CancelVirtualGuestStub        func(logger lager.Logger, cid int32) error
+	cancelVirtualGuestMutex       sync.RWMutex
+	cancelVirtualGuestArgsForCall []struct {
+		logger lager.Logger
+		cid    int32
+	}
+	cancelVirtualGuestReturns struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeProvider) OrderVirtualGuest(logger lager.Logger, policy replenisher.Policy) (int32, error) {
+	fake.orderVirtualGuestMutex.Lock()
+	fake.orderVirtualGuestArgsForCall = append(fake.orderVirtualGuestArgsForCall, struct {
+		logger lager.Logger
+		policy replenisher.Policy
+	}{logger, policy})
+	fake.recordInvocation("OrderVirtualGuest", []interface{}{logger, policy})
+	fake.orderVirtualGuestMutex.Unlock()
+	if fake.OrderVirtualGuestStub != nil {
+		return fake.OrderVirtualGuestStub(logger, policy)
+	} else {
+		return fake.orderVirtualGuestReturns.result1, fake.orderVirtualGuestReturns.result2
+	}
+}
+
+func (fake *FakeProvider) OrderVirtualGuestCallCount() int {
+	fake.orderVirtualGuestMutex.RLock()
+	defer fake.orderVirtualGuestMutex.RUnlock()
+	return len(fake.orderVirtualGuestArgsForCall)
+}
+
+func (fake *FakeProvider) OrderVirtualGuestArgsForCall(i int) (lager.Logger, replenisher.Policy) {
+	fake.orderVirtualGuestMutex.RLock()
+	defer fake.orderVirtualGuestMutex.RUnlock()
+	return fake.orderVirtualGuestArgsForCall[i].logger, fake.orderVirtualGuestArgsForCall[i].policy
+}
+
+func (fake *FakeProvider) OrderVirtualGuestReturns(result1 int32, result2 error) {
+	fake.OrderVirtualGuestStub = nil
+	fake.orderVirtualGuestReturns = struct {
+		result1 int32
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProvider) ProvisionedVirtualGuest(logger lager.Logger, cid int32) (*models.VM, error) {
+	fake.provisionedVirtualGuestMutex.Lock()
+	fake.provisionedVirtualGuestArgsForCall = append(fake.provisionedVirtualGuestArgsForCall, struct {
+		logger lager.Logger
+		cid    int32
+	}{logger, cid})
+	fake.recordInvocation("ProvisionedVirtualGuest", []interface{}{logger, cid})
+	fake.provisionedVirtualGuestMutex.Unlock()
+	if fake.ProvisionedVirtualGuestStub != nil {
+		return fake.ProvisionedVirtualGuestStub(logger, cid)
+	} else {
+		return fake.provisionedVirtualGuestReturns.result1, fake.provisionedVirtualGuestReturns.result2
+	}
+}
+
+func (fake *FakeProvider) ProvisionedVirtualGuestCallCount() int {
+	fake.provisionedVirtualGuestMutex.RLock()
+	defer fake.provisionedVirtualGuestMutex.RUnlock()
+	return len(fake.provisionedVirtualGuestArgsForCall)
+}
+
+func (fake *FakeProvider) ProvisionedVirtualGuestArgsForCall(i int) (lager.Logger, int32) {
+	fake.provisionedVirtualGuestMutex.RLock()
+	defer fake.provisionedVirtualGuestMutex.RUnlock()
+	return fake.provisionedVirtualGuestArgsForCall[i].logger, fake.provisionedVirtualGuestArgsForCall[i].cid
+}
+
+func (fake *FakeProvider) ProvisionedVirtualGuestReturns(result1 *models.VM, result2 error) {
+	fake.ProvisionedVirtualGuestStub = nil
+	fake.provisionedVirtualGuestReturns = struct {
+		result1 *models.VM
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeProvider) CancelVirtualGuest(logger lager.Logger, cid int32) error {
+	fake.cancelVirtualGuestMutex.Lock()
+	fake.cancelVirtualGuestArgsForCall = append(fake.cancelVirtualGuestArgsForCall, struct {
+		logger lager.Logger
+		cid    int32
+	}{logger, cid})
+	fake.recordInvocation("CancelVirtualGuest", []interface{}{logger, cid})
+	fake.cancelVirtualGuestMutex.Unlock()
+	if fake.CancelVirtualGuestStub != nil {
+		return fake.CancelVirtualGuestStub(logger, cid)
+	} else {
+		return fake.cancelVirtualGuestReturns.result1
+	}
+}
+
+func (fake *FakeProvider) CancelVirtualGuestCallCount() int {
+	fake.cancelVirtualGuestMutex.RLock()
+	defer fake.cancelVirtualGuestMutex.RUnlock()
+	return len(fake.cancelVirtualGuestArgsForCall)
+}
+
+func (fake *FakeProvider) CancelVirtualGuestArgsForCall(i int) (lager.Logger, int32) {
+	fake.cancelVirtualGuestMutex.RLock()
+	defer fake.cancelVirtualGuestMutex.RUnlock()
+	return fake.cancelVirtualGuestArgsForCall[i].logger, fake.cancelVirtualGuestArgsForCall[i].cid
+}
+
+func (fake *FakeProvider) CancelVirtualGuestReturns(result1 error) {
+	fake.CancelVirtualGuestStub = nil
+	fake.cancelVirtualGuestReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeProvider) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.orderVirtualGuestMutex.RLock()
+	defer fake.orderVirtualGuestMutex.RUnlock()
+	fake.provisionedVirtualGuestMutex.RLock()
+	defer fake.provisionedVirtualGuestMutex.RUnlock()
+	fake.cancelVirtualGuestMutex.RLock()
+	defer fake.cancelVirtualGuestMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeProvider) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ replenisher.Provider = new(FakeProvider)
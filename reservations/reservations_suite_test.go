@@ -0,0 +1,13 @@
+package reservations_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestReservations(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Reservations Suite")
+}
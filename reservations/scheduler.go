@@ -0,0 +1,98 @@
+package reservations
+
+import (
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/db"
+	"github.com/jianqiu/vps/models"
+)
+
+// Scheduler reserves the VMs of scheduled reservations once they start and
+// frees the VMs of reservations that have expired. Until it gets to an
+// expired reservation, its deployment's orders may still claim the reserved
+// VMs.
+type Scheduler struct {
+	logger   lager.Logger
+	db       db.ReservationDB
+	clock    clock.Clock
+	interval time.Duration
+}
+
+func NewScheduler(
+	logger lager.Logger,
+	db db.ReservationDB,
+	clock clock.Clock,
+	interval time.Duration,
+) *Scheduler {
+	return &Scheduler{
+		logger:   logger,
+		db:       db,
+		clock:    clock,
+		interval: interval,
+	}
+}
+
+func (s *Scheduler) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	logger := s.logger.Session("reservation-scheduler")
+	logger.Info("starting", lager.Data{"interval": s.interval.String()})
+	defer logger.Info("exited")
+
+	ticker := s.clock.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	close(ready)
+
+	for {
+		select {
+		case <-ticker.C():
+			s.Schedule(logger)
+		case <-signals:
+			return nil
+		}
+	}
+}
+
+// Schedule runs a single pass. A reservation that started and expired
+// since the last pass is released without ever holding VMs.
+func (s *Scheduler) Schedule(logger lager.Logger) {
+	logger = logger.Session("schedule")
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	reservations, err := s.db.Reservations(logger)
+	if err != nil {
+		logger.Error("failed-to-fetch-reservations", err)
+		return
+	}
+
+	now := s.clock.Now()
+	for _, reservation := range reservations {
+		switch {
+		case !time.Time(reservation.ExpiresAt).After(now):
+			s.release(logger, reservation)
+		case reservation.Status == models.ReservationStatusScheduled && !time.Time(reservation.StartsAt).After(now):
+			s.activate(logger, reservation)
+		}
+	}
+}
+
+func (s *Scheduler) activate(logger lager.Logger, reservation *models.Reservation) {
+	err := s.db.ActivateReservation(logger, reservation.ID)
+	if err != nil && !models.ConvertError(err).Equal(models.ErrResourceNotFound) {
+		logger.Error("failed-to-activate-reservation", err, lager.Data{"id": reservation.ID})
+		return
+	}
+	logger.Info("activated-reservation", lager.Data{"id": reservation.ID, "deployment": reservation.DeploymentName})
+}
+
+func (s *Scheduler) release(logger lager.Logger, reservation *models.Reservation) {
+	err := s.db.ReleaseReservation(logger, reservation.ID)
+	if err != nil && !models.ConvertError(err).Equal(models.ErrResourceNotFound) {
+		logger.Error("failed-to-release-reservation", err, lager.Data{"id": reservation.ID})
+		return
+	}
+	logger.Info("released-reservation", lager.Data{"id": reservation.ID, "deployment": reservation.DeploymentName, "unclaimed": len(reservation.Cids)})
+}
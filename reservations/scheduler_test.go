@@ -0,0 +1,105 @@
+package reservations_test
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jianqiu/vps/db/dbfakes"
+	"github.com/jianqiu/vps/models"
+	"github.com/jianqiu/vps/reservations"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/go-openapi/strfmt"
+	"github.com/tedsuo/ifrit"
+)
+
+var _ = Describe("Scheduler", func() {
+	var (
+		logger            *lagertest.TestLogger
+		fakeReservationDB *dbfakes.FakeReservationDB
+		scheduler         *reservations.Scheduler
+	)
+
+	reservation := func(id string, status models.ReservationStatus, startsIn, expiresIn time.Duration) *models.Reservation {
+		return &models.Reservation{
+			ID:             id,
+			DeploymentName: "cf",
+			Status:         status,
+			StartsAt:       strfmt.DateTime(time.Now().Add(startsIn)),
+			ExpiresAt:      strfmt.DateTime(time.Now().Add(expiresIn)),
+		}
+	}
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		fakeReservationDB = new(dbfakes.FakeReservationDB)
+		scheduler = reservations.NewScheduler(logger, fakeReservationDB, clock.NewClock(), 10*time.Millisecond)
+
+		fakeReservationDB.ReservationsReturns([]*models.Reservation{
+			reservation("expired", models.ReservationStatusActive, -time.Hour, -time.Minute),
+			reservation("current", models.ReservationStatusActive, -time.Hour, time.Hour),
+			reservation("also-expired", models.ReservationStatusActive, -time.Hour, -time.Second),
+			reservation("started", models.ReservationStatusScheduled, -time.Second, time.Hour),
+			reservation("later", models.ReservationStatusScheduled, time.Hour, 2*time.Hour),
+			reservation("missed", models.ReservationStatusScheduled, -time.Hour, -time.Minute),
+		}, nil)
+	})
+
+	Describe("Schedule", func() {
+		It("releases the expired reservations only", func() {
+			scheduler.Schedule(logger)
+
+			Expect(fakeReservationDB.ReleaseReservationCallCount()).To(Equal(3))
+			_, first := fakeReservationDB.ReleaseReservationArgsForCall(0)
+			_, second := fakeReservationDB.ReleaseReservationArgsForCall(1)
+			_, third := fakeReservationDB.ReleaseReservationArgsForCall(2)
+			Expect([]string{first, second, third}).To(Equal([]string{"expired", "also-expired", "missed"}))
+		})
+
+		It("activates the scheduled reservations that started", func() {
+			scheduler.Schedule(logger)
+
+			Expect(fakeReservationDB.ActivateReservationCallCount()).To(Equal(1))
+			_, id := fakeReservationDB.ActivateReservationArgsForCall(0)
+			Expect(id).To(Equal("started"))
+		})
+
+		It("moves on when releasing a reservation fails", func() {
+			fakeReservationDB.ReleaseReservationReturns(errors.New("kaboom"))
+
+			scheduler.Schedule(logger)
+			Expect(fakeReservationDB.ReleaseReservationCallCount()).To(Equal(3))
+			Expect(fakeReservationDB.ActivateReservationCallCount()).To(Equal(1))
+		})
+
+		It("does nothing when the reservations cannot be read", func() {
+			fakeReservationDB.ReservationsReturns(nil, models.ErrDeadlock)
+
+			scheduler.Schedule(logger)
+			Expect(fakeReservationDB.ReleaseReservationCallCount()).To(Equal(0))
+			Expect(fakeReservationDB.ActivateReservationCallCount()).To(Equal(0))
+		})
+	})
+
+	Describe("Run", func() {
+		var process ifrit.Process
+
+		JustBeforeEach(func() {
+			process = ifrit.Invoke(scheduler)
+		})
+
+		AfterEach(func() {
+			process.Signal(os.Interrupt)
+			Eventually(process.Wait()).Should(Receive(BeNil()))
+		})
+
+		It("schedules on every tick", func() {
+			Eventually(fakeReservationDB.ReservationsCallCount).Should(BeNumerically(">=", 2))
+		})
+	})
+})
@@ -0,0 +1,103 @@
+package accesslog
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/vpslager"
+	"github.com/nu7hatch/gouuid"
+)
+
+// RequestIDHeader carries the id that correlates the logs of a request. An id
+// sent by the client is kept, otherwise one is generated; either way it is
+// returned in the response.
+const RequestIDHeader = "X-Request-Id"
+
+const maxRequestIDLength = 128
+
+type entry struct {
+	operation string
+}
+
+type entryKey struct{}
+
+type handler struct {
+	logger  lager.Logger
+	clock   clock.Clock
+	handler http.Handler
+}
+
+// NewHandler wraps handler so that every request gets a request id and a
+// logger session of its own, available to the handlers through
+// vpslager.FromContext, and is written to the access log once served.
+func NewHandler(logger lager.Logger, clock clock.Clock, next http.Handler) http.Handler {
+	return &handler{
+		logger:  logger,
+		clock:   clock,
+		handler: next,
+	}
+}
+
+// SetOperation records the id of the operation r was routed to, for the
+// access log.
+func SetOperation(r *http.Request, operationID string) {
+	if e, ok := r.Context().Value(entryKey{}).(*entry); ok {
+		e.operation = operationID
+	}
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := h.clock.Now()
+	method, path := r.Method, r.URL.Path
+
+	requestID := r.Header.Get(RequestIDHeader)
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		guid, err := uuid.NewV4()
+		if err != nil {
+			h.logger.Error("failed-to-generate-request-id", err)
+		} else {
+			requestID = guid.String()
+		}
+	}
+	w.Header().Set(RequestIDHeader, requestID)
+
+	logger := h.logger.Session("request", lager.Data{"request-id": requestID})
+	e := &entry{}
+	ctx := context.WithValue(r.Context(), entryKey{}, e)
+	ctx = vpslager.NewContext(ctx, logger)
+
+	recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+	h.handler.ServeHTTP(recorder, r.WithContext(ctx))
+
+	user, _, _ := r.BasicAuth()
+	logger.Info("served", lager.Data{
+		"method":     method,
+		"path":       path,
+		"operation":  e.operation,
+		"user":       user,
+		"status":     recorder.status,
+		"latency-ms": float64(h.clock.Since(start)) / float64(time.Millisecond),
+	})
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	if !r.wroteHeader {
+		r.status = status
+		r.wroteHeader = true
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	r.wroteHeader = true
+	return r.ResponseWriter.Write(b)
+}
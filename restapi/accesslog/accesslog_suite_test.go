@@ -0,0 +1,13 @@
+package accesslog_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestAccesslog(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Accesslog Suite")
+}
@@ -0,0 +1,88 @@
+package accesslog_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jianqiu/vps/restapi/accesslog"
+	"github.com/jianqiu/vps/vpslager"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagertest"
+)
+
+var _ = Describe("Handler", func() {
+	var (
+		logger        *lagertest.TestLogger
+		request       *http.Request
+		recorder      *httptest.ResponseRecorder
+		handlerLogger lager.Logger
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		recorder = httptest.NewRecorder()
+		handlerLogger = nil
+
+		var err error
+		request, err = http.NewRequest("GET", "/v2/vms/1234567", nil)
+		Expect(err).NotTo(HaveOccurred())
+		request.SetBasicAuth("admin", "pass")
+	})
+
+	JustBeforeEach(func() {
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			accesslog.SetOperation(r, "getVMByCid")
+			r.URL.Path = "/vms/1234567"
+			handlerLogger = vpslager.FromContext(r.Context(), nil)
+			handlerLogger.Info("handling")
+			w.WriteHeader(http.StatusNotFound)
+		})
+		accesslog.NewHandler(logger, clock.NewClock(), next).ServeHTTP(recorder, request)
+	})
+
+	It("generates a request id and returns it", func() {
+		requestID := recorder.Header().Get(accesslog.RequestIDHeader)
+		Expect(requestID).To(HaveLen(36))
+	})
+
+	It("hands the request a logger session carrying the request id", func() {
+		Expect(handlerLogger).NotTo(BeNil())
+		Expect(handlerLogger.SessionName()).To(Equal("test.request"))
+
+		logs := logger.Logs()
+		Expect(logs).To(HaveLen(2))
+		Expect(logs[0].Message).To(Equal("test.request.handling"))
+		Expect(logs[0].Data["request-id"]).To(Equal(recorder.Header().Get(accesslog.RequestIDHeader)))
+	})
+
+	It("writes an access log entry once the request is served", func() {
+		logs := logger.Logs()
+		Expect(logs).To(HaveLen(2))
+
+		entry := logs[1]
+		Expect(entry.Message).To(Equal("test.request.served"))
+		Expect(entry.Data["request-id"]).To(Equal(recorder.Header().Get(accesslog.RequestIDHeader)))
+		Expect(entry.Data["method"]).To(Equal("GET"))
+		Expect(entry.Data["path"]).To(Equal("/v2/vms/1234567"))
+		Expect(entry.Data["operation"]).To(Equal("getVMByCid"))
+		Expect(entry.Data["user"]).To(Equal("admin"))
+		Expect(entry.Data["status"]).To(BeEquivalentTo(http.StatusNotFound))
+		Expect(entry.Data).To(HaveKey("latency-ms"))
+	})
+
+	Context("when the client sends a request id", func() {
+		BeforeEach(func() {
+			request.Header.Set(accesslog.RequestIDHeader, "abc-123")
+		})
+
+		It("keeps it", func() {
+			Expect(recorder.Header().Get(accesslog.RequestIDHeader)).To(Equal("abc-123"))
+			Expect(logger.Logs()[1].Data["request-id"]).To(Equal("abc-123"))
+		})
+	})
+})
@@ -0,0 +1,268 @@
+package restapi
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/consuladapter"
+	"github.com/hashicorp/consul/api"
+	flags "github.com/jessevdk/go-flags"
+	"golang.org/x/crypto/bcrypt"
+	"gopkg.in/yaml.v2"
+
+	"github.com/jianqiu/vps/controllers"
+	"github.com/jianqiu/vps/db/sqldb"
+	"github.com/jianqiu/vps/vpslager"
+)
+
+const configFlag = "config"
+
+// ConfigFileArgs puts the settings of the config file that args name with
+// --config in front of args, as flags, so that parsing the result applies
+// the file first and the flags given on the command line on top of it. The
+// settings of the file are named after the long flags; one repeatable on
+// the command line takes a list. A repeatable flag given on the command
+// line replaces the list of the file instead of adding to it. Switches,
+// the flags that take no value, are off unless turned on, so false leaves
+// them off; one that is on before the args are parsed cannot be turned off
+// and is refused.
+func ConfigFileArgs(parser *flags.Parser, args []string) ([]string, error) {
+	names := commandLineNames(args)
+	path, ok := names[configFlag]
+	if !ok {
+		return args, nil
+	}
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading config file: %s", err)
+	}
+
+	// JSON is YAML as well
+	settings := map[string]interface{}{}
+	err = yaml.Unmarshal(content, &settings)
+	if err != nil {
+		return nil, fmt.Errorf("parsing config file %s: %s", path, err)
+	}
+
+	keys := make([]string, 0, len(settings))
+	for key := range settings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	fileArgs := []string{}
+	for _, key := range keys {
+		option := parser.FindOptionByLongName(key)
+		if key == configFlag || option == nil {
+			return nil, fmt.Errorf("config file %s: unknown setting %s", path, key)
+		}
+		if _, given := names[key]; given {
+			continue
+		}
+
+		switch value := settings[key].(type) {
+		case nil:
+		case bool:
+			on, isSwitch := option.Value().(bool)
+			switch {
+			case !isSwitch:
+				fileArgs = append(fileArgs, fmt.Sprintf("--%s=%t", key, value))
+			case value:
+				fileArgs = append(fileArgs, "--"+key)
+			case on:
+				// a switch can only be turned on, so false would be ignored
+				return nil, fmt.Errorf("config file %s: %s is on already and cannot be switched off", path, key)
+			}
+		case []interface{}:
+			for _, v := range value {
+				fileArgs = append(fileArgs, fmt.Sprintf("--%s=%v", key, v))
+			}
+		case map[interface{}]interface{}:
+			return nil, fmt.Errorf("config file %s: %s must be a value or a list", path, key)
+		default:
+			fileArgs = append(fileArgs, fmt.Sprintf("--%s=%v", key, value))
+		}
+	}
+
+	return append(fileArgs, args...), nil
+}
+
+// commandLineNames returns the long flags in args along with the last value
+// given to each, where the value is part of the argument or follows it.
+func commandLineNames(args []string) map[string]string {
+	names := map[string]string{}
+	for i, arg := range args {
+		if arg == "--" {
+			break
+		}
+		if !strings.HasPrefix(arg, "--") {
+			continue
+		}
+
+		name := strings.TrimPrefix(arg, "--")
+		if parts := strings.SplitN(name, "=", 2); len(parts) == 2 {
+			names[parts[0]] = parts[1]
+		} else if i+1 < len(args) && !strings.HasPrefix(args[i+1], "-") {
+			names[name] = args[i+1]
+		} else {
+			names[name] = ""
+		}
+	}
+	return names
+}
+
+// Validate checks the settings together, once they are parsed, and reports
+// every problem at once.
+func (s *Server) Validate() error {
+	problems := []string{}
+	check := func(ok bool, format string, args ...interface{}) {
+		if !ok {
+			problems = append(problems, fmt.Sprintf(format, args...))
+		}
+	}
+
+	check(s.DBDriver == "mysql" || s.DBDriver == "postgres", "databaseDriver must be mysql or postgres")
+	check(s.DBConn != "", "databaseConnectionString is required")
+	check(s.DBRetryAttempts > 0, "databaseRetryAttempts must be positive")
+	check(s.DBRetryInitialBackoff >= 0, "databaseRetryInitialBackoff cannot be negative")
+	check(s.DBRetryMaxBackoff >= s.DBRetryInitialBackoff, "databaseRetryMaxBackoff cannot be shorter than databaseRetryInitialBackoff")
+	check(s.DBRetryJitter >= 0 && s.DBRetryJitter <= 1, "databaseRetryJitter must be between 0 and 1")
+	if _, err := sqldb.ParseIsolationLevels(s.DBIsolationLevels); err != nil {
+		problems = append(problems, "databaseIsolationLevel: "+err.Error())
+	}
+
+	if _, err := vpslager.ParseLogLevel(s.LogLevel); err != nil {
+		problems = append(problems, "logLevel must be debug, info, error or fatal")
+	}
+
+	for name, interval := range map[string]time.Duration{
+		"reloadPollInterval":      s.ReloadPollInterval,
+		"reloadTimeout":           s.ReloadTimeout,
+		"replenishInterval":       s.ReplenishInterval,
+		"reapInterval":            s.ReapInterval,
+		"reservationInterval":     s.ReservationInterval,
+		"settingsPollInterval":    s.SettingsPollInterval,
+		"webhookDeliveryInterval": s.WebhookDeliveryInterval,
+		"webhookTimeout":          s.WebhookTimeout,
+		"lockRetryInterval":       s.LockRetryInterval,
+		"grpcWatchInterval":       s.GRPCWatchInterval,
+	} {
+		check(interval > 0, "%s must be positive", name)
+	}
+	check(s.ReplenishOrderTimeout >= 0, "replenishOrderTimeout cannot be negative")
+	check(s.WebhookMaxAttempts > 0, "webhookMaxAttempts must be positive")
+	check(s.WebhookMaxBackoff >= s.WebhookInitialBackoff, "webhookMaxBackoff cannot be shorter than webhookInitialBackoff")
+	check(s.LockTTL > s.LockRetryInterval, "lockTTL must be longer than lockRetryInterval")
+	check(s.GRPCPort >= 0 && s.GRPCPort <= 65535, "grpcPort must be between 0 and 65535")
+	if s.GRPCPort != 0 && !s.GRPCInsecure {
+		check(s.TLSCertificate != "" && s.TLSCertificateKey != "", "grpcPort needs a tls-certificate and tls-key, or grpcInsecure")
+	}
+
+	// ordering, reloading and cancelling vms all go through the SoftLayer API
+	if s.ReleaseMode == "reload" || s.ReplenishmentPolicyFile != "" || s.ReaperPolicyFile != "" {
+		check(s.SoftLayerUsername != "" && s.SoftLayerAPIKey != "",
+			"softlayerUsername and softlayerAPIKey are required with releaseMode reload, a replenishmentPolicyFile or a reaperPolicyFile")
+	}
+
+	check((s.TLSCertificate == "") == (s.TLSCertificateKey == ""), "tls-certificate and tls-key go together")
+
+	if s.AdminPasswordHash != "" {
+		check(s.AdminUsername != "", "adminPasswordHash needs an adminUsername")
+		_, err := bcrypt.Cost([]byte(s.AdminPasswordHash))
+		check(err == nil, "adminPasswordHash must be a bcrypt hash")
+	}
+
+	if _, err := s.KeyManager(); err != nil {
+		problems = append(problems, err.Error())
+	}
+	check(!s.ReEncrypt || len(s.EncryptionKeys) > 0, "reEncrypt needs an encryptionKey")
+
+	if s.ConsulCluster != "" {
+		if _, _, err := consuladapter.Parse(s.ConsulCluster); err != nil {
+			problems = append(problems, "consulCluster: "+err.Error())
+		}
+		check(s.ConsulServiceName != "", "consulServiceName is required with a consulCluster")
+		check(s.ConsulCheckTTL > 0, "consulCheckTTL must be positive")
+		check(s.hasScheme(schemeHTTP) || s.hasScheme(schemeHTTPS), "consulCluster needs an http or https listener")
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+	sort.Strings(problems)
+	return fmt.Errorf("invalid configuration:\n  %s", strings.Join(problems, "\n  "))
+}
+
+// KeyManager builds the keys the vm secrets are encrypted with from the
+// encryptionKey and activeKeyLabel settings, or returns nil when no key is
+// given.
+func (s *Server) KeyManager() (encryption.KeyManager, error) {
+	if len(s.EncryptionKeys) == 0 {
+		if s.ActiveKeyLabel != "" {
+			return nil, fmt.Errorf("activeKeyLabel needs an encryptionKey")
+		}
+		return nil, nil
+	}
+
+	var active encryption.Key
+	keys := make([]encryption.Key, 0, len(s.EncryptionKeys))
+	for _, labelled := range s.EncryptionKeys {
+		parts := strings.SplitN(labelled, ":", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("encryptionKey must be label:passphrase")
+		}
+		key, err := encryption.NewKey(parts[0], parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("encryptionKey %s: %s", parts[0], err)
+		}
+		keys = append(keys, key)
+		if parts[0] == s.ActiveKeyLabel {
+			active = key
+		}
+	}
+	if active == nil {
+		return nil, fmt.Errorf("activeKeyLabel must be the label of an encryptionKey")
+	}
+
+	return encryption.NewKeyManager(active, keys)
+}
+
+// AdminCredentials are the credentials of the administrator, from the
+// adminUsername and adminPasswordHash settings.
+func (s *Server) AdminCredentials() controllers.AdminCredentials {
+	return controllers.AdminCredentials{
+		Username:     s.AdminUsername,
+		PasswordHash: s.AdminPasswordHash,
+	}
+}
+
+// ConsulRegistration describes the api as the Consul service with id, at
+// the port of its http listener or, without one, of its https listener.
+// Random ports are only known once the server listens.
+func (s *Server) ConsulRegistration(id string) api.AgentServiceRegistration {
+	scheme, host, port := schemeHTTP, s.Host, s.Port
+	if !s.hasScheme(schemeHTTP) {
+		scheme, host, port = schemeHTTPS, s.TLSHost, s.TLSPort
+	}
+
+	if host == "" {
+		host = s.Host
+	}
+	address := s.ConsulServiceAddress
+	if address == "" {
+		address = host
+	}
+
+	return api.AgentServiceRegistration{
+		ID:      id,
+		Name:    s.ConsulServiceName,
+		Address: address,
+		Port:    port,
+		Tags:    []string{scheme},
+	}
+}
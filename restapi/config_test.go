@@ -0,0 +1,294 @@
+package restapi_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/hashicorp/consul/api"
+	flags "github.com/jessevdk/go-flags"
+	"github.com/jianqiu/vps/restapi"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var _ = Describe("Config", func() {
+	var (
+		server *restapi.Server
+		parser *flags.Parser
+		dir    string
+	)
+
+	BeforeEach(func() {
+		server = restapi.NewServer(nil)
+		parser = flags.NewParser(server, flags.PassDoubleDash)
+
+		var err error
+		dir, err = ioutil.TempDir("", "vps-config")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	configFile := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		Expect(ioutil.WriteFile(path, []byte(content), 0600)).To(Succeed())
+		return path
+	}
+
+	parse := func(args ...string) error {
+		args, err := restapi.ConfigFileArgs(parser, args)
+		if err != nil {
+			return err
+		}
+		_, err = parser.ParseArgs(args)
+		return err
+	}
+
+	Describe("ConfigFileArgs", func() {
+		It("leaves the args alone without a config file", func() {
+			args, err := restapi.ConfigFileArgs(parser, []string{"--logLevel", "info"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(args).To(Equal([]string{"--logLevel", "info"}))
+		})
+
+		It("reads the settings of a YAML file", func() {
+			path := configFile("vps.yml", `
+databaseDriver: mysql
+databaseConnectionString: vps:secret@/vps
+webhookTimeout: 3s
+databaseRetryJitter: 0.25
+strictFlavors: true
+databaseIsolationLevel:
+- order-free-vm:SERIALIZABLE
+- reserve-vms:SERIALIZABLE
+`)
+			Expect(parse("--config", path)).To(Succeed())
+
+			Expect(server.DBDriver).To(Equal("mysql"))
+			Expect(server.DBConn).To(Equal("vps:secret@/vps"))
+			Expect(server.WebhookTimeout).To(Equal(3 * time.Second))
+			Expect(server.DBRetryJitter).To(Equal(0.25))
+			Expect(server.StrictFlavors).To(BeTrue())
+			Expect(server.DBIsolationLevels).To(Equal([]string{"order-free-vm:SERIALIZABLE", "reserve-vms:SERIALIZABLE"}))
+			Expect(server.LogLevel).To(Equal("debug"))
+		})
+
+		It("reads the settings of a JSON file", func() {
+			path := configFile("vps.json", `{"logLevel": "error", "webhookMaxAttempts": 3}`)
+			Expect(parse("--config=" + path)).To(Succeed())
+
+			Expect(server.LogLevel).To(Equal("error"))
+			Expect(server.WebhookMaxAttempts).To(Equal(3))
+		})
+
+		It("lets the command line win", func() {
+			path := configFile("vps.yml", `
+logLevel: error
+databaseIsolationLevel:
+- order-free-vm:SERIALIZABLE
+`)
+			Expect(parse("--logLevel", "info", "--config", path, "--databaseIsolationLevel=reserve-vms:REPEATABLE READ")).To(Succeed())
+
+			Expect(server.LogLevel).To(Equal("info"))
+			Expect(server.DBIsolationLevels).To(Equal([]string{"reserve-vms:REPEATABLE READ"}))
+		})
+
+		It("leaves a switch set to false off", func() {
+			path := configFile("vps.yml", "strictFlavors: false\nreEncrypt: true\n")
+			Expect(parse("--config", path)).To(Succeed())
+
+			Expect(server.StrictFlavors).To(BeFalse())
+			Expect(server.ReEncrypt).To(BeTrue())
+		})
+
+		It("refuses to switch off a switch that is on already", func() {
+			server.StrictFlavors = true
+			path := configFile("vps.yml", "strictFlavors: false\n")
+			Expect(parse("--config", path)).To(MatchError(ContainSubstring("strictFlavors is on already and cannot be switched off")))
+		})
+
+		It("passes a boolean on to a flag that takes a value", func() {
+			path := configFile("vps.yml", "activeKeyLabel: false\n")
+			Expect(parse("--config", path)).To(Succeed())
+
+			Expect(server.ActiveKeyLabel).To(Equal("false"))
+		})
+
+		It("rejects settings that are not flags", func() {
+			path := configFile("vps.yml", "logLevl: info\n")
+			Expect(parse("--config", path)).To(MatchError(ContainSubstring("unknown setting logLevl")))
+		})
+
+		It("rejects a file it cannot parse", func() {
+			path := configFile("vps.yml", "logLevel: [info\n")
+			Expect(parse("--config", path)).To(MatchError(ContainSubstring("parsing config file")))
+		})
+
+		It("rejects a file that does not exist", func() {
+			Expect(parse("--config", filepath.Join(dir, "missing.yml"))).To(HaveOccurred())
+		})
+	})
+
+	Describe("Validate", func() {
+		It("accepts the defaults along with a database", func() {
+			Expect(parse("--databaseConnectionString", "postgres://vps@localhost/vps")).To(Succeed())
+			Expect(server.Validate()).To(Succeed())
+		})
+
+		It("reports every problem at once", func() {
+			Expect(parse(
+				"--databaseDriver", "sqlite",
+				"--databaseRetryJitter", "2",
+				"--logLevel", "verbose",
+				"--webhookTimeout", "0s",
+				"--releaseMode", "reload",
+				"--replenishOrderTimeout", "-1h",
+			)).To(Succeed())
+
+			err := server.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("databaseDriver must be mysql or postgres"))
+			Expect(err.Error()).To(ContainSubstring("databaseConnectionString is required"))
+			Expect(err.Error()).To(ContainSubstring("databaseRetryJitter must be between 0 and 1"))
+			Expect(err.Error()).To(ContainSubstring("logLevel must be debug, info, error or fatal"))
+			Expect(err.Error()).To(ContainSubstring("webhookTimeout must be positive"))
+			Expect(err.Error()).To(ContainSubstring("replenishOrderTimeout cannot be negative"))
+			Expect(err.Error()).To(ContainSubstring("softlayerUsername and softlayerAPIKey are required"))
+		})
+
+		It("requires a Consul URL the api can be reached through", func() {
+			Expect(parse(
+				"--databaseConnectionString", "postgres://vps@localhost/vps",
+				"--consulCluster", "127.0.0.1:8500",
+				"--scheme", "unix",
+			)).To(Succeed())
+
+			err := server.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("consulCluster: "))
+			Expect(err.Error()).To(ContainSubstring("consulCluster needs an http or https listener"))
+		})
+
+		It("checks the gRPC port and watch interval", func() {
+			Expect(parse(
+				"--databaseConnectionString", "postgres://vps@localhost/vps",
+				"--grpcPort", "-1",
+				"--grpcWatchInterval", "0s",
+			)).To(Succeed())
+
+			err := server.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("grpcPort must be between 0 and 65535"))
+			Expect(err.Error()).To(ContainSubstring("grpcWatchInterval must be positive"))
+		})
+
+		It("serves the gRPC api over TLS", func() {
+			Expect(parse("--databaseConnectionString", "postgres://vps@localhost/vps", "--grpcPort", "9090")).To(Succeed())
+			Expect(server.Validate()).To(MatchError(ContainSubstring("grpcPort needs a tls-certificate and tls-key, or grpcInsecure")))
+		})
+
+		It("serves the gRPC api with the tls-certificate and tls-key", func() {
+			Expect(parse(
+				"--databaseConnectionString", "postgres://vps@localhost/vps",
+				"--grpcPort", "9090",
+				"--tls-certificate", "vps.crt",
+				"--tls-key", "vps.key",
+			)).To(Succeed())
+			Expect(server.Validate()).To(Succeed())
+		})
+
+		It("requires the admin password to be a bcrypt hash", func() {
+			Expect(parse("--databaseConnectionString", "postgres://vps@localhost/vps", "--adminPasswordHash", "pass")).To(Succeed())
+			Expect(server.Validate()).To(MatchError(ContainSubstring("adminPasswordHash must be a bcrypt hash")))
+		})
+
+		It("accepts a bcrypt hash of the admin password", func() {
+			hashed, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parse("--databaseConnectionString", "postgres://vps@localhost/vps", "--adminPasswordHash", string(hashed))).To(Succeed())
+			Expect(server.Validate()).To(Succeed())
+			Expect(server.AdminCredentials().Username).To(Equal("admin"))
+			Expect(server.AdminCredentials().PasswordHash).To(Equal(string(hashed)))
+		})
+
+		It("serves the gRPC api without TLS only when told to", func() {
+			Expect(parse("--databaseConnectionString", "postgres://vps@localhost/vps", "--grpcPort", "9090", "--grpcInsecure")).To(Succeed())
+			Expect(server.Validate()).To(Succeed())
+		})
+	})
+
+	Describe("ConsulRegistration", func() {
+		It("registers the http listener", func() {
+			Expect(parse("--host", "10.0.0.1", "--port", "8080", "--tls-port", "8443")).To(Succeed())
+
+			Expect(server.ConsulRegistration("vps-1")).To(Equal(api.AgentServiceRegistration{
+				ID:      "vps-1",
+				Name:    "vps",
+				Address: "10.0.0.1",
+				Port:    8080,
+				Tags:    []string{"http"},
+			}))
+		})
+
+		It("registers the https listener at the address clients use when it is the only one", func() {
+			Expect(parse(
+				"--scheme", "https",
+				"--tls-host", "10.0.0.2",
+				"--tls-port", "8443",
+				"--consulServiceName", "vm-pool",
+				"--consulServiceAddress", "vps.example.com",
+			)).To(Succeed())
+
+			Expect(server.ConsulRegistration("vps-1")).To(Equal(api.AgentServiceRegistration{
+				ID:      "vps-1",
+				Name:    "vm-pool",
+				Address: "vps.example.com",
+				Port:    8443,
+				Tags:    []string{"https"},
+			}))
+		})
+	})
+
+	Describe("KeyManager", func() {
+		It("has no keys unless they are given", func() {
+			Expect(parse()).To(Succeed())
+
+			keys, err := server.KeyManager()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(keys).To(BeNil())
+		})
+
+		It("encrypts with the active key and decrypts with every key", func() {
+			Expect(parse(
+				"--encryptionKey", "old:first passphrase",
+				"--encryptionKey", "new:second passphrase",
+				"--activeKeyLabel", "new",
+			)).To(Succeed())
+
+			keys, err := server.KeyManager()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(keys.EncryptionKey().Label()).To(Equal("new"))
+			Expect(keys.DecryptionKey("old")).NotTo(BeNil())
+		})
+
+		It("requires the active key to be one of the keys", func() {
+			Expect(parse("--encryptionKey", "old:first passphrase", "--activeKeyLabel", "new")).To(Succeed())
+
+			_, err := server.KeyManager()
+			Expect(err).To(MatchError("activeKeyLabel must be the label of an encryptionKey"))
+		})
+
+		It("rejects keys without a label", func() {
+			Expect(parse("--encryptionKey", "passphrase", "--activeKeyLabel", "new")).To(Succeed())
+
+			_, err := server.KeyManager()
+			Expect(err).To(MatchError("encryptionKey must be label:passphrase"))
+		})
+	})
+})
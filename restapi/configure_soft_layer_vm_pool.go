@@ -7,15 +7,25 @@ import (
 	errors "github.com/go-openapi/errors"
 	runtime "github.com/go-openapi/runtime"
 
+	"github.com/jianqiu/vps/restapi/accesslog"
 	"github.com/jianqiu/vps/restapi/operations"
+	"github.com/jianqiu/vps/restapi/operations/admin"
+	"github.com/jianqiu/vps/restapi/operations/flavor"
+	"github.com/jianqiu/vps/restapi/operations/health"
+	"github.com/jianqiu/vps/restapi/operations/metadata"
+	"github.com/jianqiu/vps/restapi/operations/pool"
+	"github.com/jianqiu/vps/restapi/operations/reservation"
+	"github.com/jianqiu/vps/restapi/operations/setting"
 	"github.com/jianqiu/vps/restapi/operations/vm"
+	"github.com/jianqiu/vps/restapi/operations/webhook"
 	"github.com/jianqiu/vps/restapi/handlers"
 	"github.com/jianqiu/vps/db"
 	"github.com/jianqiu/vps/models"
 	"github.com/jianqiu/vps/controllers"
+	"github.com/jianqiu/vps/settings"
 
+	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/lager"
-	"strings"
 )
 
 // This file is safe to edit. Once it exists it will not be overwritten
@@ -28,6 +38,14 @@ func configureFlags(api *operations.SoftLayerVMPoolAPI) {
 func configureAPI(api *operations.SoftLayerVMPoolAPI,
 logger lager.Logger,
 db db.DB,
+reloader controllers.OSReloader,
+strictFlavors bool,
+reaper handlers.ReaperController,
+healthController handlers.HealthController,
+logLevelController handlers.LogLevelController,
+settingsStore *settings.Store,
+secretController handlers.SecretController,
+adminCredentials controllers.AdminCredentials,
 ) http.Handler {
 	// configure the api here
 	api.ServeError = errors.ServeError
@@ -42,33 +60,104 @@ db db.DB,
 
 	api.JSONProducer = runtime.JSONProducer()
 
-	api.BasicAuthAuth = func(user string, pass string) (*models.User, error) {
-		if strings.EqualFold(user, "admin") && strings.EqualFold(pass, "pass"){
-			return &models.User{
-				Username: user,
-				Password: pass,
-			}, nil
-		}
-		return nil, errors.Unauthenticated(user)
-	}
+	poolController := controllers.NewPoolController(db)
 
-	vmController := controllers.NewVirtualGuestController(db)
+	api.BasicAuthAuth = BasicAuth(logger, adminCredentials, poolController)
+
+	vmController := controllers.NewVirtualGuestController(db, db, settingsStore, strictFlavors, reloader)
 	vmHandler := handlers.NewVmHandler(logger,vmController)
 
 	api.VMAddVMHandler = vm.AddVMHandlerFunc(vmHandler.AddVM)
 	api.VMDeleteVMHandler = vm.DeleteVMHandlerFunc(vmHandler.DeleteVM)
 	api.VMGetVMByCidHandler = vm.GetVMByCidHandlerFunc(vmHandler.GetVMByCid)
+	api.VMGetVMByIPHandler = vm.GetVMByIPHandlerFunc(vmHandler.GetVMByIP)
+	api.VMGetVMByHostnameHandler = vm.GetVMByHostnameHandlerFunc(vmHandler.GetVMByHostname)
 	api.VMListVMHandler = vm.ListVMHandlerFunc(vmHandler.ListVM)
 	api.VMUpdateVMWithStateHandler = vm.UpdateVMWithStateHandlerFunc(vmHandler.UpdateVMWithState)
 	api.VMFindVmsByFiltersHandler = vm.FindVmsByFiltersHandlerFunc(vmHandler.FindVmsByFilters)
 	api.VMFindVmsByDeploymentHandler = vm.FindVmsByDeploymentHandlerFunc(vmHandler.FindVmsByDeployment)
 	api.VMFindVmsByStatesHandler = vm.FindVmsByStatesHandlerFunc(vmHandler.FindVmsByStates)
+	api.VMFindVmsByHostnameHandler = vm.FindVmsByHostnameHandlerFunc(vmHandler.FindVmsByHostname)
 	api.VMUpdateVMHandler = vm.UpdateVMHandlerFunc(vmHandler.UpdateVM)
 	api.VMOrderVMByFilterHandler = vm.OrderVMByFilterHandlerFunc(vmHandler.OrderVmByFilter)
+	api.VMTransitionVMHandler = vm.TransitionVMHandlerFunc(vmHandler.TransitionVM)
+
+	secretHandler := handlers.NewSecretHandler(logger, secretController)
+
+	api.VMGetVMSecretHandler = vm.GetVMSecretHandlerFunc(secretHandler.GetVMSecret)
+	api.VMSetVMSecretHandler = vm.SetVMSecretHandlerFunc(secretHandler.SetVMSecret)
+	api.VMDeleteVMSecretHandler = vm.DeleteVMSecretHandlerFunc(secretHandler.DeleteVMSecret)
+
+	metadataHandler := handlers.NewMetadataHandler(logger, controllers.NewMetadataController(db, db))
+	api.MetadataGetVMMetadataHandler = metadata.GetVMMetadataHandlerFunc(metadataHandler.GetVMMetadata)
+
+	poolHandler := handlers.NewPoolHandler(logger, poolController)
+
+	api.PoolCreatePoolHandler = pool.CreatePoolHandlerFunc(poolHandler.CreatePool)
+	api.PoolListPoolsHandler = pool.ListPoolsHandlerFunc(poolHandler.ListPools)
+	api.PoolGetPoolHandler = pool.GetPoolHandlerFunc(poolHandler.GetPool)
+	api.PoolUpdatePoolHandler = pool.UpdatePoolHandlerFunc(poolHandler.UpdatePool)
+	api.PoolDeletePoolHandler = pool.DeletePoolHandlerFunc(poolHandler.DeletePool)
+
+	poolVMHandler := handlers.NewPoolVMHandler(logger, poolController, func(pool string) handlers.VirtualGuestController {
+		return vmController.InPool(pool)
+	})
+
+	api.VMAddPoolVMHandler = vm.AddPoolVMHandlerFunc(poolVMHandler.AddPoolVM)
+	api.VMListPoolVmsHandler = vm.ListPoolVmsHandlerFunc(poolVMHandler.ListPoolVms)
+	api.VMFindPoolVmsByFiltersHandler = vm.FindPoolVmsByFiltersHandlerFunc(poolVMHandler.FindPoolVmsByFilters)
+	api.VMOrderPoolVMHandler = vm.OrderPoolVMHandlerFunc(poolVMHandler.OrderPoolVM)
+	api.VMGetPoolVMByCidHandler = vm.GetPoolVMByCidHandlerFunc(poolVMHandler.GetPoolVMByCid)
+	api.VMUpdatePoolVMWithStateHandler = vm.UpdatePoolVMWithStateHandlerFunc(poolVMHandler.UpdatePoolVMWithState)
+	api.VMDeletePoolVMHandler = vm.DeletePoolVMHandlerFunc(poolVMHandler.DeletePoolVM)
+	api.PoolGetPoolSummaryHandler = pool.GetPoolSummaryHandlerFunc(poolVMHandler.GetPoolSummary)
+
+	flavorController := controllers.NewFlavorController(db)
+	flavorHandler := handlers.NewFlavorHandler(logger, flavorController)
+
+	api.FlavorCreateFlavorHandler = flavor.CreateFlavorHandlerFunc(flavorHandler.CreateFlavor)
+	api.FlavorListFlavorsHandler = flavor.ListFlavorsHandlerFunc(flavorHandler.ListFlavors)
+	api.FlavorGetFlavorHandler = flavor.GetFlavorHandlerFunc(flavorHandler.GetFlavor)
+	api.FlavorUpdateFlavorHandler = flavor.UpdateFlavorHandlerFunc(flavorHandler.UpdateFlavor)
+	api.FlavorDeleteFlavorHandler = flavor.DeleteFlavorHandlerFunc(flavorHandler.DeleteFlavor)
+
+	reservationController := controllers.NewReservationController(db, db, db, settingsStore)
+	reservationHandler := handlers.NewReservationHandler(logger, reservationController)
+
+	api.ReservationCreateReservationHandler = reservation.CreateReservationHandlerFunc(reservationHandler.CreateReservation)
+	api.ReservationListReservationsHandler = reservation.ListReservationsHandlerFunc(reservationHandler.ListReservations)
+	api.ReservationGetReservationHandler = reservation.GetReservationHandlerFunc(reservationHandler.GetReservation)
+	api.ReservationCancelReservationHandler = reservation.CancelReservationHandlerFunc(reservationHandler.CancelReservation)
+
+	reaperHandler := handlers.NewReaperHandler(logger, reaper)
+	api.VMListReapableVmsHandler = vm.ListReapableVmsHandlerFunc(reaperHandler.ListReapableVms)
+
+	webhookController := controllers.NewWebhookController(db)
+	webhookHandler := handlers.NewWebhookHandler(logger, webhookController)
+
+	api.WebhookCreateWebhookHandler = webhook.CreateWebhookHandlerFunc(webhookHandler.CreateWebhook)
+	api.WebhookListWebhooksHandler = webhook.ListWebhooksHandlerFunc(webhookHandler.ListWebhooks)
+	api.WebhookDeleteWebhookHandler = webhook.DeleteWebhookHandlerFunc(webhookHandler.DeleteWebhook)
+	api.WebhookListDeadWebhookDeliveriesHandler = webhook.ListDeadWebhookDeliveriesHandlerFunc(webhookHandler.ListDeadWebhookDeliveries)
+	api.WebhookRetryWebhookDeliveryHandler = webhook.RetryWebhookDeliveryHandlerFunc(webhookHandler.RetryWebhookDelivery)
+
+	healthHandler := handlers.NewHealthHandler(logger, healthController)
+	api.HealthGetHealthzHandler = health.GetHealthzHandlerFunc(healthHandler.GetHealthz)
+	api.HealthGetReadyzHandler = health.GetReadyzHandlerFunc(healthHandler.GetReadyz)
+
+	logLevelHandler := handlers.NewLogLevelHandler(logger, logLevelController)
+	api.AdminGetLogLevelHandler = admin.GetLogLevelHandlerFunc(logLevelHandler.GetLogLevel)
+	api.AdminSetLogLevelHandler = admin.SetLogLevelHandlerFunc(logLevelHandler.SetLogLevel)
+
+	settingHandler := handlers.NewSettingHandler(logger, settingsStore)
+	api.SettingListSettingsHandler = setting.ListSettingsHandlerFunc(settingHandler.ListSettings)
+	api.SettingGetSettingHandler = setting.GetSettingHandlerFunc(settingHandler.GetSetting)
+	api.SettingSetSettingHandler = setting.SetSettingHandlerFunc(settingHandler.SetSetting)
+	api.SettingResetSettingHandler = setting.ResetSettingHandlerFunc(settingHandler.ResetSetting)
 
 	api.ServerShutdown = func() {}
 
-	return setupGlobalMiddleware(api.Serve(setupMiddlewares))
+	return setupGlobalMiddleware(logger, api.Serve(setupMiddlewares(api)))
 }
 
 // The TLS configuration before HTTPS server starts.
@@ -79,12 +168,39 @@ func configureTLS(tlsConfig *tls.Config) {
 
 // The middleware configuration is for the handler executors. These do not apply to the swagger.json document.
 // The middleware executes after routing but before authentication, binding and validation
-func setupMiddlewares(handler http.Handler) http.Handler {
-	return handler
+func setupMiddlewares(api *operations.SoftLayerVMPoolAPI) func(http.Handler) http.Handler {
+	return func(handler http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if route, ok := api.Context().RouteInfo(r); ok && route.Operation != nil {
+				accesslog.SetOperation(r, route.Operation.ID)
+			}
+			handler.ServeHTTP(w, r)
+		})
+	}
 }
 
 // The middleware configuration happens before anything, this middleware also applies to serving the swagger.json document.
 // So this is a good place to plug in a panic handling middleware, logging and metrics
-func setupGlobalMiddleware(handler http.Handler) http.Handler {
-	return handler
+func setupGlobalMiddleware(logger lager.Logger, handler http.Handler) http.Handler {
+	if logger == nil {
+		return handler
+	}
+	return accesslog.NewHandler(logger, clock.NewClock(), handler)
+}
+
+// BasicAuth checks the basic auth credentials of a request, of the REST and
+// the gRPC API alike: the administrator may use every pool, the credentials
+// of a pool only that pool.
+func BasicAuth(logger lager.Logger, admin controllers.AdminCredentials, poolController *controllers.PoolController) func(user string, pass string) (*models.User, error) {
+	return func(user string, pass string) (*models.User, error) {
+		authenticate := poolController.Authenticate
+		if admin.IsAdmin(user) {
+			authenticate = admin.Authenticate
+		}
+		principal, err := authenticate(logger, user, pass)
+		if err != nil {
+			return nil, errors.Unauthenticated(user)
+		}
+		return principal, nil
+	}
 }
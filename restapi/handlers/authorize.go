@@ -0,0 +1,32 @@
+package handlers
+
+import (
+	"github.com/jianqiu/vps/models"
+)
+
+// authorize checks that user may work on the VMs of pool. Administrators
+// may work on every pool, the credentials of a pool only on that pool.
+func authorize(user *models.User, pool string) error {
+	if user == nil || (user.Pool != "" && user.Pool != pool) {
+		return models.ErrUnauthorized
+	}
+	return nil
+}
+
+// authorizeAdmin checks that user is an administrator, for the operations
+// that span every pool.
+func authorizeAdmin(user *models.User) error {
+	if user == nil || user.Pool != "" {
+		return models.ErrUnauthorized
+	}
+	return nil
+}
+
+// authenticated checks that there is a user at all, for the operations the
+// credentials of every pool may use, like reading the flavor catalog.
+func authenticated(user *models.User) error {
+	if user == nil {
+		return models.ErrUnauthorized
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/models"
+)
+
+// RetryAfterSeconds is how long clients are asked to wait before retrying a
+// request that failed because the database was unavailable.
+const RetryAfterSeconds = 5
+
+// StatusCode is the status every operation responds with when it fails with
+// err.
+func StatusCode(err error) int {
+	switch models.ConvertError(err).Type {
+	case models.ErrorTypeResourceNotFound:
+		return http.StatusNotFound
+	case models.ErrorTypeUnauthorized:
+		return http.StatusForbidden
+	case models.ErrorTypeInvalidRequest,
+		models.ErrorTypeInvalidRecord,
+		models.ErrorTypeInvalidJSON,
+		models.ErrorTypeDeserialize:
+		return http.StatusBadRequest
+	case models.ErrorTypeResourceConflict,
+		models.ErrorTypeResourceExist,
+		models.ErrorTypeInvalidStateTransition:
+		return http.StatusConflict
+	case models.ErrorTypeDeadlock,
+		models.ErrorTypeUnrecoverable,
+		models.ErrorTypeMaintenance:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// defaultResponse is the generated default response of an operation.
+type defaultResponse interface {
+	middleware.Responder
+	SetStatusCode(code int)
+	SetPayload(payload *models.Error)
+}
+
+// errorResponse fills the default response of an operation with err and the
+// status it maps to.
+func errorResponse(err error, response defaultResponse) middleware.Responder {
+	modelErr := models.ConvertError(err)
+	code := StatusCode(modelErr)
+
+	response.SetStatusCode(code)
+	response.SetPayload(modelErr)
+	if code == http.StatusServiceUnavailable {
+		return &retryAfterResponse{Responder: response, seconds: RetryAfterSeconds}
+	}
+	return response
+}
+
+type retryAfterResponse struct {
+	middleware.Responder
+	seconds int
+}
+
+func (r *retryAfterResponse) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+	rw.Header().Set("Retry-After", strconv.Itoa(r.seconds))
+	r.Responder.WriteResponse(rw, producer)
+}
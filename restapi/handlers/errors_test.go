@@ -0,0 +1,91 @@
+package handlers_test
+
+import (
+	"errors"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/go-openapi/runtime"
+	"github.com/jianqiu/vps/models"
+	"github.com/jianqiu/vps/restapi/handlers"
+	"github.com/jianqiu/vps/restapi/handlers/fake_controllers"
+	"github.com/jianqiu/vps/restapi/operations/vm"
+)
+
+var _ = Describe("Errors", func() {
+	Describe("StatusCode", func() {
+		statuses := []struct {
+			description string
+			err         error
+			code        int
+		}{
+			{"missing resources", models.ErrResourceNotFound, 404},
+			{"credentials of another pool", models.ErrUnauthorized, 403},
+			{"invalid requests", models.ErrBadRequest, 400},
+			{"invalid records", models.NewError(models.ErrorTypeInvalidRecord, "bad"), 400},
+			{"invalid json", models.NewError(models.ErrorTypeInvalidJSON, "bad"), 400},
+			{"undecodable payloads", models.NewError(models.ErrorTypeDeserialize, "bad"), 400},
+			{"conflicts", models.ErrResourceConflict, 409},
+			{"duplicates", models.ErrResourceExists, 409},
+			{"invalid state transitions", models.NewTaskTransitionError(models.StateFree, models.StateUsing), 409},
+			{"deadlocks", models.ErrDeadlock, 503},
+			{"unrecoverable database errors", models.NewUnrecoverableError(errors.New("gone")), 503},
+			{"maintenance mode", models.ErrMaintenance, 503},
+			{"unknown model errors", models.ErrUnknownError, 500},
+			{"plain errors", errors.New("kaboom"), 500},
+		}
+
+		for _, status := range statuses {
+			status := status
+			It("maps "+status.description, func() {
+				Expect(handlers.StatusCode(status.err)).To(Equal(status.code))
+			})
+		}
+	})
+
+	Describe("operation error responses", func() {
+		var (
+			controller *fake_controllers.FakeVirtualGuestController
+			handler    *handlers.VMHandler
+			recorder   *httptest.ResponseRecorder
+		)
+
+		BeforeEach(func() {
+			controller = &fake_controllers.FakeVirtualGuestController{}
+			handler = handlers.NewVmHandler(lagertest.NewTestLogger("test"), controller)
+			recorder = httptest.NewRecorder()
+		})
+
+		Context("when the database is unavailable", func() {
+			BeforeEach(func() {
+				controller.AllVirtualGuestsReturns(nil, models.ErrDeadlock)
+			})
+
+			It("asks the client to retry later", func() {
+				responder := handler.ListVM(vm.NewListVMParams(), &models.User{})
+				responder.WriteResponse(recorder, runtime.JSONProducer())
+
+				Expect(recorder.Code).To(Equal(503))
+				Expect(recorder.Header().Get("Retry-After")).To(Equal("5"))
+				Expect(recorder.Body.String()).To(ContainSubstring(`"Deadlock"`))
+			})
+		})
+
+		Context("when the request is invalid", func() {
+			BeforeEach(func() {
+				controller.AllVirtualGuestsReturns(nil, models.ErrBadRequest)
+			})
+
+			It("responds with a bad request and no Retry-After", func() {
+				responder := handler.ListVM(vm.NewListVMParams(), &models.User{})
+				responder.WriteResponse(recorder, runtime.JSONProducer())
+
+				Expect(recorder.Code).To(Equal(400))
+				Expect(recorder.Header().Get("Retry-After")).To(BeEmpty())
+			})
+		})
+	})
+})
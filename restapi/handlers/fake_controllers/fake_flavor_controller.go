@@ -0,0 +1,268 @@
+// This file was generated by counterfeiter
+package fake_controllers
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/models"
+	"github.com/jianqiu/vps/restapi/handlers"
+)
+
+type FakeFlavorController struct {
+	CreateFlavorStub        func(logger lager.Logger, flavor *models.Flavor) (*models.Flavor, error)
+	createFlavorMutex       sync.RWMutex
+	createFlavorArgsForCall []struct {
+		logger lager.Logger
+		flavor *models.Flavor
+	}
+	createFlavorReturns struct {
+		result1 *models.Flavor
+		result2 error
+	}
+	FlavorsStub        func(logger lager.Logger) ([]*models.Flavor, error)
+	flavorsMutex       sync.RWMutex
+	flavorsArgsForCall []struct {
+		logger lager.Logger
+	}
+	flavorsReturns struct {
+		result1 []*models.Flavor
+		result2 error
+	}
+	FlavorStub        func(logger lager.Logger, name string) (*models.Flavor, error)
+	flavorMutex       sync.RWMutex
+	flavorArgsForCall []struct {
+		logger lager.Logger
+		name   string
+	}
+	flavorReturns struct {
+		result1 *models.Flavor
+		result2 error
+	}
+	UpdateFlavorStub        func(logger lager.Logger, name string, flavor *models.Flavor) (*models.Flavor, error)
+	updateFlavorMutex       sync.RWMutex
+	updateFlavorArgsForCall []struct {
+		logger lager.Logger
+		name   string
+		flavor *models.Flavor
+	}
+	updateFlavorReturns struct {
+		result1 *models.Flavor
+		result2 error
+	}
+	DeleteFlavorStub        func(logger lager.Logger, name string) error
+	deleteFlavorMutex       sync.RWMutex
+	deleteFlavorArgsForCall []struct {
+		logger lager.Logger
+		name   string
+	}
+	deleteFlavorReturns struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeFlavorController) CreateFlavor(logger lager.Logger, flavor *models.Flavor) (*models.Flavor, error) {
+	fake.createFlavorMutex.Lock()
+	fake.createFlavorArgsForCall = append(fake.createFlavorArgsForCall, struct {
+		logger lager.Logger
+		flavor *models.Flavor
+	}{logger, flavor})
+	fake.recordInvocation("CreateFlavor", []interface{}{logger, flavor})
+	fake.createFlavorMutex.Unlock()
+	if fake.CreateFlavorStub != nil {
+		return fake.CreateFlavorStub(logger, flavor)
+	} else {
+		return fake.createFlavorReturns.result1, fake.createFlavorReturns.result2
+	}
+}
+
+func (fake *FakeFlavorController) CreateFlavorCallCount() int {
+	fake.createFlavorMutex.RLock()
+	defer fake.createFlavorMutex.RUnlock()
+	return len(fake.createFlavorArgsForCall)
+}
+
+func (fake *FakeFlavorController) CreateFlavorArgsForCall(i int) (lager.Logger, *models.Flavor) {
+	fake.createFlavorMutex.RLock()
+	defer fake.createFlavorMutex.RUnlock()
+	return fake.createFlavorArgsForCall[i].logger, fake.createFlavorArgsForCall[i].flavor
+}
+
+func (fake *FakeFlavorController) CreateFlavorReturns(result1 *models.Flavor, result2 error) {
+	fake.CreateFlavorStub = nil
+	fake.createFlavorReturns = struct {
+		result1 *models.Flavor
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFlavorController) Flavors(logger lager.Logger) ([]*models.Flavor, error) {
+	fake.flavorsMutex.Lock()
+	fake.flavorsArgsForCall = append(fake.flavorsArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("Flavors", []interface{}{logger})
+	fake.flavorsMutex.Unlock()
+	if fake.FlavorsStub != nil {
+		return fake.FlavorsStub(logger)
+	} else {
+		return fake.flavorsReturns.result1, fake.flavorsReturns.result2
+	}
+}
+
+func (fake *FakeFlavorController) FlavorsCallCount() int {
+	fake.flavorsMutex.RLock()
+	defer fake.flavorsMutex.RUnlock()
+	return len(fake.flavorsArgsForCall)
+}
+
+func (fake *FakeFlavorController) FlavorsArgsForCall(i int) lager.Logger {
+	fake.flavorsMutex.RLock()
+	defer fake.flavorsMutex.RUnlock()
+	return fake.flavorsArgsForCall[i].logger
+}
+
+func (fake *FakeFlavorController) FlavorsReturns(result1 []*models.Flavor, result2 error) {
+	fake.FlavorsStub = nil
+	fake.flavorsReturns = struct {
+		result1 []*models.Flavor
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFlavorController) Flavor(logger lager.Logger, name string) (*models.Flavor, error) {
+	fake.flavorMutex.Lock()
+	fake.flavorArgsForCall = append(fake.flavorArgsForCall, struct {
+		logger lager.Logger
+		name   string
+	}{logger, name})
+	fake.recordInvocation("Flavor", []interface{}{logger, name})
+	fake.flavorMutex.Unlock()
+	if fake.FlavorStub != nil {
+		return fake.FlavorStub(logger, name)
+	} else {
+		return fake.flavorReturns.result1, fake.flavorReturns.result2
+	}
+}
+
+func (fake *FakeFlavorController) FlavorCallCount() int {
+	fake.flavorMutex.RLock()
+	defer fake.flavorMutex.RUnlock()
+	return len(fake.flavorArgsForCall)
+}
+
+func (fake *FakeFlavorController) FlavorArgsForCall(i int) (lager.Logger, string) {
+	fake.flavorMutex.RLock()
+	defer fake.flavorMutex.RUnlock()
+	return fake.flavorArgsForCall[i].logger, fake.flavorArgsForCall[i].name
+}
+
+func (fake *FakeFlavorController) FlavorReturns(result1 *models.Flavor, result2 error) {
+	fake.FlavorStub = nil
+	fake.flavorReturns = struct {
+		result1 *models.Flavor
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFlavorController) UpdateFlavor(logger lager.Logger, name string, flavor *models.Flavor) (*models.Flavor, error) {
+	fake.updateFlavorMutex.Lock()
+	fake.updateFlavorArgsForCall = append(fake.updateFlavorArgsForCall, struct {
+		logger lager.Logger
+		name   string
+		flavor *models.Flavor
+	}{logger, name, flavor})
+	fake.recordInvocation("UpdateFlavor", []interface{}{logger, name, flavor})
+	fake.updateFlavorMutex.Unlock()
+	if fake.UpdateFlavorStub != nil {
+		return fake.UpdateFlavorStub(logger, name, flavor)
+	} else {
+		return fake.updateFlavorReturns.result1, fake.updateFlavorReturns.result2
+	}
+}
+
+func (fake *FakeFlavorController) UpdateFlavorCallCount() int {
+	fake.updateFlavorMutex.RLock()
+	defer fake.updateFlavorMutex.RUnlock()
+	return len(fake.updateFlavorArgsForCall)
+}
+
+func (fake *FakeFlavorController) UpdateFlavorArgsForCall(i int) (lager.Logger, string, *models.Flavor) {
+	fake.updateFlavorMutex.RLock()
+	defer fake.updateFlavorMutex.RUnlock()
+	return fake.updateFlavorArgsForCall[i].logger, fake.updateFlavorArgsForCall[i].name, fake.updateFlavorArgsForCall[i].flavor
+}
+
+func (fake *FakeFlavorController) UpdateFlavorReturns(result1 *models.Flavor, result2 error) {
+	fake.UpdateFlavorStub = nil
+	fake.updateFlavorReturns = struct {
+		result1 *models.Flavor
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeFlavorController) DeleteFlavor(logger lager.Logger, name string) error {
+	fake.deleteFlavorMutex.Lock()
+	fake.deleteFlavorArgsForCall = append(fake.deleteFlavorArgsForCall, struct {
+		logger lager.Logger
+		name   string
+	}{logger, name})
+	fake.recordInvocation("DeleteFlavor", []interface{}{logger, name})
+	fake.deleteFlavorMutex.Unlock()
+	if fake.DeleteFlavorStub != nil {
+		return fake.DeleteFlavorStub(logger, name)
+	} else {
+		return fake.deleteFlavorReturns.result1
+	}
+}
+
+func (fake *FakeFlavorController) DeleteFlavorCallCount() int {
+	fake.deleteFlavorMutex.RLock()
+	defer fake.deleteFlavorMutex.RUnlock()
+	return len(fake.deleteFlavorArgsForCall)
+}
+
+func (fake *FakeFlavorController) DeleteFlavorArgsForCall(i int) (lager.Logger, string) {
+	fake.deleteFlavorMutex.RLock()
+	defer fake.deleteFlavorMutex.RUnlock()
+	return fake.deleteFlavorArgsForCall[i].logger, fake.deleteFlavorArgsForCall[i].name
+}
+
+func (fake *FakeFlavorController) DeleteFlavorReturns(result1 error) {
+	fake.DeleteFlavorStub = nil
+	fake.deleteFlavorReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeFlavorController) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.createFlavorMutex.RLock()
+	defer fake.createFlavorMutex.RUnlock()
+	fake.flavorsMutex.RLock()
+	defer fake.flavorsMutex.RUnlock()
+	fake.flavorMutex.RLock()
+	defer fake.flavorMutex.RUnlock()
+	fake.updateFlavorMutex.RLock()
+	defer fake.updateFlavorMutex.RUnlock()
+	fake.deleteFlavorMutex.RLock()
+	defer fake.deleteFlavorMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeFlavorController) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ handlers.FlavorController = new(FakeFlavorController)
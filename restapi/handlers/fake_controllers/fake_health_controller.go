@@ -0,0 +1,123 @@
+// This file was generated by counterfeiter
+package fake_controllers
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/models"
+	"github.com/jianqiu/vps/restapi/handlers"
+)
+
+type FakeHealthController struct {
+	LivenessStub        func(logger lager.Logger) *models.Health
+	livenessMutex       sync.RWMutex
+	livenessArgsForCall []struct {
+		logger lager.Logger
+	}
+	livenessReturns struct {
+		result1 *models.Health
+	}
+	ReadinessStub        func(logger lager.Logger) (*models.Health, bool)
+	readinessMutex       sync.RWMutex
+	readinessArgsForCall []struct {
+		logger lager.Logger
+	}
+	readinessReturns struct {
+		result1 *models.Health
+		result2 bool
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeHealthController) Liveness(logger lager.Logger) *models.Health {
+	fake.livenessMutex.Lock()
+	fake.livenessArgsForCall = append(fake.livenessArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("Liveness", []interface{}{logger})
+	fake.livenessMutex.Unlock()
+	if fake.LivenessStub != nil {
+		return fake.LivenessStub(logger)
+	} else {
+		return fake.livenessReturns.result1
+	}
+}
+
+func (fake *FakeHealthController) LivenessCallCount() int {
+	fake.livenessMutex.RLock()
+	defer fake.livenessMutex.RUnlock()
+	return len(fake.livenessArgsForCall)
+}
+
+func (fake *FakeHealthController) LivenessArgsForCall(i int) lager.Logger {
+	fake.livenessMutex.RLock()
+	defer fake.livenessMutex.RUnlock()
+	return fake.livenessArgsForCall[i].logger
+}
+
+func (fake *FakeHealthController) LivenessReturns(result1 *models.Health) {
+	fake.LivenessStub = nil
+	fake.livenessReturns = struct {
+		result1 *models.Health
+	}{result1}
+}
+
+func (fake *FakeHealthController) Readiness(logger lager.Logger) (*models.Health, bool) {
+	fake.readinessMutex.Lock()
+	fake.readinessArgsForCall = append(fake.readinessArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("Readiness", []interface{}{logger})
+	fake.readinessMutex.Unlock()
+	if fake.ReadinessStub != nil {
+		return fake.ReadinessStub(logger)
+	} else {
+		return fake.readinessReturns.result1, fake.readinessReturns.result2
+	}
+}
+
+func (fake *FakeHealthController) ReadinessCallCount() int {
+	fake.readinessMutex.RLock()
+	defer fake.readinessMutex.RUnlock()
+	return len(fake.readinessArgsForCall)
+}
+
+func (fake *FakeHealthController) ReadinessArgsForCall(i int) lager.Logger {
+	fake.readinessMutex.RLock()
+	defer fake.readinessMutex.RUnlock()
+	return fake.readinessArgsForCall[i].logger
+}
+
+func (fake *FakeHealthController) ReadinessReturns(result1 *models.Health, result2 bool) {
+	fake.ReadinessStub = nil
+	fake.readinessReturns = struct {
+		result1 *models.Health
+		result2 bool
+	}{result1, result2}
+}
+
+func (fake *FakeHealthController) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.livenessMutex.RLock()
+	defer fake.livenessMutex.RUnlock()
+	fake.readinessMutex.RLock()
+	defer fake.readinessMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeHealthController) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ handlers.HealthController = new(FakeHealthController)
@@ -0,0 +1,122 @@
+// This file was generated by counterfeiter
+package fake_controllers
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/restapi/handlers"
+)
+
+type FakeLogLevelController struct {
+	LogLevelStub        func(logger lager.Logger) string
+	logLevelMutex       sync.RWMutex
+	logLevelArgsForCall []struct {
+		logger lager.Logger
+	}
+	logLevelReturns struct {
+		result1 string
+	}
+	SetLogLevelStub        func(logger lager.Logger, level string) error
+	setLogLevelMutex       sync.RWMutex
+	setLogLevelArgsForCall []struct {
+		logger lager.Logger
+		level  string
+	}
+	setLogLevelReturns struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeLogLevelController) LogLevel(logger lager.Logger) string {
+	fake.logLevelMutex.Lock()
+	fake.logLevelArgsForCall = append(fake.logLevelArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("LogLevel", []interface{}{logger})
+	fake.logLevelMutex.Unlock()
+	if fake.LogLevelStub != nil {
+		return fake.LogLevelStub(logger)
+	} else {
+		return fake.logLevelReturns.result1
+	}
+}
+
+func (fake *FakeLogLevelController) LogLevelCallCount() int {
+	fake.logLevelMutex.RLock()
+	defer fake.logLevelMutex.RUnlock()
+	return len(fake.logLevelArgsForCall)
+}
+
+func (fake *FakeLogLevelController) LogLevelArgsForCall(i int) lager.Logger {
+	fake.logLevelMutex.RLock()
+	defer fake.logLevelMutex.RUnlock()
+	return fake.logLevelArgsForCall[i].logger
+}
+
+func (fake *FakeLogLevelController) LogLevelReturns(result1 string) {
+	fake.LogLevelStub = nil
+	fake.logLevelReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeLogLevelController) SetLogLevel(logger lager.Logger, level string) error {
+	fake.setLogLevelMutex.Lock()
+	fake.setLogLevelArgsForCall = append(fake.setLogLevelArgsForCall, struct {
+		logger lager.Logger
+		level  string
+	}{logger, level})
+	fake.recordInvocation("SetLogLevel", []interface{}{logger, level})
+	fake.setLogLevelMutex.Unlock()
+	if fake.SetLogLevelStub != nil {
+		return fake.SetLogLevelStub(logger, level)
+	} else {
+		return fake.setLogLevelReturns.result1
+	}
+}
+
+func (fake *FakeLogLevelController) SetLogLevelCallCount() int {
+	fake.setLogLevelMutex.RLock()
+	defer fake.setLogLevelMutex.RUnlock()
+	return len(fake.setLogLevelArgsForCall)
+}
+
+func (fake *FakeLogLevelController) SetLogLevelArgsForCall(i int) (lager.Logger, string) {
+	fake.setLogLevelMutex.RLock()
+	defer fake.setLogLevelMutex.RUnlock()
+	return fake.setLogLevelArgsForCall[i].logger, fake.setLogLevelArgsForCall[i].level
+}
+
+func (fake *FakeLogLevelController) SetLogLevelReturns(result1 error) {
+	fake.SetLogLevelStub = nil
+	fake.setLogLevelReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeLogLevelController) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.logLevelMutex.RLock()
+	defer fake.logLevelMutex.RUnlock()
+	fake.setLogLevelMutex.RLock()
+	defer fake.setLogLevelMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeLogLevelController) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ handlers.LogLevelController = new(FakeLogLevelController)
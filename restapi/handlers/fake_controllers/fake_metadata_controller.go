@@ -0,0 +1,82 @@
+// This file was generated by counterfeiter
+package fake_controllers
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/models"
+	"github.com/jianqiu/vps/restapi/handlers"
+)
+
+type FakeMetadataController struct {
+	MetadataStub        func(logger lager.Logger, ip string) (*models.VMMetadata, error)
+	metadataMutex       sync.RWMutex
+	metadataArgsForCall []struct {
+		logger lager.Logger
+		ip     string
+	}
+	metadataReturns struct {
+		result1 *models.VMMetadata
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeMetadataController) Metadata(logger lager.Logger, ip string) (*models.VMMetadata, error) {
+	fake.metadataMutex.Lock()
+	fake.metadataArgsForCall = append(fake.metadataArgsForCall, struct {
+		logger lager.Logger
+		ip     string
+	}{logger, ip})
+	fake.recordInvocation("Metadata", []interface{}{logger, ip})
+	fake.metadataMutex.Unlock()
+	if fake.MetadataStub != nil {
+		return fake.MetadataStub(logger, ip)
+	} else {
+		return fake.metadataReturns.result1, fake.metadataReturns.result2
+	}
+}
+
+func (fake *FakeMetadataController) MetadataCallCount() int {
+	fake.metadataMutex.RLock()
+	defer fake.metadataMutex.RUnlock()
+	return len(fake.metadataArgsForCall)
+}
+
+func (fake *FakeMetadataController) MetadataArgsForCall(i int) (lager.Logger, string) {
+	fake.metadataMutex.RLock()
+	defer fake.metadataMutex.RUnlock()
+	return fake.metadataArgsForCall[i].logger, fake.metadataArgsForCall[i].ip
+}
+
+func (fake *FakeMetadataController) MetadataReturns(result1 *models.VMMetadata, result2 error) {
+	fake.MetadataStub = nil
+	fake.metadataReturns = struct {
+		result1 *models.VMMetadata
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeMetadataController) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.metadataMutex.RLock()
+	defer fake.metadataMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeMetadataController) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ handlers.MetadataController = new(FakeMetadataController)
@@ -0,0 +1,268 @@
+// This file was generated by counterfeiter
+package fake_controllers
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/models"
+	"github.com/jianqiu/vps/restapi/handlers"
+)
+
+type FakePoolController struct {
+	CreatePoolStub        func(logger lager.Logger, pool *models.Pool) (*models.Pool, error)
+	createPoolMutex       sync.RWMutex
+	createPoolArgsForCall []struct {
+		logger lager.Logger
+		pool   *models.Pool
+	}
+	createPoolReturns struct {
+		result1 *models.Pool
+		result2 error
+	}
+	PoolsStub        func(logger lager.Logger) ([]*models.Pool, error)
+	poolsMutex       sync.RWMutex
+	poolsArgsForCall []struct {
+		logger lager.Logger
+	}
+	poolsReturns struct {
+		result1 []*models.Pool
+		result2 error
+	}
+	PoolStub        func(logger lager.Logger, name string) (*models.Pool, error)
+	poolMutex       sync.RWMutex
+	poolArgsForCall []struct {
+		logger lager.Logger
+		name   string
+	}
+	poolReturns struct {
+		result1 *models.Pool
+		result2 error
+	}
+	UpdatePoolStub        func(logger lager.Logger, name string, pool *models.Pool) (*models.Pool, error)
+	updatePoolMutex       sync.RWMutex
+	updatePoolArgsForCall []struct {
+		logger lager.Logger
+		name   string
+		pool   *models.Pool
+	}
+	updatePoolReturns struct {
+		result1 *models.Pool
+		result2 error
+	}
+	DeletePoolStub        func(logger lager.Logger, name string) error
+	deletePoolMutex       sync.RWMutex
+	deletePoolArgsForCall []struct {
+		logger lager.Logger
+		name   string
+	}
+	deletePoolReturns struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakePoolController) CreatePool(logger lager.Logger, pool *models.Pool) (*models.Pool, error) {
+	fake.createPoolMutex.Lock()
+	fake.createPoolArgsForCall = append(fake.createPoolArgsForCall, struct {
+		logger lager.Logger
+		pool   *models.Pool
+	}{logger, pool})
+	fake.recordInvocation("CreatePool", []interface{}{logger, pool})
+	fake.createPoolMutex.Unlock()
+	if fake.CreatePoolStub != nil {
+		return fake.CreatePoolStub(logger, pool)
+	} else {
+		return fake.createPoolReturns.result1, fake.createPoolReturns.result2
+	}
+}
+
+func (fake *FakePoolController) CreatePoolCallCount() int {
+	fake.createPoolMutex.RLock()
+	defer fake.createPoolMutex.RUnlock()
+	return len(fake.createPoolArgsForCall)
+}
+
+func (fake *FakePoolController) CreatePoolArgsForCall(i int) (lager.Logger, *models.Pool) {
+	fake.createPoolMutex.RLock()
+	defer fake.createPoolMutex.RUnlock()
+	return fake.createPoolArgsForCall[i].logger, fake.createPoolArgsForCall[i].pool
+}
+
+func (fake *FakePoolController) CreatePoolReturns(result1 *models.Pool, result2 error) {
+	fake.CreatePoolStub = nil
+	fake.createPoolReturns = struct {
+		result1 *models.Pool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePoolController) Pools(logger lager.Logger) ([]*models.Pool, error) {
+	fake.poolsMutex.Lock()
+	fake.poolsArgsForCall = append(fake.poolsArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("Pools", []interface{}{logger})
+	fake.poolsMutex.Unlock()
+	if fake.PoolsStub != nil {
+		return fake.PoolsStub(logger)
+	} else {
+		return fake.poolsReturns.result1, fake.poolsReturns.result2
+	}
+}
+
+func (fake *FakePoolController) PoolsCallCount() int {
+	fake.poolsMutex.RLock()
+	defer fake.poolsMutex.RUnlock()
+	return len(fake.poolsArgsForCall)
+}
+
+func (fake *FakePoolController) PoolsArgsForCall(i int) lager.Logger {
+	fake.poolsMutex.RLock()
+	defer fake.poolsMutex.RUnlock()
+	return fake.poolsArgsForCall[i].logger
+}
+
+func (fake *FakePoolController) PoolsReturns(result1 []*models.Pool, result2 error) {
+	fake.PoolsStub = nil
+	fake.poolsReturns = struct {
+		result1 []*models.Pool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePoolController) Pool(logger lager.Logger, name string) (*models.Pool, error) {
+	fake.poolMutex.Lock()
+	fake.poolArgsForCall = append(fake.poolArgsForCall, struct {
+		logger lager.Logger
+		name   string
+	}{logger, name})
+	fake.recordInvocation("Pool", []interface{}{logger, name})
+	fake.poolMutex.Unlock()
+	if fake.PoolStub != nil {
+		return fake.PoolStub(logger, name)
+	} else {
+		return fake.poolReturns.result1, fake.poolReturns.result2
+	}
+}
+
+func (fake *FakePoolController) PoolCallCount() int {
+	fake.poolMutex.RLock()
+	defer fake.poolMutex.RUnlock()
+	return len(fake.poolArgsForCall)
+}
+
+func (fake *FakePoolController) PoolArgsForCall(i int) (lager.Logger, string) {
+	fake.poolMutex.RLock()
+	defer fake.poolMutex.RUnlock()
+	return fake.poolArgsForCall[i].logger, fake.poolArgsForCall[i].name
+}
+
+func (fake *FakePoolController) PoolReturns(result1 *models.Pool, result2 error) {
+	fake.PoolStub = nil
+	fake.poolReturns = struct {
+		result1 *models.Pool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePoolController) UpdatePool(logger lager.Logger, name string, pool *models.Pool) (*models.Pool, error) {
+	fake.updatePoolMutex.Lock()
+	fake.updatePoolArgsForCall = append(fake.updatePoolArgsForCall, struct {
+		logger lager.Logger
+		name   string
+		pool   *models.Pool
+	}{logger, name, pool})
+	fake.recordInvocation("UpdatePool", []interface{}{logger, name, pool})
+	fake.updatePoolMutex.Unlock()
+	if fake.UpdatePoolStub != nil {
+		return fake.UpdatePoolStub(logger, name, pool)
+	} else {
+		return fake.updatePoolReturns.result1, fake.updatePoolReturns.result2
+	}
+}
+
+func (fake *FakePoolController) UpdatePoolCallCount() int {
+	fake.updatePoolMutex.RLock()
+	defer fake.updatePoolMutex.RUnlock()
+	return len(fake.updatePoolArgsForCall)
+}
+
+func (fake *FakePoolController) UpdatePoolArgsForCall(i int) (lager.Logger, string, *models.Pool) {
+	fake.updatePoolMutex.RLock()
+	defer fake.updatePoolMutex.RUnlock()
+	return fake.updatePoolArgsForCall[i].logger, fake.updatePoolArgsForCall[i].name, fake.updatePoolArgsForCall[i].pool
+}
+
+func (fake *FakePoolController) UpdatePoolReturns(result1 *models.Pool, result2 error) {
+	fake.UpdatePoolStub = nil
+	fake.updatePoolReturns = struct {
+		result1 *models.Pool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePoolController) DeletePool(logger lager.Logger, name string) error {
+	fake.deletePoolMutex.Lock()
+	fake.deletePoolArgsForCall = append(fake.deletePoolArgsForCall, struct {
+		logger lager.Logger
+		name   string
+	}{logger, name})
+	fake.recordInvocation("DeletePool", []interface{}{logger, name})
+	fake.deletePoolMutex.Unlock()
+	if fake.DeletePoolStub != nil {
+		return fake.DeletePoolStub(logger, name)
+	} else {
+		return fake.deletePoolReturns.result1
+	}
+}
+
+func (fake *FakePoolController) DeletePoolCallCount() int {
+	fake.deletePoolMutex.RLock()
+	defer fake.deletePoolMutex.RUnlock()
+	return len(fake.deletePoolArgsForCall)
+}
+
+func (fake *FakePoolController) DeletePoolArgsForCall(i int) (lager.Logger, string) {
+	fake.deletePoolMutex.RLock()
+	defer fake.deletePoolMutex.RUnlock()
+	return fake.deletePoolArgsForCall[i].logger, fake.deletePoolArgsForCall[i].name
+}
+
+func (fake *FakePoolController) DeletePoolReturns(result1 error) {
+	fake.DeletePoolStub = nil
+	fake.deletePoolReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePoolController) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.createPoolMutex.RLock()
+	defer fake.createPoolMutex.RUnlock()
+	fake.poolsMutex.RLock()
+	defer fake.poolsMutex.RUnlock()
+	fake.poolMutex.RLock()
+	defer fake.poolMutex.RUnlock()
+	fake.updatePoolMutex.RLock()
+	defer fake.updatePoolMutex.RUnlock()
+	fake.deletePoolMutex.RLock()
+	defer fake.deletePoolMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakePoolController) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ handlers.PoolController = new(FakePoolController)
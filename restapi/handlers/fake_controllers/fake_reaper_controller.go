@@ -0,0 +1,80 @@
+// This file was generated by counterfeiter
+package fake_controllers
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/models"
+	"github.com/jianqiu/vps/restapi/handlers"
+)
+
+type FakeReaperController struct {
+	PlanStub        func(logger lager.Logger) ([]*models.VM, error)
+	planMutex       sync.RWMutex
+	planArgsForCall []struct {
+		logger lager.Logger
+	}
+	planReturns struct {
+		result1 []*models.VM
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeReaperController) Plan(logger lager.Logger) ([]*models.VM, error) {
+	fake.planMutex.Lock()
+	fake.planArgsForCall = append(fake.planArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("Plan", []interface{}{logger})
+	fake.planMutex.Unlock()
+	if fake.PlanStub != nil {
+		return fake.PlanStub(logger)
+	} else {
+		return fake.planReturns.result1, fake.planReturns.result2
+	}
+}
+
+func (fake *FakeReaperController) PlanCallCount() int {
+	fake.planMutex.RLock()
+	defer fake.planMutex.RUnlock()
+	return len(fake.planArgsForCall)
+}
+
+func (fake *FakeReaperController) PlanArgsForCall(i int) lager.Logger {
+	fake.planMutex.RLock()
+	defer fake.planMutex.RUnlock()
+	return fake.planArgsForCall[i].logger
+}
+
+func (fake *FakeReaperController) PlanReturns(result1 []*models.VM, result2 error) {
+	fake.PlanStub = nil
+	fake.planReturns = struct {
+		result1 []*models.VM
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReaperController) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.planMutex.RLock()
+	defer fake.planMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeReaperController) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ handlers.ReaperController = new(FakeReaperController)
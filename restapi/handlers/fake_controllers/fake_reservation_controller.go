@@ -0,0 +1,219 @@
+// This file was generated by counterfeiter
+package fake_controllers
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/models"
+	"github.com/jianqiu/vps/restapi/handlers"
+)
+
+type FakeReservationController struct {
+	CreateReservationStub        func(logger lager.Logger, reservation *models.Reservation) (*models.Reservation, error)
+	createReservationMutex       sync.RWMutex
+	createReservationArgsForCall []struct {
+		logger      lager.Logger
+		reservation *models.Reservation
+	}
+	createReservationReturns struct {
+		result1 *models.Reservation
+		result2 error
+	}
+	ReservationsStub        func(logger lager.Logger) ([]*models.Reservation, error)
+	reservationsMutex       sync.RWMutex
+	reservationsArgsForCall []struct {
+		logger lager.Logger
+	}
+	reservationsReturns struct {
+		result1 []*models.Reservation
+		result2 error
+	}
+	ReservationStub        func(logger lager.Logger, id string) (*models.Reservation, error)
+	reservationMutex       sync.RWMutex
+	reservationArgsForCall []struct {
+		logger lager.Logger
+		id     string
+	}
+	reservationReturns struct {
+		result1 *models.Reservation
+		result2 error
+	}
+	CancelReservationStub        func(logger lager.Logger, id string) error
+	cancelReservationMutex       sync.RWMutex
+	cancelReservationArgsForCall []struct {
+		logger lager.Logger
+		id     string
+	}
+	cancelReservationReturns struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeReservationController) CreateReservation(logger lager.Logger, reservation *models.Reservation) (*models.Reservation, error) {
+	fake.createReservationMutex.Lock()
+	fake.createReservationArgsForCall = append(fake.createReservationArgsForCall, struct {
+		logger      lager.Logger
+		reservation *models.Reservation
+	}{logger, reservation})
+	fake.recordInvocation("CreateReservation", []interface{}{logger, reservation})
+	fake.createReservationMutex.Unlock()
+	if fake.CreateReservationStub != nil {
+		return fake.CreateReservationStub(logger, reservation)
+	} else {
+		return fake.createReservationReturns.result1, fake.createReservationReturns.result2
+	}
+}
+
+func (fake *FakeReservationController) CreateReservationCallCount() int {
+	fake.createReservationMutex.RLock()
+	defer fake.createReservationMutex.RUnlock()
+	return len(fake.createReservationArgsForCall)
+}
+
+func (fake *FakeReservationController) CreateReservationArgsForCall(i int) (lager.Logger, *models.Reservation) {
+	fake.createReservationMutex.RLock()
+	defer fake.createReservationMutex.RUnlock()
+	return fake.createReservationArgsForCall[i].logger, fake.createReservationArgsForCall[i].reservation
+}
+
+func (fake *FakeReservationController) CreateReservationReturns(result1 *models.Reservation, result2 error) {
+	fake.CreateReservationStub = nil
+	fake.createReservationReturns = struct {
+		result1 *models.Reservation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReservationController) Reservations(logger lager.Logger) ([]*models.Reservation, error) {
+	fake.reservationsMutex.Lock()
+	fake.reservationsArgsForCall = append(fake.reservationsArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("Reservations", []interface{}{logger})
+	fake.reservationsMutex.Unlock()
+	if fake.ReservationsStub != nil {
+		return fake.ReservationsStub(logger)
+	} else {
+		return fake.reservationsReturns.result1, fake.reservationsReturns.result2
+	}
+}
+
+func (fake *FakeReservationController) ReservationsCallCount() int {
+	fake.reservationsMutex.RLock()
+	defer fake.reservationsMutex.RUnlock()
+	return len(fake.reservationsArgsForCall)
+}
+
+func (fake *FakeReservationController) ReservationsArgsForCall(i int) lager.Logger {
+	fake.reservationsMutex.RLock()
+	defer fake.reservationsMutex.RUnlock()
+	return fake.reservationsArgsForCall[i].logger
+}
+
+func (fake *FakeReservationController) ReservationsReturns(result1 []*models.Reservation, result2 error) {
+	fake.ReservationsStub = nil
+	fake.reservationsReturns = struct {
+		result1 []*models.Reservation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReservationController) Reservation(logger lager.Logger, id string) (*models.Reservation, error) {
+	fake.reservationMutex.Lock()
+	fake.reservationArgsForCall = append(fake.reservationArgsForCall, struct {
+		logger lager.Logger
+		id     string
+	}{logger, id})
+	fake.recordInvocation("Reservation", []interface{}{logger, id})
+	fake.reservationMutex.Unlock()
+	if fake.ReservationStub != nil {
+		return fake.ReservationStub(logger, id)
+	} else {
+		return fake.reservationReturns.result1, fake.reservationReturns.result2
+	}
+}
+
+func (fake *FakeReservationController) ReservationCallCount() int {
+	fake.reservationMutex.RLock()
+	defer fake.reservationMutex.RUnlock()
+	return len(fake.reservationArgsForCall)
+}
+
+func (fake *FakeReservationController) ReservationArgsForCall(i int) (lager.Logger, string) {
+	fake.reservationMutex.RLock()
+	defer fake.reservationMutex.RUnlock()
+	return fake.reservationArgsForCall[i].logger, fake.reservationArgsForCall[i].id
+}
+
+func (fake *FakeReservationController) ReservationReturns(result1 *models.Reservation, result2 error) {
+	fake.ReservationStub = nil
+	fake.reservationReturns = struct {
+		result1 *models.Reservation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeReservationController) CancelReservation(logger lager.Logger, id string) error {
+	fake.cancelReservationMutex.Lock()
+	fake.cancelReservationArgsForCall = append(fake.cancelReservationArgsForCall, struct {
+		logger lager.Logger
+		id     string
+	}{logger, id})
+	fake.recordInvocation("CancelReservation", []interface{}{logger, id})
+	fake.cancelReservationMutex.Unlock()
+	if fake.CancelReservationStub != nil {
+		return fake.CancelReservationStub(logger, id)
+	} else {
+		return fake.cancelReservationReturns.result1
+	}
+}
+
+func (fake *FakeReservationController) CancelReservationCallCount() int {
+	fake.cancelReservationMutex.RLock()
+	defer fake.cancelReservationMutex.RUnlock()
+	return len(fake.cancelReservationArgsForCall)
+}
+
+func (fake *FakeReservationController) CancelReservationArgsForCall(i int) (lager.Logger, string) {
+	fake.cancelReservationMutex.RLock()
+	defer fake.cancelReservationMutex.RUnlock()
+	return fake.cancelReservationArgsForCall[i].logger, fake.cancelReservationArgsForCall[i].id
+}
+
+func (fake *FakeReservationController) CancelReservationReturns(result1 error) {
+	fake.CancelReservationStub = nil
+	fake.cancelReservationReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeReservationController) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.createReservationMutex.RLock()
+	defer fake.createReservationMutex.RUnlock()
+	fake.reservationsMutex.RLock()
+	defer fake.reservationsMutex.RUnlock()
+	fake.reservationMutex.RLock()
+	defer fake.reservationMutex.RUnlock()
+	fake.cancelReservationMutex.RLock()
+	defer fake.cancelReservationMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeReservationController) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ handlers.ReservationController = new(FakeReservationController)
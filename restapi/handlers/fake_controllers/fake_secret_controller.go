@@ -0,0 +1,176 @@
+// This file was generated by counterfeiter
+package fake_controllers
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/models"
+	"github.com/jianqiu/vps/restapi/handlers"
+)
+
+type FakeSecretController struct {
+	VMSecretStub        func(logger lager.Logger, cid int32, token string) (*models.VMSecret, error)
+	vmSecretMutex       sync.RWMutex
+	vmSecretArgsForCall []struct {
+		logger lager.Logger
+		cid    int32
+		token  string
+	}
+	vmSecretReturns struct {
+		result1 *models.VMSecret
+		result2 error
+	}
+	SetVMSecretStub        func(logger lager.Logger, cid int32, secret *models.VMSecret) error
+	setVMSecretMutex       sync.RWMutex
+	setVMSecretArgsForCall []struct {
+		logger lager.Logger
+		cid    int32
+		secret *models.VMSecret
+	}
+	setVMSecretReturns struct {
+		result1 error
+	}
+	RemoveVMSecretStub        func(logger lager.Logger, cid int32) error
+	removeVMSecretMutex       sync.RWMutex
+	removeVMSecretArgsForCall []struct {
+		logger lager.Logger
+		cid    int32
+	}
+	removeVMSecretReturns struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeSecretController) VMSecret(logger lager.Logger, cid int32, token string) (*models.VMSecret, error) {
+	fake.vmSecretMutex.Lock()
+	fake.vmSecretArgsForCall = append(fake.vmSecretArgsForCall, struct {
+		logger lager.Logger
+		cid    int32
+		token  string
+	}{logger, cid, token})
+	fake.recordInvocation("VMSecret", []interface{}{logger, cid, token})
+	fake.vmSecretMutex.Unlock()
+	if fake.VMSecretStub != nil {
+		return fake.VMSecretStub(logger, cid, token)
+	} else {
+		return fake.vmSecretReturns.result1, fake.vmSecretReturns.result2
+	}
+}
+
+func (fake *FakeSecretController) VMSecretCallCount() int {
+	fake.vmSecretMutex.RLock()
+	defer fake.vmSecretMutex.RUnlock()
+	return len(fake.vmSecretArgsForCall)
+}
+
+func (fake *FakeSecretController) VMSecretArgsForCall(i int) (lager.Logger, int32, string) {
+	fake.vmSecretMutex.RLock()
+	defer fake.vmSecretMutex.RUnlock()
+	return fake.vmSecretArgsForCall[i].logger, fake.vmSecretArgsForCall[i].cid, fake.vmSecretArgsForCall[i].token
+}
+
+func (fake *FakeSecretController) VMSecretReturns(result1 *models.VMSecret, result2 error) {
+	fake.VMSecretStub = nil
+	fake.vmSecretReturns = struct {
+		result1 *models.VMSecret
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSecretController) SetVMSecret(logger lager.Logger, cid int32, secret *models.VMSecret) error {
+	fake.setVMSecretMutex.Lock()
+	fake.setVMSecretArgsForCall = append(fake.setVMSecretArgsForCall, struct {
+		logger lager.Logger
+		cid    int32
+		secret *models.VMSecret
+	}{logger, cid, secret})
+	fake.recordInvocation("SetVMSecret", []interface{}{logger, cid, secret})
+	fake.setVMSecretMutex.Unlock()
+	if fake.SetVMSecretStub != nil {
+		return fake.SetVMSecretStub(logger, cid, secret)
+	} else {
+		return fake.setVMSecretReturns.result1
+	}
+}
+
+func (fake *FakeSecretController) SetVMSecretCallCount() int {
+	fake.setVMSecretMutex.RLock()
+	defer fake.setVMSecretMutex.RUnlock()
+	return len(fake.setVMSecretArgsForCall)
+}
+
+func (fake *FakeSecretController) SetVMSecretArgsForCall(i int) (lager.Logger, int32, *models.VMSecret) {
+	fake.setVMSecretMutex.RLock()
+	defer fake.setVMSecretMutex.RUnlock()
+	return fake.setVMSecretArgsForCall[i].logger, fake.setVMSecretArgsForCall[i].cid, fake.setVMSecretArgsForCall[i].secret
+}
+
+func (fake *FakeSecretController) SetVMSecretReturns(result1 error) {
+	fake.SetVMSecretStub = nil
+	fake.setVMSecretReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecretController) RemoveVMSecret(logger lager.Logger, cid int32) error {
+	fake.removeVMSecretMutex.Lock()
+	fake.removeVMSecretArgsForCall = append(fake.removeVMSecretArgsForCall, struct {
+		logger lager.Logger
+		cid    int32
+	}{logger, cid})
+	fake.recordInvocation("RemoveVMSecret", []interface{}{logger, cid})
+	fake.removeVMSecretMutex.Unlock()
+	if fake.RemoveVMSecretStub != nil {
+		return fake.RemoveVMSecretStub(logger, cid)
+	} else {
+		return fake.removeVMSecretReturns.result1
+	}
+}
+
+func (fake *FakeSecretController) RemoveVMSecretCallCount() int {
+	fake.removeVMSecretMutex.RLock()
+	defer fake.removeVMSecretMutex.RUnlock()
+	return len(fake.removeVMSecretArgsForCall)
+}
+
+func (fake *FakeSecretController) RemoveVMSecretArgsForCall(i int) (lager.Logger, int32) {
+	fake.removeVMSecretMutex.RLock()
+	defer fake.removeVMSecretMutex.RUnlock()
+	return fake.removeVMSecretArgsForCall[i].logger, fake.removeVMSecretArgsForCall[i].cid
+}
+
+func (fake *FakeSecretController) RemoveVMSecretReturns(result1 error) {
+	fake.RemoveVMSecretStub = nil
+	fake.removeVMSecretReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecretController) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.vmSecretMutex.RLock()
+	defer fake.vmSecretMutex.RUnlock()
+	fake.setVMSecretMutex.RLock()
+	defer fake.setVMSecretMutex.RUnlock()
+	fake.removeVMSecretMutex.RLock()
+	defer fake.removeVMSecretMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeSecretController) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ handlers.SecretController = new(FakeSecretController)
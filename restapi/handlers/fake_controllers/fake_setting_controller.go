@@ -0,0 +1,223 @@
+// This file was generated by counterfeiter
+package fake_controllers
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/models"
+	"github.com/jianqiu/vps/restapi/handlers"
+)
+
+type FakeSettingController struct {
+	SettingsStub        func(logger lager.Logger) ([]*models.Setting, error)
+	settingsMutex       sync.RWMutex
+	settingsArgsForCall []struct {
+		logger lager.Logger
+	}
+	settingsReturns struct {
+		result1 []*models.Setting
+		result2 error
+	}
+	SettingStub        func(logger lager.Logger, name string) (*models.Setting, error)
+	settingMutex       sync.RWMutex
+	settingArgsForCall []struct {
+		logger lager.Logger
+		name   string
+	}
+	settingReturns struct {
+		result1 *models.Setting
+		result2 error
+	}
+	SetSettingStub        func(logger lager.Logger, name, value string) (*models.Setting, error)
+	setSettingMutex       sync.RWMutex
+	setSettingArgsForCall []struct {
+		logger lager.Logger
+		name   string
+		value  string
+	}
+	setSettingReturns struct {
+		result1 *models.Setting
+		result2 error
+	}
+	ResetSettingStub        func(logger lager.Logger, name string) (*models.Setting, error)
+	resetSettingMutex       sync.RWMutex
+	resetSettingArgsForCall []struct {
+		logger lager.Logger
+		name   string
+	}
+	resetSettingReturns struct {
+		result1 *models.Setting
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeSettingController) Settings(logger lager.Logger) ([]*models.Setting, error) {
+	fake.settingsMutex.Lock()
+	fake.settingsArgsForCall = append(fake.settingsArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("Settings", []interface{}{logger})
+	fake.settingsMutex.Unlock()
+	if fake.SettingsStub != nil {
+		return fake.SettingsStub(logger)
+	} else {
+		return fake.settingsReturns.result1, fake.settingsReturns.result2
+	}
+}
+
+func (fake *FakeSettingController) SettingsCallCount() int {
+	fake.settingsMutex.RLock()
+	defer fake.settingsMutex.RUnlock()
+	return len(fake.settingsArgsForCall)
+}
+
+func (fake *FakeSettingController) SettingsArgsForCall(i int) lager.Logger {
+	fake.settingsMutex.RLock()
+	defer fake.settingsMutex.RUnlock()
+	return fake.settingsArgsForCall[i].logger
+}
+
+func (fake *FakeSettingController) SettingsReturns(result1 []*models.Setting, result2 error) {
+	fake.SettingsStub = nil
+	fake.settingsReturns = struct {
+		result1 []*models.Setting
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSettingController) Setting(logger lager.Logger, name string) (*models.Setting, error) {
+	fake.settingMutex.Lock()
+	fake.settingArgsForCall = append(fake.settingArgsForCall, struct {
+		logger lager.Logger
+		name   string
+	}{logger, name})
+	fake.recordInvocation("Setting", []interface{}{logger, name})
+	fake.settingMutex.Unlock()
+	if fake.SettingStub != nil {
+		return fake.SettingStub(logger, name)
+	} else {
+		return fake.settingReturns.result1, fake.settingReturns.result2
+	}
+}
+
+func (fake *FakeSettingController) SettingCallCount() int {
+	fake.settingMutex.RLock()
+	defer fake.settingMutex.RUnlock()
+	return len(fake.settingArgsForCall)
+}
+
+func (fake *FakeSettingController) SettingArgsForCall(i int) (lager.Logger, string) {
+	fake.settingMutex.RLock()
+	defer fake.settingMutex.RUnlock()
+	return fake.settingArgsForCall[i].logger, fake.settingArgsForCall[i].name
+}
+
+func (fake *FakeSettingController) SettingReturns(result1 *models.Setting, result2 error) {
+	fake.SettingStub = nil
+	fake.settingReturns = struct {
+		result1 *models.Setting
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSettingController) SetSetting(logger lager.Logger, name string, value string) (*models.Setting, error) {
+	fake.setSettingMutex.Lock()
+	fake.setSettingArgsForCall = append(fake.setSettingArgsForCall, struct {
+		logger lager.Logger
+		name   string
+		value  string
+	}{logger, name, value})
+	fake.recordInvocation("SetSetting", []interface{}{logger, name, value})
+	fake.setSettingMutex.Unlock()
+	if fake.SetSettingStub != nil {
+		return fake.SetSettingStub(logger, name, value)
+	} else {
+		return fake.setSettingReturns.result1, fake.setSettingReturns.result2
+	}
+}
+
+func (fake *FakeSettingController) SetSettingCallCount() int {
+	fake.setSettingMutex.RLock()
+	defer fake.setSettingMutex.RUnlock()
+	return len(fake.setSettingArgsForCall)
+}
+
+func (fake *FakeSettingController) SetSettingArgsForCall(i int) (lager.Logger, string, string) {
+	fake.setSettingMutex.RLock()
+	defer fake.setSettingMutex.RUnlock()
+	return fake.setSettingArgsForCall[i].logger, fake.setSettingArgsForCall[i].name, fake.setSettingArgsForCall[i].value
+}
+
+func (fake *FakeSettingController) SetSettingReturns(result1 *models.Setting, result2 error) {
+	fake.SetSettingStub = nil
+	fake.setSettingReturns = struct {
+		result1 *models.Setting
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSettingController) ResetSetting(logger lager.Logger, name string) (*models.Setting, error) {
+	fake.resetSettingMutex.Lock()
+	fake.resetSettingArgsForCall = append(fake.resetSettingArgsForCall, struct {
+		logger lager.Logger
+		name   string
+	}{logger, name})
+	fake.recordInvocation("ResetSetting", []interface{}{logger, name})
+	fake.resetSettingMutex.Unlock()
+	if fake.ResetSettingStub != nil {
+		return fake.ResetSettingStub(logger, name)
+	} else {
+		return fake.resetSettingReturns.result1, fake.resetSettingReturns.result2
+	}
+}
+
+func (fake *FakeSettingController) ResetSettingCallCount() int {
+	fake.resetSettingMutex.RLock()
+	defer fake.resetSettingMutex.RUnlock()
+	return len(fake.resetSettingArgsForCall)
+}
+
+func (fake *FakeSettingController) ResetSettingArgsForCall(i int) (lager.Logger, string) {
+	fake.resetSettingMutex.RLock()
+	defer fake.resetSettingMutex.RUnlock()
+	return fake.resetSettingArgsForCall[i].logger, fake.resetSettingArgsForCall[i].name
+}
+
+func (fake *FakeSettingController) ResetSettingReturns(result1 *models.Setting, result2 error) {
+	fake.ResetSettingStub = nil
+	fake.resetSettingReturns = struct {
+		result1 *models.Setting
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSettingController) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.settingsMutex.RLock()
+	defer fake.settingsMutex.RUnlock()
+	fake.settingMutex.RLock()
+	defer fake.settingMutex.RUnlock()
+	fake.setSettingMutex.RLock()
+	defer fake.setSettingMutex.RUnlock()
+	fake.resetSettingMutex.RLock()
+	defer fake.resetSettingMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeSettingController) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ handlers.SettingController = new(FakeSettingController)
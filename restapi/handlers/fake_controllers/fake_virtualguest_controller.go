@@ -5,6 +5,7 @@ import (
 	"sync"
 
 	"code.cloudfoundry.org/lager"
+	"github.com/go-openapi/strfmt"
 	"github.com/jianqiu/vps/models"
 	"github.com/jianqiu/vps/restapi/handlers"
 )
@@ -43,6 +44,17 @@ type FakeVirtualGuestController struct {
 		result1 *models.VM
 		result2 error
 	}
+	OrderVirtualGuestsStub        func(logger lager.Logger, cids []int32, ips []strfmt.IPv4) ([]*models.VM, error)
+	orderVirtualGuestsMutex       sync.RWMutex
+	orderVirtualGuestsArgsForCall []struct {
+		logger lager.Logger
+		cids   []int32
+		ips    []strfmt.IPv4
+	}
+	orderVirtualGuestsReturns struct {
+		result1 []*models.VM
+		result2 error
+	}
 	VirtualGuestsByDeploymentsStub        func(logger lager.Logger, names []string) ([]*models.VM, error)
 	virtualGuestsByDeploymentsMutex       sync.RWMutex
 	virtualGuestsByDeploymentsArgsForCall []struct {
@@ -100,6 +112,16 @@ type FakeVirtualGuestController struct {
 	updateVMWithStateReturns struct {
 		result1 error
 	}
+	TransitionVMStub        func(logger lager.Logger, cid int32, state models.State) error
+	transitionVMMutex       sync.RWMutex
+	transitionVMArgsForCall []struct {
+		logger lager.Logger
+		cid    int32
+		state  models.State
+	}
+	transitionVMReturns struct {
+		result1 error
+	}
 	VirtualGuestByCidStub        func(logger lager.Logger, cid int32) (*models.VM, error)
 	virtualGuestByCidMutex       sync.RWMutex
 	virtualGuestByCidArgsForCall []struct {
@@ -110,6 +132,45 @@ type FakeVirtualGuestController struct {
 		result1 *models.VM
 		result2 error
 	}
+	VirtualGuestByIPStub        func(logger lager.Logger, ip string) (*models.VM, error)
+	virtualGuestByIPMutex       sync.RWMutex
+	virtualGuestByIPArgsForCall []struct {
+		logger lager.Logger
+		ip     string
+	}
+	virtualGuestByIPReturns struct {
+		result1 *models.VM
+		result2 error
+	}
+	VirtualGuestByHostnameStub        func(logger lager.Logger, hostname string) (*models.VM, error)
+	virtualGuestByHostnameMutex       sync.RWMutex
+	virtualGuestByHostnameArgsForCall []struct {
+		logger   lager.Logger
+		hostname string
+	}
+	virtualGuestByHostnameReturns struct {
+		result1 *models.VM
+		result2 error
+	}
+	VirtualGuestsByHostnameStub        func(logger lager.Logger, pattern string) ([]*models.VM, error)
+	virtualGuestsByHostnameMutex       sync.RWMutex
+	virtualGuestsByHostnameArgsForCall []struct {
+		logger  lager.Logger
+		pattern string
+	}
+	virtualGuestsByHostnameReturns struct {
+		result1 []*models.VM
+		result2 error
+	}
+	SummaryStub        func(logger lager.Logger) (*models.PoolSummary, error)
+	summaryMutex       sync.RWMutex
+	summaryArgsForCall []struct {
+		logger lager.Logger
+	}
+	summaryReturns struct {
+		result1 *models.PoolSummary
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -222,6 +283,52 @@ func (fake *FakeVirtualGuestController) OrderVirtualGuestReturns(result1 *models
 	}{result1, result2}
 }
 
+func (fake *FakeVirtualGuestController) OrderVirtualGuests(logger lager.Logger, cids []int32, ips []strfmt.IPv4) ([]*models.VM, error) {
+	var cidsCopy []int32
+	if cids != nil {
+		cidsCopy = make([]int32, len(cids))
+		copy(cidsCopy, cids)
+	}
+	var ipsCopy []strfmt.IPv4
+	if ips != nil {
+		ipsCopy = make([]strfmt.IPv4, len(ips))
+		copy(ipsCopy, ips)
+	}
+	fake.orderVirtualGuestsMutex.Lock()
+	fake.orderVirtualGuestsArgsForCall = append(fake.orderVirtualGuestsArgsForCall, struct {
+		logger lager.Logger
+		cids   []int32
+		ips    []strfmt.IPv4
+	}{logger, cidsCopy, ipsCopy})
+	fake.recordInvocation("OrderVirtualGuests", []interface{}{logger, cidsCopy, ipsCopy})
+	fake.orderVirtualGuestsMutex.Unlock()
+	if fake.OrderVirtualGuestsStub != nil {
+		return fake.OrderVirtualGuestsStub(logger, cids, ips)
+	} else {
+		return fake.orderVirtualGuestsReturns.result1, fake.orderVirtualGuestsReturns.result2
+	}
+}
+
+func (fake *FakeVirtualGuestController) OrderVirtualGuestsCallCount() int {
+	fake.orderVirtualGuestsMutex.RLock()
+	defer fake.orderVirtualGuestsMutex.RUnlock()
+	return len(fake.orderVirtualGuestsArgsForCall)
+}
+
+func (fake *FakeVirtualGuestController) OrderVirtualGuestsArgsForCall(i int) (lager.Logger, []int32, []strfmt.IPv4) {
+	fake.orderVirtualGuestsMutex.RLock()
+	defer fake.orderVirtualGuestsMutex.RUnlock()
+	return fake.orderVirtualGuestsArgsForCall[i].logger, fake.orderVirtualGuestsArgsForCall[i].cids, fake.orderVirtualGuestsArgsForCall[i].ips
+}
+
+func (fake *FakeVirtualGuestController) OrderVirtualGuestsReturns(result1 []*models.VM, result2 error) {
+	fake.OrderVirtualGuestsStub = nil
+	fake.orderVirtualGuestsReturns = struct {
+		result1 []*models.VM
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeVirtualGuestController) VirtualGuestsByDeployments(logger lager.Logger, names []string) ([]*models.VM, error) {
 	var namesCopy []string
 	if names != nil {
@@ -439,6 +546,41 @@ func (fake *FakeVirtualGuestController) UpdateVMWithStateReturns(result1 error)
 	}{result1}
 }
 
+func (fake *FakeVirtualGuestController) TransitionVM(logger lager.Logger, cid int32, state models.State) error {
+	fake.transitionVMMutex.Lock()
+	fake.transitionVMArgsForCall = append(fake.transitionVMArgsForCall, struct {
+		logger lager.Logger
+		cid    int32
+		state  models.State
+	}{logger, cid, state})
+	fake.recordInvocation("TransitionVM", []interface{}{logger, cid, state})
+	fake.transitionVMMutex.Unlock()
+	if fake.TransitionVMStub != nil {
+		return fake.TransitionVMStub(logger, cid, state)
+	} else {
+		return fake.transitionVMReturns.result1
+	}
+}
+
+func (fake *FakeVirtualGuestController) TransitionVMCallCount() int {
+	fake.transitionVMMutex.RLock()
+	defer fake.transitionVMMutex.RUnlock()
+	return len(fake.transitionVMArgsForCall)
+}
+
+func (fake *FakeVirtualGuestController) TransitionVMArgsForCall(i int) (lager.Logger, int32, models.State) {
+	fake.transitionVMMutex.RLock()
+	defer fake.transitionVMMutex.RUnlock()
+	return fake.transitionVMArgsForCall[i].logger, fake.transitionVMArgsForCall[i].cid, fake.transitionVMArgsForCall[i].state
+}
+
+func (fake *FakeVirtualGuestController) TransitionVMReturns(result1 error) {
+	fake.TransitionVMStub = nil
+	fake.transitionVMReturns = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeVirtualGuestController) VirtualGuestByCid(logger lager.Logger, cid int32) (*models.VM, error) {
 	fake.virtualGuestByCidMutex.Lock()
 	fake.virtualGuestByCidArgsForCall = append(fake.virtualGuestByCidArgsForCall, struct {
@@ -474,6 +616,145 @@ func (fake *FakeVirtualGuestController) VirtualGuestByCidReturns(result1 *models
 	}{result1, result2}
 }
 
+func (fake *FakeVirtualGuestController) VirtualGuestByIP(logger lager.Logger, ip string) (*models.VM, error) {
+	fake.virtualGuestByIPMutex.Lock()
+	fake.virtualGuestByIPArgsForCall = append(fake.virtualGuestByIPArgsForCall, struct {
+		logger lager.Logger
+		ip     string
+	}{logger, ip})
+	fake.recordInvocation("VirtualGuestByIP", []interface{}{logger, ip})
+	fake.virtualGuestByIPMutex.Unlock()
+	if fake.VirtualGuestByIPStub != nil {
+		return fake.VirtualGuestByIPStub(logger, ip)
+	} else {
+		return fake.virtualGuestByIPReturns.result1, fake.virtualGuestByIPReturns.result2
+	}
+}
+
+func (fake *FakeVirtualGuestController) VirtualGuestByIPCallCount() int {
+	fake.virtualGuestByIPMutex.RLock()
+	defer fake.virtualGuestByIPMutex.RUnlock()
+	return len(fake.virtualGuestByIPArgsForCall)
+}
+
+func (fake *FakeVirtualGuestController) VirtualGuestByIPArgsForCall(i int) (lager.Logger, string) {
+	fake.virtualGuestByIPMutex.RLock()
+	defer fake.virtualGuestByIPMutex.RUnlock()
+	return fake.virtualGuestByIPArgsForCall[i].logger, fake.virtualGuestByIPArgsForCall[i].ip
+}
+
+func (fake *FakeVirtualGuestController) VirtualGuestByIPReturns(result1 *models.VM, result2 error) {
+	fake.VirtualGuestByIPStub = nil
+	fake.virtualGuestByIPReturns = struct {
+		result1 *models.VM
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeVirtualGuestController) VirtualGuestByHostname(logger lager.Logger, hostname string) (*models.VM, error) {
+	fake.virtualGuestByHostnameMutex.Lock()
+	fake.virtualGuestByHostnameArgsForCall = append(fake.virtualGuestByHostnameArgsForCall, struct {
+		logger   lager.Logger
+		hostname string
+	}{logger, hostname})
+	fake.recordInvocation("VirtualGuestByHostname", []interface{}{logger, hostname})
+	fake.virtualGuestByHostnameMutex.Unlock()
+	if fake.VirtualGuestByHostnameStub != nil {
+		return fake.VirtualGuestByHostnameStub(logger, hostname)
+	} else {
+		return fake.virtualGuestByHostnameReturns.result1, fake.virtualGuestByHostnameReturns.result2
+	}
+}
+
+func (fake *FakeVirtualGuestController) VirtualGuestByHostnameCallCount() int {
+	fake.virtualGuestByHostnameMutex.RLock()
+	defer fake.virtualGuestByHostnameMutex.RUnlock()
+	return len(fake.virtualGuestByHostnameArgsForCall)
+}
+
+func (fake *FakeVirtualGuestController) VirtualGuestByHostnameArgsForCall(i int) (lager.Logger, string) {
+	fake.virtualGuestByHostnameMutex.RLock()
+	defer fake.virtualGuestByHostnameMutex.RUnlock()
+	return fake.virtualGuestByHostnameArgsForCall[i].logger, fake.virtualGuestByHostnameArgsForCall[i].hostname
+}
+
+func (fake *FakeVirtualGuestController) VirtualGuestByHostnameReturns(result1 *models.VM, result2 error) {
+	fake.VirtualGuestByHostnameStub = nil
+	fake.virtualGuestByHostnameReturns = struct {
+		result1 *models.VM
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeVirtualGuestController) VirtualGuestsByHostname(logger lager.Logger, pattern string) ([]*models.VM, error) {
+	fake.virtualGuestsByHostnameMutex.Lock()
+	fake.virtualGuestsByHostnameArgsForCall = append(fake.virtualGuestsByHostnameArgsForCall, struct {
+		logger  lager.Logger
+		pattern string
+	}{logger, pattern})
+	fake.recordInvocation("VirtualGuestsByHostname", []interface{}{logger, pattern})
+	fake.virtualGuestsByHostnameMutex.Unlock()
+	if fake.VirtualGuestsByHostnameStub != nil {
+		return fake.VirtualGuestsByHostnameStub(logger, pattern)
+	} else {
+		return fake.virtualGuestsByHostnameReturns.result1, fake.virtualGuestsByHostnameReturns.result2
+	}
+}
+
+func (fake *FakeVirtualGuestController) VirtualGuestsByHostnameCallCount() int {
+	fake.virtualGuestsByHostnameMutex.RLock()
+	defer fake.virtualGuestsByHostnameMutex.RUnlock()
+	return len(fake.virtualGuestsByHostnameArgsForCall)
+}
+
+func (fake *FakeVirtualGuestController) VirtualGuestsByHostnameArgsForCall(i int) (lager.Logger, string) {
+	fake.virtualGuestsByHostnameMutex.RLock()
+	defer fake.virtualGuestsByHostnameMutex.RUnlock()
+	return fake.virtualGuestsByHostnameArgsForCall[i].logger, fake.virtualGuestsByHostnameArgsForCall[i].pattern
+}
+
+func (fake *FakeVirtualGuestController) VirtualGuestsByHostnameReturns(result1 []*models.VM, result2 error) {
+	fake.VirtualGuestsByHostnameStub = nil
+	fake.virtualGuestsByHostnameReturns = struct {
+		result1 []*models.VM
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeVirtualGuestController) Summary(logger lager.Logger) (*models.PoolSummary, error) {
+	fake.summaryMutex.Lock()
+	fake.summaryArgsForCall = append(fake.summaryArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("Summary", []interface{}{logger})
+	fake.summaryMutex.Unlock()
+	if fake.SummaryStub != nil {
+		return fake.SummaryStub(logger)
+	} else {
+		return fake.summaryReturns.result1, fake.summaryReturns.result2
+	}
+}
+
+func (fake *FakeVirtualGuestController) SummaryCallCount() int {
+	fake.summaryMutex.RLock()
+	defer fake.summaryMutex.RUnlock()
+	return len(fake.summaryArgsForCall)
+}
+
+func (fake *FakeVirtualGuestController) SummaryArgsForCall(i int) lager.Logger {
+	fake.summaryMutex.RLock()
+	defer fake.summaryMutex.RUnlock()
+	return fake.summaryArgsForCall[i].logger
+}
+
+func (fake *FakeVirtualGuestController) SummaryReturns(result1 *models.PoolSummary, result2 error) {
+	fake.SummaryStub = nil
+	fake.summaryReturns = struct {
+		result1 *models.PoolSummary
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeVirtualGuestController) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -483,6 +764,8 @@ func (fake *FakeVirtualGuestController) Invocations() map[string][][]interface{}
 	defer fake.virtualGuestsMutex.RUnlock()
 	fake.orderVirtualGuestMutex.RLock()
 	defer fake.orderVirtualGuestMutex.RUnlock()
+	fake.orderVirtualGuestsMutex.RLock()
+	defer fake.orderVirtualGuestsMutex.RUnlock()
 	fake.virtualGuestsByDeploymentsMutex.RLock()
 	defer fake.virtualGuestsByDeploymentsMutex.RUnlock()
 	fake.virtualGuestsByStatesMutex.RLock()
@@ -495,8 +778,18 @@ func (fake *FakeVirtualGuestController) Invocations() map[string][][]interface{}
 	defer fake.updateVMMutex.RUnlock()
 	fake.updateVMWithStateMutex.RLock()
 	defer fake.updateVMWithStateMutex.RUnlock()
+	fake.transitionVMMutex.RLock()
+	defer fake.transitionVMMutex.RUnlock()
 	fake.virtualGuestByCidMutex.RLock()
 	defer fake.virtualGuestByCidMutex.RUnlock()
+	fake.virtualGuestByIPMutex.RLock()
+	defer fake.virtualGuestByIPMutex.RUnlock()
+	fake.virtualGuestByHostnameMutex.RLock()
+	defer fake.virtualGuestByHostnameMutex.RUnlock()
+	fake.virtualGuestsByHostnameMutex.RLock()
+	defer fake.virtualGuestsByHostnameMutex.RUnlock()
+	fake.summaryMutex.RLock()
+	defer fake.summaryMutex.RUnlock()
 	return fake.invocations
 }
 
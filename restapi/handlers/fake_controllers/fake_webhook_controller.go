@@ -0,0 +1,262 @@
+// This file was generated by counterfeiter
+package fake_controllers
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/models"
+	"github.com/jianqiu/vps/restapi/handlers"
+)
+
+type FakeWebhookController struct {
+	CreateWebhookStub        func(logger lager.Logger, webhook *models.Webhook) (*models.Webhook, error)
+	createWebhookMutex       sync.RWMutex
+	createWebhookArgsForCall []struct {
+		logger  lager.Logger
+		webhook *models.Webhook
+	}
+	createWebhookReturns struct {
+		result1 *models.Webhook
+		result2 error
+	}
+	WebhooksStub        func(logger lager.Logger) ([]*models.Webhook, error)
+	webhooksMutex       sync.RWMutex
+	webhooksArgsForCall []struct {
+		logger lager.Logger
+	}
+	webhooksReturns struct {
+		result1 []*models.Webhook
+		result2 error
+	}
+	DeleteWebhookStub        func(logger lager.Logger, id string) error
+	deleteWebhookMutex       sync.RWMutex
+	deleteWebhookArgsForCall []struct {
+		logger lager.Logger
+		id     string
+	}
+	deleteWebhookReturns struct {
+		result1 error
+	}
+	DeadWebhookDeliveriesStub        func(logger lager.Logger) ([]*models.WebhookDelivery, error)
+	deadWebhookDeliveriesMutex       sync.RWMutex
+	deadWebhookDeliveriesArgsForCall []struct {
+		logger lager.Logger
+	}
+	deadWebhookDeliveriesReturns struct {
+		result1 []*models.WebhookDelivery
+		result2 error
+	}
+	RetryWebhookDeliveryStub        func(logger lager.Logger, id string) error
+	retryWebhookDeliveryMutex       sync.RWMutex
+	retryWebhookDeliveryArgsForCall []struct {
+		logger lager.Logger
+		id     string
+	}
+	retryWebhookDeliveryReturns struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeWebhookController) CreateWebhook(logger lager.Logger, webhook *models.Webhook) (*models.Webhook, error) {
+	fake.createWebhookMutex.Lock()
+	fake.createWebhookArgsForCall = append(fake.createWebhookArgsForCall, struct {
+		logger  lager.Logger
+		webhook *models.Webhook
+	}{logger, webhook})
+	fake.recordInvocation("CreateWebhook", []interface{}{logger, webhook})
+	fake.createWebhookMutex.Unlock()
+	if fake.CreateWebhookStub != nil {
+		return fake.CreateWebhookStub(logger, webhook)
+	} else {
+		return fake.createWebhookReturns.result1, fake.createWebhookReturns.result2
+	}
+}
+
+func (fake *FakeWebhookController) CreateWebhookCallCount() int {
+	fake.createWebhookMutex.RLock()
+	defer fake.createWebhookMutex.RUnlock()
+	return len(fake.createWebhookArgsForCall)
+}
+
+func (fake *FakeWebhookController) CreateWebhookArgsForCall(i int) (lager.Logger, *models.Webhook) {
+	fake.createWebhookMutex.RLock()
+	defer fake.createWebhookMutex.RUnlock()
+	return fake.createWebhookArgsForCall[i].logger, fake.createWebhookArgsForCall[i].webhook
+}
+
+func (fake *FakeWebhookController) CreateWebhookReturns(result1 *models.Webhook, result2 error) {
+	fake.CreateWebhookStub = nil
+	fake.createWebhookReturns = struct {
+		result1 *models.Webhook
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWebhookController) Webhooks(logger lager.Logger) ([]*models.Webhook, error) {
+	fake.webhooksMutex.Lock()
+	fake.webhooksArgsForCall = append(fake.webhooksArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("Webhooks", []interface{}{logger})
+	fake.webhooksMutex.Unlock()
+	if fake.WebhooksStub != nil {
+		return fake.WebhooksStub(logger)
+	} else {
+		return fake.webhooksReturns.result1, fake.webhooksReturns.result2
+	}
+}
+
+func (fake *FakeWebhookController) WebhooksCallCount() int {
+	fake.webhooksMutex.RLock()
+	defer fake.webhooksMutex.RUnlock()
+	return len(fake.webhooksArgsForCall)
+}
+
+func (fake *FakeWebhookController) WebhooksArgsForCall(i int) lager.Logger {
+	fake.webhooksMutex.RLock()
+	defer fake.webhooksMutex.RUnlock()
+	return fake.webhooksArgsForCall[i].logger
+}
+
+func (fake *FakeWebhookController) WebhooksReturns(result1 []*models.Webhook, result2 error) {
+	fake.WebhooksStub = nil
+	fake.webhooksReturns = struct {
+		result1 []*models.Webhook
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWebhookController) DeleteWebhook(logger lager.Logger, id string) error {
+	fake.deleteWebhookMutex.Lock()
+	fake.deleteWebhookArgsForCall = append(fake.deleteWebhookArgsForCall, struct {
+		logger lager.Logger
+		id     string
+	}{logger, id})
+	fake.recordInvocation("DeleteWebhook", []interface{}{logger, id})
+	fake.deleteWebhookMutex.Unlock()
+	if fake.DeleteWebhookStub != nil {
+		return fake.DeleteWebhookStub(logger, id)
+	} else {
+		return fake.deleteWebhookReturns.result1
+	}
+}
+
+func (fake *FakeWebhookController) DeleteWebhookCallCount() int {
+	fake.deleteWebhookMutex.RLock()
+	defer fake.deleteWebhookMutex.RUnlock()
+	return len(fake.deleteWebhookArgsForCall)
+}
+
+func (fake *FakeWebhookController) DeleteWebhookArgsForCall(i int) (lager.Logger, string) {
+	fake.deleteWebhookMutex.RLock()
+	defer fake.deleteWebhookMutex.RUnlock()
+	return fake.deleteWebhookArgsForCall[i].logger, fake.deleteWebhookArgsForCall[i].id
+}
+
+func (fake *FakeWebhookController) DeleteWebhookReturns(result1 error) {
+	fake.DeleteWebhookStub = nil
+	fake.deleteWebhookReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWebhookController) DeadWebhookDeliveries(logger lager.Logger) ([]*models.WebhookDelivery, error) {
+	fake.deadWebhookDeliveriesMutex.Lock()
+	fake.deadWebhookDeliveriesArgsForCall = append(fake.deadWebhookDeliveriesArgsForCall, struct {
+		logger lager.Logger
+	}{logger})
+	fake.recordInvocation("DeadWebhookDeliveries", []interface{}{logger})
+	fake.deadWebhookDeliveriesMutex.Unlock()
+	if fake.DeadWebhookDeliveriesStub != nil {
+		return fake.DeadWebhookDeliveriesStub(logger)
+	} else {
+		return fake.deadWebhookDeliveriesReturns.result1, fake.deadWebhookDeliveriesReturns.result2
+	}
+}
+
+func (fake *FakeWebhookController) DeadWebhookDeliveriesCallCount() int {
+	fake.deadWebhookDeliveriesMutex.RLock()
+	defer fake.deadWebhookDeliveriesMutex.RUnlock()
+	return len(fake.deadWebhookDeliveriesArgsForCall)
+}
+
+func (fake *FakeWebhookController) DeadWebhookDeliveriesArgsForCall(i int) lager.Logger {
+	fake.deadWebhookDeliveriesMutex.RLock()
+	defer fake.deadWebhookDeliveriesMutex.RUnlock()
+	return fake.deadWebhookDeliveriesArgsForCall[i].logger
+}
+
+func (fake *FakeWebhookController) DeadWebhookDeliveriesReturns(result1 []*models.WebhookDelivery, result2 error) {
+	fake.DeadWebhookDeliveriesStub = nil
+	fake.deadWebhookDeliveriesReturns = struct {
+		result1 []*models.WebhookDelivery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWebhookController) RetryWebhookDelivery(logger lager.Logger, id string) error {
+	fake.retryWebhookDeliveryMutex.Lock()
+	fake.retryWebhookDeliveryArgsForCall = append(fake.retryWebhookDeliveryArgsForCall, struct {
+		logger lager.Logger
+		id     string
+	}{logger, id})
+	fake.recordInvocation("RetryWebhookDelivery", []interface{}{logger, id})
+	fake.retryWebhookDeliveryMutex.Unlock()
+	if fake.RetryWebhookDeliveryStub != nil {
+		return fake.RetryWebhookDeliveryStub(logger, id)
+	} else {
+		return fake.retryWebhookDeliveryReturns.result1
+	}
+}
+
+func (fake *FakeWebhookController) RetryWebhookDeliveryCallCount() int {
+	fake.retryWebhookDeliveryMutex.RLock()
+	defer fake.retryWebhookDeliveryMutex.RUnlock()
+	return len(fake.retryWebhookDeliveryArgsForCall)
+}
+
+func (fake *FakeWebhookController) RetryWebhookDeliveryArgsForCall(i int) (lager.Logger, string) {
+	fake.retryWebhookDeliveryMutex.RLock()
+	defer fake.retryWebhookDeliveryMutex.RUnlock()
+	return fake.retryWebhookDeliveryArgsForCall[i].logger, fake.retryWebhookDeliveryArgsForCall[i].id
+}
+
+func (fake *FakeWebhookController) RetryWebhookDeliveryReturns(result1 error) {
+	fake.RetryWebhookDeliveryStub = nil
+	fake.retryWebhookDeliveryReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWebhookController) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.createWebhookMutex.RLock()
+	defer fake.createWebhookMutex.RUnlock()
+	fake.webhooksMutex.RLock()
+	defer fake.webhooksMutex.RUnlock()
+	fake.deleteWebhookMutex.RLock()
+	defer fake.deleteWebhookMutex.RUnlock()
+	fake.deadWebhookDeliveriesMutex.RLock()
+	defer fake.deadWebhookDeliveriesMutex.RUnlock()
+	fake.retryWebhookDeliveryMutex.RLock()
+	defer fake.retryWebhookDeliveryMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeWebhookController) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ handlers.WebhookController = new(FakeWebhookController)
@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"github.com/jianqiu/vps/models"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/restapi/operations/flavor"
+
+	"code.cloudfoundry.org/lager"
+)
+//go:generate counterfeiter -o fake_controllers/fake_flavor_controller.go . FlavorController
+type FlavorController interface {
+	CreateFlavor(logger lager.Logger, flavor *models.Flavor) (*models.Flavor, error)
+	Flavors(logger lager.Logger) ([]*models.Flavor, error)
+	Flavor(logger lager.Logger, name string) (*models.Flavor, error)
+	UpdateFlavor(logger lager.Logger, name string, flavor *models.Flavor) (*models.Flavor, error)
+	DeleteFlavor(logger lager.Logger, name string) error
+}
+
+func NewFlavorHandler(
+logger lager.Logger,
+controller FlavorController,
+) *FlavorHandler {
+	return &FlavorHandler{
+		logger: logger,
+		controller: controller,
+	}
+}
+
+// FlavorHandler serves the flavor catalog. Every user may read it to order
+// by flavor, only administrators change it.
+type FlavorHandler struct {
+	logger lager.Logger
+	controller FlavorController
+}
+
+func (h *FlavorHandler) CreateFlavor(params flavor.CreateFlavorParams, user *models.User) middleware.Responder {
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("create-flavor")
+
+	if err := authorizeAdmin(user); err != nil {
+		return errorResponse(err, flavor.NewCreateFlavorDefault(500))
+	}
+
+	created, err := h.controller.CreateFlavor(logger, params.Body)
+	if err != nil {
+		return errorResponse(err, flavor.NewCreateFlavorDefault(500))
+	}
+
+	return flavor.NewCreateFlavorOK().WithPayload(created)
+}
+
+func (h *FlavorHandler) ListFlavors(params flavor.ListFlavorsParams, user *models.User) middleware.Responder {
+	var err error
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("list-flavors")
+
+	if err := authenticated(user); err != nil {
+		return errorResponse(err, flavor.NewListFlavorsDefault(500))
+	}
+
+	response := &models.FlavorsResponse{}
+
+	response.Flavors, err = h.controller.Flavors(logger)
+	if err != nil {
+		return errorResponse(err, flavor.NewListFlavorsDefault(500))
+	}
+
+	return flavor.NewListFlavorsOK().WithPayload(response)
+}
+
+func (h *FlavorHandler) GetFlavor(params flavor.GetFlavorParams, user *models.User) middleware.Responder {
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("get-flavor")
+
+	if err := authenticated(user); err != nil {
+		return errorResponse(err, flavor.NewGetFlavorDefault(500))
+	}
+
+	found, err := h.controller.Flavor(logger, params.Name)
+	if err != nil {
+		if models.ConvertError(err).Equal(models.ErrResourceNotFound) {
+			return flavor.NewGetFlavorNotFound()
+		}
+		return errorResponse(err, flavor.NewGetFlavorDefault(500))
+	}
+
+	return flavor.NewGetFlavorOK().WithPayload(found)
+}
+
+func (h *FlavorHandler) UpdateFlavor(params flavor.UpdateFlavorParams, user *models.User) middleware.Responder {
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("update-flavor")
+
+	if err := authorizeAdmin(user); err != nil {
+		return errorResponse(err, flavor.NewUpdateFlavorDefault(500))
+	}
+
+	updated, err := h.controller.UpdateFlavor(logger, params.Name, params.Body)
+	if err != nil {
+		if models.ConvertError(err).Equal(models.ErrResourceNotFound) {
+			return flavor.NewUpdateFlavorNotFound()
+		}
+		return errorResponse(err, flavor.NewUpdateFlavorDefault(500))
+	}
+
+	return flavor.NewUpdateFlavorOK().WithPayload(updated)
+}
+
+func (h *FlavorHandler) DeleteFlavor(params flavor.DeleteFlavorParams, user *models.User) middleware.Responder {
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("delete-flavor")
+
+	if err := authorizeAdmin(user); err != nil {
+		return errorResponse(err, flavor.NewDeleteFlavorDefault(500))
+	}
+
+	err := h.controller.DeleteFlavor(logger, params.Name)
+	if err != nil {
+		if models.ConvertError(err).Equal(models.ErrResourceNotFound) {
+			return flavor.NewDeleteFlavorNotFound()
+		}
+		return errorResponse(err, flavor.NewDeleteFlavorDefault(500))
+	}
+
+	return flavor.NewDeleteFlavorNoContent()
+}
@@ -0,0 +1,182 @@
+package handlers_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jianqiu/vps/restapi/handlers"
+	"github.com/jianqiu/vps/restapi/handlers/fake_controllers"
+	"github.com/jianqiu/vps/models"
+	"github.com/jianqiu/vps/restapi/operations/flavor"
+	"github.com/go-openapi/runtime/middleware"
+	"code.cloudfoundry.org/lager/lagertest"
+)
+
+var _ = Describe("FlavorHandlerFunc", func() {
+	var (
+		logger     *lagertest.TestLogger
+		controller *fake_controllers.FakeFlavorController
+
+		responseResponder middleware.Responder
+
+		handler *handlers.FlavorHandler
+
+		principal *models.User
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		controller = &fake_controllers.FakeFlavorController{}
+		handler = handlers.NewFlavorHandler(logger, controller)
+		principal = &models.User{}
+	})
+
+	Describe("CreateFlavor", func() {
+		var requested *models.Flavor
+
+		BeforeEach(func() {
+			requested = &models.Flavor{Name: "m1.large", CPU: 4, MemoryMb: 8192}
+		})
+
+		JustBeforeEach(func() {
+			params := flavor.NewCreateFlavorParams()
+			params.Body = requested
+			responseResponder = handler.CreateFlavor(params, principal)
+		})
+
+		Context("when the flavor is created", func() {
+			BeforeEach(func() {
+				controller.CreateFlavorReturns(requested, nil)
+			})
+
+			It("returns the created flavor", func() {
+				_, actual := controller.CreateFlavorArgsForCall(0)
+				Expect(actual).To(Equal(requested))
+				Expect(responseResponder).To(Equal(flavor.NewCreateFlavorOK().WithPayload(requested)))
+			})
+		})
+
+		Context("when the flavor is invalid", func() {
+			var invalid error
+
+			BeforeEach(func() {
+				invalid = models.NewInvalidRecordError(models.ErrInvalidField{Field: "cpu", Message: "must be positive"})
+				controller.CreateFlavorReturns(nil, invalid)
+			})
+
+			It("responds with a bad request", func() {
+				expected := flavor.NewCreateFlavorDefault(400)
+				expected.SetPayload(models.ConvertError(invalid))
+				Expect(responseResponder).To(Equal(expected))
+			})
+		})
+
+		Context("when the credentials are those of a pool", func() {
+			BeforeEach(func() {
+				principal = &models.User{Username: "staging", Pool: "staging"}
+			})
+
+			It("is forbidden", func() {
+				Expect(controller.CreateFlavorCallCount()).To(Equal(0))
+
+				expected := flavor.NewCreateFlavorDefault(403)
+				expected.SetPayload(models.ErrUnauthorized)
+				Expect(responseResponder).To(Equal(expected))
+			})
+		})
+	})
+
+	Describe("ListFlavors", func() {
+		var flavors []*models.Flavor
+
+		BeforeEach(func() {
+			flavors = []*models.Flavor{{Name: "m1.small", CPU: 2, MemoryMb: 1024}}
+			controller.FlavorsReturns(flavors, nil)
+		})
+
+		JustBeforeEach(func() {
+			responseResponder = handler.ListFlavors(flavor.NewListFlavorsParams(), principal)
+		})
+
+		It("returns the flavors", func() {
+			Expect(responseResponder).To(Equal(flavor.NewListFlavorsOK().WithPayload(&models.FlavorsResponse{Flavors: flavors})))
+		})
+
+		Context("when the credentials are those of a pool", func() {
+			BeforeEach(func() {
+				principal = &models.User{Username: "staging", Pool: "staging"}
+			})
+
+			It("returns the flavors as well", func() {
+				Expect(responseResponder).To(Equal(flavor.NewListFlavorsOK().WithPayload(&models.FlavorsResponse{Flavors: flavors})))
+			})
+		})
+	})
+
+	Describe("GetFlavor", func() {
+		JustBeforeEach(func() {
+			params := flavor.NewGetFlavorParams()
+			params.Name = "m1.large"
+			responseResponder = handler.GetFlavor(params, principal)
+		})
+
+		Context("when the flavor does not exist", func() {
+			BeforeEach(func() {
+				controller.FlavorReturns(nil, models.ErrResourceNotFound)
+			})
+
+			It("responds with not found", func() {
+				_, name := controller.FlavorArgsForCall(0)
+				Expect(name).To(Equal("m1.large"))
+				Expect(responseResponder).To(Equal(flavor.NewGetFlavorNotFound()))
+			})
+		})
+	})
+
+	Describe("UpdateFlavor", func() {
+		var requested *models.Flavor
+
+		BeforeEach(func() {
+			requested = &models.Flavor{CPU: 8, MemoryMb: 16384}
+			controller.UpdateFlavorReturns(&models.Flavor{Name: "m1.large", CPU: 8, MemoryMb: 16384}, nil)
+		})
+
+		JustBeforeEach(func() {
+			params := flavor.NewUpdateFlavorParams()
+			params.Name = "m1.large"
+			params.Body = requested
+			responseResponder = handler.UpdateFlavor(params, principal)
+		})
+
+		It("updates the flavor named by the route", func() {
+			_, name, actual := controller.UpdateFlavorArgsForCall(0)
+			Expect(name).To(Equal("m1.large"))
+			Expect(actual).To(Equal(requested))
+			Expect(responseResponder).To(Equal(flavor.NewUpdateFlavorOK().WithPayload(&models.Flavor{Name: "m1.large", CPU: 8, MemoryMb: 16384})))
+		})
+	})
+
+	Describe("DeleteFlavor", func() {
+		JustBeforeEach(func() {
+			params := flavor.NewDeleteFlavorParams()
+			params.Name = "m1.large"
+			responseResponder = handler.DeleteFlavor(params, principal)
+		})
+
+		It("removes the flavor", func() {
+			_, name := controller.DeleteFlavorArgsForCall(0)
+			Expect(name).To(Equal("m1.large"))
+			Expect(responseResponder).To(Equal(flavor.NewDeleteFlavorNoContent()))
+		})
+
+		Context("when the flavor does not exist", func() {
+			BeforeEach(func() {
+				controller.DeleteFlavorReturns(models.ErrResourceNotFound)
+			})
+
+			It("responds with not found", func() {
+				Expect(responseResponder).To(Equal(flavor.NewDeleteFlavorNotFound()))
+			})
+		})
+	})
+})
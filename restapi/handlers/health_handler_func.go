@@ -0,0 +1,42 @@
+package handlers
+
+import (
+	"github.com/jianqiu/vps/models"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/restapi/operations/health"
+
+	"code.cloudfoundry.org/lager"
+)
+//go:generate counterfeiter -o fake_controllers/fake_health_controller.go . HealthController
+type HealthController interface {
+	Liveness(logger lager.Logger) *models.Health
+	Readiness(logger lager.Logger) (*models.Health, bool)
+}
+
+func NewHealthHandler(
+logger lager.Logger,
+controller HealthController,
+) *HealthHandler {
+	return &HealthHandler{
+		logger: logger,
+		controller: controller,
+	}
+}
+
+type HealthHandler struct {
+	logger lager.Logger
+	controller HealthController
+}
+
+func (h *HealthHandler) GetHealthz(params health.GetHealthzParams) middleware.Responder {
+	return health.NewGetHealthzOK().WithPayload(h.controller.Liveness(requestLogger(h.logger, params.HTTPRequest).Session("healthz")))
+}
+
+func (h *HealthHandler) GetReadyz(params health.GetReadyzParams) middleware.Responder {
+	status, ready := h.controller.Readiness(requestLogger(h.logger, params.HTTPRequest).Session("readyz"))
+	if !ready {
+		return health.NewGetReadyzServiceUnavailable().WithPayload(status)
+	}
+
+	return health.NewGetReadyzOK().WithPayload(status)
+}
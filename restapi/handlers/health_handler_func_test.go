@@ -0,0 +1,65 @@
+package handlers_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jianqiu/vps/restapi/handlers"
+	"github.com/jianqiu/vps/restapi/handlers/fake_controllers"
+	"github.com/jianqiu/vps/models"
+	"github.com/jianqiu/vps/restapi/operations/health"
+	"code.cloudfoundry.org/lager/lagertest"
+)
+
+var _ = Describe("HealthHandlerFunc", func() {
+	var (
+		logger     *lagertest.TestLogger
+		controller *fake_controllers.FakeHealthController
+		handler    *handlers.HealthHandler
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		controller = &fake_controllers.FakeHealthController{}
+		handler = handlers.NewHealthHandler(logger, controller)
+	})
+
+	Describe("GetHealthz", func() {
+		It("returns the liveness", func() {
+			alive := &models.Health{Status: models.HealthStatusOk}
+			controller.LivenessReturns(alive)
+
+			Expect(handler.GetHealthz(health.NewGetHealthzParams())).To(Equal(health.NewGetHealthzOK().WithPayload(alive)))
+		})
+	})
+
+	Describe("GetReadyz", func() {
+		var status *models.Health
+
+		BeforeEach(func() {
+			status = &models.Health{Database: &models.DatabaseHealth{Flavor: "mysql"}}
+		})
+
+		Context("when the server is ready", func() {
+			BeforeEach(func() {
+				status.Status = models.HealthStatusOk
+				controller.ReadinessReturns(status, true)
+			})
+
+			It("responds ok", func() {
+				Expect(handler.GetReadyz(health.NewGetReadyzParams())).To(Equal(health.NewGetReadyzOK().WithPayload(status)))
+			})
+		})
+
+		Context("when the server is not ready", func() {
+			BeforeEach(func() {
+				status.Status = models.HealthStatusUnavailable
+				controller.ReadinessReturns(status, false)
+			})
+
+			It("responds service unavailable", func() {
+				Expect(handler.GetReadyz(health.NewGetReadyzParams())).To(Equal(health.NewGetReadyzServiceUnavailable().WithPayload(status)))
+			})
+		})
+	})
+})
@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"github.com/jianqiu/vps/models"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/restapi/operations/admin"
+
+	"code.cloudfoundry.org/lager"
+)
+//go:generate counterfeiter -o fake_controllers/fake_log_level_controller.go . LogLevelController
+type LogLevelController interface {
+	LogLevel(logger lager.Logger) string
+	SetLogLevel(logger lager.Logger, level string) error
+}
+
+func NewLogLevelHandler(
+logger lager.Logger,
+controller LogLevelController,
+) *LogLevelHandler {
+	return &LogLevelHandler{
+		logger: logger,
+		controller: controller,
+	}
+}
+
+// LogLevelHandler serves the log level of the server, to the admin only.
+type LogLevelHandler struct {
+	logger lager.Logger
+	controller LogLevelController
+}
+
+func (h *LogLevelHandler) GetLogLevel(params admin.GetLogLevelParams, user *models.User) middleware.Responder {
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("get-log-level")
+
+	if err := authorizeAdmin(user); err != nil {
+		return errorResponse(err, admin.NewGetLogLevelDefault(500))
+	}
+
+	return admin.NewGetLogLevelOK().WithPayload(&models.LogLevel{Level: h.controller.LogLevel(logger)})
+}
+
+func (h *LogLevelHandler) SetLogLevel(params admin.SetLogLevelParams, user *models.User) middleware.Responder {
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("set-log-level")
+
+	if err := authorizeAdmin(user); err != nil {
+		return errorResponse(err, admin.NewSetLogLevelDefault(500))
+	}
+
+	err := h.controller.SetLogLevel(logger, params.Body.Level)
+	if err != nil {
+		return errorResponse(err, admin.NewSetLogLevelDefault(500))
+	}
+
+	return admin.NewSetLogLevelOK().WithPayload(&models.LogLevel{Level: params.Body.Level})
+}
@@ -0,0 +1,102 @@
+package handlers_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jianqiu/vps/restapi/handlers"
+	"github.com/jianqiu/vps/restapi/handlers/fake_controllers"
+	"github.com/jianqiu/vps/models"
+	"github.com/jianqiu/vps/restapi/operations/admin"
+	"github.com/go-openapi/runtime/middleware"
+	"code.cloudfoundry.org/lager/lagertest"
+)
+
+var _ = Describe("LogLevelHandlerFunc", func() {
+	var (
+		logger     *lagertest.TestLogger
+		controller *fake_controllers.FakeLogLevelController
+
+		responseResponder middleware.Responder
+
+		handler *handlers.LogLevelHandler
+
+		principal *models.User
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		controller = &fake_controllers.FakeLogLevelController{}
+		handler = handlers.NewLogLevelHandler(logger, controller)
+		principal = &models.User{}
+	})
+
+	Describe("GetLogLevel", func() {
+		BeforeEach(func() {
+			controller.LogLevelReturns("info")
+		})
+
+		JustBeforeEach(func() {
+			responseResponder = handler.GetLogLevel(admin.NewGetLogLevelParams(), principal)
+		})
+
+		It("returns the level", func() {
+			Expect(responseResponder).To(Equal(admin.NewGetLogLevelOK().WithPayload(&models.LogLevel{Level: "info"})))
+		})
+
+		Context("when the credentials are those of a pool", func() {
+			BeforeEach(func() {
+				principal = &models.User{Username: "staging", Pool: "staging"}
+			})
+
+			It("is forbidden", func() {
+				expected := admin.NewGetLogLevelDefault(403)
+				expected.SetPayload(models.ErrUnauthorized)
+				Expect(responseResponder).To(Equal(expected))
+			})
+		})
+	})
+
+	Describe("SetLogLevel", func() {
+		JustBeforeEach(func() {
+			params := admin.NewSetLogLevelParams()
+			params.Body = &models.LogLevel{Level: "debug"}
+			responseResponder = handler.SetLogLevel(params, principal)
+		})
+
+		It("changes the level", func() {
+			_, level := controller.SetLogLevelArgsForCall(0)
+			Expect(level).To(Equal("debug"))
+			Expect(responseResponder).To(Equal(admin.NewSetLogLevelOK().WithPayload(&models.LogLevel{Level: "debug"})))
+		})
+
+		Context("when the level is not known", func() {
+			var invalid error
+
+			BeforeEach(func() {
+				invalid = models.NewInvalidRecordError(models.ErrInvalidField{Field: "level", Message: "must be debug, info, error or fatal"})
+				controller.SetLogLevelReturns(invalid)
+			})
+
+			It("responds with a bad request", func() {
+				expected := admin.NewSetLogLevelDefault(400)
+				expected.SetPayload(models.ConvertError(invalid))
+				Expect(responseResponder).To(Equal(expected))
+			})
+		})
+
+		Context("when the credentials are those of a pool", func() {
+			BeforeEach(func() {
+				principal = &models.User{Username: "staging", Pool: "staging"}
+			})
+
+			It("is forbidden without changing the level", func() {
+				Expect(controller.SetLogLevelCallCount()).To(Equal(0))
+
+				expected := admin.NewSetLogLevelDefault(403)
+				expected.SetPayload(models.ErrUnauthorized)
+				Expect(responseResponder).To(Equal(expected))
+			})
+		})
+	})
+})
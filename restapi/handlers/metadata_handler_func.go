@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"net"
+
+	"github.com/jianqiu/vps/models"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/restapi/operations/metadata"
+
+	"code.cloudfoundry.org/lager"
+)
+//go:generate counterfeiter -o fake_controllers/fake_metadata_controller.go . MetadataController
+type MetadataController interface {
+	Metadata(logger lager.Logger, ip string) (*models.VMMetadata, error)
+}
+
+func NewMetadataHandler(
+logger lager.Logger,
+controller MetadataController,
+) *MetadataHandler {
+	return &MetadataHandler{
+		logger: logger,
+		controller: controller,
+	}
+}
+
+// MetadataHandler answers a VM with its own metadata. The VM needs no
+// credentials: it is the one whose IP the request comes from, so the server
+// must see the addresses of the VMs rather than those of a proxy.
+type MetadataHandler struct {
+	logger lager.Logger
+	controller MetadataController
+}
+
+func (h *MetadataHandler) GetVMMetadata(params metadata.GetVMMetadataParams) middleware.Responder {
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("get-vm-metadata")
+
+	ip := params.HTTPRequest.RemoteAddr
+	if host, _, err := net.SplitHostPort(ip); err == nil {
+		ip = host
+	}
+
+	found, err := h.controller.Metadata(logger, ip)
+	if err != nil {
+		if models.ConvertError(err).Equal(models.ErrResourceNotFound) {
+			return metadata.NewGetVMMetadataNotFound()
+		}
+		return errorResponse(err, metadata.NewGetVMMetadataDefault(500))
+	}
+
+	return metadata.NewGetVMMetadataOK().WithPayload(found)
+}
@@ -0,0 +1,74 @@
+package handlers_test
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jianqiu/vps/restapi/handlers"
+	"github.com/jianqiu/vps/restapi/handlers/fake_controllers"
+	"github.com/jianqiu/vps/models"
+	"github.com/jianqiu/vps/restapi/operations/metadata"
+	"github.com/go-openapi/runtime/middleware"
+	"code.cloudfoundry.org/lager/lagertest"
+)
+
+var _ = Describe("MetadataHandlerFunc", func() {
+	var (
+		logger     *lagertest.TestLogger
+		controller *fake_controllers.FakeMetadataController
+
+		responseResponder middleware.Responder
+
+		handler *handlers.MetadataHandler
+		found   *models.VMMetadata
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		controller = &fake_controllers.FakeMetadataController{}
+		handler = handlers.NewMetadataHandler(logger, controller)
+		found = &models.VMMetadata{Cid: 1, IP: "10.0.0.1", UserData: `{"agent_id":"abc"}`}
+	})
+
+	JustBeforeEach(func() {
+		params := metadata.NewGetVMMetadataParams()
+		params.HTTPRequest = &http.Request{RemoteAddr: "10.0.0.1:54321"}
+		responseResponder = handler.GetVMMetadata(params)
+	})
+
+	Context("when the request comes from a vm in use", func() {
+		BeforeEach(func() {
+			controller.MetadataReturns(found, nil)
+		})
+
+		It("answers with the metadata of the vm with the address", func() {
+			_, ip := controller.MetadataArgsForCall(0)
+			Expect(ip).To(Equal("10.0.0.1"))
+			Expect(responseResponder).To(Equal(metadata.NewGetVMMetadataOK().WithPayload(found)))
+		})
+	})
+
+	Context("when the request comes from anywhere else", func() {
+		BeforeEach(func() {
+			controller.MetadataReturns(nil, models.ErrResourceNotFound)
+		})
+
+		It("responds with not found", func() {
+			Expect(responseResponder).To(Equal(metadata.NewGetVMMetadataNotFound()))
+		})
+	})
+
+	Context("when the DB fails", func() {
+		BeforeEach(func() {
+			controller.MetadataReturns(nil, models.ErrUnknownError)
+		})
+
+		It("responds with an error", func() {
+			expected := metadata.NewGetVMMetadataDefault(500)
+			expected.SetPayload(models.ErrUnknownError)
+			Expect(responseResponder).To(Equal(expected))
+		})
+	})
+})
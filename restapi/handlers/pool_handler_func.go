@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"github.com/jianqiu/vps/models"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/restapi/operations/pool"
+
+	"code.cloudfoundry.org/lager"
+)
+//go:generate counterfeiter -o fake_controllers/fake_pool_controller.go . PoolController
+type PoolController interface {
+	CreatePool(logger lager.Logger, pool *models.Pool) (*models.Pool, error)
+	Pools(logger lager.Logger) ([]*models.Pool, error)
+	Pool(logger lager.Logger, name string) (*models.Pool, error)
+	UpdatePool(logger lager.Logger, name string, pool *models.Pool) (*models.Pool, error)
+	DeletePool(logger lager.Logger, name string) error
+}
+
+func NewPoolHandler(
+logger lager.Logger,
+controller PoolController,
+) *PoolHandler {
+	return &PoolHandler{
+		logger: logger,
+		controller: controller,
+	}
+}
+
+type PoolHandler struct {
+	logger lager.Logger
+	controller PoolController
+}
+
+func (h *PoolHandler) CreatePool(params pool.CreatePoolParams, user *models.User) middleware.Responder {
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("create-pool")
+
+	if err := authorizeAdmin(user); err != nil {
+		return errorResponse(err, pool.NewCreatePoolDefault(500))
+	}
+
+	created, err := h.controller.CreatePool(logger, params.Body)
+	if err != nil {
+		return errorResponse(err, pool.NewCreatePoolDefault(500))
+	}
+
+	return pool.NewCreatePoolOK().WithPayload(created)
+}
+
+func (h *PoolHandler) ListPools(params pool.ListPoolsParams, user *models.User) middleware.Responder {
+	var err error
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("list-pools")
+
+	if err := authorizeAdmin(user); err != nil {
+		return errorResponse(err, pool.NewListPoolsDefault(500))
+	}
+
+	response := &models.PoolsResponse{}
+
+	response.Pools, err = h.controller.Pools(logger)
+	if err != nil {
+		return errorResponse(err, pool.NewListPoolsDefault(500))
+	}
+
+	return pool.NewListPoolsOK().WithPayload(response)
+}
+
+func (h *PoolHandler) GetPool(params pool.GetPoolParams, user *models.User) middleware.Responder {
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("get-pool")
+
+	if err := authorize(user, params.Pool); err != nil {
+		return errorResponse(err, pool.NewGetPoolDefault(500))
+	}
+
+	found, err := h.controller.Pool(logger, params.Pool)
+	if err != nil {
+		if models.ConvertError(err).Equal(models.ErrResourceNotFound) {
+			return pool.NewGetPoolNotFound()
+		}
+		return errorResponse(err, pool.NewGetPoolDefault(500))
+	}
+
+	return pool.NewGetPoolOK().WithPayload(found)
+}
+
+func (h *PoolHandler) UpdatePool(params pool.UpdatePoolParams, user *models.User) middleware.Responder {
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("update-pool")
+
+	if err := authorizeAdmin(user); err != nil {
+		return errorResponse(err, pool.NewUpdatePoolDefault(500))
+	}
+
+	updated, err := h.controller.UpdatePool(logger, params.Pool, params.Body)
+	if err != nil {
+		if models.ConvertError(err).Equal(models.ErrResourceNotFound) {
+			return pool.NewUpdatePoolNotFound()
+		}
+		return errorResponse(err, pool.NewUpdatePoolDefault(500))
+	}
+
+	return pool.NewUpdatePoolOK().WithPayload(updated)
+}
+
+func (h *PoolHandler) DeletePool(params pool.DeletePoolParams, user *models.User) middleware.Responder {
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("delete-pool")
+
+	if err := authorizeAdmin(user); err != nil {
+		return errorResponse(err, pool.NewDeletePoolDefault(500))
+	}
+
+	err := h.controller.DeletePool(logger, params.Pool)
+	if err != nil {
+		if models.ConvertError(err).Equal(models.ErrResourceNotFound) {
+			return pool.NewDeletePoolNotFound()
+		}
+		return errorResponse(err, pool.NewDeletePoolDefault(500))
+	}
+
+	return pool.NewDeletePoolNoContent()
+}
@@ -0,0 +1,261 @@
+package handlers_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jianqiu/vps/restapi/handlers"
+	"github.com/jianqiu/vps/restapi/handlers/fake_controllers"
+	"github.com/jianqiu/vps/models"
+	"github.com/jianqiu/vps/restapi/operations/pool"
+	"github.com/go-openapi/runtime/middleware"
+	"code.cloudfoundry.org/lager/lagertest"
+)
+
+var _ = Describe("PoolHandlerFunc", func() {
+	var (
+		logger     *lagertest.TestLogger
+		controller *fake_controllers.FakePoolController
+
+		responseResponder middleware.Responder
+
+		handler *handlers.PoolHandler
+
+		principal *models.User
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		controller = &fake_controllers.FakePoolController{}
+		handler = handlers.NewPoolHandler(logger, controller)
+		principal = &models.User{}
+	})
+
+	Describe("CreatePool", func() {
+		var requested *models.Pool
+
+		BeforeEach(func() {
+			requested = &models.Pool{Name: "staging", Username: "staging", Password: "s3cret"}
+		})
+
+		JustBeforeEach(func() {
+			params := pool.NewCreatePoolParams()
+			params.Body = requested
+			responseResponder = handler.CreatePool(params, principal)
+		})
+
+		Context("when the pool is created", func() {
+			var created *models.Pool
+
+			BeforeEach(func() {
+				created = &models.Pool{Name: "staging", Username: "staging"}
+				controller.CreatePoolReturns(created, nil)
+			})
+
+			It("returns the created pool", func() {
+				Expect(controller.CreatePoolCallCount()).To(Equal(1))
+				_, actual := controller.CreatePoolArgsForCall(0)
+				Expect(actual).To(Equal(requested))
+				Expect(responseResponder).To(Equal(pool.NewCreatePoolOK().WithPayload(created)))
+			})
+		})
+
+		Context("when the pool already exists", func() {
+			BeforeEach(func() {
+				controller.CreatePoolReturns(nil, models.ErrResourceExists)
+			})
+
+			It("responds with a conflict", func() {
+				expected := pool.NewCreatePoolDefault(409)
+				expected.SetPayload(models.ErrResourceExists)
+				Expect(responseResponder).To(Equal(expected))
+			})
+		})
+
+		Context("when the credentials are those of a pool", func() {
+			BeforeEach(func() {
+				principal = &models.User{Username: "prod", Pool: "prod"}
+			})
+
+			It("is forbidden", func() {
+				Expect(controller.CreatePoolCallCount()).To(Equal(0))
+
+				expected := pool.NewCreatePoolDefault(403)
+				expected.SetPayload(models.ErrUnauthorized)
+				Expect(responseResponder).To(Equal(expected))
+			})
+		})
+	})
+
+	Describe("ListPools", func() {
+		JustBeforeEach(func() {
+			responseResponder = handler.ListPools(pool.NewListPoolsParams(), principal)
+		})
+
+		Context("when listing succeeds", func() {
+			var pools []*models.Pool
+
+			BeforeEach(func() {
+				pools = []*models.Pool{{Name: models.DefaultPool}, {Name: "staging"}}
+				controller.PoolsReturns(pools, nil)
+			})
+
+			It("returns the pools", func() {
+				Expect(responseResponder).To(Equal(pool.NewListPoolsOK().WithPayload(&models.PoolsResponse{Pools: pools})))
+			})
+		})
+
+		Context("when listing fails", func() {
+			BeforeEach(func() {
+				controller.PoolsReturns(nil, errors.New("kaboom"))
+			})
+
+			It("responds with an error", func() {
+				expected := pool.NewListPoolsDefault(500)
+				expected.SetPayload(models.ConvertError(errors.New("kaboom")))
+				Expect(responseResponder).To(Equal(expected))
+			})
+		})
+	})
+
+	Describe("GetPool", func() {
+		JustBeforeEach(func() {
+			params := pool.NewGetPoolParams()
+			params.Pool = "staging"
+			responseResponder = handler.GetPool(params, principal)
+		})
+
+		Context("when the pool exists", func() {
+			var found *models.Pool
+
+			BeforeEach(func() {
+				found = &models.Pool{Name: "staging"}
+				controller.PoolReturns(found, nil)
+			})
+
+			It("returns the pool", func() {
+				_, name := controller.PoolArgsForCall(0)
+				Expect(name).To(Equal("staging"))
+				Expect(responseResponder).To(Equal(pool.NewGetPoolOK().WithPayload(found)))
+			})
+
+			Context("and the credentials are those of the pool", func() {
+				BeforeEach(func() {
+					principal = &models.User{Username: "staging", Pool: "staging"}
+				})
+
+				It("returns the pool", func() {
+					Expect(responseResponder).To(Equal(pool.NewGetPoolOK().WithPayload(found)))
+				})
+			})
+
+			Context("and the credentials are those of another pool", func() {
+				BeforeEach(func() {
+					principal = &models.User{Username: "prod", Pool: "prod"}
+				})
+
+				It("is forbidden", func() {
+					Expect(controller.PoolCallCount()).To(Equal(0))
+
+					expected := pool.NewGetPoolDefault(403)
+					expected.SetPayload(models.ErrUnauthorized)
+					Expect(responseResponder).To(Equal(expected))
+				})
+			})
+		})
+
+		Context("when the pool does not exist", func() {
+			BeforeEach(func() {
+				controller.PoolReturns(nil, models.ErrResourceNotFound)
+			})
+
+			It("responds with not found", func() {
+				Expect(responseResponder).To(Equal(pool.NewGetPoolNotFound()))
+			})
+		})
+	})
+
+	Describe("UpdatePool", func() {
+		var requested *models.Pool
+
+		BeforeEach(func() {
+			requested = &models.Pool{Description: "staging vms"}
+		})
+
+		JustBeforeEach(func() {
+			params := pool.NewUpdatePoolParams()
+			params.Pool = "staging"
+			params.Body = requested
+			responseResponder = handler.UpdatePool(params, principal)
+		})
+
+		Context("when the pool is updated", func() {
+			var updated *models.Pool
+
+			BeforeEach(func() {
+				updated = &models.Pool{Name: "staging", Description: "staging vms"}
+				controller.UpdatePoolReturns(updated, nil)
+			})
+
+			It("returns the updated pool", func() {
+				_, name, actual := controller.UpdatePoolArgsForCall(0)
+				Expect(name).To(Equal("staging"))
+				Expect(actual).To(Equal(requested))
+				Expect(responseResponder).To(Equal(pool.NewUpdatePoolOK().WithPayload(updated)))
+			})
+		})
+
+		Context("when the pool does not exist", func() {
+			BeforeEach(func() {
+				controller.UpdatePoolReturns(nil, models.ErrResourceNotFound)
+			})
+
+			It("responds with not found", func() {
+				Expect(responseResponder).To(Equal(pool.NewUpdatePoolNotFound()))
+			})
+		})
+	})
+
+	Describe("DeletePool", func() {
+		JustBeforeEach(func() {
+			params := pool.NewDeletePoolParams()
+			params.Pool = "staging"
+			responseResponder = handler.DeletePool(params, principal)
+		})
+
+		Context("when the pool is deleted", func() {
+			It("responds with no content", func() {
+				_, name := controller.DeletePoolArgsForCall(0)
+				Expect(name).To(Equal("staging"))
+				Expect(responseResponder).To(Equal(pool.NewDeletePoolNoContent()))
+			})
+		})
+
+		Context("when the pool still has vms", func() {
+			var conflict *models.Error
+
+			BeforeEach(func() {
+				conflict = models.NewResourceConflictError(models.ErrInvalidField{Field: "pool", Message: "still has vms"})
+				controller.DeletePoolReturns(conflict)
+			})
+
+			It("responds with a conflict", func() {
+				expected := pool.NewDeletePoolDefault(409)
+				expected.SetPayload(conflict)
+				Expect(responseResponder).To(Equal(expected))
+			})
+		})
+
+		Context("when the pool does not exist", func() {
+			BeforeEach(func() {
+				controller.DeletePoolReturns(models.ErrResourceNotFound)
+			})
+
+			It("responds with not found", func() {
+				Expect(responseResponder).To(Equal(pool.NewDeletePoolNotFound()))
+			})
+		})
+	})
+})
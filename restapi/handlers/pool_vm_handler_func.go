@@ -0,0 +1,210 @@
+package handlers
+
+import (
+	"github.com/jianqiu/vps/models"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/restapi/operations/pool"
+	"github.com/jianqiu/vps/restapi/operations/vm"
+
+	"code.cloudfoundry.org/lager"
+)
+
+// NewPoolVMHandler serves the /pools/{pool}/vms routes. vms returns the
+// controller for the VMs of a pool.
+func NewPoolVMHandler(
+logger lager.Logger,
+pools PoolController,
+vms func(pool string) VirtualGuestController,
+) *PoolVMHandler {
+	return &PoolVMHandler{
+		logger: logger,
+		pools: pools,
+		vms: vms,
+	}
+}
+
+type PoolVMHandler struct {
+	logger lager.Logger
+	pools PoolController
+	vms func(pool string) VirtualGuestController
+}
+
+// controller checks that user may work on pool and that it exists, and
+// returns the controller for its VMs.
+func (h *PoolVMHandler) controller(logger lager.Logger, user *models.User, pool string) (VirtualGuestController, error) {
+	if err := authorize(user, pool); err != nil {
+		return nil, err
+	}
+
+	_, err := h.pools.Pool(logger, pool)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.vms(pool), nil
+}
+
+func (h *PoolVMHandler) AddPoolVM(params vm.AddPoolVMParams, user *models.User) middleware.Responder {
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("add-pool-vm", lager.Data{"pool": params.Pool})
+
+	controller, err := h.controller(logger, user, params.Pool)
+	if err == nil {
+		err = controller.CreateVM(logger, params.Body)
+	}
+	if err != nil {
+		if models.ConvertError(err).Equal(models.ErrResourceNotFound) {
+			return vm.NewAddPoolVMNotFound()
+		}
+		return errorResponse(err, vm.NewAddPoolVMDefault(500))
+	}
+
+	return vm.NewAddPoolVMOK().WithPayload("added successfully")
+}
+
+func (h *PoolVMHandler) ListPoolVms(params vm.ListPoolVmsParams, user *models.User) middleware.Responder {
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("list-pool-vms", lager.Data{"pool": params.Pool})
+
+	response := &models.VmsResponse{}
+
+	controller, err := h.controller(logger, user, params.Pool)
+	if err == nil {
+		response.Vms, err = controller.AllVirtualGuests(logger)
+	}
+	if err != nil {
+		if models.ConvertError(err).Equal(models.ErrResourceNotFound) {
+			return vm.NewListPoolVmsNotFound()
+		}
+		return errorResponse(err, vm.NewListPoolVmsDefault(500))
+	}
+
+	return vm.NewListPoolVmsOK().WithPayload(response)
+}
+
+func (h *PoolVMHandler) FindPoolVmsByFilters(params vm.FindPoolVmsByFiltersParams, user *models.User) middleware.Responder {
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("find-pool-vms-by-filter", lager.Data{"pool": params.Pool})
+
+	response := &models.VmsResponse{}
+	request := params.Body
+
+	controller, err := h.controller(logger, user, params.Pool)
+	if err == nil {
+		if request == nil {
+			response.Vms, err = controller.AllVirtualGuests(logger)
+		} else {
+			response.Vms, err = controller.VirtualGuests(logger, request.PublicVlan, request.PrivateVlan, request.CPU, request.MemoryMb, request.State)
+		}
+	}
+	if err != nil {
+		if models.ConvertError(err).Equal(models.ErrResourceNotFound) {
+			return vm.NewFindPoolVmsByFiltersNotFound()
+		}
+		return errorResponse(err, vm.NewFindPoolVmsByFiltersDefault(500))
+	}
+
+	return vm.NewFindPoolVmsByFiltersOK().WithPayload(response)
+}
+
+func (h *PoolVMHandler) OrderPoolVM(params vm.OrderPoolVMParams, user *models.User) middleware.Responder {
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("order-pool-vm", lager.Data{"pool": params.Pool})
+
+	response := &models.VMResponse{}
+	request := params.Body
+
+	controller, err := h.controller(logger, user, params.Pool)
+	if err == nil {
+		if request != nil && (len(request.Cids) > 0 || len(request.Ips) > 0) {
+			if request.UserData != "" {
+				return errorResponse(errUserDataForManyVMs, vm.NewOrderPoolVMDefault(500))
+			}
+			response.Vms, err = controller.OrderVirtualGuests(logger, request.Cids, request.Ips)
+			if err == nil && len(response.Vms) > 0 {
+				response.VM = response.Vms[0]
+			}
+		} else {
+			response.VM, err = controller.OrderVirtualGuest(logger, request)
+		}
+	}
+	if err != nil {
+		if models.ConvertError(err).Equal(models.ErrResourceNotFound) {
+			return vm.NewOrderPoolVMNotFound()
+		}
+		return errorResponse(err, vm.NewOrderPoolVMDefault(500))
+	}
+
+	return vm.NewOrderPoolVMOK().WithPayload(response)
+}
+
+func (h *PoolVMHandler) GetPoolVMByCid(params vm.GetPoolVMByCidParams, user *models.User) middleware.Responder {
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("get-pool-vm-by-cid", lager.Data{"pool": params.Pool})
+
+	response := &models.VMResponse{}
+
+	controller, err := h.controller(logger, user, params.Pool)
+	if err == nil {
+		response.VM, err = controller.VirtualGuestByCid(logger, params.Cid)
+	}
+	if err != nil {
+		if models.ConvertError(err).Equal(models.ErrResourceNotFound) {
+			return vm.NewGetPoolVMByCidNotFound()
+		}
+		return errorResponse(err, vm.NewGetPoolVMByCidDefault(500))
+	}
+	if response.VM == nil {
+		return vm.NewGetPoolVMByCidNotFound()
+	}
+
+	return vm.NewGetPoolVMByCidOK().WithPayload(response)
+}
+
+func (h *PoolVMHandler) UpdatePoolVMWithState(params vm.UpdatePoolVMWithStateParams, user *models.User) middleware.Responder {
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("update-pool-vm-with-state", lager.Data{"pool": params.Pool})
+
+	controller, err := h.controller(logger, user, params.Pool)
+	if err == nil {
+		err = controller.UpdateVMWithState(logger, params.Cid, &params.Body.State)
+	}
+	if err != nil {
+		if models.ConvertError(err).Equal(models.ErrResourceNotFound) {
+			return vm.NewUpdatePoolVMWithStateNotFound()
+		}
+		return errorResponse(err, vm.NewUpdatePoolVMWithStateDefault(500))
+	}
+
+	return vm.NewUpdatePoolVMWithStateOK().WithPayload("updated successfully")
+}
+
+func (h *PoolVMHandler) DeletePoolVM(params vm.DeletePoolVMParams, user *models.User) middleware.Responder {
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("delete-pool-vm", lager.Data{"pool": params.Pool})
+
+	controller, err := h.controller(logger, user, params.Pool)
+	if err == nil {
+		err = controller.DeleteVM(logger, params.Cid)
+	}
+	if err != nil {
+		if models.ConvertError(err).Equal(models.ErrResourceNotFound) {
+			return vm.NewDeletePoolVMNotFound()
+		}
+		return errorResponse(err, vm.NewDeletePoolVMDefault(500))
+	}
+
+	return vm.NewDeletePoolVMNoContent().WithPayload("vm removed")
+}
+
+func (h *PoolVMHandler) GetPoolSummary(params pool.GetPoolSummaryParams, user *models.User) middleware.Responder {
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("get-pool-summary", lager.Data{"pool": params.Pool})
+
+	var summary *models.PoolSummary
+
+	controller, err := h.controller(logger, user, params.Pool)
+	if err == nil {
+		summary, err = controller.Summary(logger)
+	}
+	if err != nil {
+		if models.ConvertError(err).Equal(models.ErrResourceNotFound) {
+			return pool.NewGetPoolSummaryNotFound()
+		}
+		return errorResponse(err, pool.NewGetPoolSummaryDefault(500))
+	}
+
+	return pool.NewGetPoolSummaryOK().WithPayload(summary)
+}
@@ -0,0 +1,343 @@
+package handlers_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jianqiu/vps/restapi/handlers"
+	"github.com/jianqiu/vps/restapi/handlers/fake_controllers"
+	"github.com/jianqiu/vps/models"
+	"github.com/jianqiu/vps/restapi/operations/pool"
+	"github.com/jianqiu/vps/restapi/operations/vm"
+	"github.com/go-openapi/runtime/middleware"
+	"code.cloudfoundry.org/lager/lagertest"
+)
+
+var _ = Describe("PoolVMHandlerFunc", func() {
+	var (
+		logger         *lagertest.TestLogger
+		poolController *fake_controllers.FakePoolController
+		vmController   *fake_controllers.FakeVirtualGuestController
+		requestedPools []string
+
+		responseResponder middleware.Responder
+
+		handler *handlers.PoolVMHandler
+
+		principal *models.User
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		poolController = &fake_controllers.FakePoolController{}
+		vmController = &fake_controllers.FakeVirtualGuestController{}
+		requestedPools = nil
+		handler = handlers.NewPoolVMHandler(logger, poolController, func(pool string) handlers.VirtualGuestController {
+			requestedPools = append(requestedPools, pool)
+			return vmController
+		})
+		principal = &models.User{}
+
+		poolController.PoolReturns(&models.Pool{Name: "staging"}, nil)
+	})
+
+	Describe("ListPoolVms", func() {
+		JustBeforeEach(func() {
+			params := vm.NewListPoolVmsParams()
+			params.Pool = "staging"
+			responseResponder = handler.ListPoolVms(params, principal)
+		})
+
+		Context("when listing succeeds", func() {
+			var vms []*models.VM
+
+			BeforeEach(func() {
+				vms = []*models.VM{{Cid: 1, Pool: "staging"}}
+				vmController.AllVirtualGuestsReturns(vms, nil)
+			})
+
+			It("lists the vms of the pool", func() {
+				_, name := poolController.PoolArgsForCall(0)
+				Expect(name).To(Equal("staging"))
+				Expect(requestedPools).To(Equal([]string{"staging"}))
+				Expect(responseResponder).To(Equal(vm.NewListPoolVmsOK().WithPayload(&models.VmsResponse{Vms: vms})))
+			})
+		})
+
+		Context("when the pool has no vms", func() {
+			BeforeEach(func() {
+				vmController.AllVirtualGuestsReturns([]*models.VM{}, nil)
+			})
+
+			It("returns an empty list", func() {
+				Expect(responseResponder).To(Equal(vm.NewListPoolVmsOK().WithPayload(&models.VmsResponse{Vms: []*models.VM{}})))
+			})
+		})
+
+		Context("when the pool does not exist", func() {
+			BeforeEach(func() {
+				poolController.PoolReturns(nil, models.ErrResourceNotFound)
+			})
+
+			It("responds with not found", func() {
+				Expect(vmController.AllVirtualGuestsCallCount()).To(Equal(0))
+				Expect(responseResponder).To(Equal(vm.NewListPoolVmsNotFound()))
+			})
+		})
+
+		Context("when the credentials are those of the pool", func() {
+			BeforeEach(func() {
+				principal = &models.User{Username: "staging", Pool: "staging"}
+			})
+
+			It("lists the vms of the pool", func() {
+				Expect(vmController.AllVirtualGuestsCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the credentials are those of another pool", func() {
+			BeforeEach(func() {
+				principal = &models.User{Username: "prod", Pool: "prod"}
+			})
+
+			It("is forbidden", func() {
+				Expect(poolController.PoolCallCount()).To(Equal(0))
+				Expect(vmController.AllVirtualGuestsCallCount()).To(Equal(0))
+
+				expected := vm.NewListPoolVmsDefault(403)
+				expected.SetPayload(models.ErrUnauthorized)
+				Expect(responseResponder).To(Equal(expected))
+			})
+		})
+	})
+
+	Describe("AddPoolVM", func() {
+		var requested *models.VM
+
+		BeforeEach(func() {
+			requested = &models.VM{Cid: 1, Hostname: "vm-1.staging", IP: "10.0.0.1", CPU: 2, MemoryMb: 2048, State: models.StateFree}
+		})
+
+		JustBeforeEach(func() {
+			params := vm.NewAddPoolVMParams()
+			params.Pool = "staging"
+			params.Body = requested
+			responseResponder = handler.AddPoolVM(params, principal)
+		})
+
+		It("adds the vm to the pool", func() {
+			Expect(requestedPools).To(Equal([]string{"staging"}))
+			_, actual := vmController.CreateVMArgsForCall(0)
+			Expect(actual).To(Equal(requested))
+			Expect(responseResponder).To(Equal(vm.NewAddPoolVMOK().WithPayload("added successfully")))
+		})
+
+		Context("when the vm is invalid", func() {
+			var invalid *models.Error
+
+			BeforeEach(func() {
+				invalid = models.NewInvalidRecordError(models.ErrInvalidField{Field: "pool", Message: "must be staging"})
+				vmController.CreateVMReturns(invalid)
+			})
+
+			It("responds with a bad request", func() {
+				expected := vm.NewAddPoolVMDefault(400)
+				expected.SetPayload(invalid)
+				Expect(responseResponder).To(Equal(expected))
+			})
+		})
+	})
+
+	Describe("OrderPoolVM", func() {
+		var filter *models.VMFilter
+
+		JustBeforeEach(func() {
+			params := vm.NewOrderPoolVMParams()
+			params.Pool = "staging"
+			params.Body = filter
+			responseResponder = handler.OrderPoolVM(params, principal)
+		})
+
+		Context("when ordering by filter", func() {
+			var ordered *models.VM
+
+			BeforeEach(func() {
+				filter = &models.VMFilter{CPU: 2}
+				ordered = &models.VM{Cid: 1, Pool: "staging"}
+				vmController.OrderVirtualGuestReturns(ordered, nil)
+			})
+
+			It("orders a vm of the pool", func() {
+				Expect(requestedPools).To(Equal([]string{"staging"}))
+				_, actual := vmController.OrderVirtualGuestArgsForCall(0)
+				Expect(actual).To(Equal(filter))
+				Expect(responseResponder).To(Equal(vm.NewOrderPoolVMOK().WithPayload(&models.VMResponse{VM: ordered})))
+			})
+		})
+
+		Context("when ordering specific vms", func() {
+			var ordered []*models.VM
+
+			BeforeEach(func() {
+				filter = &models.VMFilter{Cids: []int32{1, 2}}
+				ordered = []*models.VM{{Cid: 1}, {Cid: 2}}
+				vmController.OrderVirtualGuestsReturns(ordered, nil)
+			})
+
+			It("orders all of them", func() {
+				_, cids, _ := vmController.OrderVirtualGuestsArgsForCall(0)
+				Expect(cids).To(Equal([]int32{1, 2}))
+				Expect(responseResponder).To(Equal(vm.NewOrderPoolVMOK().WithPayload(&models.VMResponse{VM: ordered[0], Vms: ordered})))
+			})
+		})
+
+		Context("when no vm is free", func() {
+			BeforeEach(func() {
+				filter = nil
+				vmController.OrderVirtualGuestReturns(nil, models.ErrResourceNotFound)
+			})
+
+			It("responds with not found", func() {
+				Expect(responseResponder).To(Equal(vm.NewOrderPoolVMNotFound()))
+			})
+		})
+
+		Context("when ordering fails", func() {
+			BeforeEach(func() {
+				filter = nil
+				vmController.OrderVirtualGuestReturns(nil, errors.New("kaboom"))
+			})
+
+			It("responds with an error", func() {
+				expected := vm.NewOrderPoolVMDefault(500)
+				expected.SetPayload(models.ConvertError(errors.New("kaboom")))
+				Expect(responseResponder).To(Equal(expected))
+			})
+		})
+	})
+
+	Describe("GetPoolVMByCid", func() {
+		JustBeforeEach(func() {
+			params := vm.NewGetPoolVMByCidParams()
+			params.Pool = "staging"
+			params.Cid = 1
+			responseResponder = handler.GetPoolVMByCid(params, principal)
+		})
+
+		Context("when the vm is in the pool", func() {
+			var found *models.VM
+
+			BeforeEach(func() {
+				found = &models.VM{Cid: 1, Pool: "staging"}
+				vmController.VirtualGuestByCidReturns(found, nil)
+			})
+
+			It("returns the vm", func() {
+				Expect(responseResponder).To(Equal(vm.NewGetPoolVMByCidOK().WithPayload(&models.VMResponse{VM: found})))
+			})
+		})
+
+		Context("when the vm is not in the pool", func() {
+			BeforeEach(func() {
+				vmController.VirtualGuestByCidReturns(nil, models.ErrResourceNotFound)
+			})
+
+			It("responds with not found", func() {
+				Expect(responseResponder).To(Equal(vm.NewGetPoolVMByCidNotFound()))
+			})
+		})
+	})
+
+	Describe("UpdatePoolVMWithState", func() {
+		JustBeforeEach(func() {
+			params := vm.NewUpdatePoolVMWithStateParams()
+			params.Pool = "staging"
+			params.Cid = 1
+			params.Body = &models.VMState{State: models.StateUsing}
+			responseResponder = handler.UpdatePoolVMWithState(params, principal)
+		})
+
+		It("updates the vm of the pool", func() {
+			_, cid, state := vmController.UpdateVMWithStateArgsForCall(0)
+			Expect(cid).To(Equal(int32(1)))
+			Expect(*state).To(Equal(models.StateUsing))
+			Expect(responseResponder).To(Equal(vm.NewUpdatePoolVMWithStateOK().WithPayload("updated successfully")))
+		})
+	})
+
+	Describe("DeletePoolVM", func() {
+		JustBeforeEach(func() {
+			params := vm.NewDeletePoolVMParams()
+			params.Pool = "staging"
+			params.Cid = 1
+			responseResponder = handler.DeletePoolVM(params, principal)
+		})
+
+		It("removes the vm from the pool", func() {
+			_, cid := vmController.DeleteVMArgsForCall(0)
+			Expect(cid).To(Equal(int32(1)))
+			Expect(responseResponder).To(Equal(vm.NewDeletePoolVMNoContent().WithPayload("vm removed")))
+		})
+
+		Context("when the vm is not in the pool", func() {
+			BeforeEach(func() {
+				vmController.DeleteVMReturns(models.ErrResourceNotFound)
+			})
+
+			It("responds with not found", func() {
+				Expect(responseResponder).To(Equal(vm.NewDeletePoolVMNotFound()))
+			})
+		})
+	})
+
+	Describe("GetPoolSummary", func() {
+		JustBeforeEach(func() {
+			params := pool.NewGetPoolSummaryParams()
+			params.Pool = "staging"
+			responseResponder = handler.GetPoolSummary(params, principal)
+		})
+
+		Context("when counting succeeds", func() {
+			var summary *models.PoolSummary
+
+			BeforeEach(func() {
+				summary = &models.PoolSummary{
+					Pool:    "staging",
+					Total:   1,
+					Flavors: []*models.FlavorSummary{{Flavor: "m1.small", Total: 1, States: map[string]int32{"free": 1}}},
+				}
+				vmController.SummaryReturns(summary, nil)
+			})
+
+			It("returns the summary of the pool", func() {
+				Expect(requestedPools).To(Equal([]string{"staging"}))
+				Expect(responseResponder).To(Equal(pool.NewGetPoolSummaryOK().WithPayload(summary)))
+			})
+		})
+
+		Context("when the pool does not exist", func() {
+			BeforeEach(func() {
+				poolController.PoolReturns(nil, models.ErrResourceNotFound)
+			})
+
+			It("responds with not found", func() {
+				Expect(vmController.SummaryCallCount()).To(Equal(0))
+				Expect(responseResponder).To(Equal(pool.NewGetPoolSummaryNotFound()))
+			})
+		})
+
+		Context("when counting fails", func() {
+			BeforeEach(func() {
+				vmController.SummaryReturns(nil, errors.New("kaboom"))
+			})
+
+			It("responds with an error", func() {
+				expected := pool.NewGetPoolSummaryDefault(500)
+				expected.SetPayload(models.ConvertError(errors.New("kaboom")))
+				Expect(responseResponder).To(Equal(expected))
+			})
+		})
+	})
+})
@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"github.com/jianqiu/vps/models"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/restapi/operations/vm"
+
+	"code.cloudfoundry.org/lager"
+)
+//go:generate counterfeiter -o fake_controllers/fake_reaper_controller.go . ReaperController
+type ReaperController interface {
+	Plan(logger lager.Logger) ([]*models.VM, error)
+}
+
+func NewReaperHandler(
+logger lager.Logger,
+controller ReaperController,
+) *ReaperHandler {
+	return &ReaperHandler{
+		logger: logger,
+		controller: controller,
+	}
+}
+
+type ReaperHandler struct {
+	logger lager.Logger
+	controller ReaperController
+}
+
+func (h *ReaperHandler) ListReapableVms(params vm.ListReapableVmsParams, user *models.User) middleware.Responder {
+	var err error
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("list-reapable-vms")
+
+	if err := authorizeAdmin(user); err != nil {
+		return errorResponse(err, vm.NewListReapableVmsDefault(500))
+	}
+
+	response := &models.VmsResponse{}
+
+	response.Vms, err = h.controller.Plan(logger)
+	if err != nil {
+		return errorResponse(err, vm.NewListReapableVmsDefault(500))
+	}
+
+	return vm.NewListReapableVmsOK().WithPayload(response)
+}
@@ -0,0 +1,102 @@
+package handlers_test
+
+import (
+	"errors"
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jianqiu/vps/restapi/handlers"
+	"github.com/jianqiu/vps/restapi/handlers/fake_controllers"
+	"github.com/jianqiu/vps/models"
+	"github.com/jianqiu/vps/restapi/operations/vm"
+	"github.com/jianqiu/vps/vpslager"
+	"github.com/go-openapi/runtime/middleware"
+	"code.cloudfoundry.org/lager/lagertest"
+)
+
+var _ = Describe("ReaperHandlerFunc", func() {
+	var (
+		logger     *lagertest.TestLogger
+		controller *fake_controllers.FakeReaperController
+
+		responseResponder middleware.Responder
+
+		handler *handlers.ReaperHandler
+
+		principal *models.User
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		controller = &fake_controllers.FakeReaperController{}
+		handler = handlers.NewReaperHandler(logger, controller)
+		principal = &models.User{}
+	})
+
+	Describe("ListReapableVms", func() {
+		var params vm.ListReapableVmsParams
+
+		BeforeEach(func() {
+			params = vm.NewListReapableVmsParams()
+		})
+
+		JustBeforeEach(func() {
+			responseResponder = handler.ListReapableVms(params, principal)
+		})
+
+		Context("when planning succeeds", func() {
+			var vms []*models.VM
+
+			BeforeEach(func() {
+				vms = []*models.VM{{Cid: 1234567}, {Cid: 1234568}}
+				controller.PlanReturns(vms, nil)
+			})
+
+			It("returns the vms that would be reaped", func() {
+				Expect(controller.PlanCallCount()).To(Equal(1))
+				Expect(responseResponder).To(Equal(vm.NewListReapableVmsOK().WithPayload(&models.VmsResponse{Vms: vms})))
+			})
+		})
+
+		Context("when the request carries a logger", func() {
+			var requestLogger *lagertest.TestLogger
+
+			BeforeEach(func() {
+				requestLogger = lagertest.NewTestLogger("request")
+				request, err := http.NewRequest("GET", "/v2/vms/reapable", nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				params.HTTPRequest = request.WithContext(vpslager.NewContext(request.Context(), requestLogger))
+				controller.PlanReturns([]*models.VM{}, nil)
+			})
+
+			It("passes a session of it to the controller", func() {
+				Expect(controller.PlanArgsForCall(0).SessionName()).To(Equal("request.list-reapable-vms"))
+			})
+		})
+
+		Context("when nothing would be reaped", func() {
+			BeforeEach(func() {
+				controller.PlanReturns([]*models.VM{}, nil)
+			})
+
+			It("returns an empty list", func() {
+				Expect(responseResponder).To(Equal(vm.NewListReapableVmsOK().WithPayload(&models.VmsResponse{Vms: []*models.VM{}})))
+			})
+		})
+
+		Context("when planning fails", func() {
+			BeforeEach(func() {
+				controller.PlanReturns(nil, errors.New("kaboom"))
+			})
+
+			It("responds with an error", func() {
+				expected := vm.NewListReapableVmsDefault(500)
+				expected.SetPayload(models.ConvertError(errors.New("kaboom")))
+				Expect(responseResponder).To(Equal(expected))
+			})
+		})
+	})
+})
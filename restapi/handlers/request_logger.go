@@ -0,0 +1,17 @@
+package handlers
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/vpslager"
+)
+
+// requestLogger returns the logger of the request being handled, which
+// carries its request id, or logger when the request has none.
+func requestLogger(logger lager.Logger, r *http.Request) lager.Logger {
+	if r == nil {
+		return logger
+	}
+	return vpslager.FromContext(r.Context(), logger)
+}
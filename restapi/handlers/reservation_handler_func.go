@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"github.com/jianqiu/vps/models"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/restapi/operations/reservation"
+
+	"code.cloudfoundry.org/lager"
+)
+//go:generate counterfeiter -o fake_controllers/fake_reservation_controller.go . ReservationController
+type ReservationController interface {
+	CreateReservation(logger lager.Logger, reservation *models.Reservation) (*models.Reservation, error)
+	Reservations(logger lager.Logger) ([]*models.Reservation, error)
+	Reservation(logger lager.Logger, id string) (*models.Reservation, error)
+	CancelReservation(logger lager.Logger, id string) error
+}
+
+func NewReservationHandler(
+logger lager.Logger,
+controller ReservationController,
+) *ReservationHandler {
+	return &ReservationHandler{
+		logger: logger,
+		controller: controller,
+	}
+}
+
+// ReservationHandler serves the reservations. The credentials of a pool
+// only see and make the reservations of that pool.
+type ReservationHandler struct {
+	logger lager.Logger
+	controller ReservationController
+}
+
+func (h *ReservationHandler) CreateReservation(params reservation.CreateReservationParams, user *models.User) middleware.Responder {
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("create-reservation")
+
+	pool := params.Body.Pool
+	if pool == "" {
+		pool = models.DefaultPool
+	}
+	if err := authorize(user, pool); err != nil {
+		return errorResponse(err, reservation.NewCreateReservationDefault(500))
+	}
+
+	created, err := h.controller.CreateReservation(logger, params.Body)
+	if err != nil {
+		return errorResponse(err, reservation.NewCreateReservationDefault(500))
+	}
+
+	return reservation.NewCreateReservationOK().WithPayload(created)
+}
+
+func (h *ReservationHandler) ListReservations(params reservation.ListReservationsParams, user *models.User) middleware.Responder {
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("list-reservations")
+
+	if err := authenticated(user); err != nil {
+		return errorResponse(err, reservation.NewListReservationsDefault(500))
+	}
+
+	reservations, err := h.controller.Reservations(logger)
+	if err != nil {
+		return errorResponse(err, reservation.NewListReservationsDefault(500))
+	}
+
+	response := &models.ReservationsResponse{Reservations: []*models.Reservation{}}
+	for _, r := range reservations {
+		if authorize(user, r.Pool) == nil {
+			response.Reservations = append(response.Reservations, r)
+		}
+	}
+
+	return reservation.NewListReservationsOK().WithPayload(response)
+}
+
+func (h *ReservationHandler) GetReservation(params reservation.GetReservationParams, user *models.User) middleware.Responder {
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("get-reservation", lager.Data{"id": params.ID})
+
+	found, err := h.reservation(logger, user, params.ID)
+	if err != nil {
+		if models.ConvertError(err).Equal(models.ErrResourceNotFound) {
+			return reservation.NewGetReservationNotFound()
+		}
+		return errorResponse(err, reservation.NewGetReservationDefault(500))
+	}
+
+	return reservation.NewGetReservationOK().WithPayload(found)
+}
+
+func (h *ReservationHandler) CancelReservation(params reservation.CancelReservationParams, user *models.User) middleware.Responder {
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("cancel-reservation", lager.Data{"id": params.ID})
+
+	_, err := h.reservation(logger, user, params.ID)
+	if err == nil {
+		err = h.controller.CancelReservation(logger, params.ID)
+	}
+	if err != nil {
+		if models.ConvertError(err).Equal(models.ErrResourceNotFound) {
+			return reservation.NewCancelReservationNotFound()
+		}
+		return errorResponse(err, reservation.NewCancelReservationDefault(500))
+	}
+
+	return reservation.NewCancelReservationNoContent()
+}
+
+// reservation finds the reservation with id and checks that user may work
+// on its pool.
+func (h *ReservationHandler) reservation(logger lager.Logger, user *models.User, id string) (*models.Reservation, error) {
+	if err := authenticated(user); err != nil {
+		return nil, err
+	}
+
+	found, err := h.controller.Reservation(logger, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authorize(user, found.Pool); err != nil {
+		return nil, err
+	}
+	return found, nil
+}
@@ -0,0 +1,173 @@
+package handlers_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jianqiu/vps/restapi/handlers"
+	"github.com/jianqiu/vps/restapi/handlers/fake_controllers"
+	"github.com/jianqiu/vps/models"
+	"github.com/jianqiu/vps/restapi/operations/reservation"
+	"github.com/go-openapi/runtime/middleware"
+	"code.cloudfoundry.org/lager/lagertest"
+)
+
+var _ = Describe("ReservationHandlerFunc", func() {
+	var (
+		logger     *lagertest.TestLogger
+		controller *fake_controllers.FakeReservationController
+
+		responseResponder middleware.Responder
+
+		handler *handlers.ReservationHandler
+
+		principal *models.User
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		controller = &fake_controllers.FakeReservationController{}
+		handler = handlers.NewReservationHandler(logger, controller)
+		principal = &models.User{}
+	})
+
+	Describe("CreateReservation", func() {
+		var requested *models.Reservation
+
+		BeforeEach(func() {
+			requested = &models.Reservation{DeploymentName: "cf", Count: 40, TTL: 3600}
+		})
+
+		JustBeforeEach(func() {
+			params := reservation.NewCreateReservationParams()
+			params.Body = requested
+			responseResponder = handler.CreateReservation(params, principal)
+		})
+
+		Context("when the vms are reserved", func() {
+			var created *models.Reservation
+
+			BeforeEach(func() {
+				created = &models.Reservation{ID: "some-id", DeploymentName: "cf", Pool: models.DefaultPool, Count: 40, TTL: 3600}
+				controller.CreateReservationReturns(created, nil)
+			})
+
+			It("returns the reservation", func() {
+				_, actual := controller.CreateReservationArgsForCall(0)
+				Expect(actual).To(Equal(requested))
+				Expect(responseResponder).To(Equal(reservation.NewCreateReservationOK().WithPayload(created)))
+			})
+		})
+
+		Context("when too few free vms match", func() {
+			var conflict error
+
+			BeforeEach(func() {
+				conflict = models.NewResourceConflictError(models.ErrInvalidField{Field: "count", Message: "only 12 free vms match"})
+				controller.CreateReservationReturns(nil, conflict)
+			})
+
+			It("responds with a conflict", func() {
+				expected := reservation.NewCreateReservationDefault(409)
+				expected.SetPayload(models.ConvertError(conflict))
+				Expect(responseResponder).To(Equal(expected))
+			})
+		})
+
+		Context("when the credentials are those of another pool", func() {
+			BeforeEach(func() {
+				principal = &models.User{Username: "staging", Pool: "staging"}
+			})
+
+			It("is forbidden", func() {
+				Expect(controller.CreateReservationCallCount()).To(Equal(0))
+
+				expected := reservation.NewCreateReservationDefault(403)
+				expected.SetPayload(models.ErrUnauthorized)
+				Expect(responseResponder).To(Equal(expected))
+			})
+		})
+	})
+
+	Describe("ListReservations", func() {
+		var reservations []*models.Reservation
+
+		BeforeEach(func() {
+			reservations = []*models.Reservation{
+				{ID: "default-id", Pool: models.DefaultPool},
+				{ID: "staging-id", Pool: "staging"},
+			}
+			controller.ReservationsReturns(reservations, nil)
+		})
+
+		JustBeforeEach(func() {
+			responseResponder = handler.ListReservations(reservation.NewListReservationsParams(), principal)
+		})
+
+		It("returns the reservations", func() {
+			Expect(responseResponder).To(Equal(reservation.NewListReservationsOK().WithPayload(&models.ReservationsResponse{Reservations: reservations})))
+		})
+
+		Context("when the credentials are those of a pool", func() {
+			BeforeEach(func() {
+				principal = &models.User{Username: "staging", Pool: "staging"}
+			})
+
+			It("returns the reservations of the pool only", func() {
+				Expect(responseResponder).To(Equal(reservation.NewListReservationsOK().WithPayload(&models.ReservationsResponse{Reservations: reservations[1:]})))
+			})
+		})
+	})
+
+	Describe("GetReservation", func() {
+		JustBeforeEach(func() {
+			params := reservation.NewGetReservationParams()
+			params.ID = "some-id"
+			responseResponder = handler.GetReservation(params, principal)
+		})
+
+		Context("when the reservation does not exist", func() {
+			BeforeEach(func() {
+				controller.ReservationReturns(nil, models.ErrResourceNotFound)
+			})
+
+			It("responds with not found", func() {
+				_, id := controller.ReservationArgsForCall(0)
+				Expect(id).To(Equal("some-id"))
+				Expect(responseResponder).To(Equal(reservation.NewGetReservationNotFound()))
+			})
+		})
+	})
+
+	Describe("CancelReservation", func() {
+		BeforeEach(func() {
+			controller.ReservationReturns(&models.Reservation{ID: "some-id", Pool: models.DefaultPool}, nil)
+		})
+
+		JustBeforeEach(func() {
+			params := reservation.NewCancelReservationParams()
+			params.ID = "some-id"
+			responseResponder = handler.CancelReservation(params, principal)
+		})
+
+		It("releases the reservation", func() {
+			_, id := controller.CancelReservationArgsForCall(0)
+			Expect(id).To(Equal("some-id"))
+			Expect(responseResponder).To(Equal(reservation.NewCancelReservationNoContent()))
+		})
+
+		Context("when the reservation belongs to another pool", func() {
+			BeforeEach(func() {
+				principal = &models.User{Username: "staging", Pool: "staging"}
+			})
+
+			It("is forbidden", func() {
+				Expect(controller.CancelReservationCallCount()).To(Equal(0))
+
+				expected := reservation.NewCancelReservationDefault(403)
+				expected.SetPayload(models.ErrUnauthorized)
+				Expect(responseResponder).To(Equal(expected))
+			})
+		})
+	})
+})
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"github.com/jianqiu/vps/models"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/restapi/operations/vm"
+
+	"code.cloudfoundry.org/lager"
+)
+//go:generate counterfeiter -o fake_controllers/fake_secret_controller.go . SecretController
+type SecretController interface {
+	VMSecret(logger lager.Logger, cid int32, token string) (*models.VMSecret, error)
+	SetVMSecret(logger lager.Logger, cid int32, secret *models.VMSecret) error
+	RemoveVMSecret(logger lager.Logger, cid int32) error
+}
+
+func NewSecretHandler(
+logger lager.Logger,
+controller SecretController,
+) *SecretHandler {
+	return &SecretHandler{
+		logger: logger,
+		controller: controller,
+	}
+}
+
+// SecretHandler serves the secrets of the VMs of the default pool. Only the
+// admin stores and removes them; they are read by the deployment that owns
+// the VM, with the credentials of the pool and the secret token its order
+// returned.
+type SecretHandler struct {
+	logger lager.Logger
+	controller SecretController
+}
+
+func (h *SecretHandler) GetVMSecret(params vm.GetVMSecretParams, user *models.User) middleware.Responder {
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("get-vm-secret", lager.Data{"cid": params.Cid})
+
+	if err := authorize(user, models.DefaultPool); err != nil {
+		return errorResponse(err, vm.NewGetVMSecretDefault(500))
+	}
+
+	secret, err := h.controller.VMSecret(logger, params.Cid, params.XSecretToken)
+	if err != nil {
+		if models.ConvertError(err).Equal(models.ErrResourceNotFound) {
+			return vm.NewGetVMSecretNotFound()
+		}
+		return errorResponse(err, vm.NewGetVMSecretDefault(500))
+	}
+
+	return vm.NewGetVMSecretOK().WithPayload(secret)
+}
+
+func (h *SecretHandler) SetVMSecret(params vm.SetVMSecretParams, user *models.User) middleware.Responder {
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("set-vm-secret", lager.Data{"cid": params.Cid})
+
+	if err := authorizeAdmin(user); err != nil {
+		return errorResponse(err, vm.NewSetVMSecretDefault(500))
+	}
+
+	err := h.controller.SetVMSecret(logger, params.Cid, params.Body)
+	if err != nil {
+		if models.ConvertError(err).Equal(models.ErrResourceNotFound) {
+			return vm.NewSetVMSecretNotFound()
+		}
+		return errorResponse(err, vm.NewSetVMSecretDefault(500))
+	}
+
+	return vm.NewSetVMSecretNoContent()
+}
+
+func (h *SecretHandler) DeleteVMSecret(params vm.DeleteVMSecretParams, user *models.User) middleware.Responder {
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("delete-vm-secret", lager.Data{"cid": params.Cid})
+
+	if err := authorizeAdmin(user); err != nil {
+		return errorResponse(err, vm.NewDeleteVMSecretDefault(500))
+	}
+
+	err := h.controller.RemoveVMSecret(logger, params.Cid)
+	if err != nil {
+		if models.ConvertError(err).Equal(models.ErrResourceNotFound) {
+			return vm.NewDeleteVMSecretNotFound()
+		}
+		return errorResponse(err, vm.NewDeleteVMSecretDefault(500))
+	}
+
+	return vm.NewDeleteVMSecretNoContent()
+}
@@ -0,0 +1,148 @@
+package handlers_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jianqiu/vps/restapi/handlers"
+	"github.com/jianqiu/vps/restapi/handlers/fake_controllers"
+	"github.com/jianqiu/vps/models"
+	"github.com/jianqiu/vps/restapi/operations/vm"
+	"github.com/go-openapi/runtime/middleware"
+	"code.cloudfoundry.org/lager/lagertest"
+)
+
+var _ = Describe("SecretHandlerFunc", func() {
+	var (
+		logger     *lagertest.TestLogger
+		controller *fake_controllers.FakeSecretController
+
+		responseResponder middleware.Responder
+
+		handler *handlers.SecretHandler
+
+		principal *models.User
+		secret    *models.VMSecret
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		controller = &fake_controllers.FakeSecretController{}
+		handler = handlers.NewSecretHandler(logger, controller)
+		principal = &models.User{}
+		secret = &models.VMSecret{Cid: 1, Username: "root", Password: "s3cret"}
+	})
+
+	Describe("GetVMSecret", func() {
+		JustBeforeEach(func() {
+			params := vm.NewGetVMSecretParams()
+			params.Cid = 1
+			params.XSecretToken = "cf-token"
+			responseResponder = handler.GetVMSecret(params, principal)
+		})
+
+		Context("when the token is the one the vm was ordered with", func() {
+			BeforeEach(func() {
+				controller.VMSecretReturns(secret, nil)
+				principal = &models.User{Username: "default", Pool: models.DefaultPool}
+			})
+
+			It("returns the secret", func() {
+				_, cid, token := controller.VMSecretArgsForCall(0)
+				Expect(cid).To(Equal(int32(1)))
+				Expect(token).To(Equal("cf-token"))
+				Expect(responseResponder).To(Equal(vm.NewGetVMSecretOK().WithPayload(secret)))
+			})
+		})
+
+		Context("when the token is not the one the vm was ordered with", func() {
+			BeforeEach(func() {
+				controller.VMSecretReturns(nil, models.ErrUnauthorized)
+			})
+
+			It("is forbidden", func() {
+				expected := vm.NewGetVMSecretDefault(403)
+				expected.SetPayload(models.ErrUnauthorized)
+				Expect(responseResponder).To(Equal(expected))
+			})
+		})
+
+		Context("when the vm has no secret", func() {
+			BeforeEach(func() {
+				controller.VMSecretReturns(nil, models.ErrResourceNotFound)
+			})
+
+			It("responds with not found", func() {
+				Expect(responseResponder).To(Equal(vm.NewGetVMSecretNotFound()))
+			})
+		})
+
+		Context("when the credentials are those of another pool", func() {
+			BeforeEach(func() {
+				principal = &models.User{Username: "staging", Pool: "staging"}
+			})
+
+			It("is forbidden without reading the secret", func() {
+				Expect(controller.VMSecretCallCount()).To(Equal(0))
+
+				expected := vm.NewGetVMSecretDefault(403)
+				expected.SetPayload(models.ErrUnauthorized)
+				Expect(responseResponder).To(Equal(expected))
+			})
+		})
+	})
+
+	Describe("SetVMSecret", func() {
+		JustBeforeEach(func() {
+			params := vm.NewSetVMSecretParams()
+			params.Cid = 1
+			params.Body = secret
+			responseResponder = handler.SetVMSecret(params, principal)
+		})
+
+		It("stores the secret", func() {
+			_, cid, stored := controller.SetVMSecretArgsForCall(0)
+			Expect(cid).To(Equal(int32(1)))
+			Expect(stored).To(Equal(secret))
+			Expect(responseResponder).To(Equal(vm.NewSetVMSecretNoContent()))
+		})
+
+		Context("when the credentials are those of a pool", func() {
+			BeforeEach(func() {
+				principal = &models.User{Username: "default", Pool: models.DefaultPool}
+			})
+
+			It("is forbidden", func() {
+				Expect(controller.SetVMSecretCallCount()).To(Equal(0))
+
+				expected := vm.NewSetVMSecretDefault(403)
+				expected.SetPayload(models.ErrUnauthorized)
+				Expect(responseResponder).To(Equal(expected))
+			})
+		})
+	})
+
+	Describe("DeleteVMSecret", func() {
+		JustBeforeEach(func() {
+			params := vm.NewDeleteVMSecretParams()
+			params.Cid = 1
+			responseResponder = handler.DeleteVMSecret(params, principal)
+		})
+
+		It("removes the secret", func() {
+			_, cid := controller.RemoveVMSecretArgsForCall(0)
+			Expect(cid).To(Equal(int32(1)))
+			Expect(responseResponder).To(Equal(vm.NewDeleteVMSecretNoContent()))
+		})
+
+		Context("when the vm does not exist", func() {
+			BeforeEach(func() {
+				controller.RemoveVMSecretReturns(models.ErrResourceNotFound)
+			})
+
+			It("responds with not found", func() {
+				Expect(responseResponder).To(Equal(vm.NewDeleteVMSecretNotFound()))
+			})
+		})
+	})
+})
@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"github.com/jianqiu/vps/models"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/restapi/operations/setting"
+
+	"code.cloudfoundry.org/lager"
+)
+//go:generate counterfeiter -o fake_controllers/fake_setting_controller.go . SettingController
+type SettingController interface {
+	Settings(logger lager.Logger) ([]*models.Setting, error)
+	Setting(logger lager.Logger, name string) (*models.Setting, error)
+	SetSetting(logger lager.Logger, name, value string) (*models.Setting, error)
+	ResetSetting(logger lager.Logger, name string) (*models.Setting, error)
+}
+
+func NewSettingHandler(
+logger lager.Logger,
+controller SettingController,
+) *SettingHandler {
+	return &SettingHandler{
+		logger: logger,
+		controller: controller,
+	}
+}
+
+// SettingHandler serves the runtime settings. Any user may read them, only
+// the admin may change them.
+type SettingHandler struct {
+	logger lager.Logger
+	controller SettingController
+}
+
+func (h *SettingHandler) ListSettings(params setting.ListSettingsParams, user *models.User) middleware.Responder {
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("list-settings")
+
+	if err := authenticated(user); err != nil {
+		return errorResponse(err, setting.NewListSettingsDefault(500))
+	}
+
+	settings, err := h.controller.Settings(logger)
+	if err != nil {
+		return errorResponse(err, setting.NewListSettingsDefault(500))
+	}
+
+	return setting.NewListSettingsOK().WithPayload(&models.SettingsResponse{Settings: settings})
+}
+
+func (h *SettingHandler) GetSetting(params setting.GetSettingParams, user *models.User) middleware.Responder {
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("get-setting", lager.Data{"name": params.Name})
+
+	if err := authenticated(user); err != nil {
+		return errorResponse(err, setting.NewGetSettingDefault(500))
+	}
+
+	found, err := h.controller.Setting(logger, params.Name)
+	if err != nil {
+		if models.ConvertError(err).Equal(models.ErrResourceNotFound) {
+			return setting.NewGetSettingNotFound()
+		}
+		return errorResponse(err, setting.NewGetSettingDefault(500))
+	}
+
+	return setting.NewGetSettingOK().WithPayload(found)
+}
+
+func (h *SettingHandler) SetSetting(params setting.SetSettingParams, user *models.User) middleware.Responder {
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("set-setting", lager.Data{"name": params.Name})
+
+	if err := authorizeAdmin(user); err != nil {
+		return errorResponse(err, setting.NewSetSettingDefault(500))
+	}
+
+	changed, err := h.controller.SetSetting(logger, params.Name, params.Body.Value)
+	if err != nil {
+		if models.ConvertError(err).Equal(models.ErrResourceNotFound) {
+			return setting.NewSetSettingNotFound()
+		}
+		return errorResponse(err, setting.NewSetSettingDefault(500))
+	}
+
+	return setting.NewSetSettingOK().WithPayload(changed)
+}
+
+func (h *SettingHandler) ResetSetting(params setting.ResetSettingParams, user *models.User) middleware.Responder {
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("reset-setting", lager.Data{"name": params.Name})
+
+	if err := authorizeAdmin(user); err != nil {
+		return errorResponse(err, setting.NewResetSettingDefault(500))
+	}
+
+	reset, err := h.controller.ResetSetting(logger, params.Name)
+	if err != nil {
+		if models.ConvertError(err).Equal(models.ErrResourceNotFound) {
+			return setting.NewResetSettingNotFound()
+		}
+		return errorResponse(err, setting.NewResetSettingDefault(500))
+	}
+
+	return setting.NewResetSettingOK().WithPayload(reset)
+}
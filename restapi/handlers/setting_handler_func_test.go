@@ -0,0 +1,163 @@
+package handlers_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jianqiu/vps/restapi/handlers"
+	"github.com/jianqiu/vps/restapi/handlers/fake_controllers"
+	"github.com/jianqiu/vps/models"
+	"github.com/jianqiu/vps/restapi/operations/setting"
+	"github.com/go-openapi/runtime/middleware"
+	"code.cloudfoundry.org/lager/lagertest"
+)
+
+var _ = Describe("SettingHandlerFunc", func() {
+	var (
+		logger     *lagertest.TestLogger
+		controller *fake_controllers.FakeSettingController
+
+		responseResponder middleware.Responder
+
+		handler *handlers.SettingHandler
+
+		principal   *models.User
+		maintenance *models.Setting
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		controller = &fake_controllers.FakeSettingController{}
+		handler = handlers.NewSettingHandler(logger, controller)
+		principal = &models.User{}
+		maintenance = &models.Setting{Name: "maintenance-mode", Value: "true", Default: "false"}
+	})
+
+	Describe("ListSettings", func() {
+		BeforeEach(func() {
+			controller.SettingsReturns([]*models.Setting{maintenance}, nil)
+			principal = &models.User{Username: "staging", Pool: "staging"}
+		})
+
+		JustBeforeEach(func() {
+			responseResponder = handler.ListSettings(setting.NewListSettingsParams(), principal)
+		})
+
+		It("lists the settings to any user", func() {
+			Expect(responseResponder).To(Equal(setting.NewListSettingsOK().WithPayload(&models.SettingsResponse{
+				Settings: []*models.Setting{maintenance},
+			})))
+		})
+	})
+
+	Describe("GetSetting", func() {
+		JustBeforeEach(func() {
+			params := setting.NewGetSettingParams()
+			params.Name = "maintenance-mode"
+			responseResponder = handler.GetSetting(params, principal)
+		})
+
+		Context("when the setting exists", func() {
+			BeforeEach(func() {
+				controller.SettingReturns(maintenance, nil)
+			})
+
+			It("returns it", func() {
+				_, name := controller.SettingArgsForCall(0)
+				Expect(name).To(Equal("maintenance-mode"))
+				Expect(responseResponder).To(Equal(setting.NewGetSettingOK().WithPayload(maintenance)))
+			})
+		})
+
+		Context("when the setting does not exist", func() {
+			BeforeEach(func() {
+				controller.SettingReturns(nil, models.ErrResourceNotFound)
+			})
+
+			It("responds with not found", func() {
+				Expect(responseResponder).To(Equal(setting.NewGetSettingNotFound()))
+			})
+		})
+	})
+
+	Describe("SetSetting", func() {
+		JustBeforeEach(func() {
+			params := setting.NewSetSettingParams()
+			params.Name = "maintenance-mode"
+			params.Body = &models.Setting{Value: "true"}
+			responseResponder = handler.SetSetting(params, principal)
+		})
+
+		BeforeEach(func() {
+			controller.SetSettingReturns(maintenance, nil)
+		})
+
+		It("changes the setting", func() {
+			_, name, value := controller.SetSettingArgsForCall(0)
+			Expect(name).To(Equal("maintenance-mode"))
+			Expect(value).To(Equal("true"))
+			Expect(responseResponder).To(Equal(setting.NewSetSettingOK().WithPayload(maintenance)))
+		})
+
+		Context("when the value is invalid", func() {
+			var invalid error
+
+			BeforeEach(func() {
+				invalid = models.NewInvalidRecordError(models.ErrInvalidField{Field: "value", Message: "must be true or false"})
+				controller.SetSettingReturns(nil, invalid)
+			})
+
+			It("responds with a bad request", func() {
+				expected := setting.NewSetSettingDefault(400)
+				expected.SetPayload(models.ConvertError(invalid))
+				Expect(responseResponder).To(Equal(expected))
+			})
+		})
+
+		Context("when the credentials are those of a pool", func() {
+			BeforeEach(func() {
+				principal = &models.User{Username: "staging", Pool: "staging"}
+			})
+
+			It("is forbidden without changing the setting", func() {
+				Expect(controller.SetSettingCallCount()).To(Equal(0))
+
+				expected := setting.NewSetSettingDefault(403)
+				expected.SetPayload(models.ErrUnauthorized)
+				Expect(responseResponder).To(Equal(expected))
+			})
+		})
+	})
+
+	Describe("ResetSetting", func() {
+		JustBeforeEach(func() {
+			params := setting.NewResetSettingParams()
+			params.Name = "maintenance-mode"
+			responseResponder = handler.ResetSetting(params, principal)
+		})
+
+		Context("when the setting does not exist", func() {
+			BeforeEach(func() {
+				controller.ResetSettingReturns(nil, models.ErrResourceNotFound)
+			})
+
+			It("responds with not found", func() {
+				Expect(responseResponder).To(Equal(setting.NewResetSettingNotFound()))
+			})
+		})
+
+		Context("when the credentials are those of a pool", func() {
+			BeforeEach(func() {
+				principal = &models.User{Username: "staging", Pool: "staging"}
+			})
+
+			It("is forbidden", func() {
+				Expect(controller.ResetSettingCallCount()).To(Equal(0))
+
+				expected := setting.NewResetSettingDefault(403)
+				expected.SetPayload(models.ErrUnauthorized)
+				Expect(responseResponder).To(Equal(expected))
+			})
+		})
+	})
+})
@@ -3,6 +3,7 @@ package handlers
 import (
 	"github.com/jianqiu/vps/models"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
 	"github.com/jianqiu/vps/restapi/operations/vm"
 
 	"code.cloudfoundry.org/lager"
@@ -12,13 +13,19 @@ type VirtualGuestController interface {
 	AllVirtualGuests(logger lager.Logger) ([]*models.VM, error)
 	VirtualGuests(logger lager.Logger, publicVlan, privateVlan, cpu, memory_mb int32, state models.State) ([]*models.VM, error)
 	OrderVirtualGuest(logger lager.Logger, vmFilter *models.VMFilter) (*models.VM, error)
+	OrderVirtualGuests(logger lager.Logger, cids []int32, ips []strfmt.IPv4) ([]*models.VM, error)
 	VirtualGuestsByDeployments(logger lager.Logger, names []string) ([]*models.VM, error)
 	VirtualGuestsByStates(logger lager.Logger, states []string) ([]*models.VM, error)
 	CreateVM(logger lager.Logger, vm *models.VM) error
 	DeleteVM(logger lager.Logger, cid int32) error
 	UpdateVM(logger lager.Logger, vm *models.VM) error
 	UpdateVMWithState(logger lager.Logger, cid int32, updateData *models.State) error
+	TransitionVM(logger lager.Logger, cid int32, state models.State) error
 	VirtualGuestByCid(logger lager.Logger, cid int32) (*models.VM, error)
+	VirtualGuestByIP(logger lager.Logger, ip string) (*models.VM, error)
+	VirtualGuestByHostname(logger lager.Logger, hostname string) (*models.VM, error)
+	VirtualGuestsByHostname(logger lager.Logger, pattern string) ([]*models.VM, error)
+	Summary(logger lager.Logger) (*models.PoolSummary, error)
 }
 
 func NewVmHandler(
@@ -38,35 +45,56 @@ type VMHandler struct {
 
 func (h *VMHandler) AddVM (params vm.AddVMParams, user *models.User) middleware.Responder {
 	var err error
-	h.logger = h.logger.ReSession("add-vm")
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("add-vm")
+
+	if err := authorize(user, models.DefaultPool); err != nil {
+		return errorResponse(err, vm.NewAddVMDefault(500))
+	}
 
 	request := params.Body
 
-	err = h.controller.CreateVM(h.logger, request)
+	err = h.controller.CreateVM(logger, request)
 	if err != nil {
-		unExpectedResponse := vm.NewAddVMDefault(500)
-		unExpectedResponse.SetPayload(models.ConvertError(err))
-		return unExpectedResponse
+		return errorResponse(err, vm.NewAddVMDefault(500))
 	}
 	return vm.NewAddVMOK().WithPayload("added successfully")
 }
 
+// Agent settings belong to the one vm ordered by its criteria; orders by
+// cids or ips are refused rather than have several vms share them.
+var errUserDataForManyVMs = models.NewInvalidRecordError(models.ErrInvalidField{Field: "userData", Message: "only goes with orders of a single vm"})
+
 func (h *VMHandler) OrderVmByFilter(params vm.OrderVMByFilterParams, user *models.User) middleware.Responder {
 	var err error
-	h.logger = h.logger.ReSession("order-vm-by-filter")
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("order-vm-by-filter")
+
+	if err := authorize(user, models.DefaultPool); err != nil {
+		return errorResponse(err, vm.NewOrderVMByFilterDefault(500))
+	}
 
 	response := &models.VMResponse{}
 	request := params.Body
 
-	response.VM, err = h.controller.OrderVirtualGuest(h.logger, request)
+	if request != nil && (len(request.Cids) > 0 || len(request.Ips) > 0) {
+		if request.UserData != "" {
+			return errorResponse(errUserDataForManyVMs, vm.NewOrderVMByFilterDefault(500))
+		}
+		response.Vms, err = h.controller.OrderVirtualGuests(logger, request.Cids, request.Ips)
+		if err != nil {
+			return errorResponse(err, vm.NewOrderVMByFilterDefault(500))
+		}
+		if len(response.Vms) > 0 {
+			response.VM = response.Vms[0]
+		}
+		return vm.NewOrderVMByFilterOK().WithPayload(response)
+	}
+
+	response.VM, err = h.controller.OrderVirtualGuest(logger, request)
 	if err != nil {
 		if models.ConvertError(err).Equal(models.ErrResourceNotFound){
 			return vm.NewOrderVMByFilterNotFound()
-		} else {
-			unExpectedResponse := vm.NewOrderVMByFilterDefault(500)
-			unExpectedResponse.SetPayload(models.ConvertError(err))
-			return unExpectedResponse
 		}
+		return errorResponse(err, vm.NewOrderVMByFilterDefault(500))
 	}
 
 	return vm.NewOrderVMByFilterOK().WithPayload(response)
@@ -74,32 +102,35 @@ func (h *VMHandler) OrderVmByFilter(params vm.OrderVMByFilterParams, user *model
 
 func (h *VMHandler) UpdateVM (params vm.UpdateVMParams, user *models.User) middleware.Responder {
 	var err error
-	h.logger = h.logger.ReSession("update-vm")
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("update-vm")
+
+	if err := authorize(user, models.DefaultPool); err != nil {
+		return errorResponse(err, vm.NewUpdateVMDefault(500))
+	}
 
 	request := params.Body
 
-	err = h.controller.UpdateVM(h.logger, request)
+	err = h.controller.UpdateVM(logger, request)
 	if err != nil {
-		unExpectedResponse := vm.NewUpdateVMDefault(500)
-		unExpectedResponse.SetPayload(models.ConvertError(err))
-		return unExpectedResponse
+		return errorResponse(err, vm.NewUpdateVMDefault(500))
 	}
 	return vm.NewUpdateVMOK().WithPayload("updated successfully")
 }
 
 func (h *VMHandler) DeleteVM(params vm.DeleteVMParams, user *models.User)  middleware.Responder {
 	var err error
-	h.logger = h.logger.ReSession("delete-vm")
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("delete-vm")
 
-	err = h.controller.DeleteVM(h.logger, params.Cid)
+	if err := authorize(user, models.DefaultPool); err != nil {
+		return errorResponse(err, vm.NewDeleteVMDefault(500))
+	}
+
+	err = h.controller.DeleteVM(logger, params.Cid)
 	if err != nil {
 		if models.ConvertError(err).Equal(models.ErrResourceNotFound){
 			return vm.NewDeleteVMNotFound()
-		} else {
-			unExpectedResponse := vm.NewDeleteVMDefault(500)
-			unExpectedResponse.SetPayload(models.ConvertError(err))
-			return unExpectedResponse
 		}
+		return errorResponse(err, vm.NewDeleteVMDefault(500))
 	}
 
 	return vm.NewDeleteVMNoContent().WithPayload("vm removed")
@@ -108,35 +139,89 @@ func (h *VMHandler) DeleteVM(params vm.DeleteVMParams, user *models.User)  middl
 
 func (h *VMHandler) GetVMByCid(params vm.GetVMByCidParams, user *models.User) middleware.Responder {
 	var err error
-	h.logger = h.logger.ReSession("get-vm-by-cid")
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("get-vm-by-cid")
+
+	if err := authorize(user, models.DefaultPool); err != nil {
+		return errorResponse(err, vm.NewGetVMByCidDefault(500))
+	}
 
 	response := &models.VMResponse{}
 
-	response.VM, err = h.controller.VirtualGuestByCid(h.logger,params.Cid)
+	response.VM, err = h.controller.VirtualGuestByCid(logger,params.Cid)
 	if err != nil {
 		if models.ConvertError(err).Equal(models.ErrResourceNotFound){
 			return vm.NewGetVMByCidNotFound()
-		} else {
-			unExpectedResponse := vm.NewGetVMByCidDefault(500)
-			unExpectedResponse.SetPayload(models.ConvertError(err))
-			return unExpectedResponse
 		}
+		return errorResponse(err, vm.NewGetVMByCidDefault(500))
+	}
+	if response.VM == nil {
+		return vm.NewGetVMByCidNotFound()
 	}
 
 	return vm.NewGetVMByCidOK().WithPayload(response)
 }
 
+func (h *VMHandler) GetVMByIP(params vm.GetVMByIPParams, user *models.User) middleware.Responder {
+	var err error
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("get-vm-by-ip")
+
+	if err := authorize(user, models.DefaultPool); err != nil {
+		return errorResponse(err, vm.NewGetVMByIPDefault(500))
+	}
+
+	response := &models.VMResponse{}
+
+	response.VM, err = h.controller.VirtualGuestByIP(logger, params.IP)
+	if err != nil {
+		if models.ConvertError(err).Equal(models.ErrResourceNotFound) {
+			return vm.NewGetVMByIPNotFound()
+		}
+		return errorResponse(err, vm.NewGetVMByIPDefault(500))
+	}
+	if response.VM == nil {
+		return vm.NewGetVMByIPNotFound()
+	}
+
+	return vm.NewGetVMByIPOK().WithPayload(response)
+}
+
+func (h *VMHandler) GetVMByHostname(params vm.GetVMByHostnameParams, user *models.User) middleware.Responder {
+	var err error
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("get-vm-by-hostname")
+
+	if err := authorize(user, models.DefaultPool); err != nil {
+		return errorResponse(err, vm.NewGetVMByHostnameDefault(500))
+	}
+
+	response := &models.VMResponse{}
+
+	response.VM, err = h.controller.VirtualGuestByHostname(logger, params.Hostname)
+	if err != nil {
+		if models.ConvertError(err).Equal(models.ErrResourceNotFound) {
+			return vm.NewGetVMByHostnameNotFound()
+		}
+		return errorResponse(err, vm.NewGetVMByHostnameDefault(500))
+	}
+	if response.VM == nil {
+		return vm.NewGetVMByHostnameNotFound()
+	}
+
+	return vm.NewGetVMByHostnameOK().WithPayload(response)
+}
+
 func (h *VMHandler) ListVM(params vm.ListVMParams, user *models.User) middleware.Responder {
 	var err error
-	h.logger =  h.logger.ReSession("list-vms")
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("list-vms")
+
+	if err := authorize(user, models.DefaultPool); err != nil {
+		return errorResponse(err, vm.NewListVMDefault(500))
+	}
 
 	response := &models.VmsResponse{}
 
-	response.Vms, err = h.controller.AllVirtualGuests(h.logger)
+	response.Vms, err = h.controller.AllVirtualGuests(logger)
 	if err != nil {
-		unExpectedResponse := vm.NewListVMDefault(500)
-		unExpectedResponse.SetPayload(models.ConvertError(err))
-		return unExpectedResponse
+		return errorResponse(err, vm.NewListVMDefault(500))
 	}
 	if len(response.Vms) == 0 {
 		return vm.NewListVMNotFound()
@@ -147,40 +232,67 @@ func (h *VMHandler) ListVM(params vm.ListVMParams, user *models.User) middleware
 
 func (h *VMHandler) UpdateVMWithState(params vm.UpdateVMWithStateParams, user *models.User) middleware.Responder {
 	var err error
-	h.logger = h.logger.ReSession("update-vm-with-state")
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("update-vm-with-state")
+
+	if err := authorize(user, models.DefaultPool); err != nil {
+		return errorResponse(err, vm.NewUpdateVMWithStateDefault(500))
+	}
 
 	vmId := params.Cid
 	updateData := params.Body
-	err = h.controller.UpdateVMWithState(h.logger, vmId, &updateData.State)
+	err = h.controller.UpdateVMWithState(logger, vmId, &updateData.State)
 	if err != nil {
 		if models.ConvertError(err).Equal(models.ErrResourceNotFound){
 			return vm.NewUpdateVMWithStateNotFound()
-		} else {
-			unExpectedResponse := vm.NewUpdateVMWithStateDefault(500)
-			unExpectedResponse.SetPayload(models.ConvertError(err))
-			return unExpectedResponse
 		}
+		return errorResponse(err, vm.NewUpdateVMWithStateDefault(500))
 	}
 
 	return vm.NewUpdateVMOK().WithPayload("updated successfully")
 }
 
+func (h *VMHandler) TransitionVM(params vm.TransitionVMParams, user *models.User) middleware.Responder {
+	var err error
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("transition-vm")
+
+	if err := authorize(user, models.DefaultPool); err != nil {
+		return errorResponse(err, vm.NewTransitionVMDefault(500))
+	}
+
+	err = h.controller.TransitionVM(logger, params.Cid, params.Body.State)
+	if err != nil {
+		modelErr := models.ConvertError(err)
+		switch modelErr.Type {
+		case models.ErrorTypeResourceNotFound:
+			return vm.NewTransitionVMNotFound()
+		case models.ErrorTypeInvalidStateTransition:
+			return vm.NewTransitionVMConflict().WithPayload(modelErr)
+		default:
+			return errorResponse(modelErr, vm.NewTransitionVMDefault(500))
+		}
+	}
+
+	return vm.NewTransitionVMOK().WithPayload("state transitioned successfully")
+}
+
 func (h *VMHandler) FindVmsByFilters(params vm.FindVmsByFiltersParams, user *models.User) middleware.Responder {
 	var err error
-	h.logger = h.logger.ReSession("find-vms-by-filter")
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("find-vms-by-filter")
+
+	if err := authorize(user, models.DefaultPool); err != nil {
+		return errorResponse(err, vm.NewFindVmsByFiltersDefault(500))
+	}
 
 	response := &models.VmsResponse{}
 	request := params.Body
 
 	if request == nil {
-		response.Vms, err = h.controller.AllVirtualGuests(h.logger)
+		response.Vms, err = h.controller.AllVirtualGuests(logger)
 	} else {
-		response.Vms, err = h.controller.VirtualGuests(h.logger, request.PublicVlan, request.PrivateVlan, request.CPU, request.MemoryMb, request.State)
+		response.Vms, err = h.controller.VirtualGuests(logger, request.PublicVlan, request.PrivateVlan, request.CPU, request.MemoryMb, request.State)
 	}
 	if err != nil {
-		unExpectedResponse := vm.NewFindVmsByFiltersDefault(500)
-		unExpectedResponse.SetPayload(models.ConvertError(err))
-		return unExpectedResponse
+		return errorResponse(err, vm.NewFindVmsByFiltersDefault(500))
 	}
 
 	return vm.NewFindVmsByFiltersOK().WithPayload(response)
@@ -188,16 +300,18 @@ func (h *VMHandler) FindVmsByFilters(params vm.FindVmsByFiltersParams, user *mod
 
 func (h *VMHandler) FindVmsByDeployment(params vm.FindVmsByDeploymentParams, user *models.User) middleware.Responder {
 	var err error
-	h.logger = h.logger.ReSession("find-vms-by-deployment")
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("find-vms-by-deployment")
+
+	if err := authorize(user, models.DefaultPool); err != nil {
+		return errorResponse(err, vm.NewFindVmsByDeploymentDefault(500))
+	}
 
 	response := &models.VmsResponse{}
 	request := params.Deployment
 
-	response.Vms, err = h.controller.VirtualGuestsByDeployments(h.logger, request)
+	response.Vms, err = h.controller.VirtualGuestsByDeployments(logger, request)
 	if err != nil {
-		unExpectedResponse := vm.NewFindVmsByDeploymentDefault(500)
-		unExpectedResponse.SetPayload(models.ConvertError(err))
-		return unExpectedResponse
+		return errorResponse(err, vm.NewFindVmsByDeploymentDefault(500))
 	}
 
 	return vm.NewFindVmsByDeploymentOK().WithPayload(response)
@@ -205,17 +319,37 @@ func (h *VMHandler) FindVmsByDeployment(params vm.FindVmsByDeploymentParams, use
 
 func (h *VMHandler) FindVmsByStates(params vm.FindVmsByStatesParams, user *models.User) middleware.Responder {
 	var err error
-	h.logger = h.logger.ReSession("find-vms-by-state")
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("find-vms-by-state")
+
+	if err := authorize(user, models.DefaultPool); err != nil {
+		return errorResponse(err, vm.NewFindVmsByStatesDefault(500))
+	}
 
 	response := &models.VmsResponse{}
 	request := params.States
 
-	response.Vms, err = h.controller.VirtualGuestsByStates(h.logger, request)
+	response.Vms, err = h.controller.VirtualGuestsByStates(logger, request)
 	if err != nil {
-		unExpectedResponse := vm.NewFindVmsByStatesDefault(500)
-		unExpectedResponse.SetPayload(models.ConvertError(err))
-		return unExpectedResponse
+		return errorResponse(err, vm.NewFindVmsByStatesDefault(500))
 	}
 
 	return vm.NewFindVmsByStatesOK().WithPayload(response)
-}
\ No newline at end of file
+}
+
+func (h *VMHandler) FindVmsByHostname(params vm.FindVmsByHostnameParams, user *models.User) middleware.Responder {
+	var err error
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("find-vms-by-hostname")
+
+	if err := authorize(user, models.DefaultPool); err != nil {
+		return errorResponse(err, vm.NewFindVmsByHostnameDefault(500))
+	}
+
+	response := &models.VmsResponse{}
+
+	response.Vms, err = h.controller.VirtualGuestsByHostname(logger, params.Pattern)
+	if err != nil {
+		return errorResponse(err, vm.NewFindVmsByHostnameDefault(500))
+	}
+
+	return vm.NewFindVmsByHostnameOK().WithPayload(response)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/jianqiu/vps/models"
 	"github.com/jianqiu/vps/restapi/operations/vm"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
 	"code.cloudfoundry.org/lager/lagertest"
 )
 
@@ -64,6 +65,31 @@ var _ = Describe("VmHandlerFunc", func() {
 			})
 		})
 
+		Context("when the credentials are those of the default pool", func() {
+			BeforeEach(func() {
+				principal = &models.User{Username: "default", Pool: models.DefaultPool}
+				controller.AllVirtualGuestsReturns([]*models.VM{&vm1}, nil)
+			})
+
+			It("lists the virtual guests", func() {
+				_, ok := responseResponder.(*vm.ListVMOK)
+				Expect(ok).To(BeTrue())
+			})
+		})
+
+		Context("when the credentials are those of another pool", func() {
+			BeforeEach(func() {
+				principal = &models.User{Username: "staging", Pool: "staging"}
+			})
+
+			It("is forbidden", func() {
+				Expect(controller.AllVirtualGuestsCallCount()).To(Equal(0))
+				listVmDefault, ok:=responseResponder.(*vm.ListVMDefault)
+				Expect(ok).To(BeTrue())
+				Expect(listVmDefault.GetStatusCode()).To(Equal(403))
+			})
+		})
+
 		Context("when the controller returns no virtual guest", func() {
 			var vms []*models.VM
 
@@ -154,6 +180,253 @@ var _ = Describe("VmHandlerFunc", func() {
 		})
 	})
 
+	Describe("OrderVmByFilter", func() {
+		var (
+			params vm.OrderVMByFilterParams
+		)
+
+		BeforeEach(func() {
+			params = vm.NewOrderVMByFilterParams()
+			params.Body = &models.VMFilter{CPU: 4, MemoryMb: 1024}
+		})
+
+		JustBeforeEach(func() {
+			responseResponder = handler.OrderVmByFilter(params, principal)
+		})
+
+		Context("when ordering by filter", func() {
+			var vm1 *models.VM
+
+			BeforeEach(func() {
+				vm1 = &models.VM{Cid: 1234567}
+				controller.OrderVirtualGuestReturns(vm1, nil)
+			})
+
+			It("orders one virtual guest matching the filter", func() {
+				Expect(controller.OrderVirtualGuestCallCount()).To(Equal(1))
+				_, filter := controller.OrderVirtualGuestArgsForCall(0)
+				Expect(filter).To(Equal(params.Body))
+				Expect(controller.OrderVirtualGuestsCallCount()).To(Equal(0))
+
+				orderVmOK, ok := responseResponder.(*vm.OrderVMByFilterOK)
+				Expect(ok).To(BeTrue())
+				Expect(orderVmOK.Payload.VM).To(Equal(vm1))
+			})
+		})
+
+		Context("when ordering specific cids and ips", func() {
+			var vms []*models.VM
+
+			BeforeEach(func() {
+				params.Body.Cids = []int32{1, 2}
+				params.Body.Ips = []strfmt.IPv4{"10.0.0.3"}
+				vms = []*models.VM{{Cid: 1}, {Cid: 2}, {Cid: 3, IP: "10.0.0.3"}}
+				controller.OrderVirtualGuestsReturns(vms, nil)
+			})
+
+			It("claims exactly those virtual guests", func() {
+				Expect(controller.OrderVirtualGuestsCallCount()).To(Equal(1))
+				_, cids, ips := controller.OrderVirtualGuestsArgsForCall(0)
+				Expect(cids).To(Equal([]int32{1, 2}))
+				Expect(ips).To(Equal([]strfmt.IPv4{"10.0.0.3"}))
+				Expect(controller.OrderVirtualGuestCallCount()).To(Equal(0))
+
+				orderVmOK, ok := responseResponder.(*vm.OrderVMByFilterOK)
+				Expect(ok).To(BeTrue())
+				Expect(orderVmOK.Payload.Vms).To(Equal(vms))
+				Expect(orderVmOK.Payload.VM).To(Equal(vms[0]))
+			})
+
+			Context("when agent settings come with them", func() {
+				BeforeEach(func() {
+					params.Body.UserData = `{"agent_id":"abc"}`
+				})
+
+				It("rejects the order", func() {
+					Expect(controller.OrderVirtualGuestsCallCount()).To(Equal(0))
+
+					orderVmDefault, ok := responseResponder.(*vm.OrderVMByFilterDefault)
+					Expect(ok).To(BeTrue())
+					Expect(orderVmDefault.GetStatusCode()).To(Equal(400))
+					Expect(orderVmDefault.GetPayload().Fields).To(ConsistOf(
+						&models.FieldError{Field: "userData", Message: "only goes with orders of a single vm"},
+					))
+				})
+			})
+
+			Context("when some of them are taken", func() {
+				var conflict *models.Error
+
+				BeforeEach(func() {
+					conflict = models.NewResourceConflictError(models.ErrInvalidField{Field: "cids", Message: "2 is using"})
+					controller.OrderVirtualGuestsReturns(nil, conflict)
+				})
+
+				It("responds with a conflict listing them", func() {
+					orderVmDefault, ok := responseResponder.(*vm.OrderVMByFilterDefault)
+					Expect(ok).To(BeTrue())
+					Expect(orderVmDefault.GetStatusCode()).To(Equal(409))
+					Expect(orderVmDefault.GetPayload()).To(Equal(conflict))
+				})
+			})
+		})
+	})
+
+	Describe("GetVMByIP", func() {
+		var (
+			params vm.GetVMByIPParams
+		)
+
+		BeforeEach(func() {
+			params = vm.NewGetVMByIPParams()
+			params.IP = "10.0.0.1"
+		})
+
+		JustBeforeEach(func() {
+			responseResponder = handler.GetVMByIP(params, principal)
+		})
+
+		Context("when a virtual guest has the ip", func() {
+			var vm1 *models.VM
+
+			BeforeEach(func() {
+				vm1 = &models.VM{Cid: 1234567, IP: "10.0.0.1"}
+				controller.VirtualGuestByIPReturns(vm1, nil)
+			})
+
+			It("returns the virtual guest", func() {
+				Expect(controller.VirtualGuestByIPCallCount()).To(Equal(1))
+				_, actualIP := controller.VirtualGuestByIPArgsForCall(0)
+				Expect(actualIP).To(Equal("10.0.0.1"))
+
+				getVmByIPOK, ok:=responseResponder.(*vm.GetVMByIPOK)
+				Expect(ok).To(BeTrue())
+				Expect(getVmByIPOK.GetPayload().VM).To(Equal(vm1))
+			})
+		})
+
+		Context("when no virtual guest has the ip", func() {
+			BeforeEach(func() {
+				controller.VirtualGuestByIPReturns(nil, models.ErrResourceNotFound)
+			})
+
+			It("returns 404 status code", func() {
+				getVmByIPNotFound, ok:=responseResponder.(*vm.GetVMByIPNotFound)
+				Expect(ok).To(BeTrue())
+				Expect(getVmByIPNotFound.GetStatusCode()).To(Equal(404))
+			})
+		})
+
+		Context("when the controller errors out", func() {
+			BeforeEach(func() {
+				controller.VirtualGuestByIPReturns(nil, models.ErrUnknownError)
+			})
+
+			It("provides relevant error information", func() {
+				getVmByIPDefault, ok:=responseResponder.(*vm.GetVMByIPDefault)
+				Expect(ok).To(BeTrue())
+				Expect(getVmByIPDefault.GetStatusCode()).To(Equal(500))
+				Expect(getVmByIPDefault.GetPayload()).To(Equal(models.ErrUnknownError))
+			})
+		})
+	})
+
+	Describe("GetVMByHostname", func() {
+		var (
+			params vm.GetVMByHostnameParams
+		)
+
+		BeforeEach(func() {
+			params = vm.NewGetVMByHostnameParams()
+			params.Hostname = "vm-1.example.com"
+		})
+
+		JustBeforeEach(func() {
+			responseResponder = handler.GetVMByHostname(params, principal)
+		})
+
+		Context("when a virtual guest has the hostname", func() {
+			var vm1 *models.VM
+
+			BeforeEach(func() {
+				vm1 = &models.VM{Cid: 1234567, Hostname: "vm-1.example.com"}
+				controller.VirtualGuestByHostnameReturns(vm1, nil)
+			})
+
+			It("returns the virtual guest", func() {
+				Expect(controller.VirtualGuestByHostnameCallCount()).To(Equal(1))
+				_, actualHostname := controller.VirtualGuestByHostnameArgsForCall(0)
+				Expect(actualHostname).To(Equal("vm-1.example.com"))
+
+				getVmByHostnameOK, ok:=responseResponder.(*vm.GetVMByHostnameOK)
+				Expect(ok).To(BeTrue())
+				Expect(getVmByHostnameOK.GetPayload().VM).To(Equal(vm1))
+			})
+		})
+
+		Context("when no virtual guest has the hostname", func() {
+			BeforeEach(func() {
+				controller.VirtualGuestByHostnameReturns(nil, models.ErrResourceNotFound)
+			})
+
+			It("returns 404 status code", func() {
+				getVmByHostnameNotFound, ok:=responseResponder.(*vm.GetVMByHostnameNotFound)
+				Expect(ok).To(BeTrue())
+				Expect(getVmByHostnameNotFound.GetStatusCode()).To(Equal(404))
+			})
+		})
+	})
+
+	Describe("FindVmsByHostname", func() {
+		var (
+			params vm.FindVmsByHostnameParams
+		)
+
+		BeforeEach(func() {
+			params = vm.NewFindVmsByHostnameParams()
+			params.Pattern = "vm-*"
+		})
+
+		JustBeforeEach(func() {
+			responseResponder = handler.FindVmsByHostname(params, principal)
+		})
+
+		Context("when the search succeeds", func() {
+			var vms []*models.VM
+
+			BeforeEach(func() {
+				vms = []*models.VM{{Cid: 1, Hostname: "vm-1"}, {Cid: 2, Hostname: "vm-2"}}
+				controller.VirtualGuestsByHostnameReturns(vms, nil)
+			})
+
+			It("returns the matching virtual guests", func() {
+				Expect(controller.VirtualGuestsByHostnameCallCount()).To(Equal(1))
+				_, actualPattern := controller.VirtualGuestsByHostnameArgsForCall(0)
+				Expect(actualPattern).To(Equal("vm-*"))
+
+				findVmsByHostnameOK, ok:=responseResponder.(*vm.FindVmsByHostnameOK)
+				Expect(ok).To(BeTrue())
+				Expect(findVmsByHostnameOK.GetPayload().Vms).To(Equal(vms))
+			})
+		})
+
+		Context("when the pattern is invalid", func() {
+			var invalid *models.Error
+
+			BeforeEach(func() {
+				invalid = models.NewError(models.ErrorTypeInvalidRequest, "hostname pattern is empty")
+				controller.VirtualGuestsByHostnameReturns(nil, invalid)
+			})
+
+			It("responds with a bad request", func() {
+				findVmsByHostnameDefault, ok:=responseResponder.(*vm.FindVmsByHostnameDefault)
+				Expect(ok).To(BeTrue())
+				Expect(findVmsByHostnameDefault.GetStatusCode()).To(Equal(400))
+				Expect(findVmsByHostnameDefault.GetPayload()).To(Equal(invalid))
+			})
+		})
+	})
+
 	Describe("DeleteVM", func() {
 		Context("when the delete request is normal", func() {
 			var (
@@ -196,6 +469,73 @@ var _ = Describe("VmHandlerFunc", func() {
 		})
 	})
 
+	Describe("TransitionVM", func() {
+		var (
+			params vm.TransitionVMParams
+		)
+
+		BeforeEach(func() {
+			params = vm.NewTransitionVMParams()
+			params.Cid = 1234567
+			params.Body = &models.VMState{State: models.StateMaintenance}
+		})
+
+		JustBeforeEach(func() {
+			responseResponder = handler.TransitionVM(params, principal)
+		})
+
+		Context("when the transition succeeds", func() {
+			It("transitions the virtual guest", func() {
+				Expect(controller.TransitionVMCallCount()).To(Equal(1))
+				_, cid, state := controller.TransitionVMArgsForCall(0)
+				Expect(cid).To(Equal(params.Cid))
+				Expect(state).To(Equal(models.StateMaintenance))
+
+				transitionVmOK, ok := responseResponder.(*vm.TransitionVMOK)
+				Expect(ok).To(BeTrue())
+				Expect(transitionVmOK.Payload).To(Equal("state transitioned successfully"))
+			})
+		})
+
+		Context("when the virtual guest does not exist", func() {
+			BeforeEach(func() {
+				controller.TransitionVMReturns(models.ErrResourceNotFound)
+			})
+
+			It("returns 404 status code", func() {
+				_, ok := responseResponder.(*vm.TransitionVMNotFound)
+				Expect(ok).To(BeTrue())
+			})
+		})
+
+		Context("when the lifecycle does not allow the transition", func() {
+			var transitionErr *models.Error
+
+			BeforeEach(func() {
+				transitionErr = models.NewTaskTransitionError(models.StateRetiring, models.StateMaintenance)
+				controller.TransitionVMReturns(transitionErr)
+			})
+
+			It("returns a conflict with the error", func() {
+				transitionVmConflict, ok := responseResponder.(*vm.TransitionVMConflict)
+				Expect(ok).To(BeTrue())
+				Expect(transitionVmConflict.Payload).To(Equal(transitionErr))
+			})
+		})
+
+		Context("when the controller errors out", func() {
+			BeforeEach(func() {
+				controller.TransitionVMReturns(models.ErrUnknownError)
+			})
+
+			It("provides relevant error information", func() {
+				transitionVmDefault, ok := responseResponder.(*vm.TransitionVMDefault)
+				Expect(ok).To(BeTrue())
+				Expect(transitionVmDefault.Payload).To(Equal(models.ErrUnknownError))
+			})
+		})
+	})
+
 	Describe("AddVM", func() {
 		var (
 			vm1  *models.VM
@@ -244,5 +584,72 @@ var _ = Describe("VmHandlerFunc", func() {
 				Expect(addVmDefault.GetPayload()).To(Equal(models.ErrUnknownError))
 			})
 		})
+
+		Context("when the virtual guest is already in the pool", func() {
+			BeforeEach(func() {
+				controller.CreateVMReturns(models.ErrResourceExists)
+			})
+
+			It("responds with a conflict", func() {
+				addVmDefault, ok:=responseResponder.(*vm.AddVMDefault)
+				Expect(ok).To(BeTrue())
+				Expect(addVmDefault.GetStatusCode()).To(Equal(409))
+				Expect(addVmDefault.GetPayload()).To(Equal(models.ErrResourceExists))
+			})
+		})
+		Context("when the credentials are those of another pool", func() {
+			BeforeEach(func() {
+				principal = &models.User{Username: "staging", Pool: "staging"}
+			})
+
+			It("is forbidden", func() {
+				Expect(controller.CreateVMCallCount()).To(Equal(0))
+				addVmDefault, ok:=responseResponder.(*vm.AddVMDefault)
+				Expect(ok).To(BeTrue())
+				Expect(addVmDefault.GetStatusCode()).To(Equal(403))
+			})
+		})
+	})
+
+	Describe("UpdateVM", func() {
+		var (
+			vm1  *models.VM
+			params vm.UpdateVMParams
+		)
+
+		BeforeEach(func() {
+			vm1 = &models.VM{Cid: 1234567, CPU: 4, MemoryMb: 1024, IP: "10.0.0.1", Hostname: "fake.test.com"}
+			params = vm.NewUpdateVMParams()
+			params.Body = vm1
+		})
+
+		JustBeforeEach(func() {
+			responseResponder = handler.UpdateVM(params, principal)
+		})
+
+		Context("when the virtual guest is updated", func() {
+			It("updates it in the pool", func() {
+				Expect(controller.UpdateVMCallCount()).To(Equal(1))
+				_, actualVm := controller.UpdateVMArgsForCall(0)
+				Expect(actualVm).To(Equal(vm1))
+
+				updateVmOK, ok:=responseResponder.(*vm.UpdateVMOK)
+				Expect(ok).To(BeTrue())
+				Expect(updateVmOK.GetPayload()).To(Equal("updated successfully"))
+			})
+		})
+
+		Context("when the credentials are those of another pool", func() {
+			BeforeEach(func() {
+				principal = &models.User{Username: "staging", Pool: "staging"}
+			})
+
+			It("is forbidden", func() {
+				Expect(controller.UpdateVMCallCount()).To(Equal(0))
+				updateVmDefault, ok:=responseResponder.(*vm.UpdateVMDefault)
+				Expect(ok).To(BeTrue())
+				Expect(updateVmDefault.GetStatusCode()).To(Equal(403))
+			})
+		})
 	})
 })
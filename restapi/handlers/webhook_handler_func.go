@@ -0,0 +1,119 @@
+package handlers
+
+import (
+	"github.com/jianqiu/vps/models"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/restapi/operations/webhook"
+
+	"code.cloudfoundry.org/lager"
+)
+//go:generate counterfeiter -o fake_controllers/fake_webhook_controller.go . WebhookController
+type WebhookController interface {
+	CreateWebhook(logger lager.Logger, webhook *models.Webhook) (*models.Webhook, error)
+	Webhooks(logger lager.Logger) ([]*models.Webhook, error)
+	DeleteWebhook(logger lager.Logger, id string) error
+	DeadWebhookDeliveries(logger lager.Logger) ([]*models.WebhookDelivery, error)
+	RetryWebhookDelivery(logger lager.Logger, id string) error
+}
+
+func NewWebhookHandler(
+logger lager.Logger,
+controller WebhookController,
+) *WebhookHandler {
+	return &WebhookHandler{
+		logger: logger,
+		controller: controller,
+	}
+}
+
+type WebhookHandler struct {
+	logger lager.Logger
+	controller WebhookController
+}
+
+func (h *WebhookHandler) CreateWebhook(params webhook.CreateWebhookParams, user *models.User) middleware.Responder {
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("create-webhook")
+
+	if err := authorizeAdmin(user); err != nil {
+		return errorResponse(err, webhook.NewCreateWebhookDefault(500))
+	}
+
+	created, err := h.controller.CreateWebhook(logger, params.Body)
+	if err != nil {
+		return errorResponse(err, webhook.NewCreateWebhookDefault(500))
+	}
+
+	return webhook.NewCreateWebhookOK().WithPayload(created)
+}
+
+func (h *WebhookHandler) ListWebhooks(params webhook.ListWebhooksParams, user *models.User) middleware.Responder {
+	var err error
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("list-webhooks")
+
+	if err := authorizeAdmin(user); err != nil {
+		return errorResponse(err, webhook.NewListWebhooksDefault(500))
+	}
+
+	response := &models.WebhooksResponse{}
+
+	response.Webhooks, err = h.controller.Webhooks(logger)
+	if err != nil {
+		return errorResponse(err, webhook.NewListWebhooksDefault(500))
+	}
+
+	return webhook.NewListWebhooksOK().WithPayload(response)
+}
+
+func (h *WebhookHandler) DeleteWebhook(params webhook.DeleteWebhookParams, user *models.User) middleware.Responder {
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("delete-webhook")
+
+	if err := authorizeAdmin(user); err != nil {
+		return errorResponse(err, webhook.NewDeleteWebhookDefault(500))
+	}
+
+	err := h.controller.DeleteWebhook(logger, params.ID)
+	if err != nil {
+		if models.ConvertError(err).Equal(models.ErrResourceNotFound) {
+			return webhook.NewDeleteWebhookNotFound()
+		}
+		return errorResponse(err, webhook.NewDeleteWebhookDefault(500))
+	}
+
+	return webhook.NewDeleteWebhookNoContent()
+}
+
+func (h *WebhookHandler) ListDeadWebhookDeliveries(params webhook.ListDeadWebhookDeliveriesParams, user *models.User) middleware.Responder {
+	var err error
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("list-dead-webhook-deliveries")
+
+	if err := authorizeAdmin(user); err != nil {
+		return errorResponse(err, webhook.NewListDeadWebhookDeliveriesDefault(500))
+	}
+
+	response := &models.WebhookDeliveriesResponse{}
+
+	response.Deliveries, err = h.controller.DeadWebhookDeliveries(logger)
+	if err != nil {
+		return errorResponse(err, webhook.NewListDeadWebhookDeliveriesDefault(500))
+	}
+
+	return webhook.NewListDeadWebhookDeliveriesOK().WithPayload(response)
+}
+
+func (h *WebhookHandler) RetryWebhookDelivery(params webhook.RetryWebhookDeliveryParams, user *models.User) middleware.Responder {
+	logger := requestLogger(h.logger, params.HTTPRequest).Session("retry-webhook-delivery")
+
+	if err := authorizeAdmin(user); err != nil {
+		return errorResponse(err, webhook.NewRetryWebhookDeliveryDefault(500))
+	}
+
+	err := h.controller.RetryWebhookDelivery(logger, params.ID)
+	if err != nil {
+		if models.ConvertError(err).Equal(models.ErrResourceNotFound) {
+			return webhook.NewRetryWebhookDeliveryNotFound()
+		}
+		return errorResponse(err, webhook.NewRetryWebhookDeliveryDefault(500))
+	}
+
+	return webhook.NewRetryWebhookDeliveryOK().WithPayload("delivery queued")
+}
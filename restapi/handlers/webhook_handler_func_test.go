@@ -0,0 +1,228 @@
+package handlers_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jianqiu/vps/restapi/handlers"
+	"github.com/jianqiu/vps/restapi/handlers/fake_controllers"
+	"github.com/jianqiu/vps/models"
+	"github.com/jianqiu/vps/restapi/operations/webhook"
+	"github.com/go-openapi/runtime/middleware"
+	"code.cloudfoundry.org/lager/lagertest"
+)
+
+var _ = Describe("WebhookHandlerFunc", func() {
+	var (
+		logger     *lagertest.TestLogger
+		controller *fake_controllers.FakeWebhookController
+
+		responseResponder middleware.Responder
+
+		handler *handlers.WebhookHandler
+
+		principal *models.User
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		controller = &fake_controllers.FakeWebhookController{}
+		handler = handlers.NewWebhookHandler(logger, controller)
+		principal = &models.User{}
+	})
+
+	Describe("CreateWebhook", func() {
+		var requested *models.Webhook
+
+		BeforeEach(func() {
+			requested = &models.Webhook{URL: "https://example.com/hook", Events: []models.WebhookEventType{models.WebhookEventTypeVMOrdered}}
+		})
+
+		JustBeforeEach(func() {
+			params := webhook.NewCreateWebhookParams()
+			params.Body = requested
+			responseResponder = handler.CreateWebhook(params, principal)
+		})
+
+		Context("when the webhook is created", func() {
+			var created *models.Webhook
+
+			BeforeEach(func() {
+				created = &models.Webhook{ID: "webhook-1", URL: requested.URL, Secret: "s3cret", Events: requested.Events}
+				controller.CreateWebhookReturns(created, nil)
+			})
+
+			It("returns the created webhook", func() {
+				Expect(controller.CreateWebhookCallCount()).To(Equal(1))
+				_, actual := controller.CreateWebhookArgsForCall(0)
+				Expect(actual).To(Equal(requested))
+				Expect(responseResponder).To(Equal(webhook.NewCreateWebhookOK().WithPayload(created)))
+			})
+		})
+
+		Context("when the request is invalid", func() {
+			var invalid error
+
+			BeforeEach(func() {
+				invalid = models.NewError(models.ErrorTypeInvalidRequest, "bad url")
+				controller.CreateWebhookReturns(nil, invalid)
+			})
+
+			It("responds with a bad request", func() {
+				expected := webhook.NewCreateWebhookDefault(400)
+				expected.SetPayload(models.ConvertError(invalid))
+				Expect(responseResponder).To(Equal(expected))
+			})
+		})
+
+		Context("when creating fails", func() {
+			BeforeEach(func() {
+				controller.CreateWebhookReturns(nil, errors.New("kaboom"))
+			})
+
+			It("responds with an error", func() {
+				expected := webhook.NewCreateWebhookDefault(500)
+				expected.SetPayload(models.ConvertError(errors.New("kaboom")))
+				Expect(responseResponder).To(Equal(expected))
+			})
+		})
+	})
+
+	Describe("ListWebhooks", func() {
+		JustBeforeEach(func() {
+			responseResponder = handler.ListWebhooks(webhook.NewListWebhooksParams(), principal)
+		})
+
+		Context("when listing succeeds", func() {
+			var webhooks []*models.Webhook
+
+			BeforeEach(func() {
+				webhooks = []*models.Webhook{{ID: "webhook-1"}, {ID: "webhook-2"}}
+				controller.WebhooksReturns(webhooks, nil)
+			})
+
+			It("returns the webhooks", func() {
+				Expect(responseResponder).To(Equal(webhook.NewListWebhooksOK().WithPayload(&models.WebhooksResponse{Webhooks: webhooks})))
+			})
+		})
+
+		Context("when listing fails", func() {
+			BeforeEach(func() {
+				controller.WebhooksReturns(nil, errors.New("kaboom"))
+			})
+
+			It("responds with an error", func() {
+				expected := webhook.NewListWebhooksDefault(500)
+				expected.SetPayload(models.ConvertError(errors.New("kaboom")))
+				Expect(responseResponder).To(Equal(expected))
+			})
+		})
+	})
+
+	Describe("DeleteWebhook", func() {
+		JustBeforeEach(func() {
+			params := webhook.NewDeleteWebhookParams()
+			params.ID = "webhook-1"
+			responseResponder = handler.DeleteWebhook(params, principal)
+		})
+
+		It("deletes the webhook", func() {
+			Expect(controller.DeleteWebhookCallCount()).To(Equal(1))
+			_, id := controller.DeleteWebhookArgsForCall(0)
+			Expect(id).To(Equal("webhook-1"))
+			Expect(responseResponder).To(Equal(webhook.NewDeleteWebhookNoContent()))
+		})
+
+		Context("when the webhook does not exist", func() {
+			BeforeEach(func() {
+				controller.DeleteWebhookReturns(models.ErrResourceNotFound)
+			})
+
+			It("responds with not found", func() {
+				Expect(responseResponder).To(Equal(webhook.NewDeleteWebhookNotFound()))
+			})
+		})
+
+		Context("when deleting fails", func() {
+			BeforeEach(func() {
+				controller.DeleteWebhookReturns(errors.New("kaboom"))
+			})
+
+			It("responds with an error", func() {
+				expected := webhook.NewDeleteWebhookDefault(500)
+				expected.SetPayload(models.ConvertError(errors.New("kaboom")))
+				Expect(responseResponder).To(Equal(expected))
+			})
+		})
+	})
+
+	Describe("ListDeadWebhookDeliveries", func() {
+		JustBeforeEach(func() {
+			responseResponder = handler.ListDeadWebhookDeliveries(webhook.NewListDeadWebhookDeliveriesParams(), principal)
+		})
+
+		Context("when listing succeeds", func() {
+			var deliveries []*models.WebhookDelivery
+
+			BeforeEach(func() {
+				deliveries = []*models.WebhookDelivery{{ID: "delivery-1", State: models.WebhookDeliveryStateDead}}
+				controller.DeadWebhookDeliveriesReturns(deliveries, nil)
+			})
+
+			It("returns the dead deliveries", func() {
+				Expect(responseResponder).To(Equal(webhook.NewListDeadWebhookDeliveriesOK().WithPayload(&models.WebhookDeliveriesResponse{Deliveries: deliveries})))
+			})
+		})
+
+		Context("when listing fails", func() {
+			BeforeEach(func() {
+				controller.DeadWebhookDeliveriesReturns(nil, errors.New("kaboom"))
+			})
+
+			It("responds with an error", func() {
+				expected := webhook.NewListDeadWebhookDeliveriesDefault(500)
+				expected.SetPayload(models.ConvertError(errors.New("kaboom")))
+				Expect(responseResponder).To(Equal(expected))
+			})
+		})
+	})
+
+	Describe("RetryWebhookDelivery", func() {
+		JustBeforeEach(func() {
+			params := webhook.NewRetryWebhookDeliveryParams()
+			params.ID = "delivery-1"
+			responseResponder = handler.RetryWebhookDelivery(params, principal)
+		})
+
+		It("queues the delivery again", func() {
+			Expect(controller.RetryWebhookDeliveryCallCount()).To(Equal(1))
+			_, id := controller.RetryWebhookDeliveryArgsForCall(0)
+			Expect(id).To(Equal("delivery-1"))
+			Expect(responseResponder).To(Equal(webhook.NewRetryWebhookDeliveryOK().WithPayload("delivery queued")))
+		})
+
+		Context("when the delivery is not dead", func() {
+			BeforeEach(func() {
+				controller.RetryWebhookDeliveryReturns(models.ErrResourceNotFound)
+			})
+
+			It("responds with not found", func() {
+				Expect(responseResponder).To(Equal(webhook.NewRetryWebhookDeliveryNotFound()))
+			})
+		})
+
+		Context("when retrying fails", func() {
+			BeforeEach(func() {
+				controller.RetryWebhookDeliveryReturns(errors.New("kaboom"))
+			})
+
+			It("responds with an error", func() {
+				expected := webhook.NewRetryWebhookDeliveryDefault(500)
+				expected.SetPayload(models.ConvertError(errors.New("kaboom")))
+				Expect(responseResponder).To(Equal(expected))
+			})
+		})
+	})
+})
@@ -0,0 +1,64 @@
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/models"
+)
+
+// GetLogLevelHandlerFunc turns a function with the right signature into a get log level handler
+type GetLogLevelHandlerFunc func(GetLogLevelParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetLogLevelHandlerFunc) Handle(params GetLogLevelParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// GetLogLevelHandler interface for that can handle valid get log level params
+type GetLogLevelHandler interface {
+	Handle(GetLogLevelParams, *models.User) middleware.Responder
+}
+
+// NewGetLogLevel creates a new http.Handler for the get log level operation
+func NewGetLogLevel(ctx *middleware.Context, handler GetLogLevelHandler) *GetLogLevel {
+	return &GetLogLevel{Context: ctx, Handler: handler}
+}
+
+/*GetLogLevel swagger:route GET /admin/log-level admin getLogLevel
+
+Report the level the server logs at
+
+*/
+type GetLogLevel struct {
+	Context *middleware.Context
+	Handler GetLogLevelHandler
+}
+
+func (o *GetLogLevel) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, _ := o.Context.RouteInfo(r)
+	var Params = NewGetLogLevelParams()
+
+	uprinc, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,104 @@
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/jianqiu/vps/models"
+)
+
+/*GetLogLevelOK successful operation
+
+swagger:response getLogLevelOK
+*/
+type GetLogLevelOK struct {
+
+	// In: body
+	Payload *models.LogLevel `json:"body,omitempty"`
+}
+
+// NewGetLogLevelOK creates GetLogLevelOK with default headers values
+func NewGetLogLevelOK() *GetLogLevelOK {
+	return &GetLogLevelOK{}
+}
+
+// WithPayload adds the payload to the get log level o k response
+func (o *GetLogLevelOK) WithPayload(payload *models.LogLevel) *GetLogLevelOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get log level o k response
+func (o *GetLogLevelOK) SetPayload(payload *models.LogLevel) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetLogLevelOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetLogLevelDefault unexpected error
+
+swagger:response getLogLevelDefault
+*/
+type GetLogLevelDefault struct {
+	_statusCode int
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetLogLevelDefault creates GetLogLevelDefault with default headers values
+func NewGetLogLevelDefault(code int) *GetLogLevelDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetLogLevelDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get log level default response
+func (o *GetLogLevelDefault) WithStatusCode(code int) *GetLogLevelDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get log level default response
+func (o *GetLogLevelDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get log level default response
+func (o *GetLogLevelDefault) WithPayload(payload *models.Error) *GetLogLevelDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get log level default response
+func (o *GetLogLevelDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetLogLevelDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
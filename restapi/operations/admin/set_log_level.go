@@ -0,0 +1,66 @@
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/models"
+)
+
+// SetLogLevelHandlerFunc turns a function with the right signature into a set log level handler
+type SetLogLevelHandlerFunc func(SetLogLevelParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn SetLogLevelHandlerFunc) Handle(params SetLogLevelParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// SetLogLevelHandler interface for that can handle valid set log level params
+type SetLogLevelHandler interface {
+	Handle(SetLogLevelParams, *models.User) middleware.Responder
+}
+
+// NewSetLogLevel creates a new http.Handler for the set log level operation
+func NewSetLogLevel(ctx *middleware.Context, handler SetLogLevelHandler) *SetLogLevel {
+	return &SetLogLevel{Context: ctx, Handler: handler}
+}
+
+/*SetLogLevel swagger:route PUT /admin/log-level admin setLogLevel
+
+Change the level the server logs at
+
+Takes effect at once and lasts until the server restarts or reloads its config file.
+
+*/
+type SetLogLevel struct {
+	Context *middleware.Context
+	Handler SetLogLevelHandler
+}
+
+func (o *SetLogLevel) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, _ := o.Context.RouteInfo(r)
+	var Params = NewSetLogLevelParams()
+
+	uprinc, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,141 @@
+package admin
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/jianqiu/vps/models"
+)
+
+/*SetLogLevelOK the level the server logs at now
+
+swagger:response setLogLevelOK
+*/
+type SetLogLevelOK struct {
+
+	// In: body
+	Payload *models.LogLevel `json:"body,omitempty"`
+}
+
+// NewSetLogLevelOK creates SetLogLevelOK with default headers values
+func NewSetLogLevelOK() *SetLogLevelOK {
+	return &SetLogLevelOK{}
+}
+
+// WithPayload adds the payload to the set log level o k response
+func (o *SetLogLevelOK) WithPayload(payload *models.LogLevel) *SetLogLevelOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the set log level o k response
+func (o *SetLogLevelOK) SetPayload(payload *models.LogLevel) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SetLogLevelOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*SetLogLevelBadRequest request is invalid
+
+swagger:response setLogLevelBadRequest
+*/
+type SetLogLevelBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewSetLogLevelBadRequest creates SetLogLevelBadRequest with default headers values
+func NewSetLogLevelBadRequest() *SetLogLevelBadRequest {
+	return &SetLogLevelBadRequest{}
+}
+
+// WithPayload adds the payload to the set log level bad request response
+func (o *SetLogLevelBadRequest) WithPayload(payload *models.Error) *SetLogLevelBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the set log level bad request response
+func (o *SetLogLevelBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SetLogLevelBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*SetLogLevelDefault unexpected error
+
+swagger:response setLogLevelDefault
+*/
+type SetLogLevelDefault struct {
+	_statusCode int
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewSetLogLevelDefault creates SetLogLevelDefault with default headers values
+func NewSetLogLevelDefault(code int) *SetLogLevelDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &SetLogLevelDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the set log level default response
+func (o *SetLogLevelDefault) WithStatusCode(code int) *SetLogLevelDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the set log level default response
+func (o *SetLogLevelDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the set log level default response
+func (o *SetLogLevelDefault) WithPayload(payload *models.Error) *SetLogLevelDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the set log level default response
+func (o *SetLogLevelDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SetLogLevelDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
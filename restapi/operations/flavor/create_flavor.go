@@ -0,0 +1,64 @@
+package flavor
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/models"
+)
+
+// CreateFlavorHandlerFunc turns a function with the right signature into a create flavor handler
+type CreateFlavorHandlerFunc func(CreateFlavorParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn CreateFlavorHandlerFunc) Handle(params CreateFlavorParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// CreateFlavorHandler interface for that can handle valid create flavor params
+type CreateFlavorHandler interface {
+	Handle(CreateFlavorParams, *models.User) middleware.Responder
+}
+
+// NewCreateFlavor creates a new http.Handler for the create flavor operation
+func NewCreateFlavor(ctx *middleware.Context, handler CreateFlavorHandler) *CreateFlavor {
+	return &CreateFlavor{Context: ctx, Handler: handler}
+}
+
+/*CreateFlavor swagger:route POST /flavors flavor createFlavor
+
+Create a flavor
+
+*/
+type CreateFlavor struct {
+	Context *middleware.Context
+	Handler CreateFlavorHandler
+}
+
+func (o *CreateFlavor) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, _ := o.Context.RouteInfo(r)
+	var Params = NewCreateFlavorParams()
+
+	uprinc, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,234 @@
+package flavor
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/jianqiu/vps/models"
+)
+
+/*CreateFlavorOK the created flavor
+
+swagger:response createFlavorOK
+*/
+type CreateFlavorOK struct {
+
+	// In: body
+	Payload *models.Flavor `json:"body,omitempty"`
+}
+
+// NewCreateFlavorOK creates CreateFlavorOK with default headers values
+func NewCreateFlavorOK() *CreateFlavorOK {
+	return &CreateFlavorOK{}
+}
+
+// WithPayload adds the payload to the create flavor o k response
+func (o *CreateFlavorOK) WithPayload(payload *models.Flavor) *CreateFlavorOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create flavor o k response
+func (o *CreateFlavorOK) SetPayload(payload *models.Flavor) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateFlavorOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*CreateFlavorBadRequest request is invalid
+
+swagger:response createFlavorBadRequest
+*/
+type CreateFlavorBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateFlavorBadRequest creates CreateFlavorBadRequest with default headers values
+func NewCreateFlavorBadRequest() *CreateFlavorBadRequest {
+	return &CreateFlavorBadRequest{}
+}
+
+// WithPayload adds the payload to the create flavor bad request response
+func (o *CreateFlavorBadRequest) WithPayload(payload *models.Error) *CreateFlavorBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create flavor bad request response
+func (o *CreateFlavorBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateFlavorBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*CreateFlavorConflict request conflicts with the current state of the pool
+
+swagger:response createFlavorConflict
+*/
+type CreateFlavorConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateFlavorConflict creates CreateFlavorConflict with default headers values
+func NewCreateFlavorConflict() *CreateFlavorConflict {
+	return &CreateFlavorConflict{}
+}
+
+// WithPayload adds the payload to the create flavor conflict response
+func (o *CreateFlavorConflict) WithPayload(payload *models.Error) *CreateFlavorConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create flavor conflict response
+func (o *CreateFlavorConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateFlavorConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*CreateFlavorServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response createFlavorServiceUnavailable
+*/
+type CreateFlavorServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateFlavorServiceUnavailable creates CreateFlavorServiceUnavailable with default headers values
+func NewCreateFlavorServiceUnavailable() *CreateFlavorServiceUnavailable {
+	return &CreateFlavorServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the create flavor service unavailable response
+func (o *CreateFlavorServiceUnavailable) WithRetryAfter(retryAfter int32) *CreateFlavorServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the create flavor service unavailable response
+func (o *CreateFlavorServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the create flavor service unavailable response
+func (o *CreateFlavorServiceUnavailable) WithPayload(payload *models.Error) *CreateFlavorServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create flavor service unavailable response
+func (o *CreateFlavorServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateFlavorServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*CreateFlavorDefault unexpected error
+
+swagger:response createFlavorDefault
+*/
+type CreateFlavorDefault struct {
+	_statusCode int
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateFlavorDefault creates CreateFlavorDefault with default headers values
+func NewCreateFlavorDefault(code int) *CreateFlavorDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &CreateFlavorDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the create flavor default response
+func (o *CreateFlavorDefault) WithStatusCode(code int) *CreateFlavorDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the create flavor default response
+func (o *CreateFlavorDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the create flavor default response
+func (o *CreateFlavorDefault) WithPayload(payload *models.Error) *CreateFlavorDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create flavor default response
+func (o *CreateFlavorDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateFlavorDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package flavor
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/models"
+)
+
+// DeleteFlavorHandlerFunc turns a function with the right signature into a delete flavor handler
+type DeleteFlavorHandlerFunc func(DeleteFlavorParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn DeleteFlavorHandlerFunc) Handle(params DeleteFlavorParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// DeleteFlavorHandler interface for that can handle valid delete flavor params
+type DeleteFlavorHandler interface {
+	Handle(DeleteFlavorParams, *models.User) middleware.Responder
+}
+
+// NewDeleteFlavor creates a new http.Handler for the delete flavor operation
+func NewDeleteFlavor(ctx *middleware.Context, handler DeleteFlavorHandler) *DeleteFlavor {
+	return &DeleteFlavor{Context: ctx, Handler: handler}
+}
+
+/*DeleteFlavor swagger:route DELETE /flavors/{name} flavor deleteFlavor
+
+Delete a flavor
+
+*/
+type DeleteFlavor struct {
+	Context *middleware.Context
+	Handler DeleteFlavorHandler
+}
+
+func (o *DeleteFlavor) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, _ := o.Context.RouteInfo(r)
+	var Params = NewDeleteFlavorParams()
+
+	uprinc, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
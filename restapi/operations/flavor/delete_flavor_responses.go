@@ -0,0 +1,233 @@
+package flavor
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/jianqiu/vps/models"
+)
+
+/*DeleteFlavorNoContent flavor removed successfully
+
+swagger:response deleteFlavorNoContent
+*/
+type DeleteFlavorNoContent struct {
+}
+
+// NewDeleteFlavorNoContent creates DeleteFlavorNoContent with default headers values
+func NewDeleteFlavorNoContent() *DeleteFlavorNoContent {
+	return &DeleteFlavorNoContent{}
+}
+
+// WriteResponse to the client
+func (o *DeleteFlavorNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(204)
+}
+
+/*DeleteFlavorBadRequest request is invalid
+
+swagger:response deleteFlavorBadRequest
+*/
+type DeleteFlavorBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeleteFlavorBadRequest creates DeleteFlavorBadRequest with default headers values
+func NewDeleteFlavorBadRequest() *DeleteFlavorBadRequest {
+	return &DeleteFlavorBadRequest{}
+}
+
+// WithPayload adds the payload to the delete flavor bad request response
+func (o *DeleteFlavorBadRequest) WithPayload(payload *models.Error) *DeleteFlavorBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete flavor bad request response
+func (o *DeleteFlavorBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteFlavorBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*DeleteFlavorNotFound flavor not found
+
+swagger:response deleteFlavorNotFound
+*/
+type DeleteFlavorNotFound struct {
+}
+
+// NewDeleteFlavorNotFound creates DeleteFlavorNotFound with default headers values
+func NewDeleteFlavorNotFound() *DeleteFlavorNotFound {
+	return &DeleteFlavorNotFound{}
+}
+
+// WriteResponse to the client
+func (o *DeleteFlavorNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+}
+
+/*DeleteFlavorConflict request conflicts with the current state of the pool
+
+swagger:response deleteFlavorConflict
+*/
+type DeleteFlavorConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeleteFlavorConflict creates DeleteFlavorConflict with default headers values
+func NewDeleteFlavorConflict() *DeleteFlavorConflict {
+	return &DeleteFlavorConflict{}
+}
+
+// WithPayload adds the payload to the delete flavor conflict response
+func (o *DeleteFlavorConflict) WithPayload(payload *models.Error) *DeleteFlavorConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete flavor conflict response
+func (o *DeleteFlavorConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteFlavorConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*DeleteFlavorServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response deleteFlavorServiceUnavailable
+*/
+type DeleteFlavorServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeleteFlavorServiceUnavailable creates DeleteFlavorServiceUnavailable with default headers values
+func NewDeleteFlavorServiceUnavailable() *DeleteFlavorServiceUnavailable {
+	return &DeleteFlavorServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the delete flavor service unavailable response
+func (o *DeleteFlavorServiceUnavailable) WithRetryAfter(retryAfter int32) *DeleteFlavorServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the delete flavor service unavailable response
+func (o *DeleteFlavorServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the delete flavor service unavailable response
+func (o *DeleteFlavorServiceUnavailable) WithPayload(payload *models.Error) *DeleteFlavorServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete flavor service unavailable response
+func (o *DeleteFlavorServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteFlavorServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*DeleteFlavorDefault unexpected error
+
+swagger:response deleteFlavorDefault
+*/
+type DeleteFlavorDefault struct {
+	_statusCode int
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeleteFlavorDefault creates DeleteFlavorDefault with default headers values
+func NewDeleteFlavorDefault(code int) *DeleteFlavorDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &DeleteFlavorDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the delete flavor default response
+func (o *DeleteFlavorDefault) WithStatusCode(code int) *DeleteFlavorDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the delete flavor default response
+func (o *DeleteFlavorDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the delete flavor default response
+func (o *DeleteFlavorDefault) WithPayload(payload *models.Error) *DeleteFlavorDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete flavor default response
+func (o *DeleteFlavorDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteFlavorDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
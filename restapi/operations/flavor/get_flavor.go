@@ -0,0 +1,64 @@
+package flavor
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/models"
+)
+
+// GetFlavorHandlerFunc turns a function with the right signature into a get flavor handler
+type GetFlavorHandlerFunc func(GetFlavorParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetFlavorHandlerFunc) Handle(params GetFlavorParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// GetFlavorHandler interface for that can handle valid get flavor params
+type GetFlavorHandler interface {
+	Handle(GetFlavorParams, *models.User) middleware.Responder
+}
+
+// NewGetFlavor creates a new http.Handler for the get flavor operation
+func NewGetFlavor(ctx *middleware.Context, handler GetFlavorHandler) *GetFlavor {
+	return &GetFlavor{Context: ctx, Handler: handler}
+}
+
+/*GetFlavor swagger:route GET /flavors/{name} flavor getFlavor
+
+Find a flavor by name
+
+*/
+type GetFlavor struct {
+	Context *middleware.Context
+	Handler GetFlavorHandler
+}
+
+func (o *GetFlavor) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, _ := o.Context.RouteInfo(r)
+	var Params = NewGetFlavorParams()
+
+	uprinc, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
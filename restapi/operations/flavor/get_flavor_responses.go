@@ -0,0 +1,252 @@
+package flavor
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/jianqiu/vps/models"
+)
+
+/*GetFlavorOK successful operation
+
+swagger:response getFlavorOK
+*/
+type GetFlavorOK struct {
+
+	// In: body
+	Payload *models.Flavor `json:"body,omitempty"`
+}
+
+// NewGetFlavorOK creates GetFlavorOK with default headers values
+func NewGetFlavorOK() *GetFlavorOK {
+	return &GetFlavorOK{}
+}
+
+// WithPayload adds the payload to the get flavor o k response
+func (o *GetFlavorOK) WithPayload(payload *models.Flavor) *GetFlavorOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get flavor o k response
+func (o *GetFlavorOK) SetPayload(payload *models.Flavor) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetFlavorOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetFlavorBadRequest request is invalid
+
+swagger:response getFlavorBadRequest
+*/
+type GetFlavorBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetFlavorBadRequest creates GetFlavorBadRequest with default headers values
+func NewGetFlavorBadRequest() *GetFlavorBadRequest {
+	return &GetFlavorBadRequest{}
+}
+
+// WithPayload adds the payload to the get flavor bad request response
+func (o *GetFlavorBadRequest) WithPayload(payload *models.Error) *GetFlavorBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get flavor bad request response
+func (o *GetFlavorBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetFlavorBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetFlavorNotFound flavor not found
+
+swagger:response getFlavorNotFound
+*/
+type GetFlavorNotFound struct {
+}
+
+// NewGetFlavorNotFound creates GetFlavorNotFound with default headers values
+func NewGetFlavorNotFound() *GetFlavorNotFound {
+	return &GetFlavorNotFound{}
+}
+
+// WriteResponse to the client
+func (o *GetFlavorNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+}
+
+/*GetFlavorConflict request conflicts with the current state of the pool
+
+swagger:response getFlavorConflict
+*/
+type GetFlavorConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetFlavorConflict creates GetFlavorConflict with default headers values
+func NewGetFlavorConflict() *GetFlavorConflict {
+	return &GetFlavorConflict{}
+}
+
+// WithPayload adds the payload to the get flavor conflict response
+func (o *GetFlavorConflict) WithPayload(payload *models.Error) *GetFlavorConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get flavor conflict response
+func (o *GetFlavorConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetFlavorConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetFlavorServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response getFlavorServiceUnavailable
+*/
+type GetFlavorServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetFlavorServiceUnavailable creates GetFlavorServiceUnavailable with default headers values
+func NewGetFlavorServiceUnavailable() *GetFlavorServiceUnavailable {
+	return &GetFlavorServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the get flavor service unavailable response
+func (o *GetFlavorServiceUnavailable) WithRetryAfter(retryAfter int32) *GetFlavorServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the get flavor service unavailable response
+func (o *GetFlavorServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the get flavor service unavailable response
+func (o *GetFlavorServiceUnavailable) WithPayload(payload *models.Error) *GetFlavorServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get flavor service unavailable response
+func (o *GetFlavorServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetFlavorServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetFlavorDefault unexpected error
+
+swagger:response getFlavorDefault
+*/
+type GetFlavorDefault struct {
+	_statusCode int
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetFlavorDefault creates GetFlavorDefault with default headers values
+func NewGetFlavorDefault(code int) *GetFlavorDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetFlavorDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get flavor default response
+func (o *GetFlavorDefault) WithStatusCode(code int) *GetFlavorDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get flavor default response
+func (o *GetFlavorDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get flavor default response
+func (o *GetFlavorDefault) WithPayload(payload *models.Error) *GetFlavorDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get flavor default response
+func (o *GetFlavorDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetFlavorDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
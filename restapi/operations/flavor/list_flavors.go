@@ -0,0 +1,64 @@
+package flavor
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/models"
+)
+
+// ListFlavorsHandlerFunc turns a function with the right signature into a list flavors handler
+type ListFlavorsHandlerFunc func(ListFlavorsParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListFlavorsHandlerFunc) Handle(params ListFlavorsParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ListFlavorsHandler interface for that can handle valid list flavors params
+type ListFlavorsHandler interface {
+	Handle(ListFlavorsParams, *models.User) middleware.Responder
+}
+
+// NewListFlavors creates a new http.Handler for the list flavors operation
+func NewListFlavors(ctx *middleware.Context, handler ListFlavorsHandler) *ListFlavors {
+	return &ListFlavors{Context: ctx, Handler: handler}
+}
+
+/*ListFlavors swagger:route GET /flavors flavor listFlavors
+
+List the flavors
+
+*/
+type ListFlavors struct {
+	Context *middleware.Context
+	Handler ListFlavorsHandler
+}
+
+func (o *ListFlavors) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, _ := o.Context.RouteInfo(r)
+	var Params = NewListFlavorsParams()
+
+	uprinc, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,234 @@
+package flavor
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/jianqiu/vps/models"
+)
+
+/*ListFlavorsOK successful operation
+
+swagger:response listFlavorsOK
+*/
+type ListFlavorsOK struct {
+
+	// In: body
+	Payload *models.FlavorsResponse `json:"body,omitempty"`
+}
+
+// NewListFlavorsOK creates ListFlavorsOK with default headers values
+func NewListFlavorsOK() *ListFlavorsOK {
+	return &ListFlavorsOK{}
+}
+
+// WithPayload adds the payload to the list flavors o k response
+func (o *ListFlavorsOK) WithPayload(payload *models.FlavorsResponse) *ListFlavorsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list flavors o k response
+func (o *ListFlavorsOK) SetPayload(payload *models.FlavorsResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListFlavorsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListFlavorsBadRequest request is invalid
+
+swagger:response listFlavorsBadRequest
+*/
+type ListFlavorsBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListFlavorsBadRequest creates ListFlavorsBadRequest with default headers values
+func NewListFlavorsBadRequest() *ListFlavorsBadRequest {
+	return &ListFlavorsBadRequest{}
+}
+
+// WithPayload adds the payload to the list flavors bad request response
+func (o *ListFlavorsBadRequest) WithPayload(payload *models.Error) *ListFlavorsBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list flavors bad request response
+func (o *ListFlavorsBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListFlavorsBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListFlavorsConflict request conflicts with the current state of the pool
+
+swagger:response listFlavorsConflict
+*/
+type ListFlavorsConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListFlavorsConflict creates ListFlavorsConflict with default headers values
+func NewListFlavorsConflict() *ListFlavorsConflict {
+	return &ListFlavorsConflict{}
+}
+
+// WithPayload adds the payload to the list flavors conflict response
+func (o *ListFlavorsConflict) WithPayload(payload *models.Error) *ListFlavorsConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list flavors conflict response
+func (o *ListFlavorsConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListFlavorsConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListFlavorsServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response listFlavorsServiceUnavailable
+*/
+type ListFlavorsServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListFlavorsServiceUnavailable creates ListFlavorsServiceUnavailable with default headers values
+func NewListFlavorsServiceUnavailable() *ListFlavorsServiceUnavailable {
+	return &ListFlavorsServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the list flavors service unavailable response
+func (o *ListFlavorsServiceUnavailable) WithRetryAfter(retryAfter int32) *ListFlavorsServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the list flavors service unavailable response
+func (o *ListFlavorsServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the list flavors service unavailable response
+func (o *ListFlavorsServiceUnavailable) WithPayload(payload *models.Error) *ListFlavorsServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list flavors service unavailable response
+func (o *ListFlavorsServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListFlavorsServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListFlavorsDefault unexpected error
+
+swagger:response listFlavorsDefault
+*/
+type ListFlavorsDefault struct {
+	_statusCode int
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListFlavorsDefault creates ListFlavorsDefault with default headers values
+func NewListFlavorsDefault(code int) *ListFlavorsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListFlavorsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list flavors default response
+func (o *ListFlavorsDefault) WithStatusCode(code int) *ListFlavorsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list flavors default response
+func (o *ListFlavorsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list flavors default response
+func (o *ListFlavorsDefault) WithPayload(payload *models.Error) *ListFlavorsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list flavors default response
+func (o *ListFlavorsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListFlavorsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package flavor
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/models"
+)
+
+// UpdateFlavorHandlerFunc turns a function with the right signature into a update flavor handler
+type UpdateFlavorHandlerFunc func(UpdateFlavorParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn UpdateFlavorHandlerFunc) Handle(params UpdateFlavorParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// UpdateFlavorHandler interface for that can handle valid update flavor params
+type UpdateFlavorHandler interface {
+	Handle(UpdateFlavorParams, *models.User) middleware.Responder
+}
+
+// NewUpdateFlavor creates a new http.Handler for the update flavor operation
+func NewUpdateFlavor(ctx *middleware.Context, handler UpdateFlavorHandler) *UpdateFlavor {
+	return &UpdateFlavor{Context: ctx, Handler: handler}
+}
+
+/*UpdateFlavor swagger:route PUT /flavors/{name} flavor updateFlavor
+
+Update the sizes, vlans or labels of a flavor
+
+*/
+type UpdateFlavor struct {
+	Context *middleware.Context
+	Handler UpdateFlavorHandler
+}
+
+func (o *UpdateFlavor) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, _ := o.Context.RouteInfo(r)
+	var Params = NewUpdateFlavorParams()
+
+	uprinc, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
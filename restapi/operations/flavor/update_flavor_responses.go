@@ -0,0 +1,252 @@
+package flavor
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/jianqiu/vps/models"
+)
+
+/*UpdateFlavorOK the updated flavor
+
+swagger:response updateFlavorOK
+*/
+type UpdateFlavorOK struct {
+
+	// In: body
+	Payload *models.Flavor `json:"body,omitempty"`
+}
+
+// NewUpdateFlavorOK creates UpdateFlavorOK with default headers values
+func NewUpdateFlavorOK() *UpdateFlavorOK {
+	return &UpdateFlavorOK{}
+}
+
+// WithPayload adds the payload to the update flavor o k response
+func (o *UpdateFlavorOK) WithPayload(payload *models.Flavor) *UpdateFlavorOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update flavor o k response
+func (o *UpdateFlavorOK) SetPayload(payload *models.Flavor) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateFlavorOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*UpdateFlavorBadRequest request is invalid
+
+swagger:response updateFlavorBadRequest
+*/
+type UpdateFlavorBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUpdateFlavorBadRequest creates UpdateFlavorBadRequest with default headers values
+func NewUpdateFlavorBadRequest() *UpdateFlavorBadRequest {
+	return &UpdateFlavorBadRequest{}
+}
+
+// WithPayload adds the payload to the update flavor bad request response
+func (o *UpdateFlavorBadRequest) WithPayload(payload *models.Error) *UpdateFlavorBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update flavor bad request response
+func (o *UpdateFlavorBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateFlavorBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*UpdateFlavorNotFound flavor not found
+
+swagger:response updateFlavorNotFound
+*/
+type UpdateFlavorNotFound struct {
+}
+
+// NewUpdateFlavorNotFound creates UpdateFlavorNotFound with default headers values
+func NewUpdateFlavorNotFound() *UpdateFlavorNotFound {
+	return &UpdateFlavorNotFound{}
+}
+
+// WriteResponse to the client
+func (o *UpdateFlavorNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+}
+
+/*UpdateFlavorConflict request conflicts with the current state of the pool
+
+swagger:response updateFlavorConflict
+*/
+type UpdateFlavorConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUpdateFlavorConflict creates UpdateFlavorConflict with default headers values
+func NewUpdateFlavorConflict() *UpdateFlavorConflict {
+	return &UpdateFlavorConflict{}
+}
+
+// WithPayload adds the payload to the update flavor conflict response
+func (o *UpdateFlavorConflict) WithPayload(payload *models.Error) *UpdateFlavorConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update flavor conflict response
+func (o *UpdateFlavorConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateFlavorConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*UpdateFlavorServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response updateFlavorServiceUnavailable
+*/
+type UpdateFlavorServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUpdateFlavorServiceUnavailable creates UpdateFlavorServiceUnavailable with default headers values
+func NewUpdateFlavorServiceUnavailable() *UpdateFlavorServiceUnavailable {
+	return &UpdateFlavorServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the update flavor service unavailable response
+func (o *UpdateFlavorServiceUnavailable) WithRetryAfter(retryAfter int32) *UpdateFlavorServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the update flavor service unavailable response
+func (o *UpdateFlavorServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the update flavor service unavailable response
+func (o *UpdateFlavorServiceUnavailable) WithPayload(payload *models.Error) *UpdateFlavorServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update flavor service unavailable response
+func (o *UpdateFlavorServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateFlavorServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*UpdateFlavorDefault unexpected error
+
+swagger:response updateFlavorDefault
+*/
+type UpdateFlavorDefault struct {
+	_statusCode int
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUpdateFlavorDefault creates UpdateFlavorDefault with default headers values
+func NewUpdateFlavorDefault(code int) *UpdateFlavorDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &UpdateFlavorDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the update flavor default response
+func (o *UpdateFlavorDefault) WithStatusCode(code int) *UpdateFlavorDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the update flavor default response
+func (o *UpdateFlavorDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the update flavor default response
+func (o *UpdateFlavorDefault) WithPayload(payload *models.Error) *UpdateFlavorDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update flavor default response
+func (o *UpdateFlavorDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateFlavorDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package health
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/jianqiu/vps/models"
+)
+
+/*GetHealthzOK the server is alive
+
+swagger:response getHealthzOK
+*/
+type GetHealthzOK struct {
+
+	// In: body
+	Payload *models.Health `json:"body,omitempty"`
+}
+
+// NewGetHealthzOK creates GetHealthzOK with default headers values
+func NewGetHealthzOK() *GetHealthzOK {
+	return &GetHealthzOK{}
+}
+
+// WithPayload adds the payload to the get healthz o k response
+func (o *GetHealthzOK) WithPayload(payload *models.Health) *GetHealthzOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get healthz o k response
+func (o *GetHealthzOK) SetPayload(payload *models.Health) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetHealthzOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
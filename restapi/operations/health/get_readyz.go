@@ -0,0 +1,55 @@
+package health
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+)
+
+// GetReadyzHandlerFunc turns a function with the right signature into a get readyz handler
+type GetReadyzHandlerFunc func(GetReadyzParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetReadyzHandlerFunc) Handle(params GetReadyzParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetReadyzHandler interface for that can handle valid get readyz params
+type GetReadyzHandler interface {
+	Handle(GetReadyzParams) middleware.Responder
+}
+
+// NewGetReadyz creates a new http.Handler for the get readyz operation
+func NewGetReadyz(ctx *middleware.Context, handler GetReadyzHandler) *GetReadyz {
+	return &GetReadyz{Context: ctx, Handler: handler}
+}
+
+/*GetReadyz swagger:route GET /readyz health getReadyz
+
+Report whether the server can serve requests
+
+Does not need authentication. The server is ready once its migrations finished and while its database answers.
+
+*/
+type GetReadyz struct {
+	Context *middleware.Context
+	Handler GetReadyzHandler
+}
+
+func (o *GetReadyz) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, _ := o.Context.RouteInfo(r)
+	var Params = NewGetReadyzParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
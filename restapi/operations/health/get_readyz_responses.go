@@ -0,0 +1,86 @@
+package health
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/jianqiu/vps/models"
+)
+
+/*GetReadyzOK the server is ready
+
+swagger:response getReadyzOK
+*/
+type GetReadyzOK struct {
+
+	// In: body
+	Payload *models.Health `json:"body,omitempty"`
+}
+
+// NewGetReadyzOK creates GetReadyzOK with default headers values
+func NewGetReadyzOK() *GetReadyzOK {
+	return &GetReadyzOK{}
+}
+
+// WithPayload adds the payload to the get readyz o k response
+func (o *GetReadyzOK) WithPayload(payload *models.Health) *GetReadyzOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get readyz o k response
+func (o *GetReadyzOK) SetPayload(payload *models.Health) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetReadyzOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetReadyzServiceUnavailable the server is not ready
+
+swagger:response getReadyzServiceUnavailable
+*/
+type GetReadyzServiceUnavailable struct {
+
+	// In: body
+	Payload *models.Health `json:"body,omitempty"`
+}
+
+// NewGetReadyzServiceUnavailable creates GetReadyzServiceUnavailable with default headers values
+func NewGetReadyzServiceUnavailable() *GetReadyzServiceUnavailable {
+	return &GetReadyzServiceUnavailable{}
+}
+
+// WithPayload adds the payload to the get readyz service unavailable response
+func (o *GetReadyzServiceUnavailable) WithPayload(payload *models.Health) *GetReadyzServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get readyz service unavailable response
+func (o *GetReadyzServiceUnavailable) SetPayload(payload *models.Health) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetReadyzServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package metadata
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+)
+
+// GetVMMetadataHandlerFunc turns a function with the right signature into a get Vm metadata handler
+type GetVMMetadataHandlerFunc func(GetVMMetadataParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetVMMetadataHandlerFunc) Handle(params GetVMMetadataParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetVMMetadataHandler interface for that can handle valid get Vm metadata params
+type GetVMMetadataHandler interface {
+	Handle(GetVMMetadataParams) middleware.Responder
+}
+
+// NewGetVMMetadata creates a new http.Handler for the get Vm metadata operation
+func NewGetVMMetadata(ctx *middleware.Context, handler GetVMMetadataHandler) *GetVMMetadata {
+	return &GetVMMetadata{Context: ctx, Handler: handler}
+}
+
+/*GetVMMetadata swagger:route GET /metadata metadata getVmMetadata
+
+Tell a vm about itself
+
+Does not need credentials: the vm is the one whose ip the request comes from. Only vms being provisioned or in use get an answer.
+
+*/
+type GetVMMetadata struct {
+	Context *middleware.Context
+	Handler GetVMMetadataHandler
+}
+
+func (o *GetVMMetadata) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, _ := o.Context.RouteInfo(r)
+	var Params = NewGetVMMetadataParams()
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,178 @@
+package metadata
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/jianqiu/vps/models"
+)
+
+/*GetVMMetadataOK successful operation
+
+swagger:response getVmMetadataOK
+*/
+type GetVMMetadataOK struct {
+
+	// In: body
+	Payload *models.VMMetadata `json:"body,omitempty"`
+}
+
+// NewGetVMMetadataOK creates GetVMMetadataOK with default headers values
+func NewGetVMMetadataOK() *GetVMMetadataOK {
+	return &GetVMMetadataOK{}
+}
+
+// WithPayload adds the payload to the get Vm metadata o k response
+func (o *GetVMMetadataOK) WithPayload(payload *models.VMMetadata) *GetVMMetadataOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get Vm metadata o k response
+func (o *GetVMMetadataOK) SetPayload(payload *models.VMMetadata) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetVMMetadataOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetVMMetadataNotFound no vm in use has the ip of the request
+
+swagger:response getVmMetadataNotFound
+*/
+type GetVMMetadataNotFound struct {
+}
+
+// NewGetVMMetadataNotFound creates GetVMMetadataNotFound with default headers values
+func NewGetVMMetadataNotFound() *GetVMMetadataNotFound {
+	return &GetVMMetadataNotFound{}
+}
+
+// WriteResponse to the client
+func (o *GetVMMetadataNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+}
+
+/*GetVMMetadataServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response getVmMetadataServiceUnavailable
+*/
+type GetVMMetadataServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetVMMetadataServiceUnavailable creates GetVMMetadataServiceUnavailable with default headers values
+func NewGetVMMetadataServiceUnavailable() *GetVMMetadataServiceUnavailable {
+	return &GetVMMetadataServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the get Vm metadata service unavailable response
+func (o *GetVMMetadataServiceUnavailable) WithRetryAfter(retryAfter int32) *GetVMMetadataServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the get Vm metadata service unavailable response
+func (o *GetVMMetadataServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the get Vm metadata service unavailable response
+func (o *GetVMMetadataServiceUnavailable) WithPayload(payload *models.Error) *GetVMMetadataServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get Vm metadata service unavailable response
+func (o *GetVMMetadataServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetVMMetadataServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetVMMetadataDefault unexpected error
+
+swagger:response getVmMetadataDefault
+*/
+type GetVMMetadataDefault struct {
+	_statusCode int
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetVMMetadataDefault creates GetVMMetadataDefault with default headers values
+func NewGetVMMetadataDefault(code int) *GetVMMetadataDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetVMMetadataDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get Vm metadata default response
+func (o *GetVMMetadataDefault) WithStatusCode(code int) *GetVMMetadataDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get Vm metadata default response
+func (o *GetVMMetadataDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get Vm metadata default response
+func (o *GetVMMetadataDefault) WithPayload(payload *models.Error) *GetVMMetadataDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get Vm metadata default response
+func (o *GetVMMetadataDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetVMMetadataDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
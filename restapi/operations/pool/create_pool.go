@@ -0,0 +1,64 @@
+package pool
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/models"
+)
+
+// CreatePoolHandlerFunc turns a function with the right signature into a create pool handler
+type CreatePoolHandlerFunc func(CreatePoolParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn CreatePoolHandlerFunc) Handle(params CreatePoolParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// CreatePoolHandler interface for that can handle valid create pool params
+type CreatePoolHandler interface {
+	Handle(CreatePoolParams, *models.User) middleware.Responder
+}
+
+// NewCreatePool creates a new http.Handler for the create pool operation
+func NewCreatePool(ctx *middleware.Context, handler CreatePoolHandler) *CreatePool {
+	return &CreatePool{Context: ctx, Handler: handler}
+}
+
+/*CreatePool swagger:route POST /pools pool createPool
+
+Create a pool
+
+*/
+type CreatePool struct {
+	Context *middleware.Context
+	Handler CreatePoolHandler
+}
+
+func (o *CreatePool) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, _ := o.Context.RouteInfo(r)
+	var Params = NewCreatePoolParams()
+
+	uprinc, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
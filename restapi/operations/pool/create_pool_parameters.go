@@ -0,0 +1,74 @@
+package pool
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/jianqiu/vps/models"
+)
+
+// NewCreatePoolParams creates a new CreatePoolParams object
+// with the default values initialized.
+func NewCreatePoolParams() CreatePoolParams {
+	var ()
+	return CreatePoolParams{}
+}
+
+// CreatePoolParams contains all the bound params for the create pool operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters createPool
+type CreatePoolParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request
+
+	/*Pool that needs to be created
+	  Required: true
+	  In: body
+	*/
+	Body *models.Pool
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls
+func (o *CreatePoolParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+	o.HTTPRequest = r
+
+	if runtime.HasBody(r) {
+		defer r.Body.Close()
+		var body models.Pool
+		if err := route.Consumer.Consume(r.Body, &body); err != nil {
+			if err == io.EOF {
+				res = append(res, errors.Required("body", "body"))
+			} else {
+				res = append(res, errors.NewParseError("body", "body", "", err))
+			}
+
+		} else {
+			if err := body.Validate(route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			if len(res) == 0 {
+				o.Body = &body
+			}
+		}
+
+	} else {
+		res = append(res, errors.Required("body", "body"))
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,234 @@
+package pool
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/jianqiu/vps/models"
+)
+
+/*CreatePoolOK the created pool
+
+swagger:response createPoolOK
+*/
+type CreatePoolOK struct {
+
+	// In: body
+	Payload *models.Pool `json:"body,omitempty"`
+}
+
+// NewCreatePoolOK creates CreatePoolOK with default headers values
+func NewCreatePoolOK() *CreatePoolOK {
+	return &CreatePoolOK{}
+}
+
+// WithPayload adds the payload to the create pool o k response
+func (o *CreatePoolOK) WithPayload(payload *models.Pool) *CreatePoolOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create pool o k response
+func (o *CreatePoolOK) SetPayload(payload *models.Pool) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreatePoolOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*CreatePoolBadRequest request is invalid
+
+swagger:response createPoolBadRequest
+*/
+type CreatePoolBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreatePoolBadRequest creates CreatePoolBadRequest with default headers values
+func NewCreatePoolBadRequest() *CreatePoolBadRequest {
+	return &CreatePoolBadRequest{}
+}
+
+// WithPayload adds the payload to the create pool bad request response
+func (o *CreatePoolBadRequest) WithPayload(payload *models.Error) *CreatePoolBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create pool bad request response
+func (o *CreatePoolBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreatePoolBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*CreatePoolConflict request conflicts with the current state of the pool
+
+swagger:response createPoolConflict
+*/
+type CreatePoolConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreatePoolConflict creates CreatePoolConflict with default headers values
+func NewCreatePoolConflict() *CreatePoolConflict {
+	return &CreatePoolConflict{}
+}
+
+// WithPayload adds the payload to the create pool conflict response
+func (o *CreatePoolConflict) WithPayload(payload *models.Error) *CreatePoolConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create pool conflict response
+func (o *CreatePoolConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreatePoolConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*CreatePoolServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response createPoolServiceUnavailable
+*/
+type CreatePoolServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreatePoolServiceUnavailable creates CreatePoolServiceUnavailable with default headers values
+func NewCreatePoolServiceUnavailable() *CreatePoolServiceUnavailable {
+	return &CreatePoolServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the create pool service unavailable response
+func (o *CreatePoolServiceUnavailable) WithRetryAfter(retryAfter int32) *CreatePoolServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the create pool service unavailable response
+func (o *CreatePoolServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the create pool service unavailable response
+func (o *CreatePoolServiceUnavailable) WithPayload(payload *models.Error) *CreatePoolServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create pool service unavailable response
+func (o *CreatePoolServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreatePoolServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*CreatePoolDefault unexpected error
+
+swagger:response createPoolDefault
+*/
+type CreatePoolDefault struct {
+	_statusCode int
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreatePoolDefault creates CreatePoolDefault with default headers values
+func NewCreatePoolDefault(code int) *CreatePoolDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &CreatePoolDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the create pool default response
+func (o *CreatePoolDefault) WithStatusCode(code int) *CreatePoolDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the create pool default response
+func (o *CreatePoolDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the create pool default response
+func (o *CreatePoolDefault) WithPayload(payload *models.Error) *CreatePoolDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create pool default response
+func (o *CreatePoolDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreatePoolDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package pool
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/models"
+)
+
+// DeletePoolHandlerFunc turns a function with the right signature into a delete pool handler
+type DeletePoolHandlerFunc func(DeletePoolParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn DeletePoolHandlerFunc) Handle(params DeletePoolParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// DeletePoolHandler interface for that can handle valid delete pool params
+type DeletePoolHandler interface {
+	Handle(DeletePoolParams, *models.User) middleware.Responder
+}
+
+// NewDeletePool creates a new http.Handler for the delete pool operation
+func NewDeletePool(ctx *middleware.Context, handler DeletePoolHandler) *DeletePool {
+	return &DeletePool{Context: ctx, Handler: handler}
+}
+
+/*DeletePool swagger:route DELETE /pools/{pool} pool deletePool
+
+Delete an empty pool
+
+Only pools without vms can be deleted, the default pool never
+
+*/
+type DeletePool struct {
+	Context *middleware.Context
+	Handler DeletePoolHandler
+}
+
+func (o *DeletePool) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, _ := o.Context.RouteInfo(r)
+	var Params = NewDeletePoolParams()
+
+	uprinc, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,64 @@
+package pool
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewDeletePoolParams creates a new DeletePoolParams object
+// with the default values initialized.
+func NewDeletePoolParams() DeletePoolParams {
+	var ()
+	return DeletePoolParams{}
+}
+
+// DeletePoolParams contains all the bound params for the delete pool operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters deletePool
+type DeletePoolParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request
+
+	/*name of the pool
+	  Required: true
+	  In: path
+	*/
+	Pool string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls
+func (o *DeletePoolParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+	o.HTTPRequest = r
+
+	rPool, rhkPool, _ := route.Params.GetOK("pool")
+	if err := o.bindPool(rPool, rhkPool, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *DeletePoolParams) bindPool(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	o.Pool = raw
+
+	return nil
+}
@@ -0,0 +1,233 @@
+package pool
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/jianqiu/vps/models"
+)
+
+/*DeletePoolNoContent pool removed successfully
+
+swagger:response deletePoolNoContent
+*/
+type DeletePoolNoContent struct {
+}
+
+// NewDeletePoolNoContent creates DeletePoolNoContent with default headers values
+func NewDeletePoolNoContent() *DeletePoolNoContent {
+	return &DeletePoolNoContent{}
+}
+
+// WriteResponse to the client
+func (o *DeletePoolNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(204)
+}
+
+/*DeletePoolBadRequest request is invalid
+
+swagger:response deletePoolBadRequest
+*/
+type DeletePoolBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeletePoolBadRequest creates DeletePoolBadRequest with default headers values
+func NewDeletePoolBadRequest() *DeletePoolBadRequest {
+	return &DeletePoolBadRequest{}
+}
+
+// WithPayload adds the payload to the delete pool bad request response
+func (o *DeletePoolBadRequest) WithPayload(payload *models.Error) *DeletePoolBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete pool bad request response
+func (o *DeletePoolBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeletePoolBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*DeletePoolNotFound pool not found
+
+swagger:response deletePoolNotFound
+*/
+type DeletePoolNotFound struct {
+}
+
+// NewDeletePoolNotFound creates DeletePoolNotFound with default headers values
+func NewDeletePoolNotFound() *DeletePoolNotFound {
+	return &DeletePoolNotFound{}
+}
+
+// WriteResponse to the client
+func (o *DeletePoolNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+}
+
+/*DeletePoolConflict request conflicts with the current state of the pool
+
+swagger:response deletePoolConflict
+*/
+type DeletePoolConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeletePoolConflict creates DeletePoolConflict with default headers values
+func NewDeletePoolConflict() *DeletePoolConflict {
+	return &DeletePoolConflict{}
+}
+
+// WithPayload adds the payload to the delete pool conflict response
+func (o *DeletePoolConflict) WithPayload(payload *models.Error) *DeletePoolConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete pool conflict response
+func (o *DeletePoolConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeletePoolConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*DeletePoolServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response deletePoolServiceUnavailable
+*/
+type DeletePoolServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeletePoolServiceUnavailable creates DeletePoolServiceUnavailable with default headers values
+func NewDeletePoolServiceUnavailable() *DeletePoolServiceUnavailable {
+	return &DeletePoolServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the delete pool service unavailable response
+func (o *DeletePoolServiceUnavailable) WithRetryAfter(retryAfter int32) *DeletePoolServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the delete pool service unavailable response
+func (o *DeletePoolServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the delete pool service unavailable response
+func (o *DeletePoolServiceUnavailable) WithPayload(payload *models.Error) *DeletePoolServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete pool service unavailable response
+func (o *DeletePoolServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeletePoolServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*DeletePoolDefault unexpected error
+
+swagger:response deletePoolDefault
+*/
+type DeletePoolDefault struct {
+	_statusCode int
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeletePoolDefault creates DeletePoolDefault with default headers values
+func NewDeletePoolDefault(code int) *DeletePoolDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &DeletePoolDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the delete pool default response
+func (o *DeletePoolDefault) WithStatusCode(code int) *DeletePoolDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the delete pool default response
+func (o *DeletePoolDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the delete pool default response
+func (o *DeletePoolDefault) WithPayload(payload *models.Error) *DeletePoolDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete pool default response
+func (o *DeletePoolDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeletePoolDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package pool
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/models"
+)
+
+// GetPoolHandlerFunc turns a function with the right signature into a get pool handler
+type GetPoolHandlerFunc func(GetPoolParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetPoolHandlerFunc) Handle(params GetPoolParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// GetPoolHandler interface for that can handle valid get pool params
+type GetPoolHandler interface {
+	Handle(GetPoolParams, *models.User) middleware.Responder
+}
+
+// NewGetPool creates a new http.Handler for the get pool operation
+func NewGetPool(ctx *middleware.Context, handler GetPoolHandler) *GetPool {
+	return &GetPool{Context: ctx, Handler: handler}
+}
+
+/*GetPool swagger:route GET /pools/{pool} pool getPool
+
+Find a pool by name
+
+*/
+type GetPool struct {
+	Context *middleware.Context
+	Handler GetPoolHandler
+}
+
+func (o *GetPool) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, _ := o.Context.RouteInfo(r)
+	var Params = NewGetPoolParams()
+
+	uprinc, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,252 @@
+package pool
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/jianqiu/vps/models"
+)
+
+/*GetPoolOK successful operation
+
+swagger:response getPoolOK
+*/
+type GetPoolOK struct {
+
+	// In: body
+	Payload *models.Pool `json:"body,omitempty"`
+}
+
+// NewGetPoolOK creates GetPoolOK with default headers values
+func NewGetPoolOK() *GetPoolOK {
+	return &GetPoolOK{}
+}
+
+// WithPayload adds the payload to the get pool o k response
+func (o *GetPoolOK) WithPayload(payload *models.Pool) *GetPoolOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get pool o k response
+func (o *GetPoolOK) SetPayload(payload *models.Pool) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetPoolOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetPoolBadRequest request is invalid
+
+swagger:response getPoolBadRequest
+*/
+type GetPoolBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetPoolBadRequest creates GetPoolBadRequest with default headers values
+func NewGetPoolBadRequest() *GetPoolBadRequest {
+	return &GetPoolBadRequest{}
+}
+
+// WithPayload adds the payload to the get pool bad request response
+func (o *GetPoolBadRequest) WithPayload(payload *models.Error) *GetPoolBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get pool bad request response
+func (o *GetPoolBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetPoolBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetPoolNotFound pool not found
+
+swagger:response getPoolNotFound
+*/
+type GetPoolNotFound struct {
+}
+
+// NewGetPoolNotFound creates GetPoolNotFound with default headers values
+func NewGetPoolNotFound() *GetPoolNotFound {
+	return &GetPoolNotFound{}
+}
+
+// WriteResponse to the client
+func (o *GetPoolNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+}
+
+/*GetPoolConflict request conflicts with the current state of the pool
+
+swagger:response getPoolConflict
+*/
+type GetPoolConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetPoolConflict creates GetPoolConflict with default headers values
+func NewGetPoolConflict() *GetPoolConflict {
+	return &GetPoolConflict{}
+}
+
+// WithPayload adds the payload to the get pool conflict response
+func (o *GetPoolConflict) WithPayload(payload *models.Error) *GetPoolConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get pool conflict response
+func (o *GetPoolConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetPoolConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetPoolServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response getPoolServiceUnavailable
+*/
+type GetPoolServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetPoolServiceUnavailable creates GetPoolServiceUnavailable with default headers values
+func NewGetPoolServiceUnavailable() *GetPoolServiceUnavailable {
+	return &GetPoolServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the get pool service unavailable response
+func (o *GetPoolServiceUnavailable) WithRetryAfter(retryAfter int32) *GetPoolServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the get pool service unavailable response
+func (o *GetPoolServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the get pool service unavailable response
+func (o *GetPoolServiceUnavailable) WithPayload(payload *models.Error) *GetPoolServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get pool service unavailable response
+func (o *GetPoolServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetPoolServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetPoolDefault unexpected error
+
+swagger:response getPoolDefault
+*/
+type GetPoolDefault struct {
+	_statusCode int
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetPoolDefault creates GetPoolDefault with default headers values
+func NewGetPoolDefault(code int) *GetPoolDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetPoolDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get pool default response
+func (o *GetPoolDefault) WithStatusCode(code int) *GetPoolDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get pool default response
+func (o *GetPoolDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get pool default response
+func (o *GetPoolDefault) WithPayload(payload *models.Error) *GetPoolDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get pool default response
+func (o *GetPoolDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetPoolDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package pool
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/models"
+)
+
+// GetPoolSummaryHandlerFunc turns a function with the right signature into a get pool summary handler
+type GetPoolSummaryHandlerFunc func(GetPoolSummaryParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetPoolSummaryHandlerFunc) Handle(params GetPoolSummaryParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// GetPoolSummaryHandler interface for that can handle valid get pool summary params
+type GetPoolSummaryHandler interface {
+	Handle(GetPoolSummaryParams, *models.User) middleware.Responder
+}
+
+// NewGetPoolSummary creates a new http.Handler for the get pool summary operation
+func NewGetPoolSummary(ctx *middleware.Context, handler GetPoolSummaryHandler) *GetPoolSummary {
+	return &GetPoolSummary{Context: ctx, Handler: handler}
+}
+
+/*GetPoolSummary swagger:route GET /pools/{pool}/summary pool getPoolSummary
+
+Count the vms of a pool by flavor and state
+
+Vms that match no flavor are counted under an empty flavor name
+
+*/
+type GetPoolSummary struct {
+	Context *middleware.Context
+	Handler GetPoolSummaryHandler
+}
+
+func (o *GetPoolSummary) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, _ := o.Context.RouteInfo(r)
+	var Params = NewGetPoolSummaryParams()
+
+	uprinc, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,252 @@
+package pool
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/jianqiu/vps/models"
+)
+
+/*GetPoolSummaryOK successful operation
+
+swagger:response getPoolSummaryOK
+*/
+type GetPoolSummaryOK struct {
+
+	// In: body
+	Payload *models.PoolSummary `json:"body,omitempty"`
+}
+
+// NewGetPoolSummaryOK creates GetPoolSummaryOK with default headers values
+func NewGetPoolSummaryOK() *GetPoolSummaryOK {
+	return &GetPoolSummaryOK{}
+}
+
+// WithPayload adds the payload to the get pool summary o k response
+func (o *GetPoolSummaryOK) WithPayload(payload *models.PoolSummary) *GetPoolSummaryOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get pool summary o k response
+func (o *GetPoolSummaryOK) SetPayload(payload *models.PoolSummary) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetPoolSummaryOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetPoolSummaryBadRequest request is invalid
+
+swagger:response getPoolSummaryBadRequest
+*/
+type GetPoolSummaryBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetPoolSummaryBadRequest creates GetPoolSummaryBadRequest with default headers values
+func NewGetPoolSummaryBadRequest() *GetPoolSummaryBadRequest {
+	return &GetPoolSummaryBadRequest{}
+}
+
+// WithPayload adds the payload to the get pool summary bad request response
+func (o *GetPoolSummaryBadRequest) WithPayload(payload *models.Error) *GetPoolSummaryBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get pool summary bad request response
+func (o *GetPoolSummaryBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetPoolSummaryBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetPoolSummaryNotFound pool not found
+
+swagger:response getPoolSummaryNotFound
+*/
+type GetPoolSummaryNotFound struct {
+}
+
+// NewGetPoolSummaryNotFound creates GetPoolSummaryNotFound with default headers values
+func NewGetPoolSummaryNotFound() *GetPoolSummaryNotFound {
+	return &GetPoolSummaryNotFound{}
+}
+
+// WriteResponse to the client
+func (o *GetPoolSummaryNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+}
+
+/*GetPoolSummaryConflict request conflicts with the current state of the pool
+
+swagger:response getPoolSummaryConflict
+*/
+type GetPoolSummaryConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetPoolSummaryConflict creates GetPoolSummaryConflict with default headers values
+func NewGetPoolSummaryConflict() *GetPoolSummaryConflict {
+	return &GetPoolSummaryConflict{}
+}
+
+// WithPayload adds the payload to the get pool summary conflict response
+func (o *GetPoolSummaryConflict) WithPayload(payload *models.Error) *GetPoolSummaryConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get pool summary conflict response
+func (o *GetPoolSummaryConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetPoolSummaryConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetPoolSummaryServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response getPoolSummaryServiceUnavailable
+*/
+type GetPoolSummaryServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetPoolSummaryServiceUnavailable creates GetPoolSummaryServiceUnavailable with default headers values
+func NewGetPoolSummaryServiceUnavailable() *GetPoolSummaryServiceUnavailable {
+	return &GetPoolSummaryServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the get pool summary service unavailable response
+func (o *GetPoolSummaryServiceUnavailable) WithRetryAfter(retryAfter int32) *GetPoolSummaryServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the get pool summary service unavailable response
+func (o *GetPoolSummaryServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the get pool summary service unavailable response
+func (o *GetPoolSummaryServiceUnavailable) WithPayload(payload *models.Error) *GetPoolSummaryServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get pool summary service unavailable response
+func (o *GetPoolSummaryServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetPoolSummaryServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetPoolSummaryDefault unexpected error
+
+swagger:response getPoolSummaryDefault
+*/
+type GetPoolSummaryDefault struct {
+	_statusCode int
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetPoolSummaryDefault creates GetPoolSummaryDefault with default headers values
+func NewGetPoolSummaryDefault(code int) *GetPoolSummaryDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetPoolSummaryDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get pool summary default response
+func (o *GetPoolSummaryDefault) WithStatusCode(code int) *GetPoolSummaryDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get pool summary default response
+func (o *GetPoolSummaryDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get pool summary default response
+func (o *GetPoolSummaryDefault) WithPayload(payload *models.Error) *GetPoolSummaryDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get pool summary default response
+func (o *GetPoolSummaryDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetPoolSummaryDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package pool
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/models"
+)
+
+// ListPoolsHandlerFunc turns a function with the right signature into a list pools handler
+type ListPoolsHandlerFunc func(ListPoolsParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListPoolsHandlerFunc) Handle(params ListPoolsParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ListPoolsHandler interface for that can handle valid list pools params
+type ListPoolsHandler interface {
+	Handle(ListPoolsParams, *models.User) middleware.Responder
+}
+
+// NewListPools creates a new http.Handler for the list pools operation
+func NewListPools(ctx *middleware.Context, handler ListPoolsHandler) *ListPools {
+	return &ListPools{Context: ctx, Handler: handler}
+}
+
+/*ListPools swagger:route GET /pools pool listPools
+
+List the pools
+
+*/
+type ListPools struct {
+	Context *middleware.Context
+	Handler ListPoolsHandler
+}
+
+func (o *ListPools) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, _ := o.Context.RouteInfo(r)
+	var Params = NewListPoolsParams()
+
+	uprinc, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,234 @@
+package pool
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/jianqiu/vps/models"
+)
+
+/*ListPoolsOK successful operation
+
+swagger:response listPoolsOK
+*/
+type ListPoolsOK struct {
+
+	// In: body
+	Payload *models.PoolsResponse `json:"body,omitempty"`
+}
+
+// NewListPoolsOK creates ListPoolsOK with default headers values
+func NewListPoolsOK() *ListPoolsOK {
+	return &ListPoolsOK{}
+}
+
+// WithPayload adds the payload to the list pools o k response
+func (o *ListPoolsOK) WithPayload(payload *models.PoolsResponse) *ListPoolsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list pools o k response
+func (o *ListPoolsOK) SetPayload(payload *models.PoolsResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListPoolsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListPoolsBadRequest request is invalid
+
+swagger:response listPoolsBadRequest
+*/
+type ListPoolsBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListPoolsBadRequest creates ListPoolsBadRequest with default headers values
+func NewListPoolsBadRequest() *ListPoolsBadRequest {
+	return &ListPoolsBadRequest{}
+}
+
+// WithPayload adds the payload to the list pools bad request response
+func (o *ListPoolsBadRequest) WithPayload(payload *models.Error) *ListPoolsBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list pools bad request response
+func (o *ListPoolsBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListPoolsBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListPoolsConflict request conflicts with the current state of the pool
+
+swagger:response listPoolsConflict
+*/
+type ListPoolsConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListPoolsConflict creates ListPoolsConflict with default headers values
+func NewListPoolsConflict() *ListPoolsConflict {
+	return &ListPoolsConflict{}
+}
+
+// WithPayload adds the payload to the list pools conflict response
+func (o *ListPoolsConflict) WithPayload(payload *models.Error) *ListPoolsConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list pools conflict response
+func (o *ListPoolsConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListPoolsConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListPoolsServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response listPoolsServiceUnavailable
+*/
+type ListPoolsServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListPoolsServiceUnavailable creates ListPoolsServiceUnavailable with default headers values
+func NewListPoolsServiceUnavailable() *ListPoolsServiceUnavailable {
+	return &ListPoolsServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the list pools service unavailable response
+func (o *ListPoolsServiceUnavailable) WithRetryAfter(retryAfter int32) *ListPoolsServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the list pools service unavailable response
+func (o *ListPoolsServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the list pools service unavailable response
+func (o *ListPoolsServiceUnavailable) WithPayload(payload *models.Error) *ListPoolsServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list pools service unavailable response
+func (o *ListPoolsServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListPoolsServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListPoolsDefault unexpected error
+
+swagger:response listPoolsDefault
+*/
+type ListPoolsDefault struct {
+	_statusCode int
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListPoolsDefault creates ListPoolsDefault with default headers values
+func NewListPoolsDefault(code int) *ListPoolsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListPoolsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list pools default response
+func (o *ListPoolsDefault) WithStatusCode(code int) *ListPoolsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list pools default response
+func (o *ListPoolsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list pools default response
+func (o *ListPoolsDefault) WithPayload(payload *models.Error) *ListPoolsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list pools default response
+func (o *ListPoolsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListPoolsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
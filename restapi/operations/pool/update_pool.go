@@ -0,0 +1,64 @@
+package pool
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/models"
+)
+
+// UpdatePoolHandlerFunc turns a function with the right signature into a update pool handler
+type UpdatePoolHandlerFunc func(UpdatePoolParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn UpdatePoolHandlerFunc) Handle(params UpdatePoolParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// UpdatePoolHandler interface for that can handle valid update pool params
+type UpdatePoolHandler interface {
+	Handle(UpdatePoolParams, *models.User) middleware.Responder
+}
+
+// NewUpdatePool creates a new http.Handler for the update pool operation
+func NewUpdatePool(ctx *middleware.Context, handler UpdatePoolHandler) *UpdatePool {
+	return &UpdatePool{Context: ctx, Handler: handler}
+}
+
+/*UpdatePool swagger:route PUT /pools/{pool} pool updatePool
+
+Update the description or credentials of a pool
+
+*/
+type UpdatePool struct {
+	Context *middleware.Context
+	Handler UpdatePoolHandler
+}
+
+func (o *UpdatePool) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, _ := o.Context.RouteInfo(r)
+	var Params = NewUpdatePoolParams()
+
+	uprinc, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
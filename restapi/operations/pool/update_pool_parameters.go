@@ -0,0 +1,97 @@
+package pool
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/jianqiu/vps/models"
+)
+
+// NewUpdatePoolParams creates a new UpdatePoolParams object
+// with the default values initialized.
+func NewUpdatePoolParams() UpdatePoolParams {
+	var ()
+	return UpdatePoolParams{}
+}
+
+// UpdatePoolParams contains all the bound params for the update pool operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters updatePool
+type UpdatePoolParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request
+
+	/*Pool with the new description and credentials, credentials are kept when the password is empty
+	  Required: true
+	  In: body
+	*/
+	Body *models.Pool
+	/*name of the pool
+	  Required: true
+	  In: path
+	*/
+	Pool string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls
+func (o *UpdatePoolParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+	o.HTTPRequest = r
+
+	if runtime.HasBody(r) {
+		defer r.Body.Close()
+		var body models.Pool
+		if err := route.Consumer.Consume(r.Body, &body); err != nil {
+			if err == io.EOF {
+				res = append(res, errors.Required("body", "body"))
+			} else {
+				res = append(res, errors.NewParseError("body", "body", "", err))
+			}
+
+		} else {
+			if err := body.Validate(route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			if len(res) == 0 {
+				o.Body = &body
+			}
+		}
+
+	} else {
+		res = append(res, errors.Required("body", "body"))
+	}
+
+	rPool, rhkPool, _ := route.Params.GetOK("pool")
+	if err := o.bindPool(rPool, rhkPool, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *UpdatePoolParams) bindPool(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	o.Pool = raw
+
+	return nil
+}
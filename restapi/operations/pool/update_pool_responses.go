@@ -0,0 +1,252 @@
+package pool
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/jianqiu/vps/models"
+)
+
+/*UpdatePoolOK the updated pool
+
+swagger:response updatePoolOK
+*/
+type UpdatePoolOK struct {
+
+	// In: body
+	Payload *models.Pool `json:"body,omitempty"`
+}
+
+// NewUpdatePoolOK creates UpdatePoolOK with default headers values
+func NewUpdatePoolOK() *UpdatePoolOK {
+	return &UpdatePoolOK{}
+}
+
+// WithPayload adds the payload to the update pool o k response
+func (o *UpdatePoolOK) WithPayload(payload *models.Pool) *UpdatePoolOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update pool o k response
+func (o *UpdatePoolOK) SetPayload(payload *models.Pool) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdatePoolOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*UpdatePoolBadRequest request is invalid
+
+swagger:response updatePoolBadRequest
+*/
+type UpdatePoolBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUpdatePoolBadRequest creates UpdatePoolBadRequest with default headers values
+func NewUpdatePoolBadRequest() *UpdatePoolBadRequest {
+	return &UpdatePoolBadRequest{}
+}
+
+// WithPayload adds the payload to the update pool bad request response
+func (o *UpdatePoolBadRequest) WithPayload(payload *models.Error) *UpdatePoolBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update pool bad request response
+func (o *UpdatePoolBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdatePoolBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*UpdatePoolNotFound pool not found
+
+swagger:response updatePoolNotFound
+*/
+type UpdatePoolNotFound struct {
+}
+
+// NewUpdatePoolNotFound creates UpdatePoolNotFound with default headers values
+func NewUpdatePoolNotFound() *UpdatePoolNotFound {
+	return &UpdatePoolNotFound{}
+}
+
+// WriteResponse to the client
+func (o *UpdatePoolNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+}
+
+/*UpdatePoolConflict request conflicts with the current state of the pool
+
+swagger:response updatePoolConflict
+*/
+type UpdatePoolConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUpdatePoolConflict creates UpdatePoolConflict with default headers values
+func NewUpdatePoolConflict() *UpdatePoolConflict {
+	return &UpdatePoolConflict{}
+}
+
+// WithPayload adds the payload to the update pool conflict response
+func (o *UpdatePoolConflict) WithPayload(payload *models.Error) *UpdatePoolConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update pool conflict response
+func (o *UpdatePoolConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdatePoolConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*UpdatePoolServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response updatePoolServiceUnavailable
+*/
+type UpdatePoolServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUpdatePoolServiceUnavailable creates UpdatePoolServiceUnavailable with default headers values
+func NewUpdatePoolServiceUnavailable() *UpdatePoolServiceUnavailable {
+	return &UpdatePoolServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the update pool service unavailable response
+func (o *UpdatePoolServiceUnavailable) WithRetryAfter(retryAfter int32) *UpdatePoolServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the update pool service unavailable response
+func (o *UpdatePoolServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the update pool service unavailable response
+func (o *UpdatePoolServiceUnavailable) WithPayload(payload *models.Error) *UpdatePoolServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update pool service unavailable response
+func (o *UpdatePoolServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdatePoolServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*UpdatePoolDefault unexpected error
+
+swagger:response updatePoolDefault
+*/
+type UpdatePoolDefault struct {
+	_statusCode int
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUpdatePoolDefault creates UpdatePoolDefault with default headers values
+func NewUpdatePoolDefault(code int) *UpdatePoolDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &UpdatePoolDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the update pool default response
+func (o *UpdatePoolDefault) WithStatusCode(code int) *UpdatePoolDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the update pool default response
+func (o *UpdatePoolDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the update pool default response
+func (o *UpdatePoolDefault) WithPayload(payload *models.Error) *UpdatePoolDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update pool default response
+func (o *UpdatePoolDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdatePoolDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
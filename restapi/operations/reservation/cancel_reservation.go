@@ -0,0 +1,64 @@
+package reservation
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/models"
+)
+
+// CancelReservationHandlerFunc turns a function with the right signature into a cancel reservation handler
+type CancelReservationHandlerFunc func(CancelReservationParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn CancelReservationHandlerFunc) Handle(params CancelReservationParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// CancelReservationHandler interface for that can handle valid cancel reservation params
+type CancelReservationHandler interface {
+	Handle(CancelReservationParams, *models.User) middleware.Responder
+}
+
+// NewCancelReservation creates a new http.Handler for the cancel reservation operation
+func NewCancelReservation(ctx *middleware.Context, handler CancelReservationHandler) *CancelReservation {
+	return &CancelReservation{Context: ctx, Handler: handler}
+}
+
+/*CancelReservation swagger:route DELETE /reservations/{id} reservation cancelReservation
+
+Cancel a reservation
+
+*/
+type CancelReservation struct {
+	Context *middleware.Context
+	Handler CancelReservationHandler
+}
+
+func (o *CancelReservation) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, _ := o.Context.RouteInfo(r)
+	var Params = NewCancelReservationParams()
+
+	uprinc, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,233 @@
+package reservation
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/jianqiu/vps/models"
+)
+
+/*CancelReservationNoContent reservation cancelled, its unclaimed vms are free again
+
+swagger:response cancelReservationNoContent
+*/
+type CancelReservationNoContent struct {
+}
+
+// NewCancelReservationNoContent creates CancelReservationNoContent with default headers values
+func NewCancelReservationNoContent() *CancelReservationNoContent {
+	return &CancelReservationNoContent{}
+}
+
+// WriteResponse to the client
+func (o *CancelReservationNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(204)
+}
+
+/*CancelReservationBadRequest request is invalid
+
+swagger:response cancelReservationBadRequest
+*/
+type CancelReservationBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCancelReservationBadRequest creates CancelReservationBadRequest with default headers values
+func NewCancelReservationBadRequest() *CancelReservationBadRequest {
+	return &CancelReservationBadRequest{}
+}
+
+// WithPayload adds the payload to the cancel reservation bad request response
+func (o *CancelReservationBadRequest) WithPayload(payload *models.Error) *CancelReservationBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cancel reservation bad request response
+func (o *CancelReservationBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CancelReservationBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*CancelReservationNotFound reservation not found
+
+swagger:response cancelReservationNotFound
+*/
+type CancelReservationNotFound struct {
+}
+
+// NewCancelReservationNotFound creates CancelReservationNotFound with default headers values
+func NewCancelReservationNotFound() *CancelReservationNotFound {
+	return &CancelReservationNotFound{}
+}
+
+// WriteResponse to the client
+func (o *CancelReservationNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+}
+
+/*CancelReservationConflict request conflicts with the current state of the pool
+
+swagger:response cancelReservationConflict
+*/
+type CancelReservationConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCancelReservationConflict creates CancelReservationConflict with default headers values
+func NewCancelReservationConflict() *CancelReservationConflict {
+	return &CancelReservationConflict{}
+}
+
+// WithPayload adds the payload to the cancel reservation conflict response
+func (o *CancelReservationConflict) WithPayload(payload *models.Error) *CancelReservationConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cancel reservation conflict response
+func (o *CancelReservationConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CancelReservationConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*CancelReservationServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response cancelReservationServiceUnavailable
+*/
+type CancelReservationServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCancelReservationServiceUnavailable creates CancelReservationServiceUnavailable with default headers values
+func NewCancelReservationServiceUnavailable() *CancelReservationServiceUnavailable {
+	return &CancelReservationServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the cancel reservation service unavailable response
+func (o *CancelReservationServiceUnavailable) WithRetryAfter(retryAfter int32) *CancelReservationServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the cancel reservation service unavailable response
+func (o *CancelReservationServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the cancel reservation service unavailable response
+func (o *CancelReservationServiceUnavailable) WithPayload(payload *models.Error) *CancelReservationServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cancel reservation service unavailable response
+func (o *CancelReservationServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CancelReservationServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*CancelReservationDefault unexpected error
+
+swagger:response cancelReservationDefault
+*/
+type CancelReservationDefault struct {
+	_statusCode int
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCancelReservationDefault creates CancelReservationDefault with default headers values
+func NewCancelReservationDefault(code int) *CancelReservationDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &CancelReservationDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the cancel reservation default response
+func (o *CancelReservationDefault) WithStatusCode(code int) *CancelReservationDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the cancel reservation default response
+func (o *CancelReservationDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the cancel reservation default response
+func (o *CancelReservationDefault) WithPayload(payload *models.Error) *CancelReservationDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cancel reservation default response
+func (o *CancelReservationDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CancelReservationDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
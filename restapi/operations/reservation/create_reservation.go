@@ -0,0 +1,66 @@
+package reservation
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/models"
+)
+
+// CreateReservationHandlerFunc turns a function with the right signature into a create reservation handler
+type CreateReservationHandlerFunc func(CreateReservationParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn CreateReservationHandlerFunc) Handle(params CreateReservationParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// CreateReservationHandler interface for that can handle valid create reservation params
+type CreateReservationHandler interface {
+	Handle(CreateReservationParams, *models.User) middleware.Responder
+}
+
+// NewCreateReservation creates a new http.Handler for the create reservation operation
+func NewCreateReservation(ctx *middleware.Context, handler CreateReservationHandler) *CreateReservation {
+	return &CreateReservation{Context: ctx, Handler: handler}
+}
+
+/*CreateReservation swagger:route POST /reservations reservation createReservation
+
+Reserve free vms for a deployment
+
+Earmarks count free vms matching the filter at once, or none when there are not enough. Only orders of the deployment claim them, until the reservation expires or is cancelled. A deployment may hold several reservations of a pool whose windows do not overlap. A reservation starting later is kept until startsAt and must name a flavor; any reservation naming a flavor is rejected when it would, together with the reservations of the flavor overlapping it, hold more vms than the pool has of the flavor.
+
+*/
+type CreateReservation struct {
+	Context *middleware.Context
+	Handler CreateReservationHandler
+}
+
+func (o *CreateReservation) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, _ := o.Context.RouteInfo(r)
+	var Params = NewCreateReservationParams()
+
+	uprinc, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
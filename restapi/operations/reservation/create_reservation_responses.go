@@ -0,0 +1,234 @@
+package reservation
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/jianqiu/vps/models"
+)
+
+/*CreateReservationOK the reservation with the vms it holds
+
+swagger:response createReservationOK
+*/
+type CreateReservationOK struct {
+
+	// In: body
+	Payload *models.Reservation `json:"body,omitempty"`
+}
+
+// NewCreateReservationOK creates CreateReservationOK with default headers values
+func NewCreateReservationOK() *CreateReservationOK {
+	return &CreateReservationOK{}
+}
+
+// WithPayload adds the payload to the create reservation o k response
+func (o *CreateReservationOK) WithPayload(payload *models.Reservation) *CreateReservationOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create reservation o k response
+func (o *CreateReservationOK) SetPayload(payload *models.Reservation) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateReservationOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*CreateReservationBadRequest request is invalid
+
+swagger:response createReservationBadRequest
+*/
+type CreateReservationBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateReservationBadRequest creates CreateReservationBadRequest with default headers values
+func NewCreateReservationBadRequest() *CreateReservationBadRequest {
+	return &CreateReservationBadRequest{}
+}
+
+// WithPayload adds the payload to the create reservation bad request response
+func (o *CreateReservationBadRequest) WithPayload(payload *models.Error) *CreateReservationBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create reservation bad request response
+func (o *CreateReservationBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateReservationBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*CreateReservationConflict request conflicts with the current state of the pool
+
+swagger:response createReservationConflict
+*/
+type CreateReservationConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateReservationConflict creates CreateReservationConflict with default headers values
+func NewCreateReservationConflict() *CreateReservationConflict {
+	return &CreateReservationConflict{}
+}
+
+// WithPayload adds the payload to the create reservation conflict response
+func (o *CreateReservationConflict) WithPayload(payload *models.Error) *CreateReservationConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create reservation conflict response
+func (o *CreateReservationConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateReservationConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*CreateReservationServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response createReservationServiceUnavailable
+*/
+type CreateReservationServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateReservationServiceUnavailable creates CreateReservationServiceUnavailable with default headers values
+func NewCreateReservationServiceUnavailable() *CreateReservationServiceUnavailable {
+	return &CreateReservationServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the create reservation service unavailable response
+func (o *CreateReservationServiceUnavailable) WithRetryAfter(retryAfter int32) *CreateReservationServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the create reservation service unavailable response
+func (o *CreateReservationServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the create reservation service unavailable response
+func (o *CreateReservationServiceUnavailable) WithPayload(payload *models.Error) *CreateReservationServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create reservation service unavailable response
+func (o *CreateReservationServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateReservationServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*CreateReservationDefault unexpected error
+
+swagger:response createReservationDefault
+*/
+type CreateReservationDefault struct {
+	_statusCode int
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateReservationDefault creates CreateReservationDefault with default headers values
+func NewCreateReservationDefault(code int) *CreateReservationDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &CreateReservationDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the create reservation default response
+func (o *CreateReservationDefault) WithStatusCode(code int) *CreateReservationDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the create reservation default response
+func (o *CreateReservationDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the create reservation default response
+func (o *CreateReservationDefault) WithPayload(payload *models.Error) *CreateReservationDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create reservation default response
+func (o *CreateReservationDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateReservationDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
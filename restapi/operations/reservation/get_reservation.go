@@ -0,0 +1,64 @@
+package reservation
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/models"
+)
+
+// GetReservationHandlerFunc turns a function with the right signature into a get reservation handler
+type GetReservationHandlerFunc func(GetReservationParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetReservationHandlerFunc) Handle(params GetReservationParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// GetReservationHandler interface for that can handle valid get reservation params
+type GetReservationHandler interface {
+	Handle(GetReservationParams, *models.User) middleware.Responder
+}
+
+// NewGetReservation creates a new http.Handler for the get reservation operation
+func NewGetReservation(ctx *middleware.Context, handler GetReservationHandler) *GetReservation {
+	return &GetReservation{Context: ctx, Handler: handler}
+}
+
+/*GetReservation swagger:route GET /reservations/{id} reservation getReservation
+
+Find a reservation by ID
+
+*/
+type GetReservation struct {
+	Context *middleware.Context
+	Handler GetReservationHandler
+}
+
+func (o *GetReservation) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, _ := o.Context.RouteInfo(r)
+	var Params = NewGetReservationParams()
+
+	uprinc, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
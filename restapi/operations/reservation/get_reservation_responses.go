@@ -0,0 +1,252 @@
+package reservation
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/jianqiu/vps/models"
+)
+
+/*GetReservationOK successful operation
+
+swagger:response getReservationOK
+*/
+type GetReservationOK struct {
+
+	// In: body
+	Payload *models.Reservation `json:"body,omitempty"`
+}
+
+// NewGetReservationOK creates GetReservationOK with default headers values
+func NewGetReservationOK() *GetReservationOK {
+	return &GetReservationOK{}
+}
+
+// WithPayload adds the payload to the get reservation o k response
+func (o *GetReservationOK) WithPayload(payload *models.Reservation) *GetReservationOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get reservation o k response
+func (o *GetReservationOK) SetPayload(payload *models.Reservation) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetReservationOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetReservationBadRequest request is invalid
+
+swagger:response getReservationBadRequest
+*/
+type GetReservationBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetReservationBadRequest creates GetReservationBadRequest with default headers values
+func NewGetReservationBadRequest() *GetReservationBadRequest {
+	return &GetReservationBadRequest{}
+}
+
+// WithPayload adds the payload to the get reservation bad request response
+func (o *GetReservationBadRequest) WithPayload(payload *models.Error) *GetReservationBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get reservation bad request response
+func (o *GetReservationBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetReservationBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetReservationNotFound reservation not found
+
+swagger:response getReservationNotFound
+*/
+type GetReservationNotFound struct {
+}
+
+// NewGetReservationNotFound creates GetReservationNotFound with default headers values
+func NewGetReservationNotFound() *GetReservationNotFound {
+	return &GetReservationNotFound{}
+}
+
+// WriteResponse to the client
+func (o *GetReservationNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+}
+
+/*GetReservationConflict request conflicts with the current state of the pool
+
+swagger:response getReservationConflict
+*/
+type GetReservationConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetReservationConflict creates GetReservationConflict with default headers values
+func NewGetReservationConflict() *GetReservationConflict {
+	return &GetReservationConflict{}
+}
+
+// WithPayload adds the payload to the get reservation conflict response
+func (o *GetReservationConflict) WithPayload(payload *models.Error) *GetReservationConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get reservation conflict response
+func (o *GetReservationConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetReservationConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetReservationServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response getReservationServiceUnavailable
+*/
+type GetReservationServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetReservationServiceUnavailable creates GetReservationServiceUnavailable with default headers values
+func NewGetReservationServiceUnavailable() *GetReservationServiceUnavailable {
+	return &GetReservationServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the get reservation service unavailable response
+func (o *GetReservationServiceUnavailable) WithRetryAfter(retryAfter int32) *GetReservationServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the get reservation service unavailable response
+func (o *GetReservationServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the get reservation service unavailable response
+func (o *GetReservationServiceUnavailable) WithPayload(payload *models.Error) *GetReservationServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get reservation service unavailable response
+func (o *GetReservationServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetReservationServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetReservationDefault unexpected error
+
+swagger:response getReservationDefault
+*/
+type GetReservationDefault struct {
+	_statusCode int
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetReservationDefault creates GetReservationDefault with default headers values
+func NewGetReservationDefault(code int) *GetReservationDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetReservationDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get reservation default response
+func (o *GetReservationDefault) WithStatusCode(code int) *GetReservationDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get reservation default response
+func (o *GetReservationDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get reservation default response
+func (o *GetReservationDefault) WithPayload(payload *models.Error) *GetReservationDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get reservation default response
+func (o *GetReservationDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetReservationDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package reservation
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/models"
+)
+
+// ListReservationsHandlerFunc turns a function with the right signature into a list reservations handler
+type ListReservationsHandlerFunc func(ListReservationsParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListReservationsHandlerFunc) Handle(params ListReservationsParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ListReservationsHandler interface for that can handle valid list reservations params
+type ListReservationsHandler interface {
+	Handle(ListReservationsParams, *models.User) middleware.Responder
+}
+
+// NewListReservations creates a new http.Handler for the list reservations operation
+func NewListReservations(ctx *middleware.Context, handler ListReservationsHandler) *ListReservations {
+	return &ListReservations{Context: ctx, Handler: handler}
+}
+
+/*ListReservations swagger:route GET /reservations reservation listReservations
+
+List the reservations
+
+*/
+type ListReservations struct {
+	Context *middleware.Context
+	Handler ListReservationsHandler
+}
+
+func (o *ListReservations) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, _ := o.Context.RouteInfo(r)
+	var Params = NewListReservationsParams()
+
+	uprinc, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
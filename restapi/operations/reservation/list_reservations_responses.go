@@ -0,0 +1,234 @@
+package reservation
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/jianqiu/vps/models"
+)
+
+/*ListReservationsOK successful operation
+
+swagger:response listReservationsOK
+*/
+type ListReservationsOK struct {
+
+	// In: body
+	Payload *models.ReservationsResponse `json:"body,omitempty"`
+}
+
+// NewListReservationsOK creates ListReservationsOK with default headers values
+func NewListReservationsOK() *ListReservationsOK {
+	return &ListReservationsOK{}
+}
+
+// WithPayload adds the payload to the list reservations o k response
+func (o *ListReservationsOK) WithPayload(payload *models.ReservationsResponse) *ListReservationsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list reservations o k response
+func (o *ListReservationsOK) SetPayload(payload *models.ReservationsResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListReservationsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListReservationsBadRequest request is invalid
+
+swagger:response listReservationsBadRequest
+*/
+type ListReservationsBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListReservationsBadRequest creates ListReservationsBadRequest with default headers values
+func NewListReservationsBadRequest() *ListReservationsBadRequest {
+	return &ListReservationsBadRequest{}
+}
+
+// WithPayload adds the payload to the list reservations bad request response
+func (o *ListReservationsBadRequest) WithPayload(payload *models.Error) *ListReservationsBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list reservations bad request response
+func (o *ListReservationsBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListReservationsBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListReservationsConflict request conflicts with the current state of the pool
+
+swagger:response listReservationsConflict
+*/
+type ListReservationsConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListReservationsConflict creates ListReservationsConflict with default headers values
+func NewListReservationsConflict() *ListReservationsConflict {
+	return &ListReservationsConflict{}
+}
+
+// WithPayload adds the payload to the list reservations conflict response
+func (o *ListReservationsConflict) WithPayload(payload *models.Error) *ListReservationsConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list reservations conflict response
+func (o *ListReservationsConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListReservationsConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListReservationsServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response listReservationsServiceUnavailable
+*/
+type ListReservationsServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListReservationsServiceUnavailable creates ListReservationsServiceUnavailable with default headers values
+func NewListReservationsServiceUnavailable() *ListReservationsServiceUnavailable {
+	return &ListReservationsServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the list reservations service unavailable response
+func (o *ListReservationsServiceUnavailable) WithRetryAfter(retryAfter int32) *ListReservationsServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the list reservations service unavailable response
+func (o *ListReservationsServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the list reservations service unavailable response
+func (o *ListReservationsServiceUnavailable) WithPayload(payload *models.Error) *ListReservationsServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list reservations service unavailable response
+func (o *ListReservationsServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListReservationsServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListReservationsDefault unexpected error
+
+swagger:response listReservationsDefault
+*/
+type ListReservationsDefault struct {
+	_statusCode int
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListReservationsDefault creates ListReservationsDefault with default headers values
+func NewListReservationsDefault(code int) *ListReservationsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListReservationsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list reservations default response
+func (o *ListReservationsDefault) WithStatusCode(code int) *ListReservationsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list reservations default response
+func (o *ListReservationsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list reservations default response
+func (o *ListReservationsDefault) WithPayload(payload *models.Error) *ListReservationsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list reservations default response
+func (o *ListReservationsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListReservationsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
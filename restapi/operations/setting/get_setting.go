@@ -0,0 +1,64 @@
+package setting
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/models"
+)
+
+// GetSettingHandlerFunc turns a function with the right signature into a get setting handler
+type GetSettingHandlerFunc func(GetSettingParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetSettingHandlerFunc) Handle(params GetSettingParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// GetSettingHandler interface for that can handle valid get setting params
+type GetSettingHandler interface {
+	Handle(GetSettingParams, *models.User) middleware.Responder
+}
+
+// NewGetSetting creates a new http.Handler for the get setting operation
+func NewGetSetting(ctx *middleware.Context, handler GetSettingHandler) *GetSetting {
+	return &GetSetting{Context: ctx, Handler: handler}
+}
+
+/*GetSetting swagger:route GET /settings/{name} setting getSetting
+
+Find a runtime setting by name
+
+*/
+type GetSetting struct {
+	Context *middleware.Context
+	Handler GetSettingHandler
+}
+
+func (o *GetSetting) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, _ := o.Context.RouteInfo(r)
+	var Params = NewGetSettingParams()
+
+	uprinc, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
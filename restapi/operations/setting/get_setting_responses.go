@@ -0,0 +1,252 @@
+package setting
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/jianqiu/vps/models"
+)
+
+/*GetSettingOK successful operation
+
+swagger:response getSettingOK
+*/
+type GetSettingOK struct {
+
+	// In: body
+	Payload *models.Setting `json:"body,omitempty"`
+}
+
+// NewGetSettingOK creates GetSettingOK with default headers values
+func NewGetSettingOK() *GetSettingOK {
+	return &GetSettingOK{}
+}
+
+// WithPayload adds the payload to the get setting o k response
+func (o *GetSettingOK) WithPayload(payload *models.Setting) *GetSettingOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get setting o k response
+func (o *GetSettingOK) SetPayload(payload *models.Setting) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetSettingOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetSettingBadRequest request is invalid
+
+swagger:response getSettingBadRequest
+*/
+type GetSettingBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetSettingBadRequest creates GetSettingBadRequest with default headers values
+func NewGetSettingBadRequest() *GetSettingBadRequest {
+	return &GetSettingBadRequest{}
+}
+
+// WithPayload adds the payload to the get setting bad request response
+func (o *GetSettingBadRequest) WithPayload(payload *models.Error) *GetSettingBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get setting bad request response
+func (o *GetSettingBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetSettingBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetSettingNotFound setting not found
+
+swagger:response getSettingNotFound
+*/
+type GetSettingNotFound struct {
+}
+
+// NewGetSettingNotFound creates GetSettingNotFound with default headers values
+func NewGetSettingNotFound() *GetSettingNotFound {
+	return &GetSettingNotFound{}
+}
+
+// WriteResponse to the client
+func (o *GetSettingNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+}
+
+/*GetSettingConflict request conflicts with the current state of the pool
+
+swagger:response getSettingConflict
+*/
+type GetSettingConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetSettingConflict creates GetSettingConflict with default headers values
+func NewGetSettingConflict() *GetSettingConflict {
+	return &GetSettingConflict{}
+}
+
+// WithPayload adds the payload to the get setting conflict response
+func (o *GetSettingConflict) WithPayload(payload *models.Error) *GetSettingConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get setting conflict response
+func (o *GetSettingConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetSettingConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetSettingServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response getSettingServiceUnavailable
+*/
+type GetSettingServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetSettingServiceUnavailable creates GetSettingServiceUnavailable with default headers values
+func NewGetSettingServiceUnavailable() *GetSettingServiceUnavailable {
+	return &GetSettingServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the get setting service unavailable response
+func (o *GetSettingServiceUnavailable) WithRetryAfter(retryAfter int32) *GetSettingServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the get setting service unavailable response
+func (o *GetSettingServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the get setting service unavailable response
+func (o *GetSettingServiceUnavailable) WithPayload(payload *models.Error) *GetSettingServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get setting service unavailable response
+func (o *GetSettingServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetSettingServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetSettingDefault unexpected error
+
+swagger:response getSettingDefault
+*/
+type GetSettingDefault struct {
+	_statusCode int
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetSettingDefault creates GetSettingDefault with default headers values
+func NewGetSettingDefault(code int) *GetSettingDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetSettingDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get setting default response
+func (o *GetSettingDefault) WithStatusCode(code int) *GetSettingDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get setting default response
+func (o *GetSettingDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get setting default response
+func (o *GetSettingDefault) WithPayload(payload *models.Error) *GetSettingDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get setting default response
+func (o *GetSettingDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetSettingDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package setting
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/models"
+)
+
+// ListSettingsHandlerFunc turns a function with the right signature into a list settings handler
+type ListSettingsHandlerFunc func(ListSettingsParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListSettingsHandlerFunc) Handle(params ListSettingsParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ListSettingsHandler interface for that can handle valid list settings params
+type ListSettingsHandler interface {
+	Handle(ListSettingsParams, *models.User) middleware.Responder
+}
+
+// NewListSettings creates a new http.Handler for the list settings operation
+func NewListSettings(ctx *middleware.Context, handler ListSettingsHandler) *ListSettings {
+	return &ListSettings{Context: ctx, Handler: handler}
+}
+
+/*ListSettings swagger:route GET /settings setting listSettings
+
+List the runtime settings with their values and defaults
+
+Every server picks up changed settings within its settingsPollInterval.
+
+*/
+type ListSettings struct {
+	Context *middleware.Context
+	Handler ListSettingsHandler
+}
+
+func (o *ListSettings) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, _ := o.Context.RouteInfo(r)
+	var Params = NewListSettingsParams()
+
+	uprinc, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,234 @@
+package setting
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/jianqiu/vps/models"
+)
+
+/*ListSettingsOK successful operation
+
+swagger:response listSettingsOK
+*/
+type ListSettingsOK struct {
+
+	// In: body
+	Payload *models.SettingsResponse `json:"body,omitempty"`
+}
+
+// NewListSettingsOK creates ListSettingsOK with default headers values
+func NewListSettingsOK() *ListSettingsOK {
+	return &ListSettingsOK{}
+}
+
+// WithPayload adds the payload to the list settings o k response
+func (o *ListSettingsOK) WithPayload(payload *models.SettingsResponse) *ListSettingsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list settings o k response
+func (o *ListSettingsOK) SetPayload(payload *models.SettingsResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListSettingsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListSettingsBadRequest request is invalid
+
+swagger:response listSettingsBadRequest
+*/
+type ListSettingsBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListSettingsBadRequest creates ListSettingsBadRequest with default headers values
+func NewListSettingsBadRequest() *ListSettingsBadRequest {
+	return &ListSettingsBadRequest{}
+}
+
+// WithPayload adds the payload to the list settings bad request response
+func (o *ListSettingsBadRequest) WithPayload(payload *models.Error) *ListSettingsBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list settings bad request response
+func (o *ListSettingsBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListSettingsBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListSettingsConflict request conflicts with the current state of the pool
+
+swagger:response listSettingsConflict
+*/
+type ListSettingsConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListSettingsConflict creates ListSettingsConflict with default headers values
+func NewListSettingsConflict() *ListSettingsConflict {
+	return &ListSettingsConflict{}
+}
+
+// WithPayload adds the payload to the list settings conflict response
+func (o *ListSettingsConflict) WithPayload(payload *models.Error) *ListSettingsConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list settings conflict response
+func (o *ListSettingsConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListSettingsConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListSettingsServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response listSettingsServiceUnavailable
+*/
+type ListSettingsServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListSettingsServiceUnavailable creates ListSettingsServiceUnavailable with default headers values
+func NewListSettingsServiceUnavailable() *ListSettingsServiceUnavailable {
+	return &ListSettingsServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the list settings service unavailable response
+func (o *ListSettingsServiceUnavailable) WithRetryAfter(retryAfter int32) *ListSettingsServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the list settings service unavailable response
+func (o *ListSettingsServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the list settings service unavailable response
+func (o *ListSettingsServiceUnavailable) WithPayload(payload *models.Error) *ListSettingsServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list settings service unavailable response
+func (o *ListSettingsServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListSettingsServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListSettingsDefault unexpected error
+
+swagger:response listSettingsDefault
+*/
+type ListSettingsDefault struct {
+	_statusCode int
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListSettingsDefault creates ListSettingsDefault with default headers values
+func NewListSettingsDefault(code int) *ListSettingsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListSettingsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list settings default response
+func (o *ListSettingsDefault) WithStatusCode(code int) *ListSettingsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list settings default response
+func (o *ListSettingsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list settings default response
+func (o *ListSettingsDefault) WithPayload(payload *models.Error) *ListSettingsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list settings default response
+func (o *ListSettingsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListSettingsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package setting
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/models"
+)
+
+// ResetSettingHandlerFunc turns a function with the right signature into a reset setting handler
+type ResetSettingHandlerFunc func(ResetSettingParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ResetSettingHandlerFunc) Handle(params ResetSettingParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ResetSettingHandler interface for that can handle valid reset setting params
+type ResetSettingHandler interface {
+	Handle(ResetSettingParams, *models.User) middleware.Responder
+}
+
+// NewResetSetting creates a new http.Handler for the reset setting operation
+func NewResetSetting(ctx *middleware.Context, handler ResetSettingHandler) *ResetSetting {
+	return &ResetSetting{Context: ctx, Handler: handler}
+}
+
+/*ResetSetting swagger:route DELETE /settings/{name} setting resetSetting
+
+Reset a runtime setting to its default
+
+*/
+type ResetSetting struct {
+	Context *middleware.Context
+	Handler ResetSettingHandler
+}
+
+func (o *ResetSetting) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, _ := o.Context.RouteInfo(r)
+	var Params = NewResetSettingParams()
+
+	uprinc, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,252 @@
+package setting
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/jianqiu/vps/models"
+)
+
+/*ResetSettingOK the setting with its default value
+
+swagger:response resetSettingOK
+*/
+type ResetSettingOK struct {
+
+	// In: body
+	Payload *models.Setting `json:"body,omitempty"`
+}
+
+// NewResetSettingOK creates ResetSettingOK with default headers values
+func NewResetSettingOK() *ResetSettingOK {
+	return &ResetSettingOK{}
+}
+
+// WithPayload adds the payload to the reset setting o k response
+func (o *ResetSettingOK) WithPayload(payload *models.Setting) *ResetSettingOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the reset setting o k response
+func (o *ResetSettingOK) SetPayload(payload *models.Setting) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ResetSettingOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ResetSettingBadRequest request is invalid
+
+swagger:response resetSettingBadRequest
+*/
+type ResetSettingBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewResetSettingBadRequest creates ResetSettingBadRequest with default headers values
+func NewResetSettingBadRequest() *ResetSettingBadRequest {
+	return &ResetSettingBadRequest{}
+}
+
+// WithPayload adds the payload to the reset setting bad request response
+func (o *ResetSettingBadRequest) WithPayload(payload *models.Error) *ResetSettingBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the reset setting bad request response
+func (o *ResetSettingBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ResetSettingBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ResetSettingNotFound setting not found
+
+swagger:response resetSettingNotFound
+*/
+type ResetSettingNotFound struct {
+}
+
+// NewResetSettingNotFound creates ResetSettingNotFound with default headers values
+func NewResetSettingNotFound() *ResetSettingNotFound {
+	return &ResetSettingNotFound{}
+}
+
+// WriteResponse to the client
+func (o *ResetSettingNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+}
+
+/*ResetSettingConflict request conflicts with the current state of the pool
+
+swagger:response resetSettingConflict
+*/
+type ResetSettingConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewResetSettingConflict creates ResetSettingConflict with default headers values
+func NewResetSettingConflict() *ResetSettingConflict {
+	return &ResetSettingConflict{}
+}
+
+// WithPayload adds the payload to the reset setting conflict response
+func (o *ResetSettingConflict) WithPayload(payload *models.Error) *ResetSettingConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the reset setting conflict response
+func (o *ResetSettingConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ResetSettingConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ResetSettingServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response resetSettingServiceUnavailable
+*/
+type ResetSettingServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewResetSettingServiceUnavailable creates ResetSettingServiceUnavailable with default headers values
+func NewResetSettingServiceUnavailable() *ResetSettingServiceUnavailable {
+	return &ResetSettingServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the reset setting service unavailable response
+func (o *ResetSettingServiceUnavailable) WithRetryAfter(retryAfter int32) *ResetSettingServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the reset setting service unavailable response
+func (o *ResetSettingServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the reset setting service unavailable response
+func (o *ResetSettingServiceUnavailable) WithPayload(payload *models.Error) *ResetSettingServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the reset setting service unavailable response
+func (o *ResetSettingServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ResetSettingServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ResetSettingDefault unexpected error
+
+swagger:response resetSettingDefault
+*/
+type ResetSettingDefault struct {
+	_statusCode int
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewResetSettingDefault creates ResetSettingDefault with default headers values
+func NewResetSettingDefault(code int) *ResetSettingDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ResetSettingDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the reset setting default response
+func (o *ResetSettingDefault) WithStatusCode(code int) *ResetSettingDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the reset setting default response
+func (o *ResetSettingDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the reset setting default response
+func (o *ResetSettingDefault) WithPayload(payload *models.Error) *ResetSettingDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the reset setting default response
+func (o *ResetSettingDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ResetSettingDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
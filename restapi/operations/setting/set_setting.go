@@ -0,0 +1,66 @@
+package setting
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/models"
+)
+
+// SetSettingHandlerFunc turns a function with the right signature into a set setting handler
+type SetSettingHandlerFunc func(SetSettingParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn SetSettingHandlerFunc) Handle(params SetSettingParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// SetSettingHandler interface for that can handle valid set setting params
+type SetSettingHandler interface {
+	Handle(SetSettingParams, *models.User) middleware.Responder
+}
+
+// NewSetSetting creates a new http.Handler for the set setting operation
+func NewSetSetting(ctx *middleware.Context, handler SetSettingHandler) *SetSetting {
+	return &SetSetting{Context: ctx, Handler: handler}
+}
+
+/*SetSetting swagger:route PUT /settings/{name} setting setSetting
+
+Change a runtime setting
+
+Only the value of the body is used.
+
+*/
+type SetSetting struct {
+	Context *middleware.Context
+	Handler SetSettingHandler
+}
+
+func (o *SetSetting) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, _ := o.Context.RouteInfo(r)
+	var Params = NewSetSettingParams()
+
+	uprinc, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
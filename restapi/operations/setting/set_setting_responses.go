@@ -0,0 +1,252 @@
+package setting
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/jianqiu/vps/models"
+)
+
+/*SetSettingOK the changed setting
+
+swagger:response setSettingOK
+*/
+type SetSettingOK struct {
+
+	// In: body
+	Payload *models.Setting `json:"body,omitempty"`
+}
+
+// NewSetSettingOK creates SetSettingOK with default headers values
+func NewSetSettingOK() *SetSettingOK {
+	return &SetSettingOK{}
+}
+
+// WithPayload adds the payload to the set setting o k response
+func (o *SetSettingOK) WithPayload(payload *models.Setting) *SetSettingOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the set setting o k response
+func (o *SetSettingOK) SetPayload(payload *models.Setting) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SetSettingOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*SetSettingBadRequest request is invalid
+
+swagger:response setSettingBadRequest
+*/
+type SetSettingBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewSetSettingBadRequest creates SetSettingBadRequest with default headers values
+func NewSetSettingBadRequest() *SetSettingBadRequest {
+	return &SetSettingBadRequest{}
+}
+
+// WithPayload adds the payload to the set setting bad request response
+func (o *SetSettingBadRequest) WithPayload(payload *models.Error) *SetSettingBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the set setting bad request response
+func (o *SetSettingBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SetSettingBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*SetSettingNotFound setting not found
+
+swagger:response setSettingNotFound
+*/
+type SetSettingNotFound struct {
+}
+
+// NewSetSettingNotFound creates SetSettingNotFound with default headers values
+func NewSetSettingNotFound() *SetSettingNotFound {
+	return &SetSettingNotFound{}
+}
+
+// WriteResponse to the client
+func (o *SetSettingNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+}
+
+/*SetSettingConflict request conflicts with the current state of the pool
+
+swagger:response setSettingConflict
+*/
+type SetSettingConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewSetSettingConflict creates SetSettingConflict with default headers values
+func NewSetSettingConflict() *SetSettingConflict {
+	return &SetSettingConflict{}
+}
+
+// WithPayload adds the payload to the set setting conflict response
+func (o *SetSettingConflict) WithPayload(payload *models.Error) *SetSettingConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the set setting conflict response
+func (o *SetSettingConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SetSettingConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*SetSettingServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response setSettingServiceUnavailable
+*/
+type SetSettingServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewSetSettingServiceUnavailable creates SetSettingServiceUnavailable with default headers values
+func NewSetSettingServiceUnavailable() *SetSettingServiceUnavailable {
+	return &SetSettingServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the set setting service unavailable response
+func (o *SetSettingServiceUnavailable) WithRetryAfter(retryAfter int32) *SetSettingServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the set setting service unavailable response
+func (o *SetSettingServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the set setting service unavailable response
+func (o *SetSettingServiceUnavailable) WithPayload(payload *models.Error) *SetSettingServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the set setting service unavailable response
+func (o *SetSettingServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SetSettingServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*SetSettingDefault unexpected error
+
+swagger:response setSettingDefault
+*/
+type SetSettingDefault struct {
+	_statusCode int
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewSetSettingDefault creates SetSettingDefault with default headers values
+func NewSetSettingDefault(code int) *SetSettingDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &SetSettingDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the set setting default response
+func (o *SetSettingDefault) WithStatusCode(code int) *SetSettingDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the set setting default response
+func (o *SetSettingDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the set setting default response
+func (o *SetSettingDefault) WithPayload(payload *models.Error) *SetSettingDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the set setting default response
+func (o *SetSettingDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SetSettingDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
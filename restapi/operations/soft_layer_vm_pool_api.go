@@ -17,7 +17,15 @@ import (
 	"github.com/go-openapi/swag"
 
 	"github.com/jianqiu/vps/models"
+	"github.com/jianqiu/vps/restapi/operations/admin"
+	"github.com/jianqiu/vps/restapi/operations/flavor"
+	"github.com/jianqiu/vps/restapi/operations/health"
+	"github.com/jianqiu/vps/restapi/operations/metadata"
+	"github.com/jianqiu/vps/restapi/operations/pool"
+	"github.com/jianqiu/vps/restapi/operations/reservation"
+	"github.com/jianqiu/vps/restapi/operations/setting"
 	"github.com/jianqiu/vps/restapi/operations/vm"
+	"github.com/jianqiu/vps/restapi/operations/webhook"
 )
 
 // NewSoftLayerVMPoolAPI creates a new SoftLayerVMPool instance
@@ -52,26 +60,114 @@ type SoftLayerVMPoolAPI struct {
 	// it performs authentication with basic auth
 	BasicAuthAuth func(string, string) (*models.User, error)
 
+	// AdminGetLogLevelHandler sets the operation handler for the get log level operation
+	AdminGetLogLevelHandler admin.GetLogLevelHandler
+	// AdminSetLogLevelHandler sets the operation handler for the set log level operation
+	AdminSetLogLevelHandler admin.SetLogLevelHandler
+	// FlavorCreateFlavorHandler sets the operation handler for the create flavor operation
+	FlavorCreateFlavorHandler flavor.CreateFlavorHandler
+	// FlavorDeleteFlavorHandler sets the operation handler for the delete flavor operation
+	FlavorDeleteFlavorHandler flavor.DeleteFlavorHandler
+	// FlavorGetFlavorHandler sets the operation handler for the get flavor operation
+	FlavorGetFlavorHandler flavor.GetFlavorHandler
+	// FlavorListFlavorsHandler sets the operation handler for the list flavors operation
+	FlavorListFlavorsHandler flavor.ListFlavorsHandler
+	// FlavorUpdateFlavorHandler sets the operation handler for the update flavor operation
+	FlavorUpdateFlavorHandler flavor.UpdateFlavorHandler
+	// HealthGetHealthzHandler sets the operation handler for the get healthz operation
+	HealthGetHealthzHandler health.GetHealthzHandler
+	// HealthGetReadyzHandler sets the operation handler for the get readyz operation
+	HealthGetReadyzHandler health.GetReadyzHandler
+	// MetadataGetVMMetadataHandler sets the operation handler for the get Vm metadata operation
+	MetadataGetVMMetadataHandler metadata.GetVMMetadataHandler
+	// PoolCreatePoolHandler sets the operation handler for the create pool operation
+	PoolCreatePoolHandler pool.CreatePoolHandler
+	// PoolDeletePoolHandler sets the operation handler for the delete pool operation
+	PoolDeletePoolHandler pool.DeletePoolHandler
+	// PoolGetPoolHandler sets the operation handler for the get pool operation
+	PoolGetPoolHandler pool.GetPoolHandler
+	// PoolGetPoolSummaryHandler sets the operation handler for the get pool summary operation
+	PoolGetPoolSummaryHandler pool.GetPoolSummaryHandler
+	// PoolListPoolsHandler sets the operation handler for the list pools operation
+	PoolListPoolsHandler pool.ListPoolsHandler
+	// PoolUpdatePoolHandler sets the operation handler for the update pool operation
+	PoolUpdatePoolHandler pool.UpdatePoolHandler
+	// ReservationCancelReservationHandler sets the operation handler for the cancel reservation operation
+	ReservationCancelReservationHandler reservation.CancelReservationHandler
+	// ReservationCreateReservationHandler sets the operation handler for the create reservation operation
+	ReservationCreateReservationHandler reservation.CreateReservationHandler
+	// ReservationGetReservationHandler sets the operation handler for the get reservation operation
+	ReservationGetReservationHandler reservation.GetReservationHandler
+	// ReservationListReservationsHandler sets the operation handler for the list reservations operation
+	ReservationListReservationsHandler reservation.ListReservationsHandler
+	// SettingGetSettingHandler sets the operation handler for the get setting operation
+	SettingGetSettingHandler setting.GetSettingHandler
+	// SettingListSettingsHandler sets the operation handler for the list settings operation
+	SettingListSettingsHandler setting.ListSettingsHandler
+	// SettingResetSettingHandler sets the operation handler for the reset setting operation
+	SettingResetSettingHandler setting.ResetSettingHandler
+	// SettingSetSettingHandler sets the operation handler for the set setting operation
+	SettingSetSettingHandler setting.SetSettingHandler
+	// VMAddPoolVMHandler sets the operation handler for the add pool Vm operation
+	VMAddPoolVMHandler vm.AddPoolVMHandler
 	// VMAddVMHandler sets the operation handler for the add Vm operation
 	VMAddVMHandler vm.AddVMHandler
+	// VMDeletePoolVMHandler sets the operation handler for the delete pool Vm operation
+	VMDeletePoolVMHandler vm.DeletePoolVMHandler
 	// VMDeleteVMHandler sets the operation handler for the delete Vm operation
 	VMDeleteVMHandler vm.DeleteVMHandler
+	// VMDeleteVMSecretHandler sets the operation handler for the delete Vm secret operation
+	VMDeleteVMSecretHandler vm.DeleteVMSecretHandler
+	// VMFindPoolVmsByFiltersHandler sets the operation handler for the find pool vms by filters operation
+	VMFindPoolVmsByFiltersHandler vm.FindPoolVmsByFiltersHandler
 	// VMFindVmsByDeploymentHandler sets the operation handler for the find vms by deployment operation
 	VMFindVmsByDeploymentHandler vm.FindVmsByDeploymentHandler
 	// VMFindVmsByFiltersHandler sets the operation handler for the find vms by filters operation
 	VMFindVmsByFiltersHandler vm.FindVmsByFiltersHandler
+	// VMFindVmsByHostnameHandler sets the operation handler for the find vms by hostname operation
+	VMFindVmsByHostnameHandler vm.FindVmsByHostnameHandler
 	// VMFindVmsByStatesHandler sets the operation handler for the find vms by states operation
 	VMFindVmsByStatesHandler vm.FindVmsByStatesHandler
+	// VMGetPoolVMByCidHandler sets the operation handler for the get pool Vm by cid operation
+	VMGetPoolVMByCidHandler vm.GetPoolVMByCidHandler
 	// VMGetVMByCidHandler sets the operation handler for the get Vm by cid operation
 	VMGetVMByCidHandler vm.GetVMByCidHandler
+	// VMGetVMByHostnameHandler sets the operation handler for the get Vm by hostname operation
+	VMGetVMByHostnameHandler vm.GetVMByHostnameHandler
+	// VMGetVMByIPHandler sets the operation handler for the get Vm by Ip operation
+	VMGetVMByIPHandler vm.GetVMByIPHandler
+	// VMGetVMSecretHandler sets the operation handler for the get Vm secret operation
+	VMGetVMSecretHandler vm.GetVMSecretHandler
+	// VMListPoolVmsHandler sets the operation handler for the list pool vms operation
+	VMListPoolVmsHandler vm.ListPoolVmsHandler
+	// VMListReapableVmsHandler sets the operation handler for the list reapable vms operation
+	VMListReapableVmsHandler vm.ListReapableVmsHandler
 	// VMListVMHandler sets the operation handler for the list Vm operation
 	VMListVMHandler vm.ListVMHandler
+	// VMOrderPoolVMHandler sets the operation handler for the order pool Vm operation
+	VMOrderPoolVMHandler vm.OrderPoolVMHandler
 	// VMOrderVMByFilterHandler sets the operation handler for the order Vm by filter operation
 	VMOrderVMByFilterHandler vm.OrderVMByFilterHandler
+	// VMSetVMSecretHandler sets the operation handler for the set Vm secret operation
+	VMSetVMSecretHandler vm.SetVMSecretHandler
+	// VMTransitionVMHandler sets the operation handler for the transition Vm operation
+	VMTransitionVMHandler vm.TransitionVMHandler
+	// VMUpdatePoolVMWithStateHandler sets the operation handler for the update pool Vm with state operation
+	VMUpdatePoolVMWithStateHandler vm.UpdatePoolVMWithStateHandler
 	// VMUpdateVMHandler sets the operation handler for the update Vm operation
 	VMUpdateVMHandler vm.UpdateVMHandler
 	// VMUpdateVMWithStateHandler sets the operation handler for the update Vm with state operation
 	VMUpdateVMWithStateHandler vm.UpdateVMWithStateHandler
+	// WebhookCreateWebhookHandler sets the operation handler for the create webhook operation
+	WebhookCreateWebhookHandler webhook.CreateWebhookHandler
+	// WebhookDeleteWebhookHandler sets the operation handler for the delete webhook operation
+	WebhookDeleteWebhookHandler webhook.DeleteWebhookHandler
+	// WebhookListDeadWebhookDeliveriesHandler sets the operation handler for the list dead webhook deliveries operation
+	WebhookListDeadWebhookDeliveriesHandler webhook.ListDeadWebhookDeliveriesHandler
+	// WebhookListWebhooksHandler sets the operation handler for the list webhooks operation
+	WebhookListWebhooksHandler webhook.ListWebhooksHandler
+	// WebhookRetryWebhookDeliveryHandler sets the operation handler for the retry webhook delivery operation
+	WebhookRetryWebhookDeliveryHandler webhook.RetryWebhookDeliveryHandler
 
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
@@ -139,14 +235,126 @@ func (o *SoftLayerVMPoolAPI) Validate() error {
 		unregistered = append(unregistered, "BasicAuthAuth")
 	}
 
+	if o.AdminGetLogLevelHandler == nil {
+		unregistered = append(unregistered, "admin.GetLogLevelHandler")
+	}
+
+	if o.AdminSetLogLevelHandler == nil {
+		unregistered = append(unregistered, "admin.SetLogLevelHandler")
+	}
+
+	if o.FlavorCreateFlavorHandler == nil {
+		unregistered = append(unregistered, "flavor.CreateFlavorHandler")
+	}
+
+	if o.FlavorDeleteFlavorHandler == nil {
+		unregistered = append(unregistered, "flavor.DeleteFlavorHandler")
+	}
+
+	if o.FlavorGetFlavorHandler == nil {
+		unregistered = append(unregistered, "flavor.GetFlavorHandler")
+	}
+
+	if o.FlavorListFlavorsHandler == nil {
+		unregistered = append(unregistered, "flavor.ListFlavorsHandler")
+	}
+
+	if o.FlavorUpdateFlavorHandler == nil {
+		unregistered = append(unregistered, "flavor.UpdateFlavorHandler")
+	}
+
+	if o.HealthGetHealthzHandler == nil {
+		unregistered = append(unregistered, "health.GetHealthzHandler")
+	}
+
+	if o.HealthGetReadyzHandler == nil {
+		unregistered = append(unregistered, "health.GetReadyzHandler")
+	}
+
+	if o.MetadataGetVMMetadataHandler == nil {
+		unregistered = append(unregistered, "metadata.GetVMMetadataHandler")
+	}
+
+	if o.PoolCreatePoolHandler == nil {
+		unregistered = append(unregistered, "pool.CreatePoolHandler")
+	}
+
+	if o.PoolDeletePoolHandler == nil {
+		unregistered = append(unregistered, "pool.DeletePoolHandler")
+	}
+
+	if o.PoolGetPoolHandler == nil {
+		unregistered = append(unregistered, "pool.GetPoolHandler")
+	}
+
+	if o.PoolGetPoolSummaryHandler == nil {
+		unregistered = append(unregistered, "pool.GetPoolSummaryHandler")
+	}
+
+	if o.PoolListPoolsHandler == nil {
+		unregistered = append(unregistered, "pool.ListPoolsHandler")
+	}
+
+	if o.PoolUpdatePoolHandler == nil {
+		unregistered = append(unregistered, "pool.UpdatePoolHandler")
+	}
+
+	if o.ReservationCancelReservationHandler == nil {
+		unregistered = append(unregistered, "reservation.CancelReservationHandler")
+	}
+
+	if o.ReservationCreateReservationHandler == nil {
+		unregistered = append(unregistered, "reservation.CreateReservationHandler")
+	}
+
+	if o.ReservationGetReservationHandler == nil {
+		unregistered = append(unregistered, "reservation.GetReservationHandler")
+	}
+
+	if o.ReservationListReservationsHandler == nil {
+		unregistered = append(unregistered, "reservation.ListReservationsHandler")
+	}
+
+	if o.SettingGetSettingHandler == nil {
+		unregistered = append(unregistered, "setting.GetSettingHandler")
+	}
+
+	if o.SettingListSettingsHandler == nil {
+		unregistered = append(unregistered, "setting.ListSettingsHandler")
+	}
+
+	if o.SettingResetSettingHandler == nil {
+		unregistered = append(unregistered, "setting.ResetSettingHandler")
+	}
+
+	if o.SettingSetSettingHandler == nil {
+		unregistered = append(unregistered, "setting.SetSettingHandler")
+	}
+
+	if o.VMAddPoolVMHandler == nil {
+		unregistered = append(unregistered, "vm.AddPoolVMHandler")
+	}
+
 	if o.VMAddVMHandler == nil {
 		unregistered = append(unregistered, "vm.AddVMHandler")
 	}
 
+	if o.VMDeletePoolVMHandler == nil {
+		unregistered = append(unregistered, "vm.DeletePoolVMHandler")
+	}
+
 	if o.VMDeleteVMHandler == nil {
 		unregistered = append(unregistered, "vm.DeleteVMHandler")
 	}
 
+	if o.VMDeleteVMSecretHandler == nil {
+		unregistered = append(unregistered, "vm.DeleteVMSecretHandler")
+	}
+
+	if o.VMFindPoolVmsByFiltersHandler == nil {
+		unregistered = append(unregistered, "vm.FindPoolVmsByFiltersHandler")
+	}
+
 	if o.VMFindVmsByDeploymentHandler == nil {
 		unregistered = append(unregistered, "vm.FindVmsByDeploymentHandler")
 	}
@@ -155,22 +363,66 @@ func (o *SoftLayerVMPoolAPI) Validate() error {
 		unregistered = append(unregistered, "vm.FindVmsByFiltersHandler")
 	}
 
+	if o.VMFindVmsByHostnameHandler == nil {
+		unregistered = append(unregistered, "vm.FindVmsByHostnameHandler")
+	}
+
 	if o.VMFindVmsByStatesHandler == nil {
 		unregistered = append(unregistered, "vm.FindVmsByStatesHandler")
 	}
 
+	if o.VMGetPoolVMByCidHandler == nil {
+		unregistered = append(unregistered, "vm.GetPoolVMByCidHandler")
+	}
+
 	if o.VMGetVMByCidHandler == nil {
 		unregistered = append(unregistered, "vm.GetVMByCidHandler")
 	}
 
+	if o.VMGetVMByHostnameHandler == nil {
+		unregistered = append(unregistered, "vm.GetVMByHostnameHandler")
+	}
+
+	if o.VMGetVMByIPHandler == nil {
+		unregistered = append(unregistered, "vm.GetVMByIPHandler")
+	}
+
+	if o.VMGetVMSecretHandler == nil {
+		unregistered = append(unregistered, "vm.GetVMSecretHandler")
+	}
+
+	if o.VMListPoolVmsHandler == nil {
+		unregistered = append(unregistered, "vm.ListPoolVmsHandler")
+	}
+
+	if o.VMListReapableVmsHandler == nil {
+		unregistered = append(unregistered, "vm.ListReapableVmsHandler")
+	}
+
 	if o.VMListVMHandler == nil {
 		unregistered = append(unregistered, "vm.ListVMHandler")
 	}
 
+	if o.VMOrderPoolVMHandler == nil {
+		unregistered = append(unregistered, "vm.OrderPoolVMHandler")
+	}
+
 	if o.VMOrderVMByFilterHandler == nil {
 		unregistered = append(unregistered, "vm.OrderVMByFilterHandler")
 	}
 
+	if o.VMSetVMSecretHandler == nil {
+		unregistered = append(unregistered, "vm.SetVMSecretHandler")
+	}
+
+	if o.VMTransitionVMHandler == nil {
+		unregistered = append(unregistered, "vm.TransitionVMHandler")
+	}
+
+	if o.VMUpdatePoolVMWithStateHandler == nil {
+		unregistered = append(unregistered, "vm.UpdatePoolVMWithStateHandler")
+	}
+
 	if o.VMUpdateVMHandler == nil {
 		unregistered = append(unregistered, "vm.UpdateVMHandler")
 	}
@@ -179,6 +431,26 @@ func (o *SoftLayerVMPoolAPI) Validate() error {
 		unregistered = append(unregistered, "vm.UpdateVMWithStateHandler")
 	}
 
+	if o.WebhookCreateWebhookHandler == nil {
+		unregistered = append(unregistered, "webhook.CreateWebhookHandler")
+	}
+
+	if o.WebhookDeleteWebhookHandler == nil {
+		unregistered = append(unregistered, "webhook.DeleteWebhookHandler")
+	}
+
+	if o.WebhookListDeadWebhookDeliveriesHandler == nil {
+		unregistered = append(unregistered, "webhook.ListDeadWebhookDeliveriesHandler")
+	}
+
+	if o.WebhookListWebhooksHandler == nil {
+		unregistered = append(unregistered, "webhook.ListWebhooksHandler")
+	}
+
+	if o.WebhookRetryWebhookDeliveryHandler == nil {
+		unregistered = append(unregistered, "webhook.RetryWebhookDeliveryHandler")
+	}
+
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
 	}
@@ -271,16 +543,156 @@ func (o *SoftLayerVMPoolAPI) initHandlerCache() {
 		o.handlers = make(map[string]map[string]http.Handler)
 	}
 
+	if o.handlers["GET"] == nil {
+		o.handlers[strings.ToUpper("GET")] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/log-level"] = admin.NewGetLogLevel(o.context, o.AdminGetLogLevelHandler)
+
+	if o.handlers["PUT"] == nil {
+		o.handlers[strings.ToUpper("PUT")] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/admin/log-level"] = admin.NewSetLogLevel(o.context, o.AdminSetLogLevelHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers[strings.ToUpper("POST")] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/flavors"] = flavor.NewCreateFlavor(o.context, o.FlavorCreateFlavorHandler)
+
+	if o.handlers["DELETE"] == nil {
+		o.handlers[strings.ToUpper("DELETE")] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/flavors/{name}"] = flavor.NewDeleteFlavor(o.context, o.FlavorDeleteFlavorHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers[strings.ToUpper("GET")] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/flavors/{name}"] = flavor.NewGetFlavor(o.context, o.FlavorGetFlavorHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers[strings.ToUpper("GET")] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/flavors"] = flavor.NewListFlavors(o.context, o.FlavorListFlavorsHandler)
+
+	if o.handlers["PUT"] == nil {
+		o.handlers[strings.ToUpper("PUT")] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/flavors/{name}"] = flavor.NewUpdateFlavor(o.context, o.FlavorUpdateFlavorHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers[strings.ToUpper("GET")] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/healthz"] = health.NewGetHealthz(o.context, o.HealthGetHealthzHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers[strings.ToUpper("GET")] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/readyz"] = health.NewGetReadyz(o.context, o.HealthGetReadyzHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers[strings.ToUpper("GET")] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/metadata"] = metadata.NewGetVMMetadata(o.context, o.MetadataGetVMMetadataHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers[strings.ToUpper("POST")] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/pools"] = pool.NewCreatePool(o.context, o.PoolCreatePoolHandler)
+
+	if o.handlers["DELETE"] == nil {
+		o.handlers[strings.ToUpper("DELETE")] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/pools/{pool}"] = pool.NewDeletePool(o.context, o.PoolDeletePoolHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers[strings.ToUpper("GET")] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/pools/{pool}"] = pool.NewGetPool(o.context, o.PoolGetPoolHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers[strings.ToUpper("GET")] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/pools/{pool}/summary"] = pool.NewGetPoolSummary(o.context, o.PoolGetPoolSummaryHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers[strings.ToUpper("GET")] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/pools"] = pool.NewListPools(o.context, o.PoolListPoolsHandler)
+
+	if o.handlers["PUT"] == nil {
+		o.handlers[strings.ToUpper("PUT")] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/pools/{pool}"] = pool.NewUpdatePool(o.context, o.PoolUpdatePoolHandler)
+
+	if o.handlers["DELETE"] == nil {
+		o.handlers[strings.ToUpper("DELETE")] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/reservations/{id}"] = reservation.NewCancelReservation(o.context, o.ReservationCancelReservationHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers[strings.ToUpper("POST")] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/reservations"] = reservation.NewCreateReservation(o.context, o.ReservationCreateReservationHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers[strings.ToUpper("GET")] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/reservations/{id}"] = reservation.NewGetReservation(o.context, o.ReservationGetReservationHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers[strings.ToUpper("GET")] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/reservations"] = reservation.NewListReservations(o.context, o.ReservationListReservationsHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers[strings.ToUpper("GET")] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/settings/{name}"] = setting.NewGetSetting(o.context, o.SettingGetSettingHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers[strings.ToUpper("GET")] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/settings"] = setting.NewListSettings(o.context, o.SettingListSettingsHandler)
+
+	if o.handlers["DELETE"] == nil {
+		o.handlers[strings.ToUpper("DELETE")] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/settings/{name}"] = setting.NewResetSetting(o.context, o.SettingResetSettingHandler)
+
+	if o.handlers["PUT"] == nil {
+		o.handlers[strings.ToUpper("PUT")] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/settings/{name}"] = setting.NewSetSetting(o.context, o.SettingSetSettingHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers[strings.ToUpper("POST")] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/pools/{pool}/vms"] = vm.NewAddPoolVM(o.context, o.VMAddPoolVMHandler)
+
 	if o.handlers["POST"] == nil {
 		o.handlers[strings.ToUpper("POST")] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/vms"] = vm.NewAddVM(o.context, o.VMAddVMHandler)
 
+	if o.handlers["DELETE"] == nil {
+		o.handlers[strings.ToUpper("DELETE")] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/pools/{pool}/vms/{cid}"] = vm.NewDeletePoolVM(o.context, o.VMDeletePoolVMHandler)
+
 	if o.handlers["DELETE"] == nil {
 		o.handlers[strings.ToUpper("DELETE")] = make(map[string]http.Handler)
 	}
 	o.handlers["DELETE"]["/vms/{cid}"] = vm.NewDeleteVM(o.context, o.VMDeleteVMHandler)
 
+	if o.handlers["DELETE"] == nil {
+		o.handlers[strings.ToUpper("DELETE")] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/vms/{cid}/secret"] = vm.NewDeleteVMSecret(o.context, o.VMDeleteVMSecretHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers[strings.ToUpper("POST")] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/pools/{pool}/vms/findByFilters"] = vm.NewFindPoolVmsByFilters(o.context, o.VMFindPoolVmsByFiltersHandler)
+
 	if o.handlers["GET"] == nil {
 		o.handlers[strings.ToUpper("GET")] = make(map[string]http.Handler)
 	}
@@ -291,26 +703,81 @@ func (o *SoftLayerVMPoolAPI) initHandlerCache() {
 	}
 	o.handlers["POST"]["/vms/findByFilters"] = vm.NewFindVmsByFilters(o.context, o.VMFindVmsByFiltersHandler)
 
+	if o.handlers["GET"] == nil {
+		o.handlers[strings.ToUpper("GET")] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/vms/findByHostname"] = vm.NewFindVmsByHostname(o.context, o.VMFindVmsByHostnameHandler)
+
 	if o.handlers["GET"] == nil {
 		o.handlers[strings.ToUpper("GET")] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/vms/findByState"] = vm.NewFindVmsByStates(o.context, o.VMFindVmsByStatesHandler)
 
+	if o.handlers["GET"] == nil {
+		o.handlers[strings.ToUpper("GET")] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/pools/{pool}/vms/{cid}"] = vm.NewGetPoolVMByCid(o.context, o.VMGetPoolVMByCidHandler)
+
 	if o.handlers["GET"] == nil {
 		o.handlers[strings.ToUpper("GET")] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/vms/{cid}"] = vm.NewGetVMByCid(o.context, o.VMGetVMByCidHandler)
 
+	if o.handlers["GET"] == nil {
+		o.handlers[strings.ToUpper("GET")] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/vms/byHostname/{hostname}"] = vm.NewGetVMByHostname(o.context, o.VMGetVMByHostnameHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers[strings.ToUpper("GET")] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/vms/byIp/{ip}"] = vm.NewGetVMByIP(o.context, o.VMGetVMByIPHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers[strings.ToUpper("GET")] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/vms/{cid}/secret"] = vm.NewGetVMSecret(o.context, o.VMGetVMSecretHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers[strings.ToUpper("GET")] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/pools/{pool}/vms"] = vm.NewListPoolVms(o.context, o.VMListPoolVmsHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers[strings.ToUpper("GET")] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/vms/reapable"] = vm.NewListReapableVms(o.context, o.VMListReapableVmsHandler)
+
 	if o.handlers["GET"] == nil {
 		o.handlers[strings.ToUpper("GET")] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/vms"] = vm.NewListVM(o.context, o.VMListVMHandler)
 
+	if o.handlers["POST"] == nil {
+		o.handlers[strings.ToUpper("POST")] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/pools/{pool}/vms/order"] = vm.NewOrderPoolVM(o.context, o.VMOrderPoolVMHandler)
+
 	if o.handlers["POST"] == nil {
 		o.handlers[strings.ToUpper("POST")] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/vms/order"] = vm.NewOrderVMByFilter(o.context, o.VMOrderVMByFilterHandler)
 
+	if o.handlers["PUT"] == nil {
+		o.handlers[strings.ToUpper("PUT")] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/vms/{cid}/secret"] = vm.NewSetVMSecret(o.context, o.VMSetVMSecretHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers[strings.ToUpper("POST")] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/vms/{cid}/transition"] = vm.NewTransitionVM(o.context, o.VMTransitionVMHandler)
+
+	if o.handlers["PUT"] == nil {
+		o.handlers[strings.ToUpper("PUT")] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/pools/{pool}/vms/{cid}"] = vm.NewUpdatePoolVMWithState(o.context, o.VMUpdatePoolVMWithStateHandler)
+
 	if o.handlers["PUT"] == nil {
 		o.handlers[strings.ToUpper("PUT")] = make(map[string]http.Handler)
 	}
@@ -321,6 +788,31 @@ func (o *SoftLayerVMPoolAPI) initHandlerCache() {
 	}
 	o.handlers["PUT"]["/vms/{cid}"] = vm.NewUpdateVMWithState(o.context, o.VMUpdateVMWithStateHandler)
 
+	if o.handlers["POST"] == nil {
+		o.handlers[strings.ToUpper("POST")] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/webhooks"] = webhook.NewCreateWebhook(o.context, o.WebhookCreateWebhookHandler)
+
+	if o.handlers["DELETE"] == nil {
+		o.handlers[strings.ToUpper("DELETE")] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/webhooks/{id}"] = webhook.NewDeleteWebhook(o.context, o.WebhookDeleteWebhookHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers[strings.ToUpper("GET")] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/webhooks/deliveries/dead"] = webhook.NewListDeadWebhookDeliveries(o.context, o.WebhookListDeadWebhookDeliveriesHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers[strings.ToUpper("GET")] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/webhooks"] = webhook.NewListWebhooks(o.context, o.WebhookListWebhooksHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers[strings.ToUpper("POST")] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/webhooks/deliveries/{id}/retry"] = webhook.NewRetryWebhookDelivery(o.context, o.WebhookRetryWebhookDeliveryHandler)
+
 }
 
 // Serve creates a http handler to serve the API over HTTP
@@ -0,0 +1,64 @@
+package vm
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/models"
+)
+
+// AddPoolVMHandlerFunc turns a function with the right signature into a add pool Vm handler
+type AddPoolVMHandlerFunc func(AddPoolVMParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn AddPoolVMHandlerFunc) Handle(params AddPoolVMParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// AddPoolVMHandler interface for that can handle valid add pool Vm params
+type AddPoolVMHandler interface {
+	Handle(AddPoolVMParams, *models.User) middleware.Responder
+}
+
+// NewAddPoolVM creates a new http.Handler for the add pool Vm operation
+func NewAddPoolVM(ctx *middleware.Context, handler AddPoolVMHandler) *AddPoolVM {
+	return &AddPoolVM{Context: ctx, Handler: handler}
+}
+
+/*AddPoolVM swagger:route POST /pools/{pool}/vms vm addPoolVm
+
+Add a new vm to a pool
+
+*/
+type AddPoolVM struct {
+	Context *middleware.Context
+	Handler AddPoolVMHandler
+}
+
+func (o *AddPoolVM) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, _ := o.Context.RouteInfo(r)
+	var Params = NewAddPoolVMParams()
+
+	uprinc, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
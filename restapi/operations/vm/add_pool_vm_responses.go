@@ -0,0 +1,251 @@
+package vm
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/jianqiu/vps/models"
+)
+
+/*AddPoolVMOK add a new vm into the pool
+
+swagger:response addPoolVmOK
+*/
+type AddPoolVMOK struct {
+
+	// In: body
+	Payload string `json:"body,omitempty"`
+}
+
+// NewAddPoolVMOK creates AddPoolVMOK with default headers values
+func NewAddPoolVMOK() *AddPoolVMOK {
+	return &AddPoolVMOK{}
+}
+
+// WithPayload adds the payload to the add pool Vm o k response
+func (o *AddPoolVMOK) WithPayload(payload string) *AddPoolVMOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the add pool Vm o k response
+func (o *AddPoolVMOK) SetPayload(payload string) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AddPoolVMOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if err := producer.Produce(rw, o.Payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+
+}
+
+/*AddPoolVMBadRequest request is invalid
+
+swagger:response addPoolVmBadRequest
+*/
+type AddPoolVMBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewAddPoolVMBadRequest creates AddPoolVMBadRequest with default headers values
+func NewAddPoolVMBadRequest() *AddPoolVMBadRequest {
+	return &AddPoolVMBadRequest{}
+}
+
+// WithPayload adds the payload to the add pool Vm bad request response
+func (o *AddPoolVMBadRequest) WithPayload(payload *models.Error) *AddPoolVMBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the add pool Vm bad request response
+func (o *AddPoolVMBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AddPoolVMBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*AddPoolVMNotFound pool not found
+
+swagger:response addPoolVmNotFound
+*/
+type AddPoolVMNotFound struct {
+}
+
+// NewAddPoolVMNotFound creates AddPoolVMNotFound with default headers values
+func NewAddPoolVMNotFound() *AddPoolVMNotFound {
+	return &AddPoolVMNotFound{}
+}
+
+// WriteResponse to the client
+func (o *AddPoolVMNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+}
+
+/*AddPoolVMConflict request conflicts with the current state of the pool
+
+swagger:response addPoolVmConflict
+*/
+type AddPoolVMConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewAddPoolVMConflict creates AddPoolVMConflict with default headers values
+func NewAddPoolVMConflict() *AddPoolVMConflict {
+	return &AddPoolVMConflict{}
+}
+
+// WithPayload adds the payload to the add pool Vm conflict response
+func (o *AddPoolVMConflict) WithPayload(payload *models.Error) *AddPoolVMConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the add pool Vm conflict response
+func (o *AddPoolVMConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AddPoolVMConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*AddPoolVMServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response addPoolVmServiceUnavailable
+*/
+type AddPoolVMServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewAddPoolVMServiceUnavailable creates AddPoolVMServiceUnavailable with default headers values
+func NewAddPoolVMServiceUnavailable() *AddPoolVMServiceUnavailable {
+	return &AddPoolVMServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the add pool Vm service unavailable response
+func (o *AddPoolVMServiceUnavailable) WithRetryAfter(retryAfter int32) *AddPoolVMServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the add pool Vm service unavailable response
+func (o *AddPoolVMServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the add pool Vm service unavailable response
+func (o *AddPoolVMServiceUnavailable) WithPayload(payload *models.Error) *AddPoolVMServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the add pool Vm service unavailable response
+func (o *AddPoolVMServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AddPoolVMServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*AddPoolVMDefault unexpected error
+
+swagger:response addPoolVmDefault
+*/
+type AddPoolVMDefault struct {
+	_statusCode int
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewAddPoolVMDefault creates AddPoolVMDefault with default headers values
+func NewAddPoolVMDefault(code int) *AddPoolVMDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &AddPoolVMDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the add pool Vm default response
+func (o *AddPoolVMDefault) WithStatusCode(code int) *AddPoolVMDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the add pool Vm default response
+func (o *AddPoolVMDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the add pool Vm default response
+func (o *AddPoolVMDefault) WithPayload(payload *models.Error) *AddPoolVMDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the add pool Vm default response
+func (o *AddPoolVMDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AddPoolVMDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -4,6 +4,7 @@ package vm
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/go-openapi/runtime"
@@ -47,6 +48,135 @@ func (o *AddVMOK) WriteResponse(rw http.ResponseWriter, producer runtime.Produce
 
 }
 
+/*AddVMBadRequest request is invalid
+
+swagger:response addVmBadRequest
+*/
+type AddVMBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewAddVMBadRequest creates AddVMBadRequest with default headers values
+func NewAddVMBadRequest() *AddVMBadRequest {
+	return &AddVMBadRequest{}
+}
+
+// WithPayload adds the payload to the add Vm bad request response
+func (o *AddVMBadRequest) WithPayload(payload *models.Error) *AddVMBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the add Vm bad request response
+func (o *AddVMBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AddVMBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*AddVMConflict request conflicts with the current state of the pool
+
+swagger:response addVmConflict
+*/
+type AddVMConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewAddVMConflict creates AddVMConflict with default headers values
+func NewAddVMConflict() *AddVMConflict {
+	return &AddVMConflict{}
+}
+
+// WithPayload adds the payload to the add Vm conflict response
+func (o *AddVMConflict) WithPayload(payload *models.Error) *AddVMConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the add Vm conflict response
+func (o *AddVMConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AddVMConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*AddVMServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response addVmServiceUnavailable
+*/
+type AddVMServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewAddVMServiceUnavailable creates AddVMServiceUnavailable with default headers values
+func NewAddVMServiceUnavailable() *AddVMServiceUnavailable {
+	return &AddVMServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the add Vm service unavailable response
+func (o *AddVMServiceUnavailable) WithRetryAfter(retryAfter int32) *AddVMServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the add Vm service unavailable response
+func (o *AddVMServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the add Vm service unavailable response
+func (o *AddVMServiceUnavailable) WithPayload(payload *models.Error) *AddVMServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the add Vm service unavailable response
+func (o *AddVMServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AddVMServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 /*AddVMDefault unexpected error
 
 swagger:response addVmDefault
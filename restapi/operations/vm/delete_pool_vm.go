@@ -0,0 +1,64 @@
+package vm
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/models"
+)
+
+// DeletePoolVMHandlerFunc turns a function with the right signature into a delete pool Vm handler
+type DeletePoolVMHandlerFunc func(DeletePoolVMParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn DeletePoolVMHandlerFunc) Handle(params DeletePoolVMParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// DeletePoolVMHandler interface for that can handle valid delete pool Vm params
+type DeletePoolVMHandler interface {
+	Handle(DeletePoolVMParams, *models.User) middleware.Responder
+}
+
+// NewDeletePoolVM creates a new http.Handler for the delete pool Vm operation
+func NewDeletePoolVM(ctx *middleware.Context, handler DeletePoolVMHandler) *DeletePoolVM {
+	return &DeletePoolVM{Context: ctx, Handler: handler}
+}
+
+/*DeletePoolVM swagger:route DELETE /pools/{pool}/vms/{cid} vm deletePoolVm
+
+Deletes a vm from a pool
+
+*/
+type DeletePoolVM struct {
+	Context *middleware.Context
+	Handler DeletePoolVMHandler
+}
+
+func (o *DeletePoolVM) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, _ := o.Context.RouteInfo(r)
+	var Params = NewDeletePoolVMParams()
+
+	uprinc, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
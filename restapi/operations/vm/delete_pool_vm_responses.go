@@ -0,0 +1,251 @@
+package vm
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/jianqiu/vps/models"
+)
+
+/*DeletePoolVMNoContent vm removed successfully
+
+swagger:response deletePoolVmNoContent
+*/
+type DeletePoolVMNoContent struct {
+
+	// In: body
+	Payload string `json:"body,omitempty"`
+}
+
+// NewDeletePoolVMNoContent creates DeletePoolVMNoContent with default headers values
+func NewDeletePoolVMNoContent() *DeletePoolVMNoContent {
+	return &DeletePoolVMNoContent{}
+}
+
+// WithPayload adds the payload to the delete pool Vm no content response
+func (o *DeletePoolVMNoContent) WithPayload(payload string) *DeletePoolVMNoContent {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete pool Vm no content response
+func (o *DeletePoolVMNoContent) SetPayload(payload string) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeletePoolVMNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(204)
+	if err := producer.Produce(rw, o.Payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+
+}
+
+/*DeletePoolVMBadRequest request is invalid
+
+swagger:response deletePoolVmBadRequest
+*/
+type DeletePoolVMBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeletePoolVMBadRequest creates DeletePoolVMBadRequest with default headers values
+func NewDeletePoolVMBadRequest() *DeletePoolVMBadRequest {
+	return &DeletePoolVMBadRequest{}
+}
+
+// WithPayload adds the payload to the delete pool Vm bad request response
+func (o *DeletePoolVMBadRequest) WithPayload(payload *models.Error) *DeletePoolVMBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete pool Vm bad request response
+func (o *DeletePoolVMBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeletePoolVMBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*DeletePoolVMNotFound vm not found
+
+swagger:response deletePoolVmNotFound
+*/
+type DeletePoolVMNotFound struct {
+}
+
+// NewDeletePoolVMNotFound creates DeletePoolVMNotFound with default headers values
+func NewDeletePoolVMNotFound() *DeletePoolVMNotFound {
+	return &DeletePoolVMNotFound{}
+}
+
+// WriteResponse to the client
+func (o *DeletePoolVMNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+}
+
+/*DeletePoolVMConflict request conflicts with the current state of the pool
+
+swagger:response deletePoolVmConflict
+*/
+type DeletePoolVMConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeletePoolVMConflict creates DeletePoolVMConflict with default headers values
+func NewDeletePoolVMConflict() *DeletePoolVMConflict {
+	return &DeletePoolVMConflict{}
+}
+
+// WithPayload adds the payload to the delete pool Vm conflict response
+func (o *DeletePoolVMConflict) WithPayload(payload *models.Error) *DeletePoolVMConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete pool Vm conflict response
+func (o *DeletePoolVMConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeletePoolVMConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*DeletePoolVMServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response deletePoolVmServiceUnavailable
+*/
+type DeletePoolVMServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeletePoolVMServiceUnavailable creates DeletePoolVMServiceUnavailable with default headers values
+func NewDeletePoolVMServiceUnavailable() *DeletePoolVMServiceUnavailable {
+	return &DeletePoolVMServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the delete pool Vm service unavailable response
+func (o *DeletePoolVMServiceUnavailable) WithRetryAfter(retryAfter int32) *DeletePoolVMServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the delete pool Vm service unavailable response
+func (o *DeletePoolVMServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the delete pool Vm service unavailable response
+func (o *DeletePoolVMServiceUnavailable) WithPayload(payload *models.Error) *DeletePoolVMServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete pool Vm service unavailable response
+func (o *DeletePoolVMServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeletePoolVMServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*DeletePoolVMDefault unexpected error
+
+swagger:response deletePoolVmDefault
+*/
+type DeletePoolVMDefault struct {
+	_statusCode int
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeletePoolVMDefault creates DeletePoolVMDefault with default headers values
+func NewDeletePoolVMDefault(code int) *DeletePoolVMDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &DeletePoolVMDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the delete pool Vm default response
+func (o *DeletePoolVMDefault) WithStatusCode(code int) *DeletePoolVMDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the delete pool Vm default response
+func (o *DeletePoolVMDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the delete pool Vm default response
+func (o *DeletePoolVMDefault) WithPayload(payload *models.Error) *DeletePoolVMDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete pool Vm default response
+func (o *DeletePoolVMDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeletePoolVMDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -4,6 +4,7 @@ package vm
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/go-openapi/runtime"
@@ -47,6 +48,43 @@ func (o *DeleteVMNoContent) WriteResponse(rw http.ResponseWriter, producer runti
 
 }
 
+/*DeleteVMBadRequest request is invalid
+
+swagger:response deleteVmBadRequest
+*/
+type DeleteVMBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeleteVMBadRequest creates DeleteVMBadRequest with default headers values
+func NewDeleteVMBadRequest() *DeleteVMBadRequest {
+	return &DeleteVMBadRequest{}
+}
+
+// WithPayload adds the payload to the delete Vm bad request response
+func (o *DeleteVMBadRequest) WithPayload(payload *models.Error) *DeleteVMBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete Vm bad request response
+func (o *DeleteVMBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteVMBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 /*DeleteVMNotFound vm not found
 
 swagger:response deleteVmNotFound
@@ -65,6 +103,98 @@ func (o *DeleteVMNotFound) WriteResponse(rw http.ResponseWriter, producer runtim
 	rw.WriteHeader(404)
 }
 
+/*DeleteVMConflict request conflicts with the current state of the pool
+
+swagger:response deleteVmConflict
+*/
+type DeleteVMConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeleteVMConflict creates DeleteVMConflict with default headers values
+func NewDeleteVMConflict() *DeleteVMConflict {
+	return &DeleteVMConflict{}
+}
+
+// WithPayload adds the payload to the delete Vm conflict response
+func (o *DeleteVMConflict) WithPayload(payload *models.Error) *DeleteVMConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete Vm conflict response
+func (o *DeleteVMConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteVMConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*DeleteVMServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response deleteVmServiceUnavailable
+*/
+type DeleteVMServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeleteVMServiceUnavailable creates DeleteVMServiceUnavailable with default headers values
+func NewDeleteVMServiceUnavailable() *DeleteVMServiceUnavailable {
+	return &DeleteVMServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the delete Vm service unavailable response
+func (o *DeleteVMServiceUnavailable) WithRetryAfter(retryAfter int32) *DeleteVMServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the delete Vm service unavailable response
+func (o *DeleteVMServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the delete Vm service unavailable response
+func (o *DeleteVMServiceUnavailable) WithPayload(payload *models.Error) *DeleteVMServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete Vm service unavailable response
+func (o *DeleteVMServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteVMServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 /*DeleteVMDefault unexpected error
 
 swagger:response deleteVmDefault
@@ -0,0 +1,66 @@
+package vm
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/models"
+)
+
+// DeleteVMSecretHandlerFunc turns a function with the right signature into a delete Vm secret handler
+type DeleteVMSecretHandlerFunc func(DeleteVMSecretParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn DeleteVMSecretHandlerFunc) Handle(params DeleteVMSecretParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// DeleteVMSecretHandler interface for that can handle valid delete Vm secret params
+type DeleteVMSecretHandler interface {
+	Handle(DeleteVMSecretParams, *models.User) middleware.Responder
+}
+
+// NewDeleteVMSecret creates a new http.Handler for the delete Vm secret operation
+func NewDeleteVMSecret(ctx *middleware.Context, handler DeleteVMSecretHandler) *DeleteVMSecret {
+	return &DeleteVMSecret{Context: ctx, Handler: handler}
+}
+
+/*DeleteVMSecret swagger:route DELETE /vms/{cid}/secret vm deleteVmSecret
+
+Forgets the root credentials and ssh keys of a vm
+
+Only the administrator may remove secrets.
+
+*/
+type DeleteVMSecret struct {
+	Context *middleware.Context
+	Handler DeleteVMSecretHandler
+}
+
+func (o *DeleteVMSecret) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, _ := o.Context.RouteInfo(r)
+	var Params = NewDeleteVMSecretParams()
+
+	uprinc, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,233 @@
+package vm
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/jianqiu/vps/models"
+)
+
+/*DeleteVMSecretNoContent secret removed successfully
+
+swagger:response deleteVmSecretNoContent
+*/
+type DeleteVMSecretNoContent struct {
+}
+
+// NewDeleteVMSecretNoContent creates DeleteVMSecretNoContent with default headers values
+func NewDeleteVMSecretNoContent() *DeleteVMSecretNoContent {
+	return &DeleteVMSecretNoContent{}
+}
+
+// WriteResponse to the client
+func (o *DeleteVMSecretNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(204)
+}
+
+/*DeleteVMSecretBadRequest request is invalid
+
+swagger:response deleteVmSecretBadRequest
+*/
+type DeleteVMSecretBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeleteVMSecretBadRequest creates DeleteVMSecretBadRequest with default headers values
+func NewDeleteVMSecretBadRequest() *DeleteVMSecretBadRequest {
+	return &DeleteVMSecretBadRequest{}
+}
+
+// WithPayload adds the payload to the delete Vm secret bad request response
+func (o *DeleteVMSecretBadRequest) WithPayload(payload *models.Error) *DeleteVMSecretBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete Vm secret bad request response
+func (o *DeleteVMSecretBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteVMSecretBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*DeleteVMSecretNotFound vm not found
+
+swagger:response deleteVmSecretNotFound
+*/
+type DeleteVMSecretNotFound struct {
+}
+
+// NewDeleteVMSecretNotFound creates DeleteVMSecretNotFound with default headers values
+func NewDeleteVMSecretNotFound() *DeleteVMSecretNotFound {
+	return &DeleteVMSecretNotFound{}
+}
+
+// WriteResponse to the client
+func (o *DeleteVMSecretNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+}
+
+/*DeleteVMSecretConflict request conflicts with the current state of the pool
+
+swagger:response deleteVmSecretConflict
+*/
+type DeleteVMSecretConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeleteVMSecretConflict creates DeleteVMSecretConflict with default headers values
+func NewDeleteVMSecretConflict() *DeleteVMSecretConflict {
+	return &DeleteVMSecretConflict{}
+}
+
+// WithPayload adds the payload to the delete Vm secret conflict response
+func (o *DeleteVMSecretConflict) WithPayload(payload *models.Error) *DeleteVMSecretConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete Vm secret conflict response
+func (o *DeleteVMSecretConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteVMSecretConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*DeleteVMSecretServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response deleteVmSecretServiceUnavailable
+*/
+type DeleteVMSecretServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeleteVMSecretServiceUnavailable creates DeleteVMSecretServiceUnavailable with default headers values
+func NewDeleteVMSecretServiceUnavailable() *DeleteVMSecretServiceUnavailable {
+	return &DeleteVMSecretServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the delete Vm secret service unavailable response
+func (o *DeleteVMSecretServiceUnavailable) WithRetryAfter(retryAfter int32) *DeleteVMSecretServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the delete Vm secret service unavailable response
+func (o *DeleteVMSecretServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the delete Vm secret service unavailable response
+func (o *DeleteVMSecretServiceUnavailable) WithPayload(payload *models.Error) *DeleteVMSecretServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete Vm secret service unavailable response
+func (o *DeleteVMSecretServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteVMSecretServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*DeleteVMSecretDefault unexpected error
+
+swagger:response deleteVmSecretDefault
+*/
+type DeleteVMSecretDefault struct {
+	_statusCode int
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeleteVMSecretDefault creates DeleteVMSecretDefault with default headers values
+func NewDeleteVMSecretDefault(code int) *DeleteVMSecretDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &DeleteVMSecretDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the delete Vm secret default response
+func (o *DeleteVMSecretDefault) WithStatusCode(code int) *DeleteVMSecretDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the delete Vm secret default response
+func (o *DeleteVMSecretDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the delete Vm secret default response
+func (o *DeleteVMSecretDefault) WithPayload(payload *models.Error) *DeleteVMSecretDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete Vm secret default response
+func (o *DeleteVMSecretDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteVMSecretDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package vm
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/models"
+)
+
+// FindPoolVmsByFiltersHandlerFunc turns a function with the right signature into a find pool vms by filters handler
+type FindPoolVmsByFiltersHandlerFunc func(FindPoolVmsByFiltersParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn FindPoolVmsByFiltersHandlerFunc) Handle(params FindPoolVmsByFiltersParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// FindPoolVmsByFiltersHandler interface for that can handle valid find pool vms by filters params
+type FindPoolVmsByFiltersHandler interface {
+	Handle(FindPoolVmsByFiltersParams, *models.User) middleware.Responder
+}
+
+// NewFindPoolVmsByFilters creates a new http.Handler for the find pool vms by filters operation
+func NewFindPoolVmsByFilters(ctx *middleware.Context, handler FindPoolVmsByFiltersHandler) *FindPoolVmsByFilters {
+	return &FindPoolVmsByFilters{Context: ctx, Handler: handler}
+}
+
+/*FindPoolVmsByFilters swagger:route POST /pools/{pool}/vms/findByFilters vm findPoolVmsByFilters
+
+Find vms of a pool by filters (cpu, memory, private_vlan, public_vlan, state)
+
+*/
+type FindPoolVmsByFilters struct {
+	Context *middleware.Context
+	Handler FindPoolVmsByFiltersHandler
+}
+
+func (o *FindPoolVmsByFilters) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, _ := o.Context.RouteInfo(r)
+	var Params = NewFindPoolVmsByFiltersParams()
+
+	uprinc, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
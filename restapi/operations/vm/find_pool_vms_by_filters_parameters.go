@@ -0,0 +1,88 @@
+package vm
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/jianqiu/vps/models"
+)
+
+// NewFindPoolVmsByFiltersParams creates a new FindPoolVmsByFiltersParams object
+// with the default values initialized.
+func NewFindPoolVmsByFiltersParams() FindPoolVmsByFiltersParams {
+	var ()
+	return FindPoolVmsByFiltersParams{}
+}
+
+// FindPoolVmsByFiltersParams contains all the bound params for the find pool vms by filters operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters findPoolVmsByFilters
+type FindPoolVmsByFiltersParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request
+
+	/*vm filters to search for
+	  In: body
+	*/
+	Body *models.VMFilter
+	/*name of the pool
+	  Required: true
+	  In: path
+	*/
+	Pool string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls
+func (o *FindPoolVmsByFiltersParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+	o.HTTPRequest = r
+
+	if runtime.HasBody(r) {
+		defer r.Body.Close()
+		var body models.VMFilter
+		if err := route.Consumer.Consume(r.Body, &body); err != nil {
+			res = append(res, errors.NewParseError("body", "body", "", err))
+		} else {
+			if err := body.Validate(route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			if len(res) == 0 {
+				o.Body = &body
+			}
+		}
+
+	}
+
+	rPool, rhkPool, _ := route.Params.GetOK("pool")
+	if err := o.bindPool(rPool, rhkPool, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *FindPoolVmsByFiltersParams) bindPool(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	o.Pool = raw
+
+	return nil
+}
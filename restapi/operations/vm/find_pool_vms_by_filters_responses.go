@@ -0,0 +1,252 @@
+package vm
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/jianqiu/vps/models"
+)
+
+/*FindPoolVmsByFiltersOK successful operation
+
+swagger:response findPoolVmsByFiltersOK
+*/
+type FindPoolVmsByFiltersOK struct {
+
+	// In: body
+	Payload *models.VmsResponse `json:"body,omitempty"`
+}
+
+// NewFindPoolVmsByFiltersOK creates FindPoolVmsByFiltersOK with default headers values
+func NewFindPoolVmsByFiltersOK() *FindPoolVmsByFiltersOK {
+	return &FindPoolVmsByFiltersOK{}
+}
+
+// WithPayload adds the payload to the find pool vms by filters o k response
+func (o *FindPoolVmsByFiltersOK) WithPayload(payload *models.VmsResponse) *FindPoolVmsByFiltersOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the find pool vms by filters o k response
+func (o *FindPoolVmsByFiltersOK) SetPayload(payload *models.VmsResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *FindPoolVmsByFiltersOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*FindPoolVmsByFiltersBadRequest request is invalid
+
+swagger:response findPoolVmsByFiltersBadRequest
+*/
+type FindPoolVmsByFiltersBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewFindPoolVmsByFiltersBadRequest creates FindPoolVmsByFiltersBadRequest with default headers values
+func NewFindPoolVmsByFiltersBadRequest() *FindPoolVmsByFiltersBadRequest {
+	return &FindPoolVmsByFiltersBadRequest{}
+}
+
+// WithPayload adds the payload to the find pool vms by filters bad request response
+func (o *FindPoolVmsByFiltersBadRequest) WithPayload(payload *models.Error) *FindPoolVmsByFiltersBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the find pool vms by filters bad request response
+func (o *FindPoolVmsByFiltersBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *FindPoolVmsByFiltersBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*FindPoolVmsByFiltersNotFound pool not found
+
+swagger:response findPoolVmsByFiltersNotFound
+*/
+type FindPoolVmsByFiltersNotFound struct {
+}
+
+// NewFindPoolVmsByFiltersNotFound creates FindPoolVmsByFiltersNotFound with default headers values
+func NewFindPoolVmsByFiltersNotFound() *FindPoolVmsByFiltersNotFound {
+	return &FindPoolVmsByFiltersNotFound{}
+}
+
+// WriteResponse to the client
+func (o *FindPoolVmsByFiltersNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+}
+
+/*FindPoolVmsByFiltersConflict request conflicts with the current state of the pool
+
+swagger:response findPoolVmsByFiltersConflict
+*/
+type FindPoolVmsByFiltersConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewFindPoolVmsByFiltersConflict creates FindPoolVmsByFiltersConflict with default headers values
+func NewFindPoolVmsByFiltersConflict() *FindPoolVmsByFiltersConflict {
+	return &FindPoolVmsByFiltersConflict{}
+}
+
+// WithPayload adds the payload to the find pool vms by filters conflict response
+func (o *FindPoolVmsByFiltersConflict) WithPayload(payload *models.Error) *FindPoolVmsByFiltersConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the find pool vms by filters conflict response
+func (o *FindPoolVmsByFiltersConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *FindPoolVmsByFiltersConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*FindPoolVmsByFiltersServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response findPoolVmsByFiltersServiceUnavailable
+*/
+type FindPoolVmsByFiltersServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewFindPoolVmsByFiltersServiceUnavailable creates FindPoolVmsByFiltersServiceUnavailable with default headers values
+func NewFindPoolVmsByFiltersServiceUnavailable() *FindPoolVmsByFiltersServiceUnavailable {
+	return &FindPoolVmsByFiltersServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the find pool vms by filters service unavailable response
+func (o *FindPoolVmsByFiltersServiceUnavailable) WithRetryAfter(retryAfter int32) *FindPoolVmsByFiltersServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the find pool vms by filters service unavailable response
+func (o *FindPoolVmsByFiltersServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the find pool vms by filters service unavailable response
+func (o *FindPoolVmsByFiltersServiceUnavailable) WithPayload(payload *models.Error) *FindPoolVmsByFiltersServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the find pool vms by filters service unavailable response
+func (o *FindPoolVmsByFiltersServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *FindPoolVmsByFiltersServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*FindPoolVmsByFiltersDefault unexpected error
+
+swagger:response findPoolVmsByFiltersDefault
+*/
+type FindPoolVmsByFiltersDefault struct {
+	_statusCode int
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewFindPoolVmsByFiltersDefault creates FindPoolVmsByFiltersDefault with default headers values
+func NewFindPoolVmsByFiltersDefault(code int) *FindPoolVmsByFiltersDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &FindPoolVmsByFiltersDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the find pool vms by filters default response
+func (o *FindPoolVmsByFiltersDefault) WithStatusCode(code int) *FindPoolVmsByFiltersDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the find pool vms by filters default response
+func (o *FindPoolVmsByFiltersDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the find pool vms by filters default response
+func (o *FindPoolVmsByFiltersDefault) WithPayload(payload *models.Error) *FindPoolVmsByFiltersDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the find pool vms by filters default response
+func (o *FindPoolVmsByFiltersDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *FindPoolVmsByFiltersDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -4,6 +4,7 @@ package vm
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/go-openapi/runtime"
@@ -48,6 +49,43 @@ func (o *FindVmsByDeploymentOK) WriteResponse(rw http.ResponseWriter, producer r
 	}
 }
 
+/*FindVmsByDeploymentBadRequest request is invalid
+
+swagger:response findVmsByDeploymentBadRequest
+*/
+type FindVmsByDeploymentBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewFindVmsByDeploymentBadRequest creates FindVmsByDeploymentBadRequest with default headers values
+func NewFindVmsByDeploymentBadRequest() *FindVmsByDeploymentBadRequest {
+	return &FindVmsByDeploymentBadRequest{}
+}
+
+// WithPayload adds the payload to the find vms by deployment bad request response
+func (o *FindVmsByDeploymentBadRequest) WithPayload(payload *models.Error) *FindVmsByDeploymentBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the find vms by deployment bad request response
+func (o *FindVmsByDeploymentBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *FindVmsByDeploymentBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 /*FindVmsByDeploymentNotFound vm not found
 
 swagger:response findVmsByDeploymentNotFound
@@ -66,6 +104,98 @@ func (o *FindVmsByDeploymentNotFound) WriteResponse(rw http.ResponseWriter, prod
 	rw.WriteHeader(404)
 }
 
+/*FindVmsByDeploymentConflict request conflicts with the current state of the pool
+
+swagger:response findVmsByDeploymentConflict
+*/
+type FindVmsByDeploymentConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewFindVmsByDeploymentConflict creates FindVmsByDeploymentConflict with default headers values
+func NewFindVmsByDeploymentConflict() *FindVmsByDeploymentConflict {
+	return &FindVmsByDeploymentConflict{}
+}
+
+// WithPayload adds the payload to the find vms by deployment conflict response
+func (o *FindVmsByDeploymentConflict) WithPayload(payload *models.Error) *FindVmsByDeploymentConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the find vms by deployment conflict response
+func (o *FindVmsByDeploymentConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *FindVmsByDeploymentConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*FindVmsByDeploymentServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response findVmsByDeploymentServiceUnavailable
+*/
+type FindVmsByDeploymentServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewFindVmsByDeploymentServiceUnavailable creates FindVmsByDeploymentServiceUnavailable with default headers values
+func NewFindVmsByDeploymentServiceUnavailable() *FindVmsByDeploymentServiceUnavailable {
+	return &FindVmsByDeploymentServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the find vms by deployment service unavailable response
+func (o *FindVmsByDeploymentServiceUnavailable) WithRetryAfter(retryAfter int32) *FindVmsByDeploymentServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the find vms by deployment service unavailable response
+func (o *FindVmsByDeploymentServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the find vms by deployment service unavailable response
+func (o *FindVmsByDeploymentServiceUnavailable) WithPayload(payload *models.Error) *FindVmsByDeploymentServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the find vms by deployment service unavailable response
+func (o *FindVmsByDeploymentServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *FindVmsByDeploymentServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 /*FindVmsByDeploymentDefault unexpected error
 
 swagger:response findVmsByDeploymentDefault
@@ -4,6 +4,7 @@ package vm
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/go-openapi/runtime"
@@ -48,6 +49,43 @@ func (o *FindVmsByFiltersOK) WriteResponse(rw http.ResponseWriter, producer runt
 	}
 }
 
+/*FindVmsByFiltersBadRequest request is invalid
+
+swagger:response findVmsByFiltersBadRequest
+*/
+type FindVmsByFiltersBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewFindVmsByFiltersBadRequest creates FindVmsByFiltersBadRequest with default headers values
+func NewFindVmsByFiltersBadRequest() *FindVmsByFiltersBadRequest {
+	return &FindVmsByFiltersBadRequest{}
+}
+
+// WithPayload adds the payload to the find vms by filters bad request response
+func (o *FindVmsByFiltersBadRequest) WithPayload(payload *models.Error) *FindVmsByFiltersBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the find vms by filters bad request response
+func (o *FindVmsByFiltersBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *FindVmsByFiltersBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 /*FindVmsByFiltersNotFound vm not found
 
 swagger:response findVmsByFiltersNotFound
@@ -66,6 +104,98 @@ func (o *FindVmsByFiltersNotFound) WriteResponse(rw http.ResponseWriter, produce
 	rw.WriteHeader(404)
 }
 
+/*FindVmsByFiltersConflict request conflicts with the current state of the pool
+
+swagger:response findVmsByFiltersConflict
+*/
+type FindVmsByFiltersConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewFindVmsByFiltersConflict creates FindVmsByFiltersConflict with default headers values
+func NewFindVmsByFiltersConflict() *FindVmsByFiltersConflict {
+	return &FindVmsByFiltersConflict{}
+}
+
+// WithPayload adds the payload to the find vms by filters conflict response
+func (o *FindVmsByFiltersConflict) WithPayload(payload *models.Error) *FindVmsByFiltersConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the find vms by filters conflict response
+func (o *FindVmsByFiltersConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *FindVmsByFiltersConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*FindVmsByFiltersServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response findVmsByFiltersServiceUnavailable
+*/
+type FindVmsByFiltersServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewFindVmsByFiltersServiceUnavailable creates FindVmsByFiltersServiceUnavailable with default headers values
+func NewFindVmsByFiltersServiceUnavailable() *FindVmsByFiltersServiceUnavailable {
+	return &FindVmsByFiltersServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the find vms by filters service unavailable response
+func (o *FindVmsByFiltersServiceUnavailable) WithRetryAfter(retryAfter int32) *FindVmsByFiltersServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the find vms by filters service unavailable response
+func (o *FindVmsByFiltersServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the find vms by filters service unavailable response
+func (o *FindVmsByFiltersServiceUnavailable) WithPayload(payload *models.Error) *FindVmsByFiltersServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the find vms by filters service unavailable response
+func (o *FindVmsByFiltersServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *FindVmsByFiltersServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 /*FindVmsByFiltersDefault unexpected error
 
 swagger:response findVmsByFiltersDefault
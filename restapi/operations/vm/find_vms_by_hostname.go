@@ -0,0 +1,66 @@
+package vm
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/models"
+)
+
+// FindVmsByHostnameHandlerFunc turns a function with the right signature into a find vms by hostname handler
+type FindVmsByHostnameHandlerFunc func(FindVmsByHostnameParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn FindVmsByHostnameHandlerFunc) Handle(params FindVmsByHostnameParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// FindVmsByHostnameHandler interface for that can handle valid find vms by hostname params
+type FindVmsByHostnameHandler interface {
+	Handle(FindVmsByHostnameParams, *models.User) middleware.Responder
+}
+
+// NewFindVmsByHostname creates a new http.Handler for the find vms by hostname operation
+func NewFindVmsByHostname(ctx *middleware.Context, handler FindVmsByHostnameHandler) *FindVmsByHostname {
+	return &FindVmsByHostname{Context: ctx, Handler: handler}
+}
+
+/*FindVmsByHostname swagger:route GET /vms/findByHostname vm findVmsByHostname
+
+Finds Vms by hostname
+
+The pattern matches hostnames exactly, a * in it matches any run of characters, e.g. web-* for a prefix search
+
+*/
+type FindVmsByHostname struct {
+	Context *middleware.Context
+	Handler FindVmsByHostnameHandler
+}
+
+func (o *FindVmsByHostname) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, _ := o.Context.RouteInfo(r)
+	var Params = NewFindVmsByHostnameParams()
+
+	uprinc, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,74 @@
+package vm
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/validate"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewFindVmsByHostnameParams creates a new FindVmsByHostnameParams object
+// with the default values initialized.
+func NewFindVmsByHostnameParams() FindVmsByHostnameParams {
+	var ()
+	return FindVmsByHostnameParams{}
+}
+
+// FindVmsByHostnameParams contains all the bound params for the find vms by hostname operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters findVmsByHostname
+type FindVmsByHostnameParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request
+
+	/*hostname or hostname pattern to search for
+	  Required: true
+	  In: query
+	*/
+	Pattern string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls
+func (o *FindVmsByHostnameParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	qPattern, qhkPattern, _ := qs.GetOK("pattern")
+	if err := o.bindPattern(qPattern, qhkPattern, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *FindVmsByHostnameParams) bindPattern(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	if !hasKey {
+		return errors.Required("pattern", "query")
+	}
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+	if err := validate.RequiredString("pattern", "query", raw); err != nil {
+		return err
+	}
+
+	o.Pattern = raw
+
+	return nil
+}
@@ -0,0 +1,234 @@
+package vm
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/jianqiu/vps/models"
+)
+
+/*FindVmsByHostnameOK successful operation
+
+swagger:response findVmsByHostnameOK
+*/
+type FindVmsByHostnameOK struct {
+
+	// In: body
+	Payload *models.VmsResponse `json:"body,omitempty"`
+}
+
+// NewFindVmsByHostnameOK creates FindVmsByHostnameOK with default headers values
+func NewFindVmsByHostnameOK() *FindVmsByHostnameOK {
+	return &FindVmsByHostnameOK{}
+}
+
+// WithPayload adds the payload to the find vms by hostname o k response
+func (o *FindVmsByHostnameOK) WithPayload(payload *models.VmsResponse) *FindVmsByHostnameOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the find vms by hostname o k response
+func (o *FindVmsByHostnameOK) SetPayload(payload *models.VmsResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *FindVmsByHostnameOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*FindVmsByHostnameBadRequest request is invalid
+
+swagger:response findVmsByHostnameBadRequest
+*/
+type FindVmsByHostnameBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewFindVmsByHostnameBadRequest creates FindVmsByHostnameBadRequest with default headers values
+func NewFindVmsByHostnameBadRequest() *FindVmsByHostnameBadRequest {
+	return &FindVmsByHostnameBadRequest{}
+}
+
+// WithPayload adds the payload to the find vms by hostname bad request response
+func (o *FindVmsByHostnameBadRequest) WithPayload(payload *models.Error) *FindVmsByHostnameBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the find vms by hostname bad request response
+func (o *FindVmsByHostnameBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *FindVmsByHostnameBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*FindVmsByHostnameConflict request conflicts with the current state of the pool
+
+swagger:response findVmsByHostnameConflict
+*/
+type FindVmsByHostnameConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewFindVmsByHostnameConflict creates FindVmsByHostnameConflict with default headers values
+func NewFindVmsByHostnameConflict() *FindVmsByHostnameConflict {
+	return &FindVmsByHostnameConflict{}
+}
+
+// WithPayload adds the payload to the find vms by hostname conflict response
+func (o *FindVmsByHostnameConflict) WithPayload(payload *models.Error) *FindVmsByHostnameConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the find vms by hostname conflict response
+func (o *FindVmsByHostnameConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *FindVmsByHostnameConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*FindVmsByHostnameServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response findVmsByHostnameServiceUnavailable
+*/
+type FindVmsByHostnameServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewFindVmsByHostnameServiceUnavailable creates FindVmsByHostnameServiceUnavailable with default headers values
+func NewFindVmsByHostnameServiceUnavailable() *FindVmsByHostnameServiceUnavailable {
+	return &FindVmsByHostnameServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the find vms by hostname service unavailable response
+func (o *FindVmsByHostnameServiceUnavailable) WithRetryAfter(retryAfter int32) *FindVmsByHostnameServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the find vms by hostname service unavailable response
+func (o *FindVmsByHostnameServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the find vms by hostname service unavailable response
+func (o *FindVmsByHostnameServiceUnavailable) WithPayload(payload *models.Error) *FindVmsByHostnameServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the find vms by hostname service unavailable response
+func (o *FindVmsByHostnameServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *FindVmsByHostnameServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*FindVmsByHostnameDefault unexpected error
+
+swagger:response findVmsByHostnameDefault
+*/
+type FindVmsByHostnameDefault struct {
+	_statusCode int
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewFindVmsByHostnameDefault creates FindVmsByHostnameDefault with default headers values
+func NewFindVmsByHostnameDefault(code int) *FindVmsByHostnameDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &FindVmsByHostnameDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the find vms by hostname default response
+func (o *FindVmsByHostnameDefault) WithStatusCode(code int) *FindVmsByHostnameDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the find vms by hostname default response
+func (o *FindVmsByHostnameDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the find vms by hostname default response
+func (o *FindVmsByHostnameDefault) WithPayload(payload *models.Error) *FindVmsByHostnameDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the find vms by hostname default response
+func (o *FindVmsByHostnameDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *FindVmsByHostnameDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,17 @@
+package vm
+
+import (
+	"github.com/jianqiu/vps/models"
+)
+
+func (o *FindVmsByHostnameOK) GetPayload() *models.VmsResponse {
+	return o.Payload
+}
+
+func (o *FindVmsByHostnameDefault) GetStatusCode() int {
+	return o._statusCode
+}
+
+func (o *FindVmsByHostnameDefault) GetPayload() *models.Error {
+	return o.Payload
+}
@@ -4,6 +4,7 @@ package vm
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/go-openapi/runtime"
@@ -48,6 +49,43 @@ func (o *FindVmsByStatesOK) WriteResponse(rw http.ResponseWriter, producer runti
 	}
 }
 
+/*FindVmsByStatesBadRequest request is invalid
+
+swagger:response findVmsByStatesBadRequest
+*/
+type FindVmsByStatesBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewFindVmsByStatesBadRequest creates FindVmsByStatesBadRequest with default headers values
+func NewFindVmsByStatesBadRequest() *FindVmsByStatesBadRequest {
+	return &FindVmsByStatesBadRequest{}
+}
+
+// WithPayload adds the payload to the find vms by states bad request response
+func (o *FindVmsByStatesBadRequest) WithPayload(payload *models.Error) *FindVmsByStatesBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the find vms by states bad request response
+func (o *FindVmsByStatesBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *FindVmsByStatesBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 /*FindVmsByStatesNotFound vm not found
 
 swagger:response findVmsByStatesNotFound
@@ -66,6 +104,98 @@ func (o *FindVmsByStatesNotFound) WriteResponse(rw http.ResponseWriter, producer
 	rw.WriteHeader(404)
 }
 
+/*FindVmsByStatesConflict request conflicts with the current state of the pool
+
+swagger:response findVmsByStatesConflict
+*/
+type FindVmsByStatesConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewFindVmsByStatesConflict creates FindVmsByStatesConflict with default headers values
+func NewFindVmsByStatesConflict() *FindVmsByStatesConflict {
+	return &FindVmsByStatesConflict{}
+}
+
+// WithPayload adds the payload to the find vms by states conflict response
+func (o *FindVmsByStatesConflict) WithPayload(payload *models.Error) *FindVmsByStatesConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the find vms by states conflict response
+func (o *FindVmsByStatesConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *FindVmsByStatesConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*FindVmsByStatesServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response findVmsByStatesServiceUnavailable
+*/
+type FindVmsByStatesServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewFindVmsByStatesServiceUnavailable creates FindVmsByStatesServiceUnavailable with default headers values
+func NewFindVmsByStatesServiceUnavailable() *FindVmsByStatesServiceUnavailable {
+	return &FindVmsByStatesServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the find vms by states service unavailable response
+func (o *FindVmsByStatesServiceUnavailable) WithRetryAfter(retryAfter int32) *FindVmsByStatesServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the find vms by states service unavailable response
+func (o *FindVmsByStatesServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the find vms by states service unavailable response
+func (o *FindVmsByStatesServiceUnavailable) WithPayload(payload *models.Error) *FindVmsByStatesServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the find vms by states service unavailable response
+func (o *FindVmsByStatesServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *FindVmsByStatesServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 /*FindVmsByStatesDefault unexpected error
 
 swagger:response findVmsByStatesDefault
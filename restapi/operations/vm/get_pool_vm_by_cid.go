@@ -0,0 +1,64 @@
+package vm
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/models"
+)
+
+// GetPoolVMByCidHandlerFunc turns a function with the right signature into a get pool Vm by cid handler
+type GetPoolVMByCidHandlerFunc func(GetPoolVMByCidParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetPoolVMByCidHandlerFunc) Handle(params GetPoolVMByCidParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// GetPoolVMByCidHandler interface for that can handle valid get pool Vm by cid params
+type GetPoolVMByCidHandler interface {
+	Handle(GetPoolVMByCidParams, *models.User) middleware.Responder
+}
+
+// NewGetPoolVMByCid creates a new http.Handler for the get pool Vm by cid operation
+func NewGetPoolVMByCid(ctx *middleware.Context, handler GetPoolVMByCidHandler) *GetPoolVMByCid {
+	return &GetPoolVMByCid{Context: ctx, Handler: handler}
+}
+
+/*GetPoolVMByCid swagger:route GET /pools/{pool}/vms/{cid} vm getPoolVmByCid
+
+Find vm of a pool by ID
+
+*/
+type GetPoolVMByCid struct {
+	Context *middleware.Context
+	Handler GetPoolVMByCidHandler
+}
+
+func (o *GetPoolVMByCid) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, _ := o.Context.RouteInfo(r)
+	var Params = NewGetPoolVMByCidParams()
+
+	uprinc, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
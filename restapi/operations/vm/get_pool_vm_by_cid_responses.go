@@ -0,0 +1,252 @@
+package vm
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/jianqiu/vps/models"
+)
+
+/*GetPoolVMByCidOK successful operation
+
+swagger:response getPoolVmByCidOK
+*/
+type GetPoolVMByCidOK struct {
+
+	// In: body
+	Payload *models.VMResponse `json:"body,omitempty"`
+}
+
+// NewGetPoolVMByCidOK creates GetPoolVMByCidOK with default headers values
+func NewGetPoolVMByCidOK() *GetPoolVMByCidOK {
+	return &GetPoolVMByCidOK{}
+}
+
+// WithPayload adds the payload to the get pool Vm by cid o k response
+func (o *GetPoolVMByCidOK) WithPayload(payload *models.VMResponse) *GetPoolVMByCidOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get pool Vm by cid o k response
+func (o *GetPoolVMByCidOK) SetPayload(payload *models.VMResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetPoolVMByCidOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetPoolVMByCidBadRequest request is invalid
+
+swagger:response getPoolVmByCidBadRequest
+*/
+type GetPoolVMByCidBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetPoolVMByCidBadRequest creates GetPoolVMByCidBadRequest with default headers values
+func NewGetPoolVMByCidBadRequest() *GetPoolVMByCidBadRequest {
+	return &GetPoolVMByCidBadRequest{}
+}
+
+// WithPayload adds the payload to the get pool Vm by cid bad request response
+func (o *GetPoolVMByCidBadRequest) WithPayload(payload *models.Error) *GetPoolVMByCidBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get pool Vm by cid bad request response
+func (o *GetPoolVMByCidBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetPoolVMByCidBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetPoolVMByCidNotFound vm not found
+
+swagger:response getPoolVmByCidNotFound
+*/
+type GetPoolVMByCidNotFound struct {
+}
+
+// NewGetPoolVMByCidNotFound creates GetPoolVMByCidNotFound with default headers values
+func NewGetPoolVMByCidNotFound() *GetPoolVMByCidNotFound {
+	return &GetPoolVMByCidNotFound{}
+}
+
+// WriteResponse to the client
+func (o *GetPoolVMByCidNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+}
+
+/*GetPoolVMByCidConflict request conflicts with the current state of the pool
+
+swagger:response getPoolVmByCidConflict
+*/
+type GetPoolVMByCidConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetPoolVMByCidConflict creates GetPoolVMByCidConflict with default headers values
+func NewGetPoolVMByCidConflict() *GetPoolVMByCidConflict {
+	return &GetPoolVMByCidConflict{}
+}
+
+// WithPayload adds the payload to the get pool Vm by cid conflict response
+func (o *GetPoolVMByCidConflict) WithPayload(payload *models.Error) *GetPoolVMByCidConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get pool Vm by cid conflict response
+func (o *GetPoolVMByCidConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetPoolVMByCidConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetPoolVMByCidServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response getPoolVmByCidServiceUnavailable
+*/
+type GetPoolVMByCidServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetPoolVMByCidServiceUnavailable creates GetPoolVMByCidServiceUnavailable with default headers values
+func NewGetPoolVMByCidServiceUnavailable() *GetPoolVMByCidServiceUnavailable {
+	return &GetPoolVMByCidServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the get pool Vm by cid service unavailable response
+func (o *GetPoolVMByCidServiceUnavailable) WithRetryAfter(retryAfter int32) *GetPoolVMByCidServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the get pool Vm by cid service unavailable response
+func (o *GetPoolVMByCidServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the get pool Vm by cid service unavailable response
+func (o *GetPoolVMByCidServiceUnavailable) WithPayload(payload *models.Error) *GetPoolVMByCidServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get pool Vm by cid service unavailable response
+func (o *GetPoolVMByCidServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetPoolVMByCidServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetPoolVMByCidDefault unexpected error
+
+swagger:response getPoolVmByCidDefault
+*/
+type GetPoolVMByCidDefault struct {
+	_statusCode int
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetPoolVMByCidDefault creates GetPoolVMByCidDefault with default headers values
+func NewGetPoolVMByCidDefault(code int) *GetPoolVMByCidDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetPoolVMByCidDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get pool Vm by cid default response
+func (o *GetPoolVMByCidDefault) WithStatusCode(code int) *GetPoolVMByCidDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get pool Vm by cid default response
+func (o *GetPoolVMByCidDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get pool Vm by cid default response
+func (o *GetPoolVMByCidDefault) WithPayload(payload *models.Error) *GetPoolVMByCidDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get pool Vm by cid default response
+func (o *GetPoolVMByCidDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetPoolVMByCidDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -4,6 +4,7 @@ package vm
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/go-openapi/runtime"
@@ -48,6 +49,43 @@ func (o *GetVMByCidOK) WriteResponse(rw http.ResponseWriter, producer runtime.Pr
 	}
 }
 
+/*GetVMByCidBadRequest request is invalid
+
+swagger:response getVmByCidBadRequest
+*/
+type GetVMByCidBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetVMByCidBadRequest creates GetVMByCidBadRequest with default headers values
+func NewGetVMByCidBadRequest() *GetVMByCidBadRequest {
+	return &GetVMByCidBadRequest{}
+}
+
+// WithPayload adds the payload to the get Vm by cid bad request response
+func (o *GetVMByCidBadRequest) WithPayload(payload *models.Error) *GetVMByCidBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get Vm by cid bad request response
+func (o *GetVMByCidBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetVMByCidBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 /*GetVMByCidNotFound vm not found
 
 swagger:response getVmByCidNotFound
@@ -66,6 +104,98 @@ func (o *GetVMByCidNotFound) WriteResponse(rw http.ResponseWriter, producer runt
 	rw.WriteHeader(404)
 }
 
+/*GetVMByCidConflict request conflicts with the current state of the pool
+
+swagger:response getVmByCidConflict
+*/
+type GetVMByCidConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetVMByCidConflict creates GetVMByCidConflict with default headers values
+func NewGetVMByCidConflict() *GetVMByCidConflict {
+	return &GetVMByCidConflict{}
+}
+
+// WithPayload adds the payload to the get Vm by cid conflict response
+func (o *GetVMByCidConflict) WithPayload(payload *models.Error) *GetVMByCidConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get Vm by cid conflict response
+func (o *GetVMByCidConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetVMByCidConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetVMByCidServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response getVmByCidServiceUnavailable
+*/
+type GetVMByCidServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetVMByCidServiceUnavailable creates GetVMByCidServiceUnavailable with default headers values
+func NewGetVMByCidServiceUnavailable() *GetVMByCidServiceUnavailable {
+	return &GetVMByCidServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the get Vm by cid service unavailable response
+func (o *GetVMByCidServiceUnavailable) WithRetryAfter(retryAfter int32) *GetVMByCidServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the get Vm by cid service unavailable response
+func (o *GetVMByCidServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the get Vm by cid service unavailable response
+func (o *GetVMByCidServiceUnavailable) WithPayload(payload *models.Error) *GetVMByCidServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get Vm by cid service unavailable response
+func (o *GetVMByCidServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetVMByCidServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 /*GetVMByCidDefault unexpected error
 
 swagger:response getVmByCidDefault
@@ -0,0 +1,64 @@
+package vm
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/models"
+)
+
+// GetVMByHostnameHandlerFunc turns a function with the right signature into a get Vm by hostname handler
+type GetVMByHostnameHandlerFunc func(GetVMByHostnameParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetVMByHostnameHandlerFunc) Handle(params GetVMByHostnameParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// GetVMByHostnameHandler interface for that can handle valid get Vm by hostname params
+type GetVMByHostnameHandler interface {
+	Handle(GetVMByHostnameParams, *models.User) middleware.Responder
+}
+
+// NewGetVMByHostname creates a new http.Handler for the get Vm by hostname operation
+func NewGetVMByHostname(ctx *middleware.Context, handler GetVMByHostnameHandler) *GetVMByHostname {
+	return &GetVMByHostname{Context: ctx, Handler: handler}
+}
+
+/*GetVMByHostname swagger:route GET /vms/byHostname/{hostname} vm getVmByHostname
+
+Find vm by hostname
+
+*/
+type GetVMByHostname struct {
+	Context *middleware.Context
+	Handler GetVMByHostnameHandler
+}
+
+func (o *GetVMByHostname) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, _ := o.Context.RouteInfo(r)
+	var Params = NewGetVMByHostnameParams()
+
+	uprinc, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,64 @@
+package vm
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewGetVMByHostnameParams creates a new GetVMByHostnameParams object
+// with the default values initialized.
+func NewGetVMByHostnameParams() GetVMByHostnameParams {
+	var ()
+	return GetVMByHostnameParams{}
+}
+
+// GetVMByHostnameParams contains all the bound params for the get Vm by hostname operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters getVmByHostname
+type GetVMByHostnameParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request
+
+	/*hostname of vm that needs to be fetched
+	  Required: true
+	  In: path
+	*/
+	Hostname string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls
+func (o *GetVMByHostnameParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+	o.HTTPRequest = r
+
+	rHostname, rhkHostname, _ := route.Params.GetOK("hostname")
+	if err := o.bindHostname(rHostname, rhkHostname, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *GetVMByHostnameParams) bindHostname(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	o.Hostname = raw
+
+	return nil
+}
@@ -0,0 +1,252 @@
+package vm
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/jianqiu/vps/models"
+)
+
+/*GetVMByHostnameOK successful operation
+
+swagger:response getVmByHostnameOK
+*/
+type GetVMByHostnameOK struct {
+
+	// In: body
+	Payload *models.VMResponse `json:"body,omitempty"`
+}
+
+// NewGetVMByHostnameOK creates GetVMByHostnameOK with default headers values
+func NewGetVMByHostnameOK() *GetVMByHostnameOK {
+	return &GetVMByHostnameOK{}
+}
+
+// WithPayload adds the payload to the get Vm by hostname o k response
+func (o *GetVMByHostnameOK) WithPayload(payload *models.VMResponse) *GetVMByHostnameOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get Vm by hostname o k response
+func (o *GetVMByHostnameOK) SetPayload(payload *models.VMResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetVMByHostnameOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetVMByHostnameBadRequest request is invalid
+
+swagger:response getVmByHostnameBadRequest
+*/
+type GetVMByHostnameBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetVMByHostnameBadRequest creates GetVMByHostnameBadRequest with default headers values
+func NewGetVMByHostnameBadRequest() *GetVMByHostnameBadRequest {
+	return &GetVMByHostnameBadRequest{}
+}
+
+// WithPayload adds the payload to the get Vm by hostname bad request response
+func (o *GetVMByHostnameBadRequest) WithPayload(payload *models.Error) *GetVMByHostnameBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get Vm by hostname bad request response
+func (o *GetVMByHostnameBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetVMByHostnameBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetVMByHostnameNotFound vm not found
+
+swagger:response getVmByHostnameNotFound
+*/
+type GetVMByHostnameNotFound struct {
+}
+
+// NewGetVMByHostnameNotFound creates GetVMByHostnameNotFound with default headers values
+func NewGetVMByHostnameNotFound() *GetVMByHostnameNotFound {
+	return &GetVMByHostnameNotFound{}
+}
+
+// WriteResponse to the client
+func (o *GetVMByHostnameNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+}
+
+/*GetVMByHostnameConflict request conflicts with the current state of the pool
+
+swagger:response getVmByHostnameConflict
+*/
+type GetVMByHostnameConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetVMByHostnameConflict creates GetVMByHostnameConflict with default headers values
+func NewGetVMByHostnameConflict() *GetVMByHostnameConflict {
+	return &GetVMByHostnameConflict{}
+}
+
+// WithPayload adds the payload to the get Vm by hostname conflict response
+func (o *GetVMByHostnameConflict) WithPayload(payload *models.Error) *GetVMByHostnameConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get Vm by hostname conflict response
+func (o *GetVMByHostnameConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetVMByHostnameConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetVMByHostnameServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response getVmByHostnameServiceUnavailable
+*/
+type GetVMByHostnameServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetVMByHostnameServiceUnavailable creates GetVMByHostnameServiceUnavailable with default headers values
+func NewGetVMByHostnameServiceUnavailable() *GetVMByHostnameServiceUnavailable {
+	return &GetVMByHostnameServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the get Vm by hostname service unavailable response
+func (o *GetVMByHostnameServiceUnavailable) WithRetryAfter(retryAfter int32) *GetVMByHostnameServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the get Vm by hostname service unavailable response
+func (o *GetVMByHostnameServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the get Vm by hostname service unavailable response
+func (o *GetVMByHostnameServiceUnavailable) WithPayload(payload *models.Error) *GetVMByHostnameServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get Vm by hostname service unavailable response
+func (o *GetVMByHostnameServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetVMByHostnameServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetVMByHostnameDefault unexpected error
+
+swagger:response getVmByHostnameDefault
+*/
+type GetVMByHostnameDefault struct {
+	_statusCode int
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetVMByHostnameDefault creates GetVMByHostnameDefault with default headers values
+func NewGetVMByHostnameDefault(code int) *GetVMByHostnameDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetVMByHostnameDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get Vm by hostname default response
+func (o *GetVMByHostnameDefault) WithStatusCode(code int) *GetVMByHostnameDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get Vm by hostname default response
+func (o *GetVMByHostnameDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get Vm by hostname default response
+func (o *GetVMByHostnameDefault) WithPayload(payload *models.Error) *GetVMByHostnameDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get Vm by hostname default response
+func (o *GetVMByHostnameDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetVMByHostnameDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
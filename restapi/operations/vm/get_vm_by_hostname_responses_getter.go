@@ -0,0 +1,21 @@
+package vm
+
+import (
+	"github.com/jianqiu/vps/models"
+)
+
+func (o *GetVMByHostnameOK) GetPayload() *models.VMResponse {
+	return o.Payload
+}
+
+func (o *GetVMByHostnameNotFound) GetStatusCode() int {
+	return 404
+}
+
+func (o *GetVMByHostnameDefault) GetStatusCode() int {
+	return o._statusCode
+}
+
+func (o *GetVMByHostnameDefault) GetPayload() *models.Error {
+	return o.Payload
+}
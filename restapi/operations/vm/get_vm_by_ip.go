@@ -0,0 +1,64 @@
+package vm
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/models"
+)
+
+// GetVMByIPHandlerFunc turns a function with the right signature into a get Vm by Ip handler
+type GetVMByIPHandlerFunc func(GetVMByIPParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetVMByIPHandlerFunc) Handle(params GetVMByIPParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// GetVMByIPHandler interface for that can handle valid get Vm by Ip params
+type GetVMByIPHandler interface {
+	Handle(GetVMByIPParams, *models.User) middleware.Responder
+}
+
+// NewGetVMByIP creates a new http.Handler for the get Vm by Ip operation
+func NewGetVMByIP(ctx *middleware.Context, handler GetVMByIPHandler) *GetVMByIP {
+	return &GetVMByIP{Context: ctx, Handler: handler}
+}
+
+/*GetVMByIP swagger:route GET /vms/byIp/{ip} vm getVmByIp
+
+Find vm by IP
+
+*/
+type GetVMByIP struct {
+	Context *middleware.Context
+	Handler GetVMByIPHandler
+}
+
+func (o *GetVMByIP) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, _ := o.Context.RouteInfo(r)
+	var Params = NewGetVMByIPParams()
+
+	uprinc, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,64 @@
+package vm
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewGetVMByIPParams creates a new GetVMByIPParams object
+// with the default values initialized.
+func NewGetVMByIPParams() GetVMByIPParams {
+	var ()
+	return GetVMByIPParams{}
+}
+
+// GetVMByIPParams contains all the bound params for the get Vm by Ip operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters getVmByIp
+type GetVMByIPParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request
+
+	/*IP of vm that needs to be fetched
+	  Required: true
+	  In: path
+	*/
+	IP string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls
+func (o *GetVMByIPParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+	o.HTTPRequest = r
+
+	rIP, rhkIP, _ := route.Params.GetOK("ip")
+	if err := o.bindIP(rIP, rhkIP, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *GetVMByIPParams) bindIP(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	o.IP = raw
+
+	return nil
+}
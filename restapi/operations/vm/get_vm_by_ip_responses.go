@@ -0,0 +1,252 @@
+package vm
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/jianqiu/vps/models"
+)
+
+/*GetVMByIPOK successful operation
+
+swagger:response getVmByIpOK
+*/
+type GetVMByIPOK struct {
+
+	// In: body
+	Payload *models.VMResponse `json:"body,omitempty"`
+}
+
+// NewGetVMByIPOK creates GetVMByIPOK with default headers values
+func NewGetVMByIPOK() *GetVMByIPOK {
+	return &GetVMByIPOK{}
+}
+
+// WithPayload adds the payload to the get Vm by Ip o k response
+func (o *GetVMByIPOK) WithPayload(payload *models.VMResponse) *GetVMByIPOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get Vm by Ip o k response
+func (o *GetVMByIPOK) SetPayload(payload *models.VMResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetVMByIPOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetVMByIPBadRequest request is invalid
+
+swagger:response getVmByIpBadRequest
+*/
+type GetVMByIPBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetVMByIPBadRequest creates GetVMByIPBadRequest with default headers values
+func NewGetVMByIPBadRequest() *GetVMByIPBadRequest {
+	return &GetVMByIPBadRequest{}
+}
+
+// WithPayload adds the payload to the get Vm by Ip bad request response
+func (o *GetVMByIPBadRequest) WithPayload(payload *models.Error) *GetVMByIPBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get Vm by Ip bad request response
+func (o *GetVMByIPBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetVMByIPBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetVMByIPNotFound vm not found
+
+swagger:response getVmByIpNotFound
+*/
+type GetVMByIPNotFound struct {
+}
+
+// NewGetVMByIPNotFound creates GetVMByIPNotFound with default headers values
+func NewGetVMByIPNotFound() *GetVMByIPNotFound {
+	return &GetVMByIPNotFound{}
+}
+
+// WriteResponse to the client
+func (o *GetVMByIPNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+}
+
+/*GetVMByIPConflict request conflicts with the current state of the pool
+
+swagger:response getVmByIpConflict
+*/
+type GetVMByIPConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetVMByIPConflict creates GetVMByIPConflict with default headers values
+func NewGetVMByIPConflict() *GetVMByIPConflict {
+	return &GetVMByIPConflict{}
+}
+
+// WithPayload adds the payload to the get Vm by Ip conflict response
+func (o *GetVMByIPConflict) WithPayload(payload *models.Error) *GetVMByIPConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get Vm by Ip conflict response
+func (o *GetVMByIPConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetVMByIPConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetVMByIPServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response getVmByIpServiceUnavailable
+*/
+type GetVMByIPServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetVMByIPServiceUnavailable creates GetVMByIPServiceUnavailable with default headers values
+func NewGetVMByIPServiceUnavailable() *GetVMByIPServiceUnavailable {
+	return &GetVMByIPServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the get Vm by Ip service unavailable response
+func (o *GetVMByIPServiceUnavailable) WithRetryAfter(retryAfter int32) *GetVMByIPServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the get Vm by Ip service unavailable response
+func (o *GetVMByIPServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the get Vm by Ip service unavailable response
+func (o *GetVMByIPServiceUnavailable) WithPayload(payload *models.Error) *GetVMByIPServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get Vm by Ip service unavailable response
+func (o *GetVMByIPServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetVMByIPServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetVMByIPDefault unexpected error
+
+swagger:response getVmByIpDefault
+*/
+type GetVMByIPDefault struct {
+	_statusCode int
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetVMByIPDefault creates GetVMByIPDefault with default headers values
+func NewGetVMByIPDefault(code int) *GetVMByIPDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetVMByIPDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get Vm by Ip default response
+func (o *GetVMByIPDefault) WithStatusCode(code int) *GetVMByIPDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get Vm by Ip default response
+func (o *GetVMByIPDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get Vm by Ip default response
+func (o *GetVMByIPDefault) WithPayload(payload *models.Error) *GetVMByIPDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get Vm by Ip default response
+func (o *GetVMByIPDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetVMByIPDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
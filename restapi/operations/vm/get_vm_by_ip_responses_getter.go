@@ -0,0 +1,21 @@
+package vm
+
+import (
+	"github.com/jianqiu/vps/models"
+)
+
+func (o *GetVMByIPOK) GetPayload() *models.VMResponse {
+	return o.Payload
+}
+
+func (o *GetVMByIPNotFound) GetStatusCode() int {
+	return 404
+}
+
+func (o *GetVMByIPDefault) GetStatusCode() int {
+	return o._statusCode
+}
+
+func (o *GetVMByIPDefault) GetPayload() *models.Error {
+	return o.Payload
+}
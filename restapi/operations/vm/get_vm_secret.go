@@ -0,0 +1,66 @@
+package vm
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/models"
+)
+
+// GetVMSecretHandlerFunc turns a function with the right signature into a get Vm secret handler
+type GetVMSecretHandlerFunc func(GetVMSecretParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetVMSecretHandlerFunc) Handle(params GetVMSecretParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// GetVMSecretHandler interface for that can handle valid get Vm secret params
+type GetVMSecretHandler interface {
+	Handle(GetVMSecretParams, *models.User) middleware.Responder
+}
+
+// NewGetVMSecret creates a new http.Handler for the get Vm secret operation
+func NewGetVMSecret(ctx *middleware.Context, handler GetVMSecretHandler) *GetVMSecret {
+	return &GetVMSecret{Context: ctx, Handler: handler}
+}
+
+/*GetVMSecret swagger:route GET /vms/{cid}/secret vm getVmSecret
+
+Find the root credentials and ssh keys of a vm
+
+Only the deployment the vm is provisioned for or used by gets them, with the secretToken the vm was ordered with; any other token is forbidden.
+
+*/
+type GetVMSecret struct {
+	Context *middleware.Context
+	Handler GetVMSecretHandler
+}
+
+func (o *GetVMSecret) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, _ := o.Context.RouteInfo(r)
+	var Params = NewGetVMSecretParams()
+
+	uprinc, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,96 @@
+package vm
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewGetVMSecretParams creates a new GetVMSecretParams object
+// with the default values initialized.
+func NewGetVMSecretParams() GetVMSecretParams {
+	var ()
+	return GetVMSecretParams{}
+}
+
+// GetVMSecretParams contains all the bound params for the get Vm secret operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters getVmSecret
+type GetVMSecretParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request
+
+	/*ID of the vm
+	  Required: true
+	  In: path
+	*/
+	Cid int32
+	/*the secretToken of the order that provisioned the vm
+	  Required: true
+	  In: header
+	*/
+	XSecretToken string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls
+func (o *GetVMSecretParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+	o.HTTPRequest = r
+
+	rCid, rhkCid, _ := route.Params.GetOK("cid")
+	if err := o.bindCid(rCid, rhkCid, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.bindXSecretToken(r.Header[http.CanonicalHeaderKey("X-Secret-Token")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *GetVMSecretParams) bindCid(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	value, err := swag.ConvertInt32(raw)
+	if err != nil {
+		return errors.InvalidType("cid", "path", "int32", raw)
+	}
+	o.Cid = value
+
+	return nil
+}
+
+func (o *GetVMSecretParams) bindXSecretToken(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	if !hasKey {
+		return errors.Required("X-Secret-Token", "header")
+	}
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+	if err := validate.RequiredString("X-Secret-Token", "header", raw); err != nil {
+		return err
+	}
+
+	o.XSecretToken = raw
+
+	return nil
+}
@@ -0,0 +1,252 @@
+package vm
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/jianqiu/vps/models"
+)
+
+/*GetVMSecretOK successful operation
+
+swagger:response getVmSecretOK
+*/
+type GetVMSecretOK struct {
+
+	// In: body
+	Payload *models.VMSecret `json:"body,omitempty"`
+}
+
+// NewGetVMSecretOK creates GetVMSecretOK with default headers values
+func NewGetVMSecretOK() *GetVMSecretOK {
+	return &GetVMSecretOK{}
+}
+
+// WithPayload adds the payload to the get Vm secret o k response
+func (o *GetVMSecretOK) WithPayload(payload *models.VMSecret) *GetVMSecretOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get Vm secret o k response
+func (o *GetVMSecretOK) SetPayload(payload *models.VMSecret) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetVMSecretOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetVMSecretBadRequest request is invalid
+
+swagger:response getVmSecretBadRequest
+*/
+type GetVMSecretBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetVMSecretBadRequest creates GetVMSecretBadRequest with default headers values
+func NewGetVMSecretBadRequest() *GetVMSecretBadRequest {
+	return &GetVMSecretBadRequest{}
+}
+
+// WithPayload adds the payload to the get Vm secret bad request response
+func (o *GetVMSecretBadRequest) WithPayload(payload *models.Error) *GetVMSecretBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get Vm secret bad request response
+func (o *GetVMSecretBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetVMSecretBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetVMSecretNotFound vm or secret not found
+
+swagger:response getVmSecretNotFound
+*/
+type GetVMSecretNotFound struct {
+}
+
+// NewGetVMSecretNotFound creates GetVMSecretNotFound with default headers values
+func NewGetVMSecretNotFound() *GetVMSecretNotFound {
+	return &GetVMSecretNotFound{}
+}
+
+// WriteResponse to the client
+func (o *GetVMSecretNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+}
+
+/*GetVMSecretConflict request conflicts with the current state of the pool
+
+swagger:response getVmSecretConflict
+*/
+type GetVMSecretConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetVMSecretConflict creates GetVMSecretConflict with default headers values
+func NewGetVMSecretConflict() *GetVMSecretConflict {
+	return &GetVMSecretConflict{}
+}
+
+// WithPayload adds the payload to the get Vm secret conflict response
+func (o *GetVMSecretConflict) WithPayload(payload *models.Error) *GetVMSecretConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get Vm secret conflict response
+func (o *GetVMSecretConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetVMSecretConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetVMSecretServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response getVmSecretServiceUnavailable
+*/
+type GetVMSecretServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetVMSecretServiceUnavailable creates GetVMSecretServiceUnavailable with default headers values
+func NewGetVMSecretServiceUnavailable() *GetVMSecretServiceUnavailable {
+	return &GetVMSecretServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the get Vm secret service unavailable response
+func (o *GetVMSecretServiceUnavailable) WithRetryAfter(retryAfter int32) *GetVMSecretServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the get Vm secret service unavailable response
+func (o *GetVMSecretServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the get Vm secret service unavailable response
+func (o *GetVMSecretServiceUnavailable) WithPayload(payload *models.Error) *GetVMSecretServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get Vm secret service unavailable response
+func (o *GetVMSecretServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetVMSecretServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetVMSecretDefault unexpected error
+
+swagger:response getVmSecretDefault
+*/
+type GetVMSecretDefault struct {
+	_statusCode int
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetVMSecretDefault creates GetVMSecretDefault with default headers values
+func NewGetVMSecretDefault(code int) *GetVMSecretDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetVMSecretDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get Vm secret default response
+func (o *GetVMSecretDefault) WithStatusCode(code int) *GetVMSecretDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get Vm secret default response
+func (o *GetVMSecretDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get Vm secret default response
+func (o *GetVMSecretDefault) WithPayload(payload *models.Error) *GetVMSecretDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get Vm secret default response
+func (o *GetVMSecretDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetVMSecretDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package vm
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/models"
+)
+
+// ListPoolVmsHandlerFunc turns a function with the right signature into a list pool vms handler
+type ListPoolVmsHandlerFunc func(ListPoolVmsParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListPoolVmsHandlerFunc) Handle(params ListPoolVmsParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ListPoolVmsHandler interface for that can handle valid list pool vms params
+type ListPoolVmsHandler interface {
+	Handle(ListPoolVmsParams, *models.User) middleware.Responder
+}
+
+// NewListPoolVms creates a new http.Handler for the list pool vms operation
+func NewListPoolVms(ctx *middleware.Context, handler ListPoolVmsHandler) *ListPoolVms {
+	return &ListPoolVms{Context: ctx, Handler: handler}
+}
+
+/*ListPoolVms swagger:route GET /pools/{pool}/vms vm listPoolVms
+
+List vms of a pool
+
+*/
+type ListPoolVms struct {
+	Context *middleware.Context
+	Handler ListPoolVmsHandler
+}
+
+func (o *ListPoolVms) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, _ := o.Context.RouteInfo(r)
+	var Params = NewListPoolVmsParams()
+
+	uprinc, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
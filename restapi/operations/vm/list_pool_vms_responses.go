@@ -0,0 +1,252 @@
+package vm
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/jianqiu/vps/models"
+)
+
+/*ListPoolVmsOK successful operation
+
+swagger:response listPoolVmsOK
+*/
+type ListPoolVmsOK struct {
+
+	// In: body
+	Payload *models.VmsResponse `json:"body,omitempty"`
+}
+
+// NewListPoolVmsOK creates ListPoolVmsOK with default headers values
+func NewListPoolVmsOK() *ListPoolVmsOK {
+	return &ListPoolVmsOK{}
+}
+
+// WithPayload adds the payload to the list pool vms o k response
+func (o *ListPoolVmsOK) WithPayload(payload *models.VmsResponse) *ListPoolVmsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list pool vms o k response
+func (o *ListPoolVmsOK) SetPayload(payload *models.VmsResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListPoolVmsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListPoolVmsBadRequest request is invalid
+
+swagger:response listPoolVmsBadRequest
+*/
+type ListPoolVmsBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListPoolVmsBadRequest creates ListPoolVmsBadRequest with default headers values
+func NewListPoolVmsBadRequest() *ListPoolVmsBadRequest {
+	return &ListPoolVmsBadRequest{}
+}
+
+// WithPayload adds the payload to the list pool vms bad request response
+func (o *ListPoolVmsBadRequest) WithPayload(payload *models.Error) *ListPoolVmsBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list pool vms bad request response
+func (o *ListPoolVmsBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListPoolVmsBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListPoolVmsNotFound pool not found
+
+swagger:response listPoolVmsNotFound
+*/
+type ListPoolVmsNotFound struct {
+}
+
+// NewListPoolVmsNotFound creates ListPoolVmsNotFound with default headers values
+func NewListPoolVmsNotFound() *ListPoolVmsNotFound {
+	return &ListPoolVmsNotFound{}
+}
+
+// WriteResponse to the client
+func (o *ListPoolVmsNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+}
+
+/*ListPoolVmsConflict request conflicts with the current state of the pool
+
+swagger:response listPoolVmsConflict
+*/
+type ListPoolVmsConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListPoolVmsConflict creates ListPoolVmsConflict with default headers values
+func NewListPoolVmsConflict() *ListPoolVmsConflict {
+	return &ListPoolVmsConflict{}
+}
+
+// WithPayload adds the payload to the list pool vms conflict response
+func (o *ListPoolVmsConflict) WithPayload(payload *models.Error) *ListPoolVmsConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list pool vms conflict response
+func (o *ListPoolVmsConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListPoolVmsConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListPoolVmsServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response listPoolVmsServiceUnavailable
+*/
+type ListPoolVmsServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListPoolVmsServiceUnavailable creates ListPoolVmsServiceUnavailable with default headers values
+func NewListPoolVmsServiceUnavailable() *ListPoolVmsServiceUnavailable {
+	return &ListPoolVmsServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the list pool vms service unavailable response
+func (o *ListPoolVmsServiceUnavailable) WithRetryAfter(retryAfter int32) *ListPoolVmsServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the list pool vms service unavailable response
+func (o *ListPoolVmsServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the list pool vms service unavailable response
+func (o *ListPoolVmsServiceUnavailable) WithPayload(payload *models.Error) *ListPoolVmsServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list pool vms service unavailable response
+func (o *ListPoolVmsServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListPoolVmsServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListPoolVmsDefault unexpected error
+
+swagger:response listPoolVmsDefault
+*/
+type ListPoolVmsDefault struct {
+	_statusCode int
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListPoolVmsDefault creates ListPoolVmsDefault with default headers values
+func NewListPoolVmsDefault(code int) *ListPoolVmsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListPoolVmsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list pool vms default response
+func (o *ListPoolVmsDefault) WithStatusCode(code int) *ListPoolVmsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list pool vms default response
+func (o *ListPoolVmsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list pool vms default response
+func (o *ListPoolVmsDefault) WithPayload(payload *models.Error) *ListPoolVmsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list pool vms default response
+func (o *ListPoolVmsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListPoolVmsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package vm
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/models"
+)
+
+// ListReapableVmsHandlerFunc turns a function with the right signature into a list reapable vms handler
+type ListReapableVmsHandlerFunc func(ListReapableVmsParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListReapableVmsHandlerFunc) Handle(params ListReapableVmsParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ListReapableVmsHandler interface for that can handle valid list reapable vms params
+type ListReapableVmsHandler interface {
+	Handle(ListReapableVmsParams, *models.User) middleware.Responder
+}
+
+// NewListReapableVms creates a new http.Handler for the list reapable vms operation
+func NewListReapableVms(ctx *middleware.Context, handler ListReapableVmsHandler) *ListReapableVms {
+	return &ListReapableVms{Context: ctx, Handler: handler}
+}
+
+/*ListReapableVms swagger:route GET /vms/reapable vm listReapableVms
+
+Lists the free vms the reaper would cancel on its next pass
+
+A dry run of the idle vm reaper, nothing is changed
+
+*/
+type ListReapableVms struct {
+	Context *middleware.Context
+	Handler ListReapableVmsHandler
+}
+
+func (o *ListReapableVms) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, _ := o.Context.RouteInfo(r)
+	var Params = NewListReapableVmsParams()
+
+	uprinc, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
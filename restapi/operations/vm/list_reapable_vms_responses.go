@@ -0,0 +1,234 @@
+package vm
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/jianqiu/vps/models"
+)
+
+/*ListReapableVmsOK successful operation
+
+swagger:response listReapableVmsOK
+*/
+type ListReapableVmsOK struct {
+
+	// In: body
+	Payload *models.VmsResponse `json:"body,omitempty"`
+}
+
+// NewListReapableVmsOK creates ListReapableVmsOK with default headers values
+func NewListReapableVmsOK() *ListReapableVmsOK {
+	return &ListReapableVmsOK{}
+}
+
+// WithPayload adds the payload to the list reapable vms o k response
+func (o *ListReapableVmsOK) WithPayload(payload *models.VmsResponse) *ListReapableVmsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list reapable vms o k response
+func (o *ListReapableVmsOK) SetPayload(payload *models.VmsResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListReapableVmsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListReapableVmsBadRequest request is invalid
+
+swagger:response listReapableVmsBadRequest
+*/
+type ListReapableVmsBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListReapableVmsBadRequest creates ListReapableVmsBadRequest with default headers values
+func NewListReapableVmsBadRequest() *ListReapableVmsBadRequest {
+	return &ListReapableVmsBadRequest{}
+}
+
+// WithPayload adds the payload to the list reapable vms bad request response
+func (o *ListReapableVmsBadRequest) WithPayload(payload *models.Error) *ListReapableVmsBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list reapable vms bad request response
+func (o *ListReapableVmsBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListReapableVmsBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListReapableVmsConflict request conflicts with the current state of the pool
+
+swagger:response listReapableVmsConflict
+*/
+type ListReapableVmsConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListReapableVmsConflict creates ListReapableVmsConflict with default headers values
+func NewListReapableVmsConflict() *ListReapableVmsConflict {
+	return &ListReapableVmsConflict{}
+}
+
+// WithPayload adds the payload to the list reapable vms conflict response
+func (o *ListReapableVmsConflict) WithPayload(payload *models.Error) *ListReapableVmsConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list reapable vms conflict response
+func (o *ListReapableVmsConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListReapableVmsConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListReapableVmsServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response listReapableVmsServiceUnavailable
+*/
+type ListReapableVmsServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListReapableVmsServiceUnavailable creates ListReapableVmsServiceUnavailable with default headers values
+func NewListReapableVmsServiceUnavailable() *ListReapableVmsServiceUnavailable {
+	return &ListReapableVmsServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the list reapable vms service unavailable response
+func (o *ListReapableVmsServiceUnavailable) WithRetryAfter(retryAfter int32) *ListReapableVmsServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the list reapable vms service unavailable response
+func (o *ListReapableVmsServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the list reapable vms service unavailable response
+func (o *ListReapableVmsServiceUnavailable) WithPayload(payload *models.Error) *ListReapableVmsServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list reapable vms service unavailable response
+func (o *ListReapableVmsServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListReapableVmsServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListReapableVmsDefault unexpected error
+
+swagger:response listReapableVmsDefault
+*/
+type ListReapableVmsDefault struct {
+	_statusCode int
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListReapableVmsDefault creates ListReapableVmsDefault with default headers values
+func NewListReapableVmsDefault(code int) *ListReapableVmsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListReapableVmsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list reapable vms default response
+func (o *ListReapableVmsDefault) WithStatusCode(code int) *ListReapableVmsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list reapable vms default response
+func (o *ListReapableVmsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list reapable vms default response
+func (o *ListReapableVmsDefault) WithPayload(payload *models.Error) *ListReapableVmsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list reapable vms default response
+func (o *ListReapableVmsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListReapableVmsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -4,6 +4,7 @@ package vm
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/go-openapi/runtime"
@@ -48,6 +49,43 @@ func (o *ListVMOK) WriteResponse(rw http.ResponseWriter, producer runtime.Produc
 	}
 }
 
+/*ListVMBadRequest request is invalid
+
+swagger:response listVmBadRequest
+*/
+type ListVMBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListVMBadRequest creates ListVMBadRequest with default headers values
+func NewListVMBadRequest() *ListVMBadRequest {
+	return &ListVMBadRequest{}
+}
+
+// WithPayload adds the payload to the list Vm bad request response
+func (o *ListVMBadRequest) WithPayload(payload *models.Error) *ListVMBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list Vm bad request response
+func (o *ListVMBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListVMBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 /*ListVMNotFound vm not found
 
 swagger:response listVmNotFound
@@ -66,6 +104,98 @@ func (o *ListVMNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.
 	rw.WriteHeader(404)
 }
 
+/*ListVMConflict request conflicts with the current state of the pool
+
+swagger:response listVmConflict
+*/
+type ListVMConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListVMConflict creates ListVMConflict with default headers values
+func NewListVMConflict() *ListVMConflict {
+	return &ListVMConflict{}
+}
+
+// WithPayload adds the payload to the list Vm conflict response
+func (o *ListVMConflict) WithPayload(payload *models.Error) *ListVMConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list Vm conflict response
+func (o *ListVMConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListVMConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListVMServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response listVmServiceUnavailable
+*/
+type ListVMServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListVMServiceUnavailable creates ListVMServiceUnavailable with default headers values
+func NewListVMServiceUnavailable() *ListVMServiceUnavailable {
+	return &ListVMServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the list Vm service unavailable response
+func (o *ListVMServiceUnavailable) WithRetryAfter(retryAfter int32) *ListVMServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the list Vm service unavailable response
+func (o *ListVMServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the list Vm service unavailable response
+func (o *ListVMServiceUnavailable) WithPayload(payload *models.Error) *ListVMServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list Vm service unavailable response
+func (o *ListVMServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListVMServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 /*ListVMDefault unexpected error
 
 swagger:response listVmDefault
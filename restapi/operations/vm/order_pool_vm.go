@@ -0,0 +1,66 @@
+package vm
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/models"
+)
+
+// OrderPoolVMHandlerFunc turns a function with the right signature into a order pool Vm handler
+type OrderPoolVMHandlerFunc func(OrderPoolVMParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn OrderPoolVMHandlerFunc) Handle(params OrderPoolVMParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// OrderPoolVMHandler interface for that can handle valid order pool Vm params
+type OrderPoolVMHandler interface {
+	Handle(OrderPoolVMParams, *models.User) middleware.Responder
+}
+
+// NewOrderPoolVM creates a new http.Handler for the order pool Vm operation
+func NewOrderPoolVM(ctx *middleware.Context, handler OrderPoolVMHandler) *OrderPoolVM {
+	return &OrderPoolVM{Context: ctx, Handler: handler}
+}
+
+/*OrderPoolVM swagger:route POST /pools/{pool}/vms/order vm orderPoolVm
+
+Order a free vm of a pool by filters (cpu, memory, private_vlan, public_vlan, state)
+
+Given cids or ips, claims all of those vms at once, or none of them when any is not free
+
+*/
+type OrderPoolVM struct {
+	Context *middleware.Context
+	Handler OrderPoolVMHandler
+}
+
+func (o *OrderPoolVM) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, _ := o.Context.RouteInfo(r)
+	var Params = NewOrderPoolVMParams()
+
+	uprinc, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,252 @@
+package vm
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/jianqiu/vps/models"
+)
+
+/*OrderPoolVMOK successful operation
+
+swagger:response orderPoolVmOK
+*/
+type OrderPoolVMOK struct {
+
+	// In: body
+	Payload *models.VMResponse `json:"body,omitempty"`
+}
+
+// NewOrderPoolVMOK creates OrderPoolVMOK with default headers values
+func NewOrderPoolVMOK() *OrderPoolVMOK {
+	return &OrderPoolVMOK{}
+}
+
+// WithPayload adds the payload to the order pool Vm o k response
+func (o *OrderPoolVMOK) WithPayload(payload *models.VMResponse) *OrderPoolVMOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the order pool Vm o k response
+func (o *OrderPoolVMOK) SetPayload(payload *models.VMResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *OrderPoolVMOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*OrderPoolVMBadRequest request is invalid
+
+swagger:response orderPoolVmBadRequest
+*/
+type OrderPoolVMBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewOrderPoolVMBadRequest creates OrderPoolVMBadRequest with default headers values
+func NewOrderPoolVMBadRequest() *OrderPoolVMBadRequest {
+	return &OrderPoolVMBadRequest{}
+}
+
+// WithPayload adds the payload to the order pool Vm bad request response
+func (o *OrderPoolVMBadRequest) WithPayload(payload *models.Error) *OrderPoolVMBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the order pool Vm bad request response
+func (o *OrderPoolVMBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *OrderPoolVMBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*OrderPoolVMNotFound vm not found
+
+swagger:response orderPoolVmNotFound
+*/
+type OrderPoolVMNotFound struct {
+}
+
+// NewOrderPoolVMNotFound creates OrderPoolVMNotFound with default headers values
+func NewOrderPoolVMNotFound() *OrderPoolVMNotFound {
+	return &OrderPoolVMNotFound{}
+}
+
+// WriteResponse to the client
+func (o *OrderPoolVMNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+}
+
+/*OrderPoolVMConflict request conflicts with the current state of the pool
+
+swagger:response orderPoolVmConflict
+*/
+type OrderPoolVMConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewOrderPoolVMConflict creates OrderPoolVMConflict with default headers values
+func NewOrderPoolVMConflict() *OrderPoolVMConflict {
+	return &OrderPoolVMConflict{}
+}
+
+// WithPayload adds the payload to the order pool Vm conflict response
+func (o *OrderPoolVMConflict) WithPayload(payload *models.Error) *OrderPoolVMConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the order pool Vm conflict response
+func (o *OrderPoolVMConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *OrderPoolVMConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*OrderPoolVMServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response orderPoolVmServiceUnavailable
+*/
+type OrderPoolVMServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewOrderPoolVMServiceUnavailable creates OrderPoolVMServiceUnavailable with default headers values
+func NewOrderPoolVMServiceUnavailable() *OrderPoolVMServiceUnavailable {
+	return &OrderPoolVMServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the order pool Vm service unavailable response
+func (o *OrderPoolVMServiceUnavailable) WithRetryAfter(retryAfter int32) *OrderPoolVMServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the order pool Vm service unavailable response
+func (o *OrderPoolVMServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the order pool Vm service unavailable response
+func (o *OrderPoolVMServiceUnavailable) WithPayload(payload *models.Error) *OrderPoolVMServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the order pool Vm service unavailable response
+func (o *OrderPoolVMServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *OrderPoolVMServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*OrderPoolVMDefault unexpected error
+
+swagger:response orderPoolVmDefault
+*/
+type OrderPoolVMDefault struct {
+	_statusCode int
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewOrderPoolVMDefault creates OrderPoolVMDefault with default headers values
+func NewOrderPoolVMDefault(code int) *OrderPoolVMDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &OrderPoolVMDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the order pool Vm default response
+func (o *OrderPoolVMDefault) WithStatusCode(code int) *OrderPoolVMDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the order pool Vm default response
+func (o *OrderPoolVMDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the order pool Vm default response
+func (o *OrderPoolVMDefault) WithPayload(payload *models.Error) *OrderPoolVMDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the order pool Vm default response
+func (o *OrderPoolVMDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *OrderPoolVMDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -4,6 +4,7 @@ package vm
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/go-openapi/runtime"
@@ -48,6 +49,43 @@ func (o *OrderVMByFilterOK) WriteResponse(rw http.ResponseWriter, producer runti
 	}
 }
 
+/*OrderVMByFilterBadRequest request is invalid
+
+swagger:response orderVmByFilterBadRequest
+*/
+type OrderVMByFilterBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewOrderVMByFilterBadRequest creates OrderVMByFilterBadRequest with default headers values
+func NewOrderVMByFilterBadRequest() *OrderVMByFilterBadRequest {
+	return &OrderVMByFilterBadRequest{}
+}
+
+// WithPayload adds the payload to the order Vm by filter bad request response
+func (o *OrderVMByFilterBadRequest) WithPayload(payload *models.Error) *OrderVMByFilterBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the order Vm by filter bad request response
+func (o *OrderVMByFilterBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *OrderVMByFilterBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 /*OrderVMByFilterNotFound vm not found
 
 swagger:response orderVmByFilterNotFound
@@ -66,6 +104,98 @@ func (o *OrderVMByFilterNotFound) WriteResponse(rw http.ResponseWriter, producer
 	rw.WriteHeader(404)
 }
 
+/*OrderVMByFilterConflict request conflicts with the current state of the pool
+
+swagger:response orderVmByFilterConflict
+*/
+type OrderVMByFilterConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewOrderVMByFilterConflict creates OrderVMByFilterConflict with default headers values
+func NewOrderVMByFilterConflict() *OrderVMByFilterConflict {
+	return &OrderVMByFilterConflict{}
+}
+
+// WithPayload adds the payload to the order Vm by filter conflict response
+func (o *OrderVMByFilterConflict) WithPayload(payload *models.Error) *OrderVMByFilterConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the order Vm by filter conflict response
+func (o *OrderVMByFilterConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *OrderVMByFilterConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*OrderVMByFilterServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response orderVmByFilterServiceUnavailable
+*/
+type OrderVMByFilterServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewOrderVMByFilterServiceUnavailable creates OrderVMByFilterServiceUnavailable with default headers values
+func NewOrderVMByFilterServiceUnavailable() *OrderVMByFilterServiceUnavailable {
+	return &OrderVMByFilterServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the order Vm by filter service unavailable response
+func (o *OrderVMByFilterServiceUnavailable) WithRetryAfter(retryAfter int32) *OrderVMByFilterServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the order Vm by filter service unavailable response
+func (o *OrderVMByFilterServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the order Vm by filter service unavailable response
+func (o *OrderVMByFilterServiceUnavailable) WithPayload(payload *models.Error) *OrderVMByFilterServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the order Vm by filter service unavailable response
+func (o *OrderVMByFilterServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *OrderVMByFilterServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 /*OrderVMByFilterDefault unexpected error
 
 swagger:response orderVmByFilterDefault
@@ -0,0 +1,66 @@
+package vm
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/models"
+)
+
+// SetVMSecretHandlerFunc turns a function with the right signature into a set Vm secret handler
+type SetVMSecretHandlerFunc func(SetVMSecretParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn SetVMSecretHandlerFunc) Handle(params SetVMSecretParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// SetVMSecretHandler interface for that can handle valid set Vm secret params
+type SetVMSecretHandler interface {
+	Handle(SetVMSecretParams, *models.User) middleware.Responder
+}
+
+// NewSetVMSecret creates a new http.Handler for the set Vm secret operation
+func NewSetVMSecret(ctx *middleware.Context, handler SetVMSecretHandler) *SetVMSecret {
+	return &SetVMSecret{Context: ctx, Handler: handler}
+}
+
+/*SetVMSecret swagger:route PUT /vms/{cid}/secret vm setVmSecret
+
+Stores the root credentials and ssh keys of a vm, encrypted with the active key
+
+Only the administrator may store secrets.
+
+*/
+type SetVMSecret struct {
+	Context *middleware.Context
+	Handler SetVMSecretHandler
+}
+
+func (o *SetVMSecret) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, _ := o.Context.RouteInfo(r)
+	var Params = NewSetVMSecretParams()
+
+	uprinc, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
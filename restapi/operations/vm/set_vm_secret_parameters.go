@@ -0,0 +1,102 @@
+package vm
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/jianqiu/vps/models"
+)
+
+// NewSetVMSecretParams creates a new SetVMSecretParams object
+// with the default values initialized.
+func NewSetVMSecretParams() SetVMSecretParams {
+	var ()
+	return SetVMSecretParams{}
+}
+
+// SetVMSecretParams contains all the bound params for the set Vm secret operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters setVmSecret
+type SetVMSecretParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request
+
+	/*the secret of the vm
+	  Required: true
+	  In: body
+	*/
+	Body *models.VMSecret
+	/*ID of the vm
+	  Required: true
+	  In: path
+	*/
+	Cid int32
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls
+func (o *SetVMSecretParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+	o.HTTPRequest = r
+
+	if runtime.HasBody(r) {
+		defer r.Body.Close()
+		var body models.VMSecret
+		if err := route.Consumer.Consume(r.Body, &body); err != nil {
+			if err == io.EOF {
+				res = append(res, errors.Required("body", "body"))
+			} else {
+				res = append(res, errors.NewParseError("body", "body", "", err))
+			}
+
+		} else {
+			if err := body.Validate(route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			if len(res) == 0 {
+				o.Body = &body
+			}
+		}
+
+	} else {
+		res = append(res, errors.Required("body", "body"))
+	}
+
+	rCid, rhkCid, _ := route.Params.GetOK("cid")
+	if err := o.bindCid(rCid, rhkCid, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *SetVMSecretParams) bindCid(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	value, err := swag.ConvertInt32(raw)
+	if err != nil {
+		return errors.InvalidType("cid", "path", "int32", raw)
+	}
+	o.Cid = value
+
+	return nil
+}
@@ -0,0 +1,233 @@
+package vm
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/jianqiu/vps/models"
+)
+
+/*SetVMSecretNoContent secret stored successfully
+
+swagger:response setVmSecretNoContent
+*/
+type SetVMSecretNoContent struct {
+}
+
+// NewSetVMSecretNoContent creates SetVMSecretNoContent with default headers values
+func NewSetVMSecretNoContent() *SetVMSecretNoContent {
+	return &SetVMSecretNoContent{}
+}
+
+// WriteResponse to the client
+func (o *SetVMSecretNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(204)
+}
+
+/*SetVMSecretBadRequest request is invalid
+
+swagger:response setVmSecretBadRequest
+*/
+type SetVMSecretBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewSetVMSecretBadRequest creates SetVMSecretBadRequest with default headers values
+func NewSetVMSecretBadRequest() *SetVMSecretBadRequest {
+	return &SetVMSecretBadRequest{}
+}
+
+// WithPayload adds the payload to the set Vm secret bad request response
+func (o *SetVMSecretBadRequest) WithPayload(payload *models.Error) *SetVMSecretBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the set Vm secret bad request response
+func (o *SetVMSecretBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SetVMSecretBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*SetVMSecretNotFound vm not found
+
+swagger:response setVmSecretNotFound
+*/
+type SetVMSecretNotFound struct {
+}
+
+// NewSetVMSecretNotFound creates SetVMSecretNotFound with default headers values
+func NewSetVMSecretNotFound() *SetVMSecretNotFound {
+	return &SetVMSecretNotFound{}
+}
+
+// WriteResponse to the client
+func (o *SetVMSecretNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+}
+
+/*SetVMSecretConflict request conflicts with the current state of the pool
+
+swagger:response setVmSecretConflict
+*/
+type SetVMSecretConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewSetVMSecretConflict creates SetVMSecretConflict with default headers values
+func NewSetVMSecretConflict() *SetVMSecretConflict {
+	return &SetVMSecretConflict{}
+}
+
+// WithPayload adds the payload to the set Vm secret conflict response
+func (o *SetVMSecretConflict) WithPayload(payload *models.Error) *SetVMSecretConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the set Vm secret conflict response
+func (o *SetVMSecretConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SetVMSecretConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*SetVMSecretServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response setVmSecretServiceUnavailable
+*/
+type SetVMSecretServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewSetVMSecretServiceUnavailable creates SetVMSecretServiceUnavailable with default headers values
+func NewSetVMSecretServiceUnavailable() *SetVMSecretServiceUnavailable {
+	return &SetVMSecretServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the set Vm secret service unavailable response
+func (o *SetVMSecretServiceUnavailable) WithRetryAfter(retryAfter int32) *SetVMSecretServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the set Vm secret service unavailable response
+func (o *SetVMSecretServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the set Vm secret service unavailable response
+func (o *SetVMSecretServiceUnavailable) WithPayload(payload *models.Error) *SetVMSecretServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the set Vm secret service unavailable response
+func (o *SetVMSecretServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SetVMSecretServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*SetVMSecretDefault unexpected error
+
+swagger:response setVmSecretDefault
+*/
+type SetVMSecretDefault struct {
+	_statusCode int
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewSetVMSecretDefault creates SetVMSecretDefault with default headers values
+func NewSetVMSecretDefault(code int) *SetVMSecretDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &SetVMSecretDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the set Vm secret default response
+func (o *SetVMSecretDefault) WithStatusCode(code int) *SetVMSecretDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the set Vm secret default response
+func (o *SetVMSecretDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the set Vm secret default response
+func (o *SetVMSecretDefault) WithPayload(payload *models.Error) *SetVMSecretDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the set Vm secret default response
+func (o *SetVMSecretDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SetVMSecretDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
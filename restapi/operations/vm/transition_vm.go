@@ -0,0 +1,66 @@
+package vm
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/models"
+)
+
+// TransitionVMHandlerFunc turns a function with the right signature into a transition Vm handler
+type TransitionVMHandlerFunc func(TransitionVMParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn TransitionVMHandlerFunc) Handle(params TransitionVMParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// TransitionVMHandler interface for that can handle valid transition Vm params
+type TransitionVMHandler interface {
+	Handle(TransitionVMParams, *models.User) middleware.Responder
+}
+
+// NewTransitionVM creates a new http.Handler for the transition Vm operation
+func NewTransitionVM(ctx *middleware.Context, handler TransitionVMHandler) *TransitionVM {
+	return &TransitionVM{Context: ctx, Handler: handler}
+}
+
+/*TransitionVM swagger:route POST /vms/{cid}/transition vm transitionVm
+
+Moves a vm to another lifecycle state
+
+The transition is checked against the vm lifecycle, any state may be requested
+
+*/
+type TransitionVM struct {
+	Context *middleware.Context
+	Handler TransitionVMHandler
+}
+
+func (o *TransitionVM) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, _ := o.Context.RouteInfo(r)
+	var Params = NewTransitionVMParams()
+
+	uprinc, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,251 @@
+package vm
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/jianqiu/vps/models"
+)
+
+/*TransitionVMOK state transitioned successfully
+
+swagger:response transitionVmOK
+*/
+type TransitionVMOK struct {
+
+	// In: body
+	Payload string `json:"body,omitempty"`
+}
+
+// NewTransitionVMOK creates TransitionVMOK with default headers values
+func NewTransitionVMOK() *TransitionVMOK {
+	return &TransitionVMOK{}
+}
+
+// WithPayload adds the payload to the transition Vm o k response
+func (o *TransitionVMOK) WithPayload(payload string) *TransitionVMOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the transition Vm o k response
+func (o *TransitionVMOK) SetPayload(payload string) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *TransitionVMOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if err := producer.Produce(rw, o.Payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+
+}
+
+/*TransitionVMBadRequest request is invalid
+
+swagger:response transitionVmBadRequest
+*/
+type TransitionVMBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewTransitionVMBadRequest creates TransitionVMBadRequest with default headers values
+func NewTransitionVMBadRequest() *TransitionVMBadRequest {
+	return &TransitionVMBadRequest{}
+}
+
+// WithPayload adds the payload to the transition Vm bad request response
+func (o *TransitionVMBadRequest) WithPayload(payload *models.Error) *TransitionVMBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the transition Vm bad request response
+func (o *TransitionVMBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *TransitionVMBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*TransitionVMNotFound vm not found
+
+swagger:response transitionVmNotFound
+*/
+type TransitionVMNotFound struct {
+}
+
+// NewTransitionVMNotFound creates TransitionVMNotFound with default headers values
+func NewTransitionVMNotFound() *TransitionVMNotFound {
+	return &TransitionVMNotFound{}
+}
+
+// WriteResponse to the client
+func (o *TransitionVMNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+}
+
+/*TransitionVMConflict transition is not allowed from the current state
+
+swagger:response transitionVmConflict
+*/
+type TransitionVMConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewTransitionVMConflict creates TransitionVMConflict with default headers values
+func NewTransitionVMConflict() *TransitionVMConflict {
+	return &TransitionVMConflict{}
+}
+
+// WithPayload adds the payload to the transition Vm conflict response
+func (o *TransitionVMConflict) WithPayload(payload *models.Error) *TransitionVMConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the transition Vm conflict response
+func (o *TransitionVMConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *TransitionVMConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*TransitionVMServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response transitionVmServiceUnavailable
+*/
+type TransitionVMServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewTransitionVMServiceUnavailable creates TransitionVMServiceUnavailable with default headers values
+func NewTransitionVMServiceUnavailable() *TransitionVMServiceUnavailable {
+	return &TransitionVMServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the transition Vm service unavailable response
+func (o *TransitionVMServiceUnavailable) WithRetryAfter(retryAfter int32) *TransitionVMServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the transition Vm service unavailable response
+func (o *TransitionVMServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the transition Vm service unavailable response
+func (o *TransitionVMServiceUnavailable) WithPayload(payload *models.Error) *TransitionVMServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the transition Vm service unavailable response
+func (o *TransitionVMServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *TransitionVMServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*TransitionVMDefault unexpected error
+
+swagger:response transitionVmDefault
+*/
+type TransitionVMDefault struct {
+	_statusCode int
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewTransitionVMDefault creates TransitionVMDefault with default headers values
+func NewTransitionVMDefault(code int) *TransitionVMDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &TransitionVMDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the transition Vm default response
+func (o *TransitionVMDefault) WithStatusCode(code int) *TransitionVMDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the transition Vm default response
+func (o *TransitionVMDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the transition Vm default response
+func (o *TransitionVMDefault) WithPayload(payload *models.Error) *TransitionVMDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the transition Vm default response
+func (o *TransitionVMDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *TransitionVMDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package vm
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/models"
+)
+
+// UpdatePoolVMWithStateHandlerFunc turns a function with the right signature into a update pool Vm with state handler
+type UpdatePoolVMWithStateHandlerFunc func(UpdatePoolVMWithStateParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn UpdatePoolVMWithStateHandlerFunc) Handle(params UpdatePoolVMWithStateParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// UpdatePoolVMWithStateHandler interface for that can handle valid update pool Vm with state params
+type UpdatePoolVMWithStateHandler interface {
+	Handle(UpdatePoolVMWithStateParams, *models.User) middleware.Responder
+}
+
+// NewUpdatePoolVMWithState creates a new http.Handler for the update pool Vm with state operation
+func NewUpdatePoolVMWithState(ctx *middleware.Context, handler UpdatePoolVMWithStateHandler) *UpdatePoolVMWithState {
+	return &UpdatePoolVMWithState{Context: ctx, Handler: handler}
+}
+
+/*UpdatePoolVMWithState swagger:route PUT /pools/{pool}/vms/{cid} vm updatePoolVmWithState
+
+Updates a vm of a pool with state
+
+*/
+type UpdatePoolVMWithState struct {
+	Context *middleware.Context
+	Handler UpdatePoolVMWithStateHandler
+}
+
+func (o *UpdatePoolVMWithState) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, _ := o.Context.RouteInfo(r)
+	var Params = NewUpdatePoolVMWithStateParams()
+
+	uprinc, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
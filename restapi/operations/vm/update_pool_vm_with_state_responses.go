@@ -0,0 +1,251 @@
+package vm
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/jianqiu/vps/models"
+)
+
+/*UpdatePoolVMWithStateOK state updated successfully
+
+swagger:response updatePoolVmWithStateOK
+*/
+type UpdatePoolVMWithStateOK struct {
+
+	// In: body
+	Payload string `json:"body,omitempty"`
+}
+
+// NewUpdatePoolVMWithStateOK creates UpdatePoolVMWithStateOK with default headers values
+func NewUpdatePoolVMWithStateOK() *UpdatePoolVMWithStateOK {
+	return &UpdatePoolVMWithStateOK{}
+}
+
+// WithPayload adds the payload to the update pool Vm with state o k response
+func (o *UpdatePoolVMWithStateOK) WithPayload(payload string) *UpdatePoolVMWithStateOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update pool Vm with state o k response
+func (o *UpdatePoolVMWithStateOK) SetPayload(payload string) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdatePoolVMWithStateOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if err := producer.Produce(rw, o.Payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+
+}
+
+/*UpdatePoolVMWithStateBadRequest request is invalid
+
+swagger:response updatePoolVmWithStateBadRequest
+*/
+type UpdatePoolVMWithStateBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUpdatePoolVMWithStateBadRequest creates UpdatePoolVMWithStateBadRequest with default headers values
+func NewUpdatePoolVMWithStateBadRequest() *UpdatePoolVMWithStateBadRequest {
+	return &UpdatePoolVMWithStateBadRequest{}
+}
+
+// WithPayload adds the payload to the update pool Vm with state bad request response
+func (o *UpdatePoolVMWithStateBadRequest) WithPayload(payload *models.Error) *UpdatePoolVMWithStateBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update pool Vm with state bad request response
+func (o *UpdatePoolVMWithStateBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdatePoolVMWithStateBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*UpdatePoolVMWithStateNotFound vm not found
+
+swagger:response updatePoolVmWithStateNotFound
+*/
+type UpdatePoolVMWithStateNotFound struct {
+}
+
+// NewUpdatePoolVMWithStateNotFound creates UpdatePoolVMWithStateNotFound with default headers values
+func NewUpdatePoolVMWithStateNotFound() *UpdatePoolVMWithStateNotFound {
+	return &UpdatePoolVMWithStateNotFound{}
+}
+
+// WriteResponse to the client
+func (o *UpdatePoolVMWithStateNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+}
+
+/*UpdatePoolVMWithStateConflict request conflicts with the current state of the pool
+
+swagger:response updatePoolVmWithStateConflict
+*/
+type UpdatePoolVMWithStateConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUpdatePoolVMWithStateConflict creates UpdatePoolVMWithStateConflict with default headers values
+func NewUpdatePoolVMWithStateConflict() *UpdatePoolVMWithStateConflict {
+	return &UpdatePoolVMWithStateConflict{}
+}
+
+// WithPayload adds the payload to the update pool Vm with state conflict response
+func (o *UpdatePoolVMWithStateConflict) WithPayload(payload *models.Error) *UpdatePoolVMWithStateConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update pool Vm with state conflict response
+func (o *UpdatePoolVMWithStateConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdatePoolVMWithStateConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*UpdatePoolVMWithStateServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response updatePoolVmWithStateServiceUnavailable
+*/
+type UpdatePoolVMWithStateServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUpdatePoolVMWithStateServiceUnavailable creates UpdatePoolVMWithStateServiceUnavailable with default headers values
+func NewUpdatePoolVMWithStateServiceUnavailable() *UpdatePoolVMWithStateServiceUnavailable {
+	return &UpdatePoolVMWithStateServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the update pool Vm with state service unavailable response
+func (o *UpdatePoolVMWithStateServiceUnavailable) WithRetryAfter(retryAfter int32) *UpdatePoolVMWithStateServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the update pool Vm with state service unavailable response
+func (o *UpdatePoolVMWithStateServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the update pool Vm with state service unavailable response
+func (o *UpdatePoolVMWithStateServiceUnavailable) WithPayload(payload *models.Error) *UpdatePoolVMWithStateServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update pool Vm with state service unavailable response
+func (o *UpdatePoolVMWithStateServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdatePoolVMWithStateServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*UpdatePoolVMWithStateDefault unexpected error
+
+swagger:response updatePoolVmWithStateDefault
+*/
+type UpdatePoolVMWithStateDefault struct {
+	_statusCode int
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUpdatePoolVMWithStateDefault creates UpdatePoolVMWithStateDefault with default headers values
+func NewUpdatePoolVMWithStateDefault(code int) *UpdatePoolVMWithStateDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &UpdatePoolVMWithStateDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the update pool Vm with state default response
+func (o *UpdatePoolVMWithStateDefault) WithStatusCode(code int) *UpdatePoolVMWithStateDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the update pool Vm with state default response
+func (o *UpdatePoolVMWithStateDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the update pool Vm with state default response
+func (o *UpdatePoolVMWithStateDefault) WithPayload(payload *models.Error) *UpdatePoolVMWithStateDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update pool Vm with state default response
+func (o *UpdatePoolVMWithStateDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdatePoolVMWithStateDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -4,6 +4,7 @@ package vm
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/go-openapi/runtime"
@@ -47,6 +48,43 @@ func (o *UpdateVMOK) WriteResponse(rw http.ResponseWriter, producer runtime.Prod
 
 }
 
+/*UpdateVMBadRequest request is invalid
+
+swagger:response updateVmBadRequest
+*/
+type UpdateVMBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUpdateVMBadRequest creates UpdateVMBadRequest with default headers values
+func NewUpdateVMBadRequest() *UpdateVMBadRequest {
+	return &UpdateVMBadRequest{}
+}
+
+// WithPayload adds the payload to the update Vm bad request response
+func (o *UpdateVMBadRequest) WithPayload(payload *models.Error) *UpdateVMBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update Vm bad request response
+func (o *UpdateVMBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateVMBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 /*UpdateVMNotFound vm not found
 
 swagger:response updateVmNotFound
@@ -65,6 +103,98 @@ func (o *UpdateVMNotFound) WriteResponse(rw http.ResponseWriter, producer runtim
 	rw.WriteHeader(404)
 }
 
+/*UpdateVMConflict request conflicts with the current state of the pool
+
+swagger:response updateVmConflict
+*/
+type UpdateVMConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUpdateVMConflict creates UpdateVMConflict with default headers values
+func NewUpdateVMConflict() *UpdateVMConflict {
+	return &UpdateVMConflict{}
+}
+
+// WithPayload adds the payload to the update Vm conflict response
+func (o *UpdateVMConflict) WithPayload(payload *models.Error) *UpdateVMConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update Vm conflict response
+func (o *UpdateVMConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateVMConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*UpdateVMServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response updateVmServiceUnavailable
+*/
+type UpdateVMServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUpdateVMServiceUnavailable creates UpdateVMServiceUnavailable with default headers values
+func NewUpdateVMServiceUnavailable() *UpdateVMServiceUnavailable {
+	return &UpdateVMServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the update Vm service unavailable response
+func (o *UpdateVMServiceUnavailable) WithRetryAfter(retryAfter int32) *UpdateVMServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the update Vm service unavailable response
+func (o *UpdateVMServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the update Vm service unavailable response
+func (o *UpdateVMServiceUnavailable) WithPayload(payload *models.Error) *UpdateVMServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update Vm service unavailable response
+func (o *UpdateVMServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateVMServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 /*UpdateVMDefault unexpected error
 
 swagger:response updateVmDefault
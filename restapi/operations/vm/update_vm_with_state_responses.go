@@ -4,6 +4,7 @@ package vm
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/go-openapi/runtime"
@@ -47,6 +48,43 @@ func (o *UpdateVMWithStateOK) WriteResponse(rw http.ResponseWriter, producer run
 
 }
 
+/*UpdateVMWithStateBadRequest request is invalid
+
+swagger:response updateVmWithStateBadRequest
+*/
+type UpdateVMWithStateBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUpdateVMWithStateBadRequest creates UpdateVMWithStateBadRequest with default headers values
+func NewUpdateVMWithStateBadRequest() *UpdateVMWithStateBadRequest {
+	return &UpdateVMWithStateBadRequest{}
+}
+
+// WithPayload adds the payload to the update Vm with state bad request response
+func (o *UpdateVMWithStateBadRequest) WithPayload(payload *models.Error) *UpdateVMWithStateBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update Vm with state bad request response
+func (o *UpdateVMWithStateBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateVMWithStateBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 /*UpdateVMWithStateNotFound vm not found
 
 swagger:response updateVmWithStateNotFound
@@ -65,6 +103,98 @@ func (o *UpdateVMWithStateNotFound) WriteResponse(rw http.ResponseWriter, produc
 	rw.WriteHeader(404)
 }
 
+/*UpdateVMWithStateConflict request conflicts with the current state of the pool
+
+swagger:response updateVmWithStateConflict
+*/
+type UpdateVMWithStateConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUpdateVMWithStateConflict creates UpdateVMWithStateConflict with default headers values
+func NewUpdateVMWithStateConflict() *UpdateVMWithStateConflict {
+	return &UpdateVMWithStateConflict{}
+}
+
+// WithPayload adds the payload to the update Vm with state conflict response
+func (o *UpdateVMWithStateConflict) WithPayload(payload *models.Error) *UpdateVMWithStateConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update Vm with state conflict response
+func (o *UpdateVMWithStateConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateVMWithStateConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*UpdateVMWithStateServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response updateVmWithStateServiceUnavailable
+*/
+type UpdateVMWithStateServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUpdateVMWithStateServiceUnavailable creates UpdateVMWithStateServiceUnavailable with default headers values
+func NewUpdateVMWithStateServiceUnavailable() *UpdateVMWithStateServiceUnavailable {
+	return &UpdateVMWithStateServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the update Vm with state service unavailable response
+func (o *UpdateVMWithStateServiceUnavailable) WithRetryAfter(retryAfter int32) *UpdateVMWithStateServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the update Vm with state service unavailable response
+func (o *UpdateVMWithStateServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the update Vm with state service unavailable response
+func (o *UpdateVMWithStateServiceUnavailable) WithPayload(payload *models.Error) *UpdateVMWithStateServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update Vm with state service unavailable response
+func (o *UpdateVMWithStateServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateVMWithStateServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 /*UpdateVMWithStateDefault unexpected error
 
 swagger:response updateVmWithStateDefault
@@ -0,0 +1,66 @@
+package webhook
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/models"
+)
+
+// CreateWebhookHandlerFunc turns a function with the right signature into a create webhook handler
+type CreateWebhookHandlerFunc func(CreateWebhookParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn CreateWebhookHandlerFunc) Handle(params CreateWebhookParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// CreateWebhookHandler interface for that can handle valid create webhook params
+type CreateWebhookHandler interface {
+	Handle(CreateWebhookParams, *models.User) middleware.Responder
+}
+
+// NewCreateWebhook creates a new http.Handler for the create webhook operation
+func NewCreateWebhook(ctx *middleware.Context, handler CreateWebhookHandler) *CreateWebhook {
+	return &CreateWebhook{Context: ctx, Handler: handler}
+}
+
+/*CreateWebhook swagger:route POST /webhooks webhook createWebhook
+
+Subscribe a webhook to pool events
+
+The signing secret is generated when none is given and only returned here
+
+*/
+type CreateWebhook struct {
+	Context *middleware.Context
+	Handler CreateWebhookHandler
+}
+
+func (o *CreateWebhook) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, _ := o.Context.RouteInfo(r)
+	var Params = NewCreateWebhookParams()
+
+	uprinc, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,234 @@
+package webhook
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/jianqiu/vps/models"
+)
+
+/*CreateWebhookOK webhook subscribed
+
+swagger:response createWebhookOK
+*/
+type CreateWebhookOK struct {
+
+	// In: body
+	Payload *models.Webhook `json:"body,omitempty"`
+}
+
+// NewCreateWebhookOK creates CreateWebhookOK with default headers values
+func NewCreateWebhookOK() *CreateWebhookOK {
+	return &CreateWebhookOK{}
+}
+
+// WithPayload adds the payload to the create webhook o k response
+func (o *CreateWebhookOK) WithPayload(payload *models.Webhook) *CreateWebhookOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create webhook o k response
+func (o *CreateWebhookOK) SetPayload(payload *models.Webhook) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateWebhookOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*CreateWebhookBadRequest request is invalid
+
+swagger:response createWebhookBadRequest
+*/
+type CreateWebhookBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateWebhookBadRequest creates CreateWebhookBadRequest with default headers values
+func NewCreateWebhookBadRequest() *CreateWebhookBadRequest {
+	return &CreateWebhookBadRequest{}
+}
+
+// WithPayload adds the payload to the create webhook bad request response
+func (o *CreateWebhookBadRequest) WithPayload(payload *models.Error) *CreateWebhookBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create webhook bad request response
+func (o *CreateWebhookBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateWebhookBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*CreateWebhookConflict request conflicts with the current state of the pool
+
+swagger:response createWebhookConflict
+*/
+type CreateWebhookConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateWebhookConflict creates CreateWebhookConflict with default headers values
+func NewCreateWebhookConflict() *CreateWebhookConflict {
+	return &CreateWebhookConflict{}
+}
+
+// WithPayload adds the payload to the create webhook conflict response
+func (o *CreateWebhookConflict) WithPayload(payload *models.Error) *CreateWebhookConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create webhook conflict response
+func (o *CreateWebhookConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateWebhookConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*CreateWebhookServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response createWebhookServiceUnavailable
+*/
+type CreateWebhookServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateWebhookServiceUnavailable creates CreateWebhookServiceUnavailable with default headers values
+func NewCreateWebhookServiceUnavailable() *CreateWebhookServiceUnavailable {
+	return &CreateWebhookServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the create webhook service unavailable response
+func (o *CreateWebhookServiceUnavailable) WithRetryAfter(retryAfter int32) *CreateWebhookServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the create webhook service unavailable response
+func (o *CreateWebhookServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the create webhook service unavailable response
+func (o *CreateWebhookServiceUnavailable) WithPayload(payload *models.Error) *CreateWebhookServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create webhook service unavailable response
+func (o *CreateWebhookServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateWebhookServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*CreateWebhookDefault unexpected error
+
+swagger:response createWebhookDefault
+*/
+type CreateWebhookDefault struct {
+	_statusCode int
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateWebhookDefault creates CreateWebhookDefault with default headers values
+func NewCreateWebhookDefault(code int) *CreateWebhookDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &CreateWebhookDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the create webhook default response
+func (o *CreateWebhookDefault) WithStatusCode(code int) *CreateWebhookDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the create webhook default response
+func (o *CreateWebhookDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the create webhook default response
+func (o *CreateWebhookDefault) WithPayload(payload *models.Error) *CreateWebhookDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create webhook default response
+func (o *CreateWebhookDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateWebhookDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,233 @@
+package webhook
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/jianqiu/vps/models"
+)
+
+/*DeleteWebhookNoContent webhook removed successfully
+
+swagger:response deleteWebhookNoContent
+*/
+type DeleteWebhookNoContent struct {
+}
+
+// NewDeleteWebhookNoContent creates DeleteWebhookNoContent with default headers values
+func NewDeleteWebhookNoContent() *DeleteWebhookNoContent {
+	return &DeleteWebhookNoContent{}
+}
+
+// WriteResponse to the client
+func (o *DeleteWebhookNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(204)
+}
+
+/*DeleteWebhookBadRequest request is invalid
+
+swagger:response deleteWebhookBadRequest
+*/
+type DeleteWebhookBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeleteWebhookBadRequest creates DeleteWebhookBadRequest with default headers values
+func NewDeleteWebhookBadRequest() *DeleteWebhookBadRequest {
+	return &DeleteWebhookBadRequest{}
+}
+
+// WithPayload adds the payload to the delete webhook bad request response
+func (o *DeleteWebhookBadRequest) WithPayload(payload *models.Error) *DeleteWebhookBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete webhook bad request response
+func (o *DeleteWebhookBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteWebhookBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*DeleteWebhookNotFound webhook not found
+
+swagger:response deleteWebhookNotFound
+*/
+type DeleteWebhookNotFound struct {
+}
+
+// NewDeleteWebhookNotFound creates DeleteWebhookNotFound with default headers values
+func NewDeleteWebhookNotFound() *DeleteWebhookNotFound {
+	return &DeleteWebhookNotFound{}
+}
+
+// WriteResponse to the client
+func (o *DeleteWebhookNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+}
+
+/*DeleteWebhookConflict request conflicts with the current state of the pool
+
+swagger:response deleteWebhookConflict
+*/
+type DeleteWebhookConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeleteWebhookConflict creates DeleteWebhookConflict with default headers values
+func NewDeleteWebhookConflict() *DeleteWebhookConflict {
+	return &DeleteWebhookConflict{}
+}
+
+// WithPayload adds the payload to the delete webhook conflict response
+func (o *DeleteWebhookConflict) WithPayload(payload *models.Error) *DeleteWebhookConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete webhook conflict response
+func (o *DeleteWebhookConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteWebhookConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*DeleteWebhookServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response deleteWebhookServiceUnavailable
+*/
+type DeleteWebhookServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeleteWebhookServiceUnavailable creates DeleteWebhookServiceUnavailable with default headers values
+func NewDeleteWebhookServiceUnavailable() *DeleteWebhookServiceUnavailable {
+	return &DeleteWebhookServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the delete webhook service unavailable response
+func (o *DeleteWebhookServiceUnavailable) WithRetryAfter(retryAfter int32) *DeleteWebhookServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the delete webhook service unavailable response
+func (o *DeleteWebhookServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the delete webhook service unavailable response
+func (o *DeleteWebhookServiceUnavailable) WithPayload(payload *models.Error) *DeleteWebhookServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete webhook service unavailable response
+func (o *DeleteWebhookServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteWebhookServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*DeleteWebhookDefault unexpected error
+
+swagger:response deleteWebhookDefault
+*/
+type DeleteWebhookDefault struct {
+	_statusCode int
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeleteWebhookDefault creates DeleteWebhookDefault with default headers values
+func NewDeleteWebhookDefault(code int) *DeleteWebhookDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &DeleteWebhookDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the delete webhook default response
+func (o *DeleteWebhookDefault) WithStatusCode(code int) *DeleteWebhookDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the delete webhook default response
+func (o *DeleteWebhookDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the delete webhook default response
+func (o *DeleteWebhookDefault) WithPayload(payload *models.Error) *DeleteWebhookDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete webhook default response
+func (o *DeleteWebhookDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteWebhookDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
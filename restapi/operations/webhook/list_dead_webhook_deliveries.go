@@ -0,0 +1,64 @@
+package webhook
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/models"
+)
+
+// ListDeadWebhookDeliveriesHandlerFunc turns a function with the right signature into a list dead webhook deliveries handler
+type ListDeadWebhookDeliveriesHandlerFunc func(ListDeadWebhookDeliveriesParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListDeadWebhookDeliveriesHandlerFunc) Handle(params ListDeadWebhookDeliveriesParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ListDeadWebhookDeliveriesHandler interface for that can handle valid list dead webhook deliveries params
+type ListDeadWebhookDeliveriesHandler interface {
+	Handle(ListDeadWebhookDeliveriesParams, *models.User) middleware.Responder
+}
+
+// NewListDeadWebhookDeliveries creates a new http.Handler for the list dead webhook deliveries operation
+func NewListDeadWebhookDeliveries(ctx *middleware.Context, handler ListDeadWebhookDeliveriesHandler) *ListDeadWebhookDeliveries {
+	return &ListDeadWebhookDeliveries{Context: ctx, Handler: handler}
+}
+
+/*ListDeadWebhookDeliveries swagger:route GET /webhooks/deliveries/dead webhook listDeadWebhookDeliveries
+
+List webhook deliveries that ran out of attempts
+
+*/
+type ListDeadWebhookDeliveries struct {
+	Context *middleware.Context
+	Handler ListDeadWebhookDeliveriesHandler
+}
+
+func (o *ListDeadWebhookDeliveries) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, _ := o.Context.RouteInfo(r)
+	var Params = NewListDeadWebhookDeliveriesParams()
+
+	uprinc, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
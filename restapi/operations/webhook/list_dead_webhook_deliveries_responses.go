@@ -0,0 +1,234 @@
+package webhook
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/jianqiu/vps/models"
+)
+
+/*ListDeadWebhookDeliveriesOK successful operation
+
+swagger:response listDeadWebhookDeliveriesOK
+*/
+type ListDeadWebhookDeliveriesOK struct {
+
+	// In: body
+	Payload *models.WebhookDeliveriesResponse `json:"body,omitempty"`
+}
+
+// NewListDeadWebhookDeliveriesOK creates ListDeadWebhookDeliveriesOK with default headers values
+func NewListDeadWebhookDeliveriesOK() *ListDeadWebhookDeliveriesOK {
+	return &ListDeadWebhookDeliveriesOK{}
+}
+
+// WithPayload adds the payload to the list dead webhook deliveries o k response
+func (o *ListDeadWebhookDeliveriesOK) WithPayload(payload *models.WebhookDeliveriesResponse) *ListDeadWebhookDeliveriesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list dead webhook deliveries o k response
+func (o *ListDeadWebhookDeliveriesOK) SetPayload(payload *models.WebhookDeliveriesResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListDeadWebhookDeliveriesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListDeadWebhookDeliveriesBadRequest request is invalid
+
+swagger:response listDeadWebhookDeliveriesBadRequest
+*/
+type ListDeadWebhookDeliveriesBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListDeadWebhookDeliveriesBadRequest creates ListDeadWebhookDeliveriesBadRequest with default headers values
+func NewListDeadWebhookDeliveriesBadRequest() *ListDeadWebhookDeliveriesBadRequest {
+	return &ListDeadWebhookDeliveriesBadRequest{}
+}
+
+// WithPayload adds the payload to the list dead webhook deliveries bad request response
+func (o *ListDeadWebhookDeliveriesBadRequest) WithPayload(payload *models.Error) *ListDeadWebhookDeliveriesBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list dead webhook deliveries bad request response
+func (o *ListDeadWebhookDeliveriesBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListDeadWebhookDeliveriesBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListDeadWebhookDeliveriesConflict request conflicts with the current state of the pool
+
+swagger:response listDeadWebhookDeliveriesConflict
+*/
+type ListDeadWebhookDeliveriesConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListDeadWebhookDeliveriesConflict creates ListDeadWebhookDeliveriesConflict with default headers values
+func NewListDeadWebhookDeliveriesConflict() *ListDeadWebhookDeliveriesConflict {
+	return &ListDeadWebhookDeliveriesConflict{}
+}
+
+// WithPayload adds the payload to the list dead webhook deliveries conflict response
+func (o *ListDeadWebhookDeliveriesConflict) WithPayload(payload *models.Error) *ListDeadWebhookDeliveriesConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list dead webhook deliveries conflict response
+func (o *ListDeadWebhookDeliveriesConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListDeadWebhookDeliveriesConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListDeadWebhookDeliveriesServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response listDeadWebhookDeliveriesServiceUnavailable
+*/
+type ListDeadWebhookDeliveriesServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListDeadWebhookDeliveriesServiceUnavailable creates ListDeadWebhookDeliveriesServiceUnavailable with default headers values
+func NewListDeadWebhookDeliveriesServiceUnavailable() *ListDeadWebhookDeliveriesServiceUnavailable {
+	return &ListDeadWebhookDeliveriesServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the list dead webhook deliveries service unavailable response
+func (o *ListDeadWebhookDeliveriesServiceUnavailable) WithRetryAfter(retryAfter int32) *ListDeadWebhookDeliveriesServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the list dead webhook deliveries service unavailable response
+func (o *ListDeadWebhookDeliveriesServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the list dead webhook deliveries service unavailable response
+func (o *ListDeadWebhookDeliveriesServiceUnavailable) WithPayload(payload *models.Error) *ListDeadWebhookDeliveriesServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list dead webhook deliveries service unavailable response
+func (o *ListDeadWebhookDeliveriesServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListDeadWebhookDeliveriesServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListDeadWebhookDeliveriesDefault unexpected error
+
+swagger:response listDeadWebhookDeliveriesDefault
+*/
+type ListDeadWebhookDeliveriesDefault struct {
+	_statusCode int
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListDeadWebhookDeliveriesDefault creates ListDeadWebhookDeliveriesDefault with default headers values
+func NewListDeadWebhookDeliveriesDefault(code int) *ListDeadWebhookDeliveriesDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListDeadWebhookDeliveriesDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list dead webhook deliveries default response
+func (o *ListDeadWebhookDeliveriesDefault) WithStatusCode(code int) *ListDeadWebhookDeliveriesDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list dead webhook deliveries default response
+func (o *ListDeadWebhookDeliveriesDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list dead webhook deliveries default response
+func (o *ListDeadWebhookDeliveriesDefault) WithPayload(payload *models.Error) *ListDeadWebhookDeliveriesDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list dead webhook deliveries default response
+func (o *ListDeadWebhookDeliveriesDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListDeadWebhookDeliveriesDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,234 @@
+package webhook
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/jianqiu/vps/models"
+)
+
+/*ListWebhooksOK successful operation
+
+swagger:response listWebhooksOK
+*/
+type ListWebhooksOK struct {
+
+	// In: body
+	Payload *models.WebhooksResponse `json:"body,omitempty"`
+}
+
+// NewListWebhooksOK creates ListWebhooksOK with default headers values
+func NewListWebhooksOK() *ListWebhooksOK {
+	return &ListWebhooksOK{}
+}
+
+// WithPayload adds the payload to the list webhooks o k response
+func (o *ListWebhooksOK) WithPayload(payload *models.WebhooksResponse) *ListWebhooksOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list webhooks o k response
+func (o *ListWebhooksOK) SetPayload(payload *models.WebhooksResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListWebhooksOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListWebhooksBadRequest request is invalid
+
+swagger:response listWebhooksBadRequest
+*/
+type ListWebhooksBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListWebhooksBadRequest creates ListWebhooksBadRequest with default headers values
+func NewListWebhooksBadRequest() *ListWebhooksBadRequest {
+	return &ListWebhooksBadRequest{}
+}
+
+// WithPayload adds the payload to the list webhooks bad request response
+func (o *ListWebhooksBadRequest) WithPayload(payload *models.Error) *ListWebhooksBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list webhooks bad request response
+func (o *ListWebhooksBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListWebhooksBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListWebhooksConflict request conflicts with the current state of the pool
+
+swagger:response listWebhooksConflict
+*/
+type ListWebhooksConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListWebhooksConflict creates ListWebhooksConflict with default headers values
+func NewListWebhooksConflict() *ListWebhooksConflict {
+	return &ListWebhooksConflict{}
+}
+
+// WithPayload adds the payload to the list webhooks conflict response
+func (o *ListWebhooksConflict) WithPayload(payload *models.Error) *ListWebhooksConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list webhooks conflict response
+func (o *ListWebhooksConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListWebhooksConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListWebhooksServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response listWebhooksServiceUnavailable
+*/
+type ListWebhooksServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListWebhooksServiceUnavailable creates ListWebhooksServiceUnavailable with default headers values
+func NewListWebhooksServiceUnavailable() *ListWebhooksServiceUnavailable {
+	return &ListWebhooksServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the list webhooks service unavailable response
+func (o *ListWebhooksServiceUnavailable) WithRetryAfter(retryAfter int32) *ListWebhooksServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the list webhooks service unavailable response
+func (o *ListWebhooksServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the list webhooks service unavailable response
+func (o *ListWebhooksServiceUnavailable) WithPayload(payload *models.Error) *ListWebhooksServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list webhooks service unavailable response
+func (o *ListWebhooksServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListWebhooksServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListWebhooksDefault unexpected error
+
+swagger:response listWebhooksDefault
+*/
+type ListWebhooksDefault struct {
+	_statusCode int
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListWebhooksDefault creates ListWebhooksDefault with default headers values
+func NewListWebhooksDefault(code int) *ListWebhooksDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListWebhooksDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list webhooks default response
+func (o *ListWebhooksDefault) WithStatusCode(code int) *ListWebhooksDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list webhooks default response
+func (o *ListWebhooksDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list webhooks default response
+func (o *ListWebhooksDefault) WithPayload(payload *models.Error) *ListWebhooksDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list webhooks default response
+func (o *ListWebhooksDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListWebhooksDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
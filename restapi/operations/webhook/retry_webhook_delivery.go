@@ -0,0 +1,64 @@
+package webhook
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/jianqiu/vps/models"
+)
+
+// RetryWebhookDeliveryHandlerFunc turns a function with the right signature into a retry webhook delivery handler
+type RetryWebhookDeliveryHandlerFunc func(RetryWebhookDeliveryParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn RetryWebhookDeliveryHandlerFunc) Handle(params RetryWebhookDeliveryParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// RetryWebhookDeliveryHandler interface for that can handle valid retry webhook delivery params
+type RetryWebhookDeliveryHandler interface {
+	Handle(RetryWebhookDeliveryParams, *models.User) middleware.Responder
+}
+
+// NewRetryWebhookDelivery creates a new http.Handler for the retry webhook delivery operation
+func NewRetryWebhookDelivery(ctx *middleware.Context, handler RetryWebhookDeliveryHandler) *RetryWebhookDelivery {
+	return &RetryWebhookDelivery{Context: ctx, Handler: handler}
+}
+
+/*RetryWebhookDelivery swagger:route POST /webhooks/deliveries/{id}/retry webhook retryWebhookDelivery
+
+Queue a dead webhook delivery for another round of attempts
+
+*/
+type RetryWebhookDelivery struct {
+	Context *middleware.Context
+	Handler RetryWebhookDeliveryHandler
+}
+
+func (o *RetryWebhookDelivery) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, _ := o.Context.RouteInfo(r)
+	var Params = NewRetryWebhookDeliveryParams()
+
+	uprinc, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,64 @@
+package webhook
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewRetryWebhookDeliveryParams creates a new RetryWebhookDeliveryParams object
+// with the default values initialized.
+func NewRetryWebhookDeliveryParams() RetryWebhookDeliveryParams {
+	var ()
+	return RetryWebhookDeliveryParams{}
+}
+
+// RetryWebhookDeliveryParams contains all the bound params for the retry webhook delivery operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters retryWebhookDelivery
+type RetryWebhookDeliveryParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request
+
+	/*delivery id to retry
+	  Required: true
+	  In: path
+	*/
+	ID string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls
+func (o *RetryWebhookDeliveryParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+	o.HTTPRequest = r
+
+	rID, rhkID, _ := route.Params.GetOK("id")
+	if err := o.bindID(rID, rhkID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *RetryWebhookDeliveryParams) bindID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	o.ID = raw
+
+	return nil
+}
@@ -0,0 +1,251 @@
+package webhook
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/jianqiu/vps/models"
+)
+
+/*RetryWebhookDeliveryOK delivery queued
+
+swagger:response retryWebhookDeliveryOK
+*/
+type RetryWebhookDeliveryOK struct {
+
+	// In: body
+	Payload string `json:"body,omitempty"`
+}
+
+// NewRetryWebhookDeliveryOK creates RetryWebhookDeliveryOK with default headers values
+func NewRetryWebhookDeliveryOK() *RetryWebhookDeliveryOK {
+	return &RetryWebhookDeliveryOK{}
+}
+
+// WithPayload adds the payload to the retry webhook delivery o k response
+func (o *RetryWebhookDeliveryOK) WithPayload(payload string) *RetryWebhookDeliveryOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the retry webhook delivery o k response
+func (o *RetryWebhookDeliveryOK) SetPayload(payload string) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RetryWebhookDeliveryOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if err := producer.Produce(rw, o.Payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+
+}
+
+/*RetryWebhookDeliveryBadRequest request is invalid
+
+swagger:response retryWebhookDeliveryBadRequest
+*/
+type RetryWebhookDeliveryBadRequest struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewRetryWebhookDeliveryBadRequest creates RetryWebhookDeliveryBadRequest with default headers values
+func NewRetryWebhookDeliveryBadRequest() *RetryWebhookDeliveryBadRequest {
+	return &RetryWebhookDeliveryBadRequest{}
+}
+
+// WithPayload adds the payload to the retry webhook delivery bad request response
+func (o *RetryWebhookDeliveryBadRequest) WithPayload(payload *models.Error) *RetryWebhookDeliveryBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the retry webhook delivery bad request response
+func (o *RetryWebhookDeliveryBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RetryWebhookDeliveryBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*RetryWebhookDeliveryNotFound dead delivery not found
+
+swagger:response retryWebhookDeliveryNotFound
+*/
+type RetryWebhookDeliveryNotFound struct {
+}
+
+// NewRetryWebhookDeliveryNotFound creates RetryWebhookDeliveryNotFound with default headers values
+func NewRetryWebhookDeliveryNotFound() *RetryWebhookDeliveryNotFound {
+	return &RetryWebhookDeliveryNotFound{}
+}
+
+// WriteResponse to the client
+func (o *RetryWebhookDeliveryNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+}
+
+/*RetryWebhookDeliveryConflict request conflicts with the current state of the pool
+
+swagger:response retryWebhookDeliveryConflict
+*/
+type RetryWebhookDeliveryConflict struct {
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewRetryWebhookDeliveryConflict creates RetryWebhookDeliveryConflict with default headers values
+func NewRetryWebhookDeliveryConflict() *RetryWebhookDeliveryConflict {
+	return &RetryWebhookDeliveryConflict{}
+}
+
+// WithPayload adds the payload to the retry webhook delivery conflict response
+func (o *RetryWebhookDeliveryConflict) WithPayload(payload *models.Error) *RetryWebhookDeliveryConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the retry webhook delivery conflict response
+func (o *RetryWebhookDeliveryConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RetryWebhookDeliveryConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*RetryWebhookDeliveryServiceUnavailable database is unavailable, retry after the given number of seconds
+
+swagger:response retryWebhookDeliveryServiceUnavailable
+*/
+type RetryWebhookDeliveryServiceUnavailable struct {
+
+	/*seconds to wait before retrying
+	 */
+	RetryAfter int32 `json:"Retry-After"`
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewRetryWebhookDeliveryServiceUnavailable creates RetryWebhookDeliveryServiceUnavailable with default headers values
+func NewRetryWebhookDeliveryServiceUnavailable() *RetryWebhookDeliveryServiceUnavailable {
+	return &RetryWebhookDeliveryServiceUnavailable{}
+}
+
+// WithRetryAfter adds the retryAfter to the retry webhook delivery service unavailable response
+func (o *RetryWebhookDeliveryServiceUnavailable) WithRetryAfter(retryAfter int32) *RetryWebhookDeliveryServiceUnavailable {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the retry webhook delivery service unavailable response
+func (o *RetryWebhookDeliveryServiceUnavailable) SetRetryAfter(retryAfter int32) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the retry webhook delivery service unavailable response
+func (o *RetryWebhookDeliveryServiceUnavailable) WithPayload(payload *models.Error) *RetryWebhookDeliveryServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the retry webhook delivery service unavailable response
+func (o *RetryWebhookDeliveryServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RetryWebhookDeliveryServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+	rw.Header().Set("Retry-After", fmt.Sprintf("%v", o.RetryAfter))
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*RetryWebhookDeliveryDefault unexpected error
+
+swagger:response retryWebhookDeliveryDefault
+*/
+type RetryWebhookDeliveryDefault struct {
+	_statusCode int
+
+	// In: body
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewRetryWebhookDeliveryDefault creates RetryWebhookDeliveryDefault with default headers values
+func NewRetryWebhookDeliveryDefault(code int) *RetryWebhookDeliveryDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &RetryWebhookDeliveryDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the retry webhook delivery default response
+func (o *RetryWebhookDeliveryDefault) WithStatusCode(code int) *RetryWebhookDeliveryDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the retry webhook delivery default response
+func (o *RetryWebhookDeliveryDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the retry webhook delivery default response
+func (o *RetryWebhookDeliveryDefault) WithPayload(payload *models.Error) *RetryWebhookDeliveryDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the retry webhook delivery default response
+func (o *RetryWebhookDeliveryDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RetryWebhookDeliveryDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		if err := producer.Produce(rw, o.Payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
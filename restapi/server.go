@@ -15,6 +15,9 @@ import (
 	graceful "github.com/tylerb/graceful"
 
 	"github.com/jianqiu/vps/db"
+	"github.com/jianqiu/vps/controllers"
+	"github.com/jianqiu/vps/restapi/handlers"
+	"github.com/jianqiu/vps/settings"
 	"code.cloudfoundry.org/lager"
 	"github.com/jianqiu/vps/restapi/operations"
 )
@@ -42,9 +45,9 @@ func NewServer(api *operations.SoftLayerVMPoolAPI) *Server {
 }
 
 // ConfigureAPI configures the API and handlers. Needs to be called before Serve
-func (s *Server) ConfigureAPI(logger lager.Logger, db db.DB) {
+func (s *Server) ConfigureAPI(logger lager.Logger, db db.DB, reloader controllers.OSReloader, reaper handlers.ReaperController, health handlers.HealthController, logLevel handlers.LogLevelController, settingsStore *settings.Store, secrets handlers.SecretController) {
 	if s.api != nil {
-		s.handler = configureAPI(s.api, logger, db)
+		s.handler = configureAPI(s.api, logger, db, reloader, s.StrictFlavors, reaper, health, logLevel, settingsStore, secrets, s.AdminCredentials())
 	}
 }
 
@@ -61,8 +64,68 @@ type Server struct {
 	DBConn string `long:"databaseConnectionString"`
 	MaxDatabaseConnections int `long:"MaxDatabaseConnections" default:"200"`
 	SqlCACertFile string `long:"sqlCACertFile"`
+	DBRetryAttempts int `long:"databaseRetryAttempts" default:"3" description:"attempts a transaction gets when it fails with a deadlock or a serialization failure"`
+	DBRetryInitialBackoff time.Duration `long:"databaseRetryInitialBackoff" default:"100ms" description:"wait after the first deadlocked attempt, doubled for every further attempt"`
+	DBRetryMaxBackoff time.Duration `long:"databaseRetryMaxBackoff" default:"2s" description:"longest wait between attempts of a deadlocked transaction"`
+	DBRetryJitter float64 `long:"databaseRetryJitter" default:"0.5" description:"share of the wait, between 0 and 1, taken off at random"`
+	DBIsolationLevels []string `long:"databaseIsolationLevel" description:"operation:level, e.g. order-free-vm:SERIALIZABLE, to run an operation's transactions at another isolation level; can be repeated"`
 
-	LogLevel string `long:"logLevel" default:"debug"`
+	ConfigFile flags.Filename `long:"config" description:"a YAML or JSON file of settings named after the long flags; flags given on the command line win"`
+
+	LogLevel string `long:"logLevel" default:"debug" description:"debug, info, error or fatal; reloaded from the config file on SIGHUP"`
+
+	ReleaseMode string `long:"releaseMode" default:"free" choice:"free" choice:"reload" description:"what happens to a vm released by its deployment: free returns it to the pool, reload reloads its OS first"`
+	SoftLayerEndpoint string `long:"softlayerEndpoint" default:"https://api.softlayer.com/rest/v3" description:"the SoftLayer REST API endpoint"`
+	SoftLayerUsername string `long:"softlayerUsername" description:"the SoftLayer API username"`
+	SoftLayerAPIKey string `long:"softlayerAPIKey" description:"the SoftLayer API key"`
+	ReloadPollInterval time.Duration `long:"reloadPollInterval" default:"30s" description:"how often to check whether an OS reload has finished"`
+	ReloadTimeout time.Duration `long:"reloadTimeout" default:"2h" description:"how long an OS reload may take before the vm is marked failed"`
+
+	StrictFlavors bool `long:"strictFlavors" description:"reject vms added through the API that match none of the flavors in the catalog"`
+
+	ReplenishmentPolicyFile string `long:"replenishmentPolicyFile" description:"a JSON file of per flavor policies; when set, missing free vms are ordered from SoftLayer"`
+	ReplenishInterval time.Duration `long:"replenishInterval" default:"1m" description:"how often to check the pool against the replenishment policies"`
+	ReplenishOrderTimeout time.Duration `long:"replenishOrderTimeout" default:"6h" description:"how long an ordered vm may take to be provisioned before its order is cancelled; 0 waits forever"`
+	SoftLayerDatacenter string `long:"softlayerDatacenter" description:"the datacenter new vms are ordered in"`
+	SoftLayerDomain string `long:"softlayerDomain" description:"the domain of new vms"`
+	SoftLayerHostnamePrefix string `long:"softlayerHostnamePrefix" default:"vps" description:"the prefix of the hostnames of new vms"`
+	SoftLayerOSReferenceCode string `long:"softlayerOSReferenceCode" default:"UBUNTU_LATEST" description:"the operating system new vms are ordered with"`
+	SoftLayerHourlyBilling bool `long:"softlayerHourlyBilling" description:"order new vms with hourly instead of monthly billing"`
+
+	ReaperPolicyFile string `long:"reaperPolicyFile" description:"a JSON file of per flavor policies; when set, surplus idle free vms are cancelled"`
+	ReapInterval time.Duration `long:"reapInterval" default:"1h" description:"how often to look for surplus idle free vms"`
+
+	ReservationInterval time.Duration `long:"reservationInterval" default:"30s" description:"how often to start scheduled reservations and release the vms of expired ones"`
+
+	SettingsPollInterval time.Duration `long:"settingsPollInterval" default:"5s" description:"how often to read the runtime settings, which other servers may have changed"`
+
+	AdminUsername string `long:"adminUsername" default:"admin" description:"the username of the administrator, who has access to every pool"`
+	AdminPasswordHash string `long:"adminPasswordHash" description:"the bcrypt hash of the password of the administrator; nobody logs in as the administrator without it"`
+
+	EncryptionKeys []string `long:"encryptionKey" description:"label:passphrase of a key the vm secrets may be encrypted with; can be repeated, vm secrets are only stored when one is given"`
+	ActiveKeyLabel string `long:"activeKeyLabel" description:"the label of the key new vm secrets are encrypted with"`
+	ReEncrypt bool `long:"reEncrypt" description:"encrypt every vm secret with the active key, e.g. after adding a new one, and exit"`
+
+	WebhookDeliveryInterval time.Duration `long:"webhookDeliveryInterval" default:"5s" description:"how often to deliver queued webhook events"`
+	WebhookTimeout time.Duration `long:"webhookTimeout" default:"10s" description:"how long a webhook may take to answer a delivery"`
+	WebhookMaxAttempts int `long:"webhookMaxAttempts" default:"10" description:"failed attempts after which a delivery is dead"`
+	WebhookInitialBackoff time.Duration `long:"webhookInitialBackoff" default:"10s" description:"wait after the first failed delivery attempt, doubled for every further attempt"`
+	WebhookMaxBackoff time.Duration `long:"webhookMaxBackoff" default:"1h" description:"longest wait between delivery attempts"`
+
+	LockOwner string `long:"lockOwner" description:"identifies this server in the locks that elect which server runs the background jobs; defaults to the hostname and a random suffix"`
+	LockTTL time.Duration `long:"lockTTL" default:"15s" description:"how long a lock outlives a server that stopped renewing it"`
+	LockRetryInterval time.Duration `long:"lockRetryInterval" default:"5s" description:"how often to renew a held lock or try to take a free one"`
+
+	ConsulCluster string `long:"consulCluster" description:"the URL of the local Consul agent, e.g. http://127.0.0.1:8500, to register the api with; the api is not registered when empty"`
+	ConsulServiceName string `long:"consulServiceName" default:"vps" description:"the name the api is registered under in Consul"`
+	ConsulServiceAddress string `long:"consulServiceAddress" description:"the address clients reach the api at; defaults to the host it listens on"`
+	ConsulCheckTTL time.Duration `long:"consulCheckTTL" default:"20s" description:"how long Consul keeps the api healthy without hearing from it; the api reports its readiness twice per TTL"`
+
+	GRPCPort int `long:"grpcPort" description:"the port to serve the gRPC api on, next to the REST api; the gRPC api is not served when 0"`
+	GRPCInsecure bool `long:"grpcInsecure" description:"serve the gRPC api without TLS, sending the basic auth credentials of every call in plaintext; it is served with the tls-certificate and tls-key otherwise"`
+	GRPCWatchInterval time.Duration `long:"grpcWatchInterval" default:"1s" description:"how often the gRPC Watch streams read the outbox for new vm events"`
+
+	ShutdownTimeout time.Duration `long:"shutdownTimeout" default:"30s" description:"how long to wait for requests in flight when the server is stopped"`
 
 	EnabledListeners []string `long:"scheme" description:"the listeners to enable, this can be repeated and defaults to the schemes in the swagger spec"`
 
@@ -114,7 +177,7 @@ func (s *Server) SetAPI(api *operations.SoftLayerVMPoolAPI) {
 
 	s.api = api
 	s.api.Logger = log.Printf
-	s.handler = configureAPI(api,nil,nil)
+	s.handler = configureAPI(api,nil,nil,nil,false,nil,nil,nil,nil,nil,controllers.AdminCredentials{})
 }
 
 func (s *Server) hasScheme(scheme string) bool {
@@ -131,29 +194,28 @@ func (s *Server) hasScheme(scheme string) bool {
 	return false
 }
 
-// Serve the api
-func (s *Server) Serve() (err error) {
+// servedListener is a listener together with the server that serves the api
+// on it.
+type servedListener struct {
+	server   *graceful.Server
+	listener net.Listener
+	url      string
+}
+
+func (s *Server) servedListeners() ([]servedListener, error) {
 	if !s.hasListeners {
 		if err := s.Listen(); err != nil {
-			return err
+			return nil, err
 		}
 	}
 
-	var wg sync.WaitGroup
+	listeners := []servedListener{}
 
 	if s.hasScheme(schemeUnix) {
 		domainSocket := &graceful.Server{Server: new(http.Server)}
 		domainSocket.Handler = s.handler
 
-		wg.Add(1)
-		s.Logf("Serving soft layer VM pool at unix://%s", s.SocketPath)
-		go func(l net.Listener) {
-			defer wg.Done()
-			if err := domainSocket.Serve(l); err != nil {
-				s.Fatalf("%v", err)
-			}
-			s.Logf("Stopped serving soft layer VM pool at unix://%s", s.SocketPath)
-		}(s.domainSocketL)
+		listeners = append(listeners, servedListener{domainSocket, s.domainSocketL, "unix://" + string(s.SocketPath)})
 	}
 
 	if s.hasScheme(schemeHTTP) {
@@ -162,15 +224,7 @@ func (s *Server) Serve() (err error) {
 		httpServer.TCPKeepAlive = 3 * time.Minute
 		httpServer.Handler = s.handler
 
-		wg.Add(1)
-		s.Logf("Serving soft layer VM pool at http://%s", s.httpServerL.Addr())
-		go func(l net.Listener) {
-			defer wg.Done()
-			if err := httpServer.Serve(l); err != nil {
-				s.Fatalf("%v", err)
-			}
-			s.Logf("Stopped serving soft layer VM pool at http://%s", l.Addr())
-		}(s.httpServerL)
+		listeners = append(listeners, servedListener{httpServer, s.httpServerL, "http://" + s.httpServerL.Addr().String()})
 	}
 
 	if s.hasScheme(schemeHTTPS) {
@@ -179,6 +233,7 @@ func (s *Server) Serve() (err error) {
 		httpsServer.TCPKeepAlive = 3 * time.Minute
 		httpsServer.Handler = s.handler
 
+		var err error
 		httpsServer.TLSConfig = new(tls.Config)
 		httpsServer.TLSConfig.NextProtos = []string{"http/1.1"}
 		// https://www.owasp.org/index.php/Transport_Layer_Protection_Cheat_Sheet#Rule_-_Only_Support_Strong_Protocols
@@ -189,24 +244,87 @@ func (s *Server) Serve() (err error) {
 		configureTLS(httpsServer.TLSConfig)
 
 		if err != nil {
-			return err
+			return nil, err
 		}
 
+		listeners = append(listeners, servedListener{httpsServer, tls.NewListener(s.httpsServerL, httpsServer.TLSConfig), "https://" + s.httpsServerL.Addr().String()})
+	}
+
+	return listeners, nil
+}
+
+// Serve the api
+func (s *Server) Serve() (err error) {
+	listeners, err := s.servedListeners()
+	if err != nil {
+		return err
+	}
+
+	var wg sync.WaitGroup
+
+	for _, l := range listeners {
 		wg.Add(1)
-		s.Logf("Serving soft layer VM pool at https://%s", s.httpsServerL.Addr())
-		go func(l net.Listener) {
+		s.Logf("Serving soft layer VM pool at %s", l.url)
+		go func(l servedListener) {
 			defer wg.Done()
-			if err := httpsServer.Serve(l); err != nil {
+			if err := l.server.Serve(l.listener); err != nil {
 				s.Fatalf("%v", err)
 			}
-			s.Logf("Stopped serving soft layer VM pool at https://%s", l.Addr())
-		}(tls.NewListener(s.httpsServerL, httpsServer.TLSConfig))
+			s.Logf("Stopped serving soft layer VM pool at %s", l.url)
+		}(l)
 	}
 
 	wg.Wait()
 	return nil
 }
 
+// Run serves the api as an ifrit member. It is ready once it listens. On a
+// signal it stops accepting connections and waits up to ShutdownTimeout for
+// the requests in flight before it returns.
+func (s *Server) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	listeners, err := s.servedListeners()
+	if err != nil {
+		return err
+	}
+
+	errs := make(chan error, len(listeners))
+	for _, l := range listeners {
+		l.server.NoSignalHandling = true
+		l.server.Timeout = s.ShutdownTimeout
+
+		s.Logf("Serving soft layer VM pool at %s", l.url)
+		go func(l servedListener) {
+			err := l.server.Serve(l.listener)
+			s.Logf("Stopped serving soft layer VM pool at %s", l.url)
+			errs <- err
+		}(l)
+	}
+
+	close(ready)
+
+	running := len(listeners)
+	select {
+	case <-signals:
+		s.Logf("Draining soft layer VM pool for up to %s", s.ShutdownTimeout)
+	case err = <-errs:
+		running--
+	}
+
+	for _, l := range listeners {
+		l.server.Stop(s.ShutdownTimeout)
+	}
+	for ; running > 0; running-- {
+		if stopErr := <-errs; err == nil {
+			err = stopErr
+		}
+	}
+
+	if s.api != nil {
+		s.Shutdown()
+	}
+	return err
+}
+
 // Listen creates the listeners for the server
 func (s *Server) Listen() error {
 	if s.hasListeners { // already done this
@@ -0,0 +1,107 @@
+package restapi_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jianqiu/vps/restapi"
+	"github.com/tedsuo/ifrit"
+)
+
+var _ = Describe("Server", func() {
+	var (
+		server   *restapi.Server
+		started  chan struct{}
+		release  chan struct{}
+		process  ifrit.Process
+		address  string
+		endpoint string
+	)
+
+	BeforeEach(func() {
+		// Requests of earlier tests may still be in flight, so the handler
+		// holds on to the channels of its own test.
+		handlerStarted := make(chan struct{}, 1)
+		handlerRelease := make(chan struct{})
+		started, release = handlerStarted, handlerRelease
+
+		server = restapi.NewServer(nil)
+		server.EnabledListeners = []string{"http"}
+		server.Host = "127.0.0.1"
+		server.ShutdownTimeout = 5 * time.Second
+		server.SetHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			handlerStarted <- struct{}{}
+			<-handlerRelease
+			w.Write([]byte("done"))
+		}))
+	})
+
+	JustBeforeEach(func() {
+		process = ifrit.Invoke(server)
+		address = fmt.Sprintf("127.0.0.1:%d", server.Port)
+		endpoint = "http://" + address + "/"
+	})
+
+	AfterEach(func() {
+		process.Signal(os.Interrupt)
+		Eventually(process.Wait()).Should(Receive())
+	})
+
+	It("is ready once it listens", func() {
+		Expect(server.Port).NotTo(BeZero())
+		go http.Get(endpoint)
+		Eventually(started).Should(Receive())
+		close(release)
+	})
+
+	Context("when signalled", func() {
+		It("stops accepting connections and drains the requests in flight", func() {
+			responses := make(chan string, 1)
+			go func() {
+				defer GinkgoRecover()
+				resp, err := http.Get(endpoint)
+				Expect(err).NotTo(HaveOccurred())
+				defer resp.Body.Close()
+				body, _ := ioutil.ReadAll(resp.Body)
+				responses <- string(body)
+			}()
+			Eventually(started).Should(Receive())
+
+			process.Signal(os.Interrupt)
+			Eventually(func() error {
+				conn, err := net.Dial("tcp", address)
+				if err == nil {
+					conn.Close()
+				}
+				return err
+			}).Should(HaveOccurred())
+			Consistently(process.Wait()).ShouldNot(Receive())
+
+			close(release)
+			Eventually(responses).Should(Receive(Equal("done")))
+			Eventually(process.Wait()).Should(Receive(BeNil()))
+		})
+
+		Context("when the requests in flight take too long", func() {
+			BeforeEach(func() {
+				server.ShutdownTimeout = 100 * time.Millisecond
+			})
+
+			It("gives up on them", func() {
+				go http.Get(endpoint)
+				Eventually(started).Should(Receive())
+
+				process.Signal(os.Interrupt)
+				Eventually(process.Wait()).Should(Receive(BeNil()))
+				close(release)
+			})
+		})
+	})
+})
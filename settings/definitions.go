@@ -0,0 +1,74 @@
+package settings
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+
+	"github.com/jianqiu/vps/models"
+)
+
+const (
+	// MaintenanceMode refuses orders and reservations while it is true.
+	MaintenanceMode = "maintenance-mode"
+	// DefaultFilter fills in the sizes, vlans or flavor an order leaves out.
+	DefaultFilter = "default-filter"
+)
+
+// definition describes a setting: what it is for, the value it has until
+// it is changed and which values it takes.
+type definition struct {
+	description  string
+	defaultValue string
+	validate     func(value string) string
+}
+
+var definitions = map[string]definition{
+	MaintenanceMode: {
+		description:  "true to refuse orders and reservations, e.g. while the pool is being worked on",
+		defaultValue: "false",
+		validate:     validateBool,
+	},
+	DefaultFilter: {
+		description:  "a JSON VmFilter whose cpu, memory_mb, vlans or flavor fill in what an order leaves out; an order naming a flavor keeps all of them out, one naming cpu or memory_mb the sizes and the flavor",
+		defaultValue: "{}",
+		validate:     validateDefaultFilter,
+	},
+}
+
+// names are the names of the settings, in order.
+func names() []string {
+	names := make([]string, 0, len(definitions))
+	for name := range definitions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// validateBool and the other validate functions return what is wrong with
+// value, or "" when nothing is.
+func validateBool(value string) string {
+	if _, err := strconv.ParseBool(value); err != nil {
+		return "must be true or false"
+	}
+	return ""
+}
+
+func validateDefaultFilter(value string) string {
+	filter, err := parseFilter(value)
+	if err != nil {
+		return "must be a JSON VmFilter"
+	}
+	if filter.Cid != 0 || filter.IP != "" || len(filter.Cids) > 0 || len(filter.Ips) > 0 ||
+		filter.State != "" || filter.DeploymentName != "" || filter.Pool != "" || filter.UserData != "" {
+		return "may only give cpu, memory_mb, public_vlan, private_vlan and flavor"
+	}
+	return ""
+}
+
+func parseFilter(value string) (models.VMFilter, error) {
+	filter := models.VMFilter{}
+	err := json.Unmarshal([]byte(value), &filter)
+	return filter, err
+}
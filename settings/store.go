@@ -0,0 +1,198 @@
+package settings
+
+import (
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/db"
+	"github.com/jianqiu/vps/models"
+)
+
+// Store serves the runtime settings. Every server runs one: it reads the
+// settings from the DB on every tick, so a change made through any server
+// reaches all of them within the interval, and right away on the server
+// that made it. Until the first read succeeds, every setting has its
+// default.
+type Store struct {
+	logger   lager.Logger
+	db       db.SettingDB
+	clock    clock.Clock
+	interval time.Duration
+
+	mutex  sync.RWMutex
+	values map[string]string
+}
+
+func NewStore(
+	logger lager.Logger,
+	db db.SettingDB,
+	clock clock.Clock,
+	interval time.Duration,
+) *Store {
+	return &Store{
+		logger:   logger,
+		db:       db,
+		clock:    clock,
+		interval: interval,
+		values:   map[string]string{},
+	}
+}
+
+func (s *Store) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	logger := s.logger.Session("settings")
+	logger.Info("starting", lager.Data{"interval": s.interval.String()})
+	defer logger.Info("exited")
+
+	ticker := s.clock.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	s.Refresh(logger)
+	close(ready)
+
+	for {
+		select {
+		case <-ticker.C():
+			s.Refresh(logger)
+		case <-signals:
+			return nil
+		}
+	}
+}
+
+// Refresh reads the settings from the DB and logs the ones that changed.
+// When the DB cannot be read, the settings keep their values.
+func (s *Store) Refresh(logger lager.Logger) {
+	logger = logger.Session("refresh")
+
+	stored, err := s.db.Settings(logger)
+	if err != nil {
+		logger.Error("failed-to-read-settings", err)
+		return
+	}
+
+	values := map[string]string{}
+	for name, value := range stored {
+		def, ok := definitions[name]
+		if !ok {
+			continue
+		}
+		if problem := def.validate(value); problem != "" {
+			logger.Info("ignoring-invalid-setting", lager.Data{"name": name, "value": value, "problem": problem})
+			continue
+		}
+		values[name] = value
+	}
+
+	s.mutex.Lock()
+	previous := s.values
+	s.values = values
+	s.mutex.Unlock()
+
+	for _, name := range names() {
+		before, after := valueOf(previous, name), valueOf(values, name)
+		if before != after {
+			logger.Info("setting-changed", lager.Data{"name": name, "from": before, "to": after})
+		}
+	}
+}
+
+func valueOf(values map[string]string, name string) string {
+	if value, ok := values[name]; ok {
+		return value
+	}
+	return definitions[name].defaultValue
+}
+
+func (s *Store) value(name string) string {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return valueOf(s.values, name)
+}
+
+func (s *Store) MaintenanceMode() bool {
+	on, _ := strconv.ParseBool(s.value(MaintenanceMode))
+	return on
+}
+
+func (s *Store) DefaultFilter() models.VMFilter {
+	filter, _ := parseFilter(s.value(DefaultFilter))
+	return filter
+}
+
+// Settings returns every setting with the value the DB has for it.
+func (s *Store) Settings(logger lager.Logger) ([]*models.Setting, error) {
+	stored, err := s.db.Settings(logger)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := []*models.Setting{}
+	for _, name := range names() {
+		settings = append(settings, setting(name, valueOf(stored, name)))
+	}
+	return settings, nil
+}
+
+func (s *Store) Setting(logger lager.Logger, name string) (*models.Setting, error) {
+	if _, ok := definitions[name]; !ok {
+		return nil, models.ErrResourceNotFound
+	}
+
+	stored, err := s.db.Settings(logger)
+	if err != nil {
+		return nil, err
+	}
+	return setting(name, valueOf(stored, name)), nil
+}
+
+func (s *Store) SetSetting(logger lager.Logger, name, value string) (*models.Setting, error) {
+	logger = logger.Session("set-setting", lager.Data{"name": name})
+
+	def, ok := definitions[name]
+	if !ok {
+		return nil, models.ErrResourceNotFound
+	}
+	if problem := def.validate(value); problem != "" {
+		return nil, models.NewInvalidRecordError(models.ErrInvalidField{Field: "value", Message: problem})
+	}
+
+	err := s.db.SetSetting(logger, name, value)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Refresh(logger)
+	return setting(name, value), nil
+}
+
+// ResetSetting gives a setting its default value back.
+func (s *Store) ResetSetting(logger lager.Logger, name string) (*models.Setting, error) {
+	logger = logger.Session("reset-setting", lager.Data{"name": name})
+
+	def, ok := definitions[name]
+	if !ok {
+		return nil, models.ErrResourceNotFound
+	}
+
+	err := s.db.RemoveSetting(logger, name)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Refresh(logger)
+	return setting(name, def.defaultValue), nil
+}
+
+func setting(name, value string) *models.Setting {
+	def := definitions[name]
+	return &models.Setting{
+		Name:        name,
+		Value:       value,
+		Default:     def.defaultValue,
+		Description: def.description,
+	}
+}
@@ -0,0 +1,158 @@
+package settings_test
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jianqiu/vps/db/dbfakes"
+	"github.com/jianqiu/vps/models"
+	"github.com/jianqiu/vps/settings"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/tedsuo/ifrit"
+)
+
+var _ = Describe("Store", func() {
+	var (
+		logger        *lagertest.TestLogger
+		fakeSettingDB *dbfakes.FakeSettingDB
+		store         *settings.Store
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		fakeSettingDB = new(dbfakes.FakeSettingDB)
+		fakeSettingDB.SettingsReturns(map[string]string{}, nil)
+		store = settings.NewStore(logger, fakeSettingDB, clock.NewClock(), 10*time.Millisecond)
+	})
+
+	Describe("Refresh", func() {
+		It("has the defaults before the settings are read", func() {
+			Expect(store.MaintenanceMode()).To(BeFalse())
+			Expect(store.DefaultFilter()).To(Equal(models.VMFilter{}))
+		})
+
+		It("takes the values of the DB", func() {
+			fakeSettingDB.SettingsReturns(map[string]string{
+				settings.MaintenanceMode: "true",
+				settings.DefaultFilter:   `{"cpu":2,"memory_mb":4096}`,
+			}, nil)
+
+			store.Refresh(logger)
+
+			Expect(store.MaintenanceMode()).To(BeTrue())
+			Expect(store.DefaultFilter()).To(Equal(models.VMFilter{CPU: 2, MemoryMb: 4096}))
+			Expect(logger.LogMessages()).To(ContainElement("test.refresh.setting-changed"))
+		})
+
+		It("ignores invalid values", func() {
+			fakeSettingDB.SettingsReturns(map[string]string{settings.MaintenanceMode: "maybe"}, nil)
+
+			store.Refresh(logger)
+
+			Expect(store.MaintenanceMode()).To(BeFalse())
+		})
+
+		It("keeps the values when the DB cannot be read", func() {
+			fakeSettingDB.SettingsReturns(map[string]string{settings.MaintenanceMode: "true"}, nil)
+			store.Refresh(logger)
+
+			fakeSettingDB.SettingsReturns(nil, errors.New("connection refused"))
+			store.Refresh(logger)
+
+			Expect(store.MaintenanceMode()).To(BeTrue())
+		})
+	})
+
+	Describe("Settings", func() {
+		It("lists every setting with its value and default", func() {
+			fakeSettingDB.SettingsReturns(map[string]string{settings.MaintenanceMode: "true"}, nil)
+
+			all, err := store.Settings(logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(all).To(HaveLen(2))
+			Expect(all[0].Name).To(Equal(settings.DefaultFilter))
+			Expect(all[0].Value).To(Equal("{}"))
+			Expect(all[1].Name).To(Equal(settings.MaintenanceMode))
+			Expect(all[1].Value).To(Equal("true"))
+			Expect(all[1].Default).To(Equal("false"))
+		})
+	})
+
+	Describe("Setting", func() {
+		It("does not find settings that do not exist", func() {
+			_, err := store.Setting(logger, "order-timeout")
+			Expect(err).To(Equal(models.ErrResourceNotFound))
+		})
+	})
+
+	Describe("SetSetting", func() {
+		It("stores the value and goes by it right away", func() {
+			fakeSettingDB.SetSettingStub = func(_ lager.Logger, name, value string) error {
+				fakeSettingDB.SettingsReturns(map[string]string{name: value}, nil)
+				return nil
+			}
+
+			changed, err := store.SetSetting(logger, settings.MaintenanceMode, "true")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changed.Value).To(Equal("true"))
+
+			_, name, value := fakeSettingDB.SetSettingArgsForCall(0)
+			Expect(name).To(Equal(settings.MaintenanceMode))
+			Expect(value).To(Equal("true"))
+			Expect(store.MaintenanceMode()).To(BeTrue())
+		})
+
+		It("rejects invalid values", func() {
+			_, err := store.SetSetting(logger, settings.DefaultFilter, `{"state":"free"}`)
+
+			Expect(fakeSettingDB.SetSettingCallCount()).To(Equal(0))
+			Expect(models.ConvertError(err).Fields).To(ConsistOf(
+				&models.FieldError{Field: "value", Message: "may only give cpu, memory_mb, public_vlan, private_vlan and flavor"},
+			))
+		})
+
+		It("does not find settings that do not exist", func() {
+			_, err := store.SetSetting(logger, "order-timeout", "1m")
+			Expect(err).To(Equal(models.ErrResourceNotFound))
+		})
+	})
+
+	Describe("ResetSetting", func() {
+		It("removes the value and returns the default", func() {
+			reset, err := store.ResetSetting(logger, settings.MaintenanceMode)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reset.Value).To(Equal("false"))
+
+			_, name := fakeSettingDB.RemoveSettingArgsForCall(0)
+			Expect(name).To(Equal(settings.MaintenanceMode))
+		})
+	})
+
+	Describe("Run", func() {
+		var process ifrit.Process
+
+		BeforeEach(func() {
+			fakeSettingDB.SettingsReturns(map[string]string{settings.MaintenanceMode: "true"}, nil)
+			process = ifrit.Invoke(store)
+		})
+
+		AfterEach(func() {
+			process.Signal(os.Interrupt)
+			Eventually(process.Wait()).Should(Receive(BeNil()))
+		})
+
+		It("reads the settings before it is ready and again on every tick", func() {
+			Expect(store.MaintenanceMode()).To(BeTrue())
+
+			fakeSettingDB.SettingsReturns(map[string]string{}, nil)
+			Eventually(store.MaintenanceMode).Should(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,289 @@
+package softlayer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	strfmt "github.com/go-openapi/strfmt"
+	"github.com/jianqiu/vps/models"
+)
+
+const (
+	DefaultEndpoint       = "https://api.softlayer.com/rest/v3"
+	DefaultRequestTimeout = time.Minute
+)
+
+//go:generate counterfeiter . SoftLayerClient
+
+type SoftLayerClient interface {
+	ReloadOS(logger lager.Logger, cid int32) error
+	ReloadCompleted(logger lager.Logger, cid int32) (bool, error)
+	CreateVirtualGuest(logger lager.Logger, template VirtualGuestTemplate) (int32, error)
+	ProvisionedVirtualGuest(logger lager.Logger, cid int32) (*models.VM, error)
+	CancelVirtualGuest(logger lager.Logger, cid int32) error
+}
+
+// VirtualGuestTemplate describes a virtual guest to order. Vlan ids of zero
+// let SoftLayer pick the vlans.
+type VirtualGuestTemplate struct {
+	Hostname        string
+	Domain          string
+	Datacenter      string
+	OSReferenceCode string
+	HourlyBilling   bool
+	CPU             int32
+	MemoryMb        int32
+	PublicVlan      int32
+	PrivateVlan     int32
+}
+
+const virtualGuestMask = "mask[id,hostname,maxCpu,maxMemory,primaryBackendIpAddress,provisionDate,activeTransaction[id],primaryNetworkComponent[networkVlan[id]],primaryBackendNetworkComponent[networkVlan[id]]]"
+
+type client struct {
+	endpoint   string
+	username   string
+	apiKey     string
+	httpClient *http.Client
+}
+
+func NewSoftLayerClient(endpoint, username, apiKey string, httpClient *http.Client) SoftLayerClient {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: DefaultRequestTimeout}
+	}
+	return &client{
+		endpoint:   strings.TrimRight(endpoint, "/"),
+		username:   username,
+		apiKey:     apiKey,
+		httpClient: httpClient,
+	}
+}
+
+type apiError struct {
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+type transaction struct {
+	Id int `json:"id"`
+}
+
+type datacenter struct {
+	Name string `json:"name"`
+}
+
+type networkVlan struct {
+	Id int32 `json:"id"`
+}
+
+type networkComponent struct {
+	NetworkVlan *networkVlan `json:"networkVlan,omitempty"`
+}
+
+type virtualGuest struct {
+	Id                             int32             `json:"id,omitempty"`
+	Hostname                       string            `json:"hostname,omitempty"`
+	Domain                         string            `json:"domain,omitempty"`
+	StartCpus                      int32             `json:"startCpus,omitempty"`
+	MaxCpu                         int32             `json:"maxCpu,omitempty"`
+	MaxMemory                      int32             `json:"maxMemory,omitempty"`
+	HourlyBillingFlag              bool              `json:"hourlyBillingFlag"`
+	LocalDiskFlag                  bool              `json:"localDiskFlag"`
+	OperatingSystemReferenceCode   string            `json:"operatingSystemReferenceCode,omitempty"`
+	Datacenter                     *datacenter       `json:"datacenter,omitempty"`
+	PrimaryNetworkComponent        *networkComponent `json:"primaryNetworkComponent,omitempty"`
+	PrimaryBackendNetworkComponent *networkComponent `json:"primaryBackendNetworkComponent,omitempty"`
+	PrimaryBackendIpAddress        string            `json:"primaryBackendIpAddress,omitempty"`
+	ProvisionDate                  string            `json:"provisionDate,omitempty"`
+	ActiveTransaction              *transaction      `json:"activeTransaction,omitempty"`
+}
+
+func vlanComponent(id int32) *networkComponent {
+	if id == 0 {
+		return nil
+	}
+	return &networkComponent{NetworkVlan: &networkVlan{Id: id}}
+}
+
+func componentVlan(component *networkComponent) int32 {
+	if component == nil || component.NetworkVlan == nil {
+		return 0
+	}
+	return component.NetworkVlan.Id
+}
+
+// ReloadOS asks SoftLayer to reinstall the operating system the virtual
+// guest is currently provisioned with, wiping its disks.
+func (c *client) ReloadOS(logger lager.Logger, cid int32) error {
+	logger = logger.Session("reload-os", lager.Data{"cid": cid})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	body, err := json.Marshal(map[string][]string{"parameters": {"FORCE"}})
+	if err != nil {
+		return err
+	}
+
+	_, _, err = c.do(logger, "POST", fmt.Sprintf("SoftLayer_Virtual_Guest/%d/reloadCurrentOperatingSystemConfiguration.json", cid), body)
+	return err
+}
+
+// ReloadCompleted reports whether the virtual guest has no transaction left
+// running, which is how SoftLayer signals that an OS reload has finished.
+func (c *client) ReloadCompleted(logger lager.Logger, cid int32) (bool, error) {
+	logger = logger.Session("reload-completed", lager.Data{"cid": cid})
+
+	body, _, err := c.do(logger, "GET", fmt.Sprintf("SoftLayer_Virtual_Guest/%d/getActiveTransaction.json", cid), nil)
+	if err != nil {
+		return false, err
+	}
+
+	trimmed := strings.TrimSpace(string(body))
+	if trimmed == "" || trimmed == "null" {
+		return true, nil
+	}
+
+	var txn transaction
+	err = json.Unmarshal(body, &txn)
+	if err != nil {
+		logger.Error("failed-to-unmarshal-transaction", err)
+		return false, models.NewError(models.ErrorTypeInvalidResponse, err.Error())
+	}
+
+	return txn.Id == 0, nil
+}
+
+// CreateVirtualGuest places an order for a new virtual guest and returns its
+// id. The guest is not usable until ProvisionedVirtualGuest returns it.
+func (c *client) CreateVirtualGuest(logger lager.Logger, template VirtualGuestTemplate) (int32, error) {
+	logger = logger.Session("create-virtual-guest", lager.Data{"hostname": template.Hostname})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	guest := virtualGuest{
+		Hostname:                       template.Hostname,
+		Domain:                         template.Domain,
+		StartCpus:                      template.CPU,
+		MaxMemory:                      template.MemoryMb,
+		HourlyBillingFlag:              template.HourlyBilling,
+		LocalDiskFlag:                  true,
+		OperatingSystemReferenceCode:   template.OSReferenceCode,
+		Datacenter:                     &datacenter{Name: template.Datacenter},
+		PrimaryNetworkComponent:        vlanComponent(template.PublicVlan),
+		PrimaryBackendNetworkComponent: vlanComponent(template.PrivateVlan),
+	}
+	body, err := json.Marshal(map[string][]virtualGuest{"parameters": {guest}})
+	if err != nil {
+		return 0, err
+	}
+
+	respBody, _, err := c.do(logger, "POST", "SoftLayer_Virtual_Guest/createObject.json", body)
+	if err != nil {
+		return 0, err
+	}
+
+	var created virtualGuest
+	err = json.Unmarshal(respBody, &created)
+	if err != nil || created.Id == 0 {
+		if err == nil {
+			err = fmt.Errorf("softlayer returned no virtual guest id")
+		}
+		logger.Error("failed-to-unmarshal-virtual-guest", err)
+		return 0, models.NewError(models.ErrorTypeInvalidResponse, err.Error())
+	}
+
+	return created.Id, nil
+}
+
+// ProvisionedVirtualGuest returns the virtual guest once SoftLayer has
+// finished provisioning it, and nil while it is still being set up.
+func (c *client) ProvisionedVirtualGuest(logger lager.Logger, cid int32) (*models.VM, error) {
+	logger = logger.Session("provisioned-virtual-guest", lager.Data{"cid": cid})
+
+	respBody, _, err := c.do(logger, "GET", fmt.Sprintf("SoftLayer_Virtual_Guest/%d/getObject.json?objectMask=%s", cid, url.QueryEscape(virtualGuestMask)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var guest virtualGuest
+	err = json.Unmarshal(respBody, &guest)
+	if err != nil {
+		logger.Error("failed-to-unmarshal-virtual-guest", err)
+		return nil, models.NewError(models.ErrorTypeInvalidResponse, err.Error())
+	}
+
+	if guest.ProvisionDate == "" || guest.ActiveTransaction != nil || guest.PrimaryBackendIpAddress == "" {
+		return nil, nil
+	}
+
+	return &models.VM{
+		Cid:         guest.Id,
+		Hostname:    guest.Hostname,
+		IP:          strfmt.IPv4(guest.PrimaryBackendIpAddress),
+		CPU:         guest.MaxCpu,
+		MemoryMb:    guest.MaxMemory,
+		PublicVlan:  componentVlan(guest.PrimaryNetworkComponent),
+		PrivateVlan: componentVlan(guest.PrimaryBackendNetworkComponent),
+	}, nil
+}
+
+// CancelVirtualGuest cancels the virtual guest immediately. SoftLayer answers
+// a cancellation of a guest it no longer knows about with a 404, which is
+// treated as success.
+func (c *client) CancelVirtualGuest(logger lager.Logger, cid int32) error {
+	logger = logger.Session("cancel-virtual-guest", lager.Data{"cid": cid})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	_, status, err := c.do(logger, "DELETE", fmt.Sprintf("SoftLayer_Virtual_Guest/%d.json", cid), nil)
+	if status == http.StatusNotFound {
+		logger.Info("virtual-guest-already-gone")
+		return nil
+	}
+	return err
+}
+
+// do sends a request to the SoftLayer API and returns the response body and
+// status code. Responses outside of 2xx are turned into SoftLayerAPIErrors.
+func (c *client) do(logger lager.Logger, method, path string, body []byte) ([]byte, int, error) {
+	req, err := http.NewRequest(method, c.endpoint+"/"+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, 0, err
+	}
+	req.SetBasicAuth(c.username, c.apiKey)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		logger.Error("failed-request", err)
+		return nil, 0, models.NewError(models.ErrorTypeSoftLayerAPIError, err.Error())
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		logger.Error("failed-reading-response", err)
+		return nil, resp.StatusCode, models.NewError(models.ErrorTypeSoftLayerAPIError, err.Error())
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		message := fmt.Sprintf("softlayer returned status %d", resp.StatusCode)
+		var apiErr apiError
+		if json.Unmarshal(respBody, &apiErr) == nil && apiErr.Error != "" {
+			message = fmt.Sprintf("%s: %s", message, apiErr.Error)
+		}
+		err := models.NewError(models.ErrorTypeSoftLayerAPIError, message)
+		logger.Error("failed-request", err, lager.Data{"code": apiErr.Code})
+		return nil, resp.StatusCode, err
+	}
+
+	return respBody, resp.StatusCode, nil
+}
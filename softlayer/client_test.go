@@ -0,0 +1,309 @@
+package softlayer_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jianqiu/vps/models"
+	"github.com/jianqiu/vps/softlayer"
+
+	"code.cloudfoundry.org/lager/lagertest"
+)
+
+var _ = Describe("SoftLayerClient", func() {
+	var (
+		logger *lagertest.TestLogger
+		server *httptest.Server
+		client softlayer.SoftLayerClient
+
+		statusCode   int
+		responseBody string
+		requests     []*http.Request
+		requestBody  string
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		statusCode = http.StatusOK
+		responseBody = ""
+		requests = nil
+		requestBody = ""
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := ioutil.ReadAll(r.Body)
+			requestBody = string(body)
+			requests = append(requests, r)
+			w.WriteHeader(statusCode)
+			w.Write([]byte(responseBody))
+		}))
+
+		client = softlayer.NewSoftLayerClient(server.URL+"/", "fake-user", "fake-key", nil)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Describe("ReloadOS", func() {
+		var err error
+
+		JustBeforeEach(func() {
+			err = client.ReloadOS(logger, 1234)
+		})
+
+		Context("when SoftLayer accepts the reload", func() {
+			BeforeEach(func() {
+				responseBody = "true"
+			})
+
+			It("posts a forced reload for the virtual guest", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(requests).To(HaveLen(1))
+				Expect(requests[0].Method).To(Equal("POST"))
+				Expect(requests[0].URL.Path).To(Equal("/SoftLayer_Virtual_Guest/1234/reloadCurrentOperatingSystemConfiguration.json"))
+				Expect(requestBody).To(MatchJSON(`{"parameters":["FORCE"]}`))
+			})
+
+			It("authenticates with the username and api key", func() {
+				username, apiKey, ok := requests[0].BasicAuth()
+				Expect(ok).To(BeTrue())
+				Expect(username).To(Equal("fake-user"))
+				Expect(apiKey).To(Equal("fake-key"))
+			})
+		})
+
+		Context("when SoftLayer rejects the reload", func() {
+			BeforeEach(func() {
+				statusCode = http.StatusInternalServerError
+				responseBody = `{"error":"a transaction is already running","code":"SoftLayer_Exception_Public"}`
+			})
+
+			It("returns a SoftLayer API error", func() {
+				Expect(err).To(HaveOccurred())
+				modelErr := models.ConvertError(err)
+				Expect(modelErr.Type).To(Equal(models.ErrorTypeSoftLayerAPIError))
+				Expect(modelErr.Message).To(ContainSubstring("a transaction is already running"))
+			})
+		})
+	})
+
+	Describe("ReloadCompleted", func() {
+		var (
+			completed bool
+			err       error
+		)
+
+		JustBeforeEach(func() {
+			completed, err = client.ReloadCompleted(logger, 1234)
+		})
+
+		It("asks for the active transaction of the virtual guest", func() {
+			Expect(requests).To(HaveLen(1))
+			Expect(requests[0].Method).To(Equal("GET"))
+			Expect(requests[0].URL.Path).To(Equal("/SoftLayer_Virtual_Guest/1234/getActiveTransaction.json"))
+		})
+
+		Context("when no transaction is running", func() {
+			BeforeEach(func() {
+				responseBody = "null"
+			})
+
+			It("reports the reload as completed", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(completed).To(BeTrue())
+			})
+		})
+
+		Context("when a transaction is still running", func() {
+			BeforeEach(func() {
+				responseBody = `{"id":98765,"transactionStatus":{"name":"RELOAD_OS"}}`
+			})
+
+			It("reports the reload as in progress", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(completed).To(BeFalse())
+			})
+		})
+
+		Context("when SoftLayer returns garbage", func() {
+			BeforeEach(func() {
+				responseBody = "{not-json"
+			})
+
+			It("returns an invalid response error", func() {
+				Expect(models.ConvertError(err).Type).To(Equal(models.ErrorTypeInvalidResponse))
+			})
+		})
+
+		Context("when the request fails", func() {
+			BeforeEach(func() {
+				statusCode = http.StatusUnauthorized
+			})
+
+			It("returns a SoftLayer API error", func() {
+				Expect(completed).To(BeFalse())
+				Expect(models.ConvertError(err).Type).To(Equal(models.ErrorTypeSoftLayerAPIError))
+			})
+		})
+	})
+
+	Describe("CreateVirtualGuest", func() {
+		var (
+			template softlayer.VirtualGuestTemplate
+			cid      int32
+			err      error
+		)
+
+		BeforeEach(func() {
+			template = softlayer.VirtualGuestTemplate{
+				Hostname:        "vps-1",
+				Domain:          "example.com",
+				Datacenter:      "dal10",
+				OSReferenceCode: "UBUNTU_LATEST",
+				HourlyBilling:   true,
+				CPU:             4,
+				MemoryMb:        8192,
+				PrivateVlan:     222,
+			}
+			responseBody = `{"id":4567,"hostname":"vps-1"}`
+		})
+
+		JustBeforeEach(func() {
+			cid, err = client.CreateVirtualGuest(logger, template)
+		})
+
+		It("orders the virtual guest from the template", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cid).To(Equal(int32(4567)))
+			Expect(requests[0].Method).To(Equal("POST"))
+			Expect(requests[0].URL.Path).To(Equal("/SoftLayer_Virtual_Guest/createObject.json"))
+			Expect(requestBody).To(MatchJSON(`{"parameters":[{
+				"hostname":"vps-1",
+				"domain":"example.com",
+				"startCpus":4,
+				"maxMemory":8192,
+				"hourlyBillingFlag":true,
+				"localDiskFlag":true,
+				"operatingSystemReferenceCode":"UBUNTU_LATEST",
+				"datacenter":{"name":"dal10"},
+				"primaryBackendNetworkComponent":{"networkVlan":{"id":222}}
+			}]}`))
+		})
+
+		Context("when SoftLayer returns no id", func() {
+			BeforeEach(func() {
+				responseBody = `{}`
+			})
+
+			It("returns an invalid response error", func() {
+				Expect(models.ConvertError(err).Type).To(Equal(models.ErrorTypeInvalidResponse))
+			})
+		})
+
+		Context("when the order is rejected", func() {
+			BeforeEach(func() {
+				statusCode = http.StatusBadRequest
+				responseBody = `{"error":"invalid datacenter"}`
+			})
+
+			It("returns a SoftLayer API error", func() {
+				Expect(models.ConvertError(err).Type).To(Equal(models.ErrorTypeSoftLayerAPIError))
+			})
+		})
+	})
+
+	Describe("ProvisionedVirtualGuest", func() {
+		var (
+			vm  *models.VM
+			err error
+		)
+
+		JustBeforeEach(func() {
+			vm, err = client.ProvisionedVirtualGuest(logger, 4567)
+		})
+
+		Context("when the guest is provisioned", func() {
+			BeforeEach(func() {
+				responseBody = `{
+					"id":4567,
+					"hostname":"vps-1",
+					"maxCpu":4,
+					"maxMemory":8192,
+					"primaryBackendIpAddress":"10.0.0.5",
+					"provisionDate":"2016-01-01T00:00:00-06:00",
+					"primaryNetworkComponent":{"networkVlan":{"id":111}},
+					"primaryBackendNetworkComponent":{"networkVlan":{"id":222}}
+				}`
+			})
+
+			It("fetches the guest with an object mask", func() {
+				Expect(requests[0].Method).To(Equal("GET"))
+				Expect(requests[0].URL.Path).To(Equal("/SoftLayer_Virtual_Guest/4567/getObject.json"))
+				Expect(requests[0].URL.Query().Get("objectMask")).To(ContainSubstring("provisionDate"))
+			})
+
+			It("returns the vm", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(vm).To(Equal(&models.VM{
+					Cid:         4567,
+					Hostname:    "vps-1",
+					IP:          "10.0.0.5",
+					CPU:         4,
+					MemoryMb:    8192,
+					PublicVlan:  111,
+					PrivateVlan: 222,
+				}))
+			})
+		})
+
+		Context("when the guest is still provisioning", func() {
+			BeforeEach(func() {
+				responseBody = `{"id":4567,"hostname":"vps-1","activeTransaction":{"id":1}}`
+			})
+
+			It("returns no vm", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(vm).To(BeNil())
+			})
+		})
+	})
+
+	Describe("CancelVirtualGuest", func() {
+		var err error
+
+		JustBeforeEach(func() {
+			err = client.CancelVirtualGuest(logger, 4567)
+		})
+
+		It("deletes the virtual guest", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(requests[0].Method).To(Equal("DELETE"))
+			Expect(requests[0].URL.Path).To(Equal("/SoftLayer_Virtual_Guest/4567.json"))
+		})
+
+		Context("when the guest is already gone", func() {
+			BeforeEach(func() {
+				statusCode = http.StatusNotFound
+				responseBody = `{"error":"Unable to find object with id of '4567'."}`
+			})
+
+			It("succeeds", func() {
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when the cancellation is rejected", func() {
+			BeforeEach(func() {
+				statusCode = http.StatusInternalServerError
+			})
+
+			It("returns a SoftLayer API error", func() {
+				Expect(models.ConvertError(err).Type).To(Equal(models.ErrorTypeSoftLayerAPIError))
+			})
+		})
+	})
+})
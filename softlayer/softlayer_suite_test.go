@@ -0,0 +1,13 @@
+package softlayer_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestSoftLayer(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "SoftLayer Suite")
+}
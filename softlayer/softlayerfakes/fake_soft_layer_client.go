@@ -0,0 +1,266 @@
+// This file was generated by counterfeiter
+package softlayerfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/models"
+	"github.com/jianqiu/vps/softlayer"
+)
+
+type FakeSoftLayerClient struct {
+	ReloadOSStub        func(logger lager.Logger, cid int32) error
+	reloadOSMutex       sync.RWMutex
+	reloadOSArgsForCall []struct {
+		logger lager.Logger
+		cid    int32
+	}
+	reloadOSReturns struct {
+		result1 error
+	}
+	ReloadCompletedStub        func(logger lager.Logger, cid int32) (bool, error)
+	reloadCompletedMutex       sync.RWMutex
+	reloadCompletedArgsForCall []struct {
+		logger lager.Logger
+		cid    int32
+	}
+	reloadCompletedReturns struct {
+		result1 bool
+		result2 error
+	}
+	CreateVirtualGuestStub        func(logger lager.Logger, template softlayer.VirtualGuestTemplate) (int32, error)
+	createVirtualGuestMutex       sync.RWMutex
+	createVirtualGuestArgsForCall []struct {
+		logger   lager.Logger
+		template softlayer.VirtualGuestTemplate
+	}
+	createVirtualGuestReturns struct {
+		result1 int32
+		result2 error
+	}
+	ProvisionedVirtualGuestStub        func(logger lager.Logger, cid int32) (*models.VM, error)
+	provisionedVirtualGuestMutex       sync.RWMutex
+	provisionedVirtualGuestArgsForCall []struct {
+		logger lager.Logger
+		cid    int32
+	}
+	provisionedVirtualGuestReturns struct {
+		result1 *models.VM
+		result2 error
+	}
+	CancelVirtualGuestStub        func(logger lager.Logger, cid int32) error
+	cancelVirtualGuestMutex       sync.RWMutex
+	cancelVirtualGuestArgsForCall []struct {
+		logger lager.Logger
+		cid    int32
+	}
+	cancelVirtualGuestReturns struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeSoftLayerClient) ReloadOS(logger lager.Logger, cid int32) error {
+	fake.reloadOSMutex.Lock()
+	fake.reloadOSArgsForCall = append(fake.reloadOSArgsForCall, struct {
+		logger lager.Logger
+		cid    int32
+	}{logger, cid})
+	fake.recordInvocation("ReloadOS", []interface{}{logger, cid})
+	fake.reloadOSMutex.Unlock()
+	if fake.ReloadOSStub != nil {
+		return fake.ReloadOSStub(logger, cid)
+	} else {
+		return fake.reloadOSReturns.result1
+	}
+}
+
+func (fake *FakeSoftLayerClient) ReloadOSCallCount() int {
+	fake.reloadOSMutex.RLock()
+	defer fake.reloadOSMutex.RUnlock()
+	return len(fake.reloadOSArgsForCall)
+}
+
+func (fake *FakeSoftLayerClient) ReloadOSArgsForCall(i int) (lager.Logger, int32) {
+	fake.reloadOSMutex.RLock()
+	defer fake.reloadOSMutex.RUnlock()
+	return fake.reloadOSArgsForCall[i].logger, fake.reloadOSArgsForCall[i].cid
+}
+
+func (fake *FakeSoftLayerClient) ReloadOSReturns(result1 error) {
+	fake.ReloadOSStub = nil
+	fake.reloadOSReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSoftLayerClient) ReloadCompleted(logger lager.Logger, cid int32) (bool, error) {
+	fake.reloadCompletedMutex.Lock()
+	fake.reloadCompletedArgsForCall = append(fake.reloadCompletedArgsForCall, struct {
+		logger lager.Logger
+		cid    int32
+	}{logger, cid})
+	fake.recordInvocation("ReloadCompleted", []interface{}{logger, cid})
+	fake.reloadCompletedMutex.Unlock()
+	if fake.ReloadCompletedStub != nil {
+		return fake.ReloadCompletedStub(logger, cid)
+	} else {
+		return fake.reloadCompletedReturns.result1, fake.reloadCompletedReturns.result2
+	}
+}
+
+func (fake *FakeSoftLayerClient) ReloadCompletedCallCount() int {
+	fake.reloadCompletedMutex.RLock()
+	defer fake.reloadCompletedMutex.RUnlock()
+	return len(fake.reloadCompletedArgsForCall)
+}
+
+func (fake *FakeSoftLayerClient) ReloadCompletedArgsForCall(i int) (lager.Logger, int32) {
+	fake.reloadCompletedMutex.RLock()
+	defer fake.reloadCompletedMutex.RUnlock()
+	return fake.reloadCompletedArgsForCall[i].logger, fake.reloadCompletedArgsForCall[i].cid
+}
+
+func (fake *FakeSoftLayerClient) ReloadCompletedReturns(result1 bool, result2 error) {
+	fake.ReloadCompletedStub = nil
+	fake.reloadCompletedReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSoftLayerClient) CreateVirtualGuest(logger lager.Logger, template softlayer.VirtualGuestTemplate) (int32, error) {
+	fake.createVirtualGuestMutex.Lock()
+	fake.createVirtualGuestArgsForCall = append(fake.createVirtualGuestArgsForCall, struct {
+		logger   lager.Logger
+		template softlayer.VirtualGuestTemplate
+	}{logger, template})
+	fake.recordInvocation("CreateVirtualGuest", []interface{}{logger, template})
+	fake.createVirtualGuestMutex.Unlock()
+	if fake.CreateVirtualGuestStub != nil {
+		return fake.CreateVirtualGuestStub(logger, template)
+	} else {
+		return fake.createVirtualGuestReturns.result1, fake.createVirtualGuestReturns.result2
+	}
+}
+
+func (fake *FakeSoftLayerClient) CreateVirtualGuestCallCount() int {
+	fake.createVirtualGuestMutex.RLock()
+	defer fake.createVirtualGuestMutex.RUnlock()
+	return len(fake.createVirtualGuestArgsForCall)
+}
+
+func (fake *FakeSoftLayerClient) CreateVirtualGuestArgsForCall(i int) (lager.Logger, softlayer.VirtualGuestTemplate) {
+	fake.createVirtualGuestMutex.RLock()
+	defer fake.createVirtualGuestMutex.RUnlock()
+	return fake.createVirtualGuestArgsForCall[i].logger, fake.createVirtualGuestArgsForCall[i].template
+}
+
+func (fake *FakeSoftLayerClient) CreateVirtualGuestReturns(result1 int32, result2 error) {
+	fake.CreateVirtualGuestStub = nil
+	fake.createVirtualGuestReturns = struct {
+		result1 int32
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSoftLayerClient) ProvisionedVirtualGuest(logger lager.Logger, cid int32) (*models.VM, error) {
+	fake.provisionedVirtualGuestMutex.Lock()
+	fake.provisionedVirtualGuestArgsForCall = append(fake.provisionedVirtualGuestArgsForCall, struct {
+		logger lager.Logger
+		cid    int32
+	}{logger, cid})
+	fake.recordInvocation("ProvisionedVirtualGuest", []interface{}{logger, cid})
+	fake.provisionedVirtualGuestMutex.Unlock()
+	if fake.ProvisionedVirtualGuestStub != nil {
+		return fake.ProvisionedVirtualGuestStub(logger, cid)
+	} else {
+		return fake.provisionedVirtualGuestReturns.result1, fake.provisionedVirtualGuestReturns.result2
+	}
+}
+
+func (fake *FakeSoftLayerClient) ProvisionedVirtualGuestCallCount() int {
+	fake.provisionedVirtualGuestMutex.RLock()
+	defer fake.provisionedVirtualGuestMutex.RUnlock()
+	return len(fake.provisionedVirtualGuestArgsForCall)
+}
+
+func (fake *FakeSoftLayerClient) ProvisionedVirtualGuestArgsForCall(i int) (lager.Logger, int32) {
+	fake.provisionedVirtualGuestMutex.RLock()
+	defer fake.provisionedVirtualGuestMutex.RUnlock()
+	return fake.provisionedVirtualGuestArgsForCall[i].logger, fake.provisionedVirtualGuestArgsForCall[i].cid
+}
+
+func (fake *FakeSoftLayerClient) ProvisionedVirtualGuestReturns(result1 *models.VM, result2 error) {
+	fake.ProvisionedVirtualGuestStub = nil
+	fake.provisionedVirtualGuestReturns = struct {
+		result1 *models.VM
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSoftLayerClient) CancelVirtualGuest(logger lager.Logger, cid int32) error {
+	fake.cancelVirtualGuestMutex.Lock()
+	fake.cancelVirtualGuestArgsForCall = append(fake.cancelVirtualGuestArgsForCall, struct {
+		logger lager.Logger
+		cid    int32
+	}{logger, cid})
+	fake.recordInvocation("CancelVirtualGuest", []interface{}{logger, cid})
+	fake.cancelVirtualGuestMutex.Unlock()
+	if fake.CancelVirtualGuestStub != nil {
+		return fake.CancelVirtualGuestStub(logger, cid)
+	} else {
+		return fake.cancelVirtualGuestReturns.result1
+	}
+}
+
+func (fake *FakeSoftLayerClient) CancelVirtualGuestCallCount() int {
+	fake.cancelVirtualGuestMutex.RLock()
+	defer fake.cancelVirtualGuestMutex.RUnlock()
+	return len(fake.cancelVirtualGuestArgsForCall)
+}
+
+func (fake *FakeSoftLayerClient) CancelVirtualGuestArgsForCall(i int) (lager.Logger, int32) {
+	fake.cancelVirtualGuestMutex.RLock()
+	defer fake.cancelVirtualGuestMutex.RUnlock()
+	return fake.cancelVirtualGuestArgsForCall[i].logger, fake.cancelVirtualGuestArgsForCall[i].cid
+}
+
+func (fake *FakeSoftLayerClient) CancelVirtualGuestReturns(result1 error) {
+	fake.CancelVirtualGuestStub = nil
+	fake.cancelVirtualGuestReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSoftLayerClient) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.reloadOSMutex.RLock()
+	defer fake.reloadOSMutex.RUnlock()
+	fake.reloadCompletedMutex.RLock()
+	defer fake.reloadCompletedMutex.RUnlock()
+	fake.createVirtualGuestMutex.RLock()
+	defer fake.createVirtualGuestMutex.RUnlock()
+	fake.provisionedVirtualGuestMutex.RLock()
+	defer fake.provisionedVirtualGuestMutex.RUnlock()
+	fake.cancelVirtualGuestMutex.RLock()
+	defer fake.cancelVirtualGuestMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeSoftLayerClient) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ softlayer.SoftLayerClient = new(FakeSoftLayerClient)
@@ -0,0 +1,24 @@
+package vpslager
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/lager"
+)
+
+type loggerKey struct{}
+
+// NewContext returns a copy of ctx that carries logger, e.g. the logger of
+// the request ctx belongs to.
+func NewContext(ctx context.Context, logger lager.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, logger)
+}
+
+// FromContext returns the logger carried by ctx, or fallback when it carries
+// none.
+func FromContext(ctx context.Context, fallback lager.Logger) lager.Logger {
+	if logger, ok := ctx.Value(loggerKey{}).(lager.Logger); ok {
+		return logger
+	}
+	return fallback
+}
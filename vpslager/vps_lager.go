@@ -14,19 +14,36 @@ const (
 	FATAL = "fatal"
 )
 
-func New(component string,logLevel string) (lager.Logger, *lager.ReconfigurableSink) {
-	var minLagerLogLevel lager.LogLevel
-	switch logLevel {
-	case DEBUG:
-		minLagerLogLevel = lager.DEBUG
-	case INFO:
-		minLagerLogLevel = lager.INFO
-	case ERROR:
-		minLagerLogLevel = lager.ERROR
-	case FATAL:
-		minLagerLogLevel = lager.FATAL
-	default:
-		panic(fmt.Errorf("unknown log level: %s", logLevel))
+var logLevels = map[string]lager.LogLevel{
+	DEBUG: lager.DEBUG,
+	INFO:  lager.INFO,
+	ERROR: lager.ERROR,
+	FATAL: lager.FATAL,
+}
+
+// ParseLogLevel maps the name of a log level onto the lager level.
+func ParseLogLevel(logLevel string) (lager.LogLevel, error) {
+	level, ok := logLevels[logLevel]
+	if !ok {
+		return 0, fmt.Errorf("unknown log level: %s", logLevel)
+	}
+	return level, nil
+}
+
+// LogLevelName is the name ParseLogLevel maps onto level.
+func LogLevelName(level lager.LogLevel) string {
+	for name, l := range logLevels {
+		if l == level {
+			return name
+		}
+	}
+	return fmt.Sprintf("%d", level)
+}
+
+func New(component string, logLevel string) (lager.Logger, *lager.ReconfigurableSink) {
+	minLagerLogLevel, err := ParseLogLevel(logLevel)
+	if err != nil {
+		panic(err)
 	}
 
 	logger := lager.NewLogger(component)
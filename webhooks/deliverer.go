@@ -0,0 +1,205 @@
+package webhooks
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"github.com/jianqiu/vps/db"
+	"github.com/jianqiu/vps/models"
+)
+
+const (
+	SignatureHeader = "X-Vps-Signature"
+	EventHeader     = "X-Vps-Event"
+	DeliveryHeader  = "X-Vps-Delivery"
+
+	batchSize = 100
+)
+
+// RetryPolicy decides when a failed delivery is tried again. The wait starts
+// at InitialBackoff and doubles with every failed attempt, up to MaxBackoff.
+// After MaxAttempts failed attempts the delivery is dead.
+type RetryPolicy struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// Backoff returns how long to wait after the given number of failed
+// attempts.
+func (p RetryPolicy) Backoff(failedAttempts int) time.Duration {
+	backoff := p.InitialBackoff
+	for i := 1; i < failedAttempts; i++ {
+		backoff *= 2
+		if backoff >= p.MaxBackoff {
+			return p.MaxBackoff
+		}
+	}
+	if backoff > p.MaxBackoff {
+		return p.MaxBackoff
+	}
+	return backoff
+}
+
+// Sign returns the signature header value for payload: the hex encoded
+// HMAC-SHA256 of the payload keyed with the webhook secret.
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Deliverer posts the events queued in the outbox to the subscribed webhooks.
+type Deliverer struct {
+	logger     lager.Logger
+	db         db.WebhookDB
+	httpClient *http.Client
+	clock      clock.Clock
+	interval   time.Duration
+	policy     RetryPolicy
+}
+
+func NewDeliverer(
+	logger lager.Logger,
+	db db.WebhookDB,
+	httpClient *http.Client,
+	clock clock.Clock,
+	interval time.Duration,
+	policy RetryPolicy,
+) *Deliverer {
+	return &Deliverer{
+		logger:     logger,
+		db:         db,
+		httpClient: httpClient,
+		clock:      clock,
+		interval:   interval,
+		policy:     policy,
+	}
+}
+
+func (d *Deliverer) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	logger := d.logger.Session("webhook-deliverer")
+	logger.Info("starting", lager.Data{"interval": d.interval.String()})
+	defer logger.Info("exited")
+
+	ticker := d.clock.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	close(ready)
+
+	for {
+		select {
+		case <-ticker.C():
+			d.Deliver(logger)
+		case <-signals:
+			return nil
+		}
+	}
+}
+
+// Deliver runs a single pass: it fans the outbox out into deliveries and
+// attempts every delivery that is due.
+func (d *Deliverer) Deliver(logger lager.Logger) {
+	logger = logger.Session("deliver")
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	for {
+		dispatched, err := d.db.DispatchOutboxEvents(logger, batchSize)
+		if err != nil {
+			logger.Error("failed-to-dispatch-outbox-events", err)
+			break
+		}
+		if dispatched < batchSize {
+			break
+		}
+	}
+
+	deliveries, err := d.db.PendingWebhookDeliveries(logger, batchSize)
+	if err != nil {
+		logger.Error("failed-to-fetch-pending-deliveries", err)
+		return
+	}
+
+	secrets := map[string]string{}
+	for _, delivery := range deliveries {
+		secret, ok := secrets[delivery.WebhookID]
+		if !ok {
+			webhook, err := d.db.WebhookByID(logger, delivery.WebhookID)
+			if err != nil {
+				logger.Error("failed-to-fetch-webhook", err, lager.Data{"webhook-id": delivery.WebhookID})
+				if models.ErrResourceNotFound.Equal(err) {
+					d.fail(logger, delivery, err, true)
+				}
+				continue
+			}
+			secret = webhook.Secret
+			secrets[delivery.WebhookID] = secret
+		}
+
+		err := d.post(logger, delivery, secret)
+		if err != nil {
+			d.fail(logger, delivery, err, int(delivery.Attempts)+1 >= d.policy.MaxAttempts)
+			continue
+		}
+
+		err = d.db.CompleteWebhookDelivery(logger, delivery.ID)
+		if err != nil {
+			logger.Error("failed-to-complete-delivery", err, lager.Data{"id": delivery.ID})
+		}
+	}
+}
+
+func (d *Deliverer) post(logger lager.Logger, delivery *models.WebhookDelivery, secret string) error {
+	logger = logger.Session("post", lager.Data{"id": delivery.ID, "url": delivery.URL, "attempt": delivery.Attempts + 1})
+
+	payload := []byte(delivery.Payload)
+	req, err := http.NewRequest("POST", delivery.URL, bytes.NewReader(payload))
+	if err != nil {
+		logger.Error("failed-building-request", err)
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, string(delivery.EventType))
+	req.Header.Set(DeliveryHeader, delivery.ID)
+	req.Header.Set(SignatureHeader, Sign(secret, payload))
+
+	resp, err := d.httpClient.Do(req)
+	if err != nil {
+		logger.Error("failed-request", err)
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		err = fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+		logger.Error("failed-request", err)
+		return err
+	}
+
+	logger.Info("delivered")
+	return nil
+}
+
+func (d *Deliverer) fail(logger lager.Logger, delivery *models.WebhookDelivery, reason error, dead bool) {
+	nextAttemptAt := d.clock.Now().Add(d.policy.Backoff(int(delivery.Attempts) + 1))
+	if dead {
+		logger.Info("delivery-dead", lager.Data{"id": delivery.ID, "attempts": delivery.Attempts + 1})
+	}
+
+	err := d.db.FailWebhookDelivery(logger, delivery.ID, reason.Error(), nextAttemptAt, dead)
+	if err != nil {
+		logger.Error("failed-to-record-failure", err, lager.Data{"id": delivery.ID})
+	}
+}
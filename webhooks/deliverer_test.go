@@ -0,0 +1,216 @@
+package webhooks_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/jianqiu/vps/db/dbfakes"
+	"github.com/jianqiu/vps/models"
+	"github.com/jianqiu/vps/webhooks"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/tedsuo/ifrit"
+)
+
+type receivedRequest struct {
+	header http.Header
+	body   string
+}
+
+var _ = Describe("Deliverer", func() {
+	var (
+		logger        *lagertest.TestLogger
+		fakeWebhookDB *dbfakes.FakeWebhookDB
+		receiver      *httptest.Server
+		statusCode    int
+		received      []receivedRequest
+		receivedLock  sync.Mutex
+		policy        webhooks.RetryPolicy
+		delivery      *models.WebhookDelivery
+		deliverer     *webhooks.Deliverer
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		fakeWebhookDB = new(dbfakes.FakeWebhookDB)
+		statusCode = http.StatusOK
+		received = nil
+
+		receiver = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := ioutil.ReadAll(r.Body)
+			receivedLock.Lock()
+			received = append(received, receivedRequest{header: r.Header, body: string(body)})
+			receivedLock.Unlock()
+			w.WriteHeader(statusCode)
+		}))
+
+		policy = webhooks.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Second, MaxBackoff: time.Minute}
+		delivery = &models.WebhookDelivery{
+			ID:        "delivery-1",
+			WebhookID: "webhook-1",
+			EventType: models.WebhookEventTypeVMOrdered,
+			URL:       receiver.URL + "/hook",
+			Payload:   `{"type":"vm-ordered","vm":{"cid":1234567}}`,
+			State:     models.WebhookDeliveryStatePending,
+		}
+		fakeWebhookDB.PendingWebhookDeliveriesReturns([]*models.WebhookDelivery{delivery}, nil)
+		fakeWebhookDB.WebhookByIDReturns(&models.Webhook{ID: "webhook-1", Secret: "s3cret"}, nil)
+	})
+
+	AfterEach(func() {
+		receiver.Close()
+	})
+
+	JustBeforeEach(func() {
+		deliverer = webhooks.NewDeliverer(logger, fakeWebhookDB, http.DefaultClient, clock.NewClock(), 10*time.Millisecond, policy)
+	})
+
+	Describe("Deliver", func() {
+		JustBeforeEach(func() {
+			deliverer.Deliver(logger)
+		})
+
+		It("dispatches the outbox before delivering", func() {
+			Expect(fakeWebhookDB.DispatchOutboxEventsCallCount()).To(Equal(1))
+		})
+
+		It("posts the signed payload to the webhook", func() {
+			Expect(received).To(HaveLen(1))
+			Expect(received[0].body).To(Equal(delivery.Payload))
+			Expect(received[0].header.Get("Content-Type")).To(Equal("application/json"))
+			Expect(received[0].header.Get(webhooks.EventHeader)).To(Equal("vm-ordered"))
+			Expect(received[0].header.Get(webhooks.DeliveryHeader)).To(Equal("delivery-1"))
+			Expect(received[0].header.Get(webhooks.SignatureHeader)).To(Equal(webhooks.Sign("s3cret", []byte(delivery.Payload))))
+		})
+
+		It("marks the delivery as delivered", func() {
+			Expect(fakeWebhookDB.CompleteWebhookDeliveryCallCount()).To(Equal(1))
+			_, id := fakeWebhookDB.CompleteWebhookDeliveryArgsForCall(0)
+			Expect(id).To(Equal("delivery-1"))
+			Expect(fakeWebhookDB.FailWebhookDeliveryCallCount()).To(Equal(0))
+		})
+
+		Context("when the outbox holds more than one batch", func() {
+			BeforeEach(func() {
+				calls := 0
+				fakeWebhookDB.DispatchOutboxEventsStub = func(_ lager.Logger, limit int) (int, error) {
+					calls++
+					if calls == 1 {
+						return limit, nil
+					}
+					return 3, nil
+				}
+			})
+
+			It("keeps dispatching until the outbox is drained", func() {
+				Expect(fakeWebhookDB.DispatchOutboxEventsCallCount()).To(Equal(2))
+			})
+		})
+
+		Context("when the webhook fails", func() {
+			BeforeEach(func() {
+				statusCode = http.StatusInternalServerError
+				delivery.Attempts = 1
+			})
+
+			It("schedules another attempt with exponential backoff", func() {
+				Expect(fakeWebhookDB.FailWebhookDeliveryCallCount()).To(Equal(1))
+				_, id, reason, nextAttemptAt, dead := fakeWebhookDB.FailWebhookDeliveryArgsForCall(0)
+				Expect(id).To(Equal("delivery-1"))
+				Expect(reason).To(ContainSubstring("500"))
+				Expect(nextAttemptAt).To(BeTemporally("~", time.Now().Add(2*time.Second), time.Second))
+				Expect(dead).To(BeFalse())
+				Expect(fakeWebhookDB.CompleteWebhookDeliveryCallCount()).To(Equal(0))
+			})
+
+			Context("for the last allowed time", func() {
+				BeforeEach(func() {
+					delivery.Attempts = 2
+				})
+
+				It("moves the delivery to the dead letters", func() {
+					_, _, _, _, dead := fakeWebhookDB.FailWebhookDeliveryArgsForCall(0)
+					Expect(dead).To(BeTrue())
+				})
+			})
+		})
+
+		Context("when the webhook cannot be reached", func() {
+			BeforeEach(func() {
+				delivery.URL = "http://127.0.0.1:1/hook"
+			})
+
+			It("records the failure", func() {
+				Expect(fakeWebhookDB.FailWebhookDeliveryCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the webhook was deleted", func() {
+			BeforeEach(func() {
+				fakeWebhookDB.WebhookByIDReturns(nil, models.ErrResourceNotFound)
+			})
+
+			It("moves the delivery to the dead letters without posting", func() {
+				Expect(received).To(BeEmpty())
+				_, _, _, _, dead := fakeWebhookDB.FailWebhookDeliveryArgsForCall(0)
+				Expect(dead).To(BeTrue())
+			})
+		})
+
+		Context("when the webhook cannot be looked up", func() {
+			BeforeEach(func() {
+				fakeWebhookDB.WebhookByIDReturns(nil, errors.New("kaboom"))
+			})
+
+			It("leaves the delivery pending", func() {
+				Expect(received).To(BeEmpty())
+				Expect(fakeWebhookDB.FailWebhookDeliveryCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("Run", func() {
+		var process ifrit.Process
+
+		JustBeforeEach(func() {
+			process = ifrit.Invoke(deliverer)
+		})
+
+		AfterEach(func() {
+			process.Signal(os.Interrupt)
+			Eventually(process.Wait()).Should(Receive(BeNil()))
+		})
+
+		It("delivers on every tick", func() {
+			Eventually(fakeWebhookDB.CompleteWebhookDeliveryCallCount).Should(BeNumerically(">=", 2))
+		})
+	})
+})
+
+var _ = Describe("RetryPolicy", func() {
+	It("doubles the backoff up to the maximum", func() {
+		policy := webhooks.RetryPolicy{MaxAttempts: 10, InitialBackoff: time.Second, MaxBackoff: 10 * time.Second}
+		Expect(policy.Backoff(1)).To(Equal(time.Second))
+		Expect(policy.Backoff(2)).To(Equal(2 * time.Second))
+		Expect(policy.Backoff(4)).To(Equal(8 * time.Second))
+		Expect(policy.Backoff(5)).To(Equal(10 * time.Second))
+		Expect(policy.Backoff(50)).To(Equal(10 * time.Second))
+	})
+})
+
+var _ = Describe("Sign", func() {
+	It("produces the hex HMAC-SHA256 of the payload", func() {
+		Expect(webhooks.Sign("key", []byte("The quick brown fox jumps over the lazy dog"))).To(Equal(
+			"sha256=f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8"))
+	})
+})